@@ -0,0 +1,75 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// APIKey 开放平台 API 密钥
+type APIKey struct {
+	ID         int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	KeyID      string     `gorm:"column:key_id;size:64;uniqueIndex;not null"`
+	UserID     int64      `gorm:"column:user_id;index;not null"`
+	Name       string     `gorm:"column:name;size:64;not null"`
+	SecretHash string     `gorm:"column:secret_hash;size:255;not null"` // 密钥 SHA-256 摘要，加密存储
+	Scopes     string     `gorm:"column:scopes;size:512"`               // 空格分隔
+	ExpiresAt  time.Time  `gorm:"column:expires_at;not null"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for APIKey model
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// Active 密钥未吊销且未过期
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && now.Before(k.ExpiresAt)
+}
+
+// CreateAPIKey 创建 API 密钥
+func CreateAPIKey(key *APIKey) error {
+	return DB.Create(key).Error
+}
+
+// GetAPIKey 通过 key_id 获取 API 密钥
+func GetAPIKey(keyID string) (*APIKey, error) {
+	var k APIKey
+	err := DB.Where("key_id = ?", keyID).First(&k).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &k, err
+}
+
+// ListAPIKeys 获取用户的全部 API 密钥，按创建时间倒序
+func ListAPIKeys(userID int64) ([]*APIKey, error) {
+	var keys []*APIKey
+	err := DB.Where("user_id = ?", userID).Order("created_at DESC, id DESC").Find(&keys).Error
+	return keys, err
+}
+
+// CountActiveAPIKeys 统计用户未吊销且未过期的 API 密钥数量
+func CountActiveAPIKeys(userID int64, now time.Time) (int64, error) {
+	var count int64
+	err := DB.Model(&APIKey{}).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+		Count(&count).Error
+	return count, err
+}
+
+// RevokeAPIKey 吊销用户的 API 密钥，密钥不存在或已吊销时返回 false
+func RevokeAPIKey(userID int64, keyID string) (bool, error) {
+	result := DB.Model(&APIKey{}).
+		Where("user_id = ? AND key_id = ? AND revoked_at IS NULL", userID, keyID).
+		Update("revoked_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+// TouchAPIKey 更新 API 密钥的最近使用时间
+func TouchAPIKey(keyID string, usedAt time.Time) error {
+	return DB.Model(&APIKey{}).Where("key_id = ?", keyID).Update("last_used_at", usedAt).Error
+}
@@ -0,0 +1,63 @@
+package mysql
+
+import "time"
+
+// AuditLog 安全审计日志，只追加不修改
+type AuditLog struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID     int64     `gorm:"column:user_id;not null;default:0"`
+	Username   string    `gorm:"column:username;size:64"`
+	Event      string    `gorm:"column:event;size:32;not null"`
+	Outcome    string    `gorm:"column:outcome;size:16;not null"`
+	Reason     string    `gorm:"column:reason;size:255"`
+	OperatorID int64     `gorm:"column:operator_id;not null;default:0"`
+	IP         string    `gorm:"column:ip;size:64"`
+	UserAgent  string    `gorm:"column:user_agent;size:512"`
+	TraceID    string    `gorm:"column:trace_id;size:64"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+// TableName specifies the table name for AuditLog model
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// AuditLogFilter 审计日志查询条件，零值字段不参与过滤
+type AuditLogFilter struct {
+	UserID int64
+	Event  string
+	Since  time.Time // 包含
+	Until  time.Time // 不包含
+	Offset int
+	Limit  int
+}
+
+// CreateAuditLogs 批量写入审计日志
+func CreateAuditLogs(logs []*AuditLog) error {
+	return DB.CreateInBatches(logs, 100).Error
+}
+
+// ListAuditLogs 按条件分页查询审计日志，按时间倒序，同时返回总数
+func ListAuditLogs(filter AuditLogFilter) ([]*AuditLog, int64, error) {
+	query := DB.Model(&AuditLog{})
+	if filter.UserID != 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Event != "" {
+		query = query.Where("event = ?", filter.Event)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("created_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("created_at < ?", filter.Until)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var logs []*AuditLog
+	err := query.Order("created_at DESC, id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&logs).Error
+	return logs, total, err
+}
@@ -0,0 +1,109 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 用户状态，与 service.UserStatusNormal / UserStatusBanned 一致
+// 封禁和解封只在这两个状态之间切换，不影响注销中和已注销的用户
+const (
+	userStatusNormal = 1
+	userStatusBanned = 2
+)
+
+// 解封原因
+const (
+	BanLiftReasonExpired    = "expired"    // 到期自动解封
+	BanLiftReasonSuperseded = "superseded" // 被新的封禁替换
+)
+
+// UserBan 用户封禁记录，LiftedAt 为空表示封禁仍然生效
+type UserBan struct {
+	ID         int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID     int64      `gorm:"column:user_id;index;not null"`
+	Reason     string     `gorm:"column:reason;size:255;not null"`
+	OperatorID int64      `gorm:"column:operator_id;not null"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at;index"` // 为空表示永久封禁
+	LiftedAt   *time.Time `gorm:"column:lifted_at"`
+	LiftedBy   int64      `gorm:"column:lifted_by"` // 0 表示到期自动解封
+	LiftReason string     `gorm:"column:lift_reason;size:255"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for UserBan model
+func (UserBan) TableName() string {
+	return "user_bans"
+}
+
+// CreateUserBan 封禁用户：结束仍然生效的旧封禁，保存新封禁并更新用户状态
+func CreateUserBan(ban *UserBan) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if err := liftActiveBans(tx, ban.UserID, ban.OperatorID, BanLiftReasonSuperseded, ban.CreatedAt); err != nil {
+			return err
+		}
+		if err := tx.Create(ban).Error; err != nil {
+			return err
+		}
+		return tx.Model(&User{}).Where("id = ? AND status IN ?", ban.UserID, []int{userStatusNormal, userStatusBanned}).
+			Update("status", userStatusBanned).Error
+	})
+}
+
+// LiftUserBans 解封用户：结束所有生效的封禁并恢复用户状态
+func LiftUserBans(userID, liftedBy int64, reason string, at time.Time) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if err := liftActiveBans(tx, userID, liftedBy, reason, at); err != nil {
+			return err
+		}
+		return tx.Model(&User{}).Where("id = ? AND status IN ?", userID, []int{userStatusNormal, userStatusBanned}).
+			Update("status", userStatusNormal).Error
+	})
+}
+
+// ListExpiredUserBans 查询已到期但尚未解封的封禁
+func ListExpiredUserBans(now time.Time, limit int) ([]*UserBan, error) {
+	var bans []*UserBan
+	err := DB.Where("lifted_at IS NULL AND expires_at IS NOT NULL AND expires_at <= ?", now).
+		Order("expires_at").Limit(limit).Find(&bans).Error
+	return bans, err
+}
+
+// LiftExpiredUserBan 到期自动解封，封禁已被解除或替换时返回 false，多个实例同时执行时只有一个生效
+func LiftExpiredUserBan(id int64, at time.Time) (bool, error) {
+	lifted := false
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		var ban UserBan
+		if err := tx.Where("id = ?", id).First(&ban).Error; err != nil {
+			return err
+		}
+		result := tx.Model(&UserBan{}).Where("id = ? AND lifted_at IS NULL", id).Updates(map[string]interface{}{
+			"lifted_at":   at,
+			"lifted_by":   0,
+			"lift_reason": BanLiftReasonExpired,
+		})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		lifted = true
+		return tx.Model(&User{}).Where("id = ? AND status = ?", ban.UserID, userStatusBanned).
+			Update("status", userStatusNormal).Error
+	})
+	return lifted, err
+}
+
+// ListUserBans 查询用户的封禁记录，按封禁时间倒序
+func ListUserBans(userID int64) ([]*UserBan, error) {
+	var bans []*UserBan
+	err := DB.Where("user_id = ?", userID).Order("created_at DESC, id DESC").Find(&bans).Error
+	return bans, err
+}
+
+func liftActiveBans(tx *gorm.DB, userID, liftedBy int64, reason string, at time.Time) error {
+	return tx.Model(&UserBan{}).Where("user_id = ? AND lifted_at IS NULL", userID).Updates(map[string]interface{}{
+		"lifted_at":   at,
+		"lifted_by":   liftedBy,
+		"lift_reason": reason,
+	}).Error
+}
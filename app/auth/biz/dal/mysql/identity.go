@@ -0,0 +1,38 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// UserIdentity 外部身份提供方账号与本地用户的绑定关系
+type UserIdentity struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null;index"`
+	Provider  string    `gorm:"column:provider;size:64;not null;uniqueIndex:uk_provider_subject"`
+	Subject   string    `gorm:"column:subject;size:255;not null;uniqueIndex:uk_provider_subject"` // 提供方 ID 令牌中的 sub
+	Email     string    `gorm:"column:email;size:255"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for UserIdentity model
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+// GetUserIdentity 通过提供方和 sub 获取绑定关系
+func GetUserIdentity(provider, subject string) (*UserIdentity, error) {
+	var identity UserIdentity
+	err := DB.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &identity, err
+}
+
+// CreateUserIdentity 创建绑定关系
+func CreateUserIdentity(identity *UserIdentity) error {
+	return DB.Create(identity).Error
+}
@@ -0,0 +1,107 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OAuthClient 第三方应用
+type OAuthClient struct {
+	ID               int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	ClientID         string    `gorm:"column:client_id;size:64;uniqueIndex;not null"`
+	ClientSecretHash string    `gorm:"column:client_secret_hash;size:255"` // 公开客户端为空
+	Name             string    `gorm:"column:name;size:128;not null"`
+	RedirectURIs     string    `gorm:"column:redirect_uris;type:text"` // 空格分隔
+	GrantTypes       string    `gorm:"column:grant_types;size:255"`    // 空格分隔
+	Scopes           string    `gorm:"column:scopes;size:512"`         // 允许申请的 scope，空格分隔
+	OwnerID          int64     `gorm:"column:owner_id;index"`          // 注册该应用的用户
+	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt        time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for OAuthClient model
+func (OAuthClient) TableName() string {
+	return "oauth_clients"
+}
+
+// Public 公开客户端（SPA、移动端）没有密钥
+func (c *OAuthClient) Public() bool {
+	return c.ClientSecretHash == ""
+}
+
+// OAuthConsent 用户对第三方应用的授权记录
+type OAuthConsent struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null;uniqueIndex:uk_user_client"`
+	ClientID  string    `gorm:"column:client_id;size:64;not null;uniqueIndex:uk_user_client"`
+	Scopes    string    `gorm:"column:scopes;size:512"` // 已授权的 scope，空格分隔
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for OAuthConsent model
+func (OAuthConsent) TableName() string {
+	return "oauth_consents"
+}
+
+// CreateOAuthClient 创建第三方应用
+func CreateOAuthClient(client *OAuthClient) error {
+	return DB.Create(client).Error
+}
+
+// GetOAuthClient 通过 client_id 获取第三方应用
+func GetOAuthClient(clientID string) (*OAuthClient, error) {
+	var c OAuthClient
+	err := DB.Where("client_id = ?", clientID).First(&c).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &c, err
+}
+
+// GetOAuthConsent 获取用户对应用的授权记录
+func GetOAuthConsent(userID int64, clientID string) (*OAuthConsent, error) {
+	var c OAuthConsent
+	err := DB.Where("user_id = ? AND client_id = ?", userID, clientID).First(&c).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &c, err
+}
+
+// SaveOAuthConsent 保存授权记录，已存在时更新 scope
+func SaveOAuthConsent(consent *OAuthConsent) error {
+	return DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "client_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"scopes", "updated_at"}),
+	}).Create(consent).Error
+}
+
+// ListOAuthConsents 获取用户的全部授权记录
+func ListOAuthConsents(userID int64) ([]*OAuthConsent, error) {
+	var consents []*OAuthConsent
+	err := DB.Where("user_id = ?", userID).Order("updated_at DESC").Find(&consents).Error
+	return consents, err
+}
+
+// DeleteOAuthConsent 删除授权记录
+func DeleteOAuthConsent(userID int64, clientID string) error {
+	return DB.Where("user_id = ? AND client_id = ?", userID, clientID).Delete(&OAuthConsent{}).Error
+}
+
+// RevokeClientTokens 吊销用户在某个应用下的全部令牌，返回此前未吊销的记录
+func RevokeClientTokens(userID int64, clientID string) ([]*Token, error) {
+	var tokens []*Token
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND client_id = ? AND revoked_at IS NULL", userID, clientID).
+			Find(&tokens).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Token{}).
+			Where("user_id = ? AND client_id = ? AND revoked_at IS NULL", userID, clientID).
+			Update("revoked_at", time.Now()).Error
+	})
+	return tokens, err
+}
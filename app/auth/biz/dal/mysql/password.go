@@ -0,0 +1,40 @@
+package mysql
+
+import "time"
+
+// PasswordHistory 用户使用过的密码哈希
+type PasswordHistory struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null;index"`
+	Password  string    `gorm:"column:password;size:255;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for PasswordHistory model
+func (PasswordHistory) TableName() string {
+	return "password_histories"
+}
+
+// GetPasswordHistory 获取用户最近使用过的密码哈希，按时间倒序
+func GetPasswordHistory(userID int64, limit int) ([]string, error) {
+	var hashes []string
+	err := DB.Model(&PasswordHistory{}).Where("user_id = ?", userID).
+		Order("id DESC").Limit(limit).Pluck("password", &hashes).Error
+	return hashes, err
+}
+
+// AddPasswordHistory 记录用户的新密码，只保留最近 keep 条
+func AddPasswordHistory(userID int64, password string, keep int) error {
+	if err := DB.Create(&PasswordHistory{UserID: userID, Password: password}).Error; err != nil {
+		return err
+	}
+	var stale []int64
+	if err := DB.Model(&PasswordHistory{}).Where("user_id = ?", userID).
+		Order("id DESC").Offset(keep).Limit(1000).Pluck("id", &stale).Error; err != nil {
+		return err
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	return DB.Where("id IN ?", stale).Delete(&PasswordHistory{}).Error
+}
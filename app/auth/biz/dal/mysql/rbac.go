@@ -0,0 +1,109 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Role 角色
+type Role struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Name        string    `gorm:"column:name;size:64;uniqueIndex;not null"`
+	Description string    `gorm:"column:description;size:255"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for Role model
+func (Role) TableName() string {
+	return "roles"
+}
+
+// Permission 权限，名称格式为 资源:操作
+type Permission struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Name        string    `gorm:"column:name;size:64;uniqueIndex;not null"`
+	Description string    `gorm:"column:description;size:255"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for Permission model
+func (Permission) TableName() string {
+	return "permissions"
+}
+
+// RolePermission 角色拥有的权限
+type RolePermission struct {
+	RoleID       int64 `gorm:"column:role_id;primaryKey"`
+	PermissionID int64 `gorm:"column:permission_id;primaryKey"`
+}
+
+// TableName specifies the table name for RolePermission model
+func (RolePermission) TableName() string {
+	return "role_permissions"
+}
+
+// UserRole 用户被授予的角色
+type UserRole struct {
+	UserID    int64     `gorm:"column:user_id;primaryKey"`
+	RoleID    int64     `gorm:"column:role_id;primaryKey"`
+	GrantedBy int64     `gorm:"column:granted_by;not null;default:0"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for UserRole model
+func (UserRole) TableName() string {
+	return "user_roles"
+}
+
+// GetRoleByName 通过名称获取角色
+func GetRoleByName(name string) (*Role, error) {
+	var role Role
+	err := DB.Where("name = ?", name).First(&role).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &role, err
+}
+
+// GetUserRoles 获取用户被授予的角色名称
+func GetUserRoles(userID int64) ([]string, error) {
+	var names []string
+	err := DB.Model(&Role{}).
+		Joins("JOIN user_roles ON user_roles.role_id = roles.id").
+		Where("user_roles.user_id = ?", userID).
+		Order("roles.name").
+		Pluck("roles.name", &names).Error
+	return names, err
+}
+
+// GetRolePermissions 获取角色拥有的权限名称，已去重
+func GetRolePermissions(roles []string) ([]string, error) {
+	var names []string
+	if len(roles) == 0 {
+		return names, nil
+	}
+	err := DB.Model(&Permission{}).
+		Joins("JOIN role_permissions ON role_permissions.permission_id = permissions.id").
+		Joins("JOIN roles ON roles.id = role_permissions.role_id").
+		Where("roles.name IN ?", roles).
+		Distinct().
+		Order("permissions.name").
+		Pluck("permissions.name", &names).Error
+	return names, err
+}
+
+// AssignUserRole 授予用户角色，已拥有时不做修改
+func AssignUserRole(userID, roleID, grantedBy int64) error {
+	return DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&UserRole{
+		UserID:    userID,
+		RoleID:    roleID,
+		GrantedBy: grantedBy,
+	}).Error
+}
+
+// RevokeUserRole 撤销用户角色
+func RevokeUserRole(userID, roleID int64) error {
+	return DB.Where("user_id = ? AND role_id = ?", userID, roleID).Delete(&UserRole{}).Error
+}
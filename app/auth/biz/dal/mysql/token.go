@@ -4,16 +4,29 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+
+	"TikTokMall/pkg/tokenhash"
 )
 
-// Token 令牌模型
+// Token 令牌模型，访问令牌和刷新令牌只保存 SHA-256 摘要
 type Token struct {
-	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
-	UserID       int64     `gorm:"column:user_id;index;not null"`
-	Token        string    `gorm:"column:token;size:512;not null;index"`
-	RefreshToken string    `gorm:"column:refresh_token;size:512;index"`
-	ExpiredAt    time.Time `gorm:"column:expired_at;not null"`
-	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+	ID               int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID           int64      `gorm:"column:user_id;index;not null"`
+	Token            string     `gorm:"column:token;size:1024;not null;index"` // 访问令牌摘要
+	RefreshToken     string     `gorm:"column:refresh_token;size:512;index"`   // 刷新令牌摘要
+	FamilyID         string     `gorm:"column:family_id;size:64;index"`        // 令牌族，同一次登录轮换出的令牌共享
+	ParentID         int64      `gorm:"column:parent_id"`                      // 轮换前的令牌记录
+	ExpiredAt        time.Time  `gorm:"column:expired_at;not null"`
+	RefreshExpiredAt *time.Time `gorm:"column:refresh_expired_at"`
+	UsedAt           *time.Time `gorm:"column:used_at"`                 // 刷新令牌已被使用（已轮换）
+	RevokedAt        *time.Time `gorm:"column:revoked_at"`              // 令牌族被吊销
+	ClientID         string     `gorm:"column:client_id;size:64;index"` // 第三方应用签发的令牌
+	Scope            string     `gorm:"column:scope;size:512"`
+	DeviceName       string     `gorm:"column:device_name;size:128"` // 登录设备，由客户端提供
+	UserAgent        string     `gorm:"column:user_agent;size:512"`
+	IP               string     `gorm:"column:ip;size:64"`
+	LastSeenAt       *time.Time `gorm:"column:last_seen_at"`
+	CreatedAt        time.Time  `gorm:"column:created_at;autoCreateTime"`
 }
 
 // TableName specifies the table name for Token model
@@ -26,34 +39,93 @@ func CreateToken(token *Token) error {
 	return DB.Create(token).Error
 }
 
-// GetTokenByToken 通过Token获取记录
-func GetTokenByToken(token string) (*Token, error) {
+// GetTokenByToken 通过访问令牌摘要获取记录
+func GetTokenByToken(digest string) (*Token, error) {
+	var t Token
+	err := DB.Where("token = ?", digest).First(&t).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &t, err
+}
+
+// GetTokenByRefreshToken 通过刷新令牌摘要获取记录
+func GetTokenByRefreshToken(digest string) (*Token, error) {
 	var t Token
-	err := DB.Where("token = ?", token).First(&t).Error
+	err := DB.Where("refresh_token = ?", digest).First(&t).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
 	return &t, err
 }
 
-// GetTokenByRefreshToken 通过RefreshToken获取记录
-func GetTokenByRefreshToken(refreshToken string) (*Token, error) {
+// GetTokenByID 通过ID获取记录
+func GetTokenByID(id int64) (*Token, error) {
 	var t Token
-	err := DB.Where("refresh_token = ?", refreshToken).First(&t).Error
+	err := DB.Where("id = ?", id).First(&t).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
 	return &t, err
 }
 
+// ListUserSessions 获取用户的活跃会话，每个令牌族只返回最新一次轮换的记录，不包含第三方应用令牌
+func ListUserSessions(userID int64) ([]*Token, error) {
+	var tokens []*Token
+	now := time.Now()
+	err := DB.Where("user_id = ? AND (client_id = '' OR client_id IS NULL) AND used_at IS NULL AND revoked_at IS NULL", userID).
+		Where("refresh_expired_at > ? OR (refresh_expired_at IS NULL AND expired_at > ?)", now, now).
+		Order("COALESCE(last_seen_at, created_at) DESC").
+		Find(&tokens).Error
+	return tokens, err
+}
+
+// TouchToken 更新令牌的最近使用时间
+func TouchToken(digest string, seenAt time.Time) error {
+	return DB.Model(&Token{}).Where("token = ?", digest).Update("last_seen_at", seenAt).Error
+}
+
+// MarkRefreshTokenUsed 标记刷新令牌已使用，并发使用同一刷新令牌时只有一次能成功
+func MarkRefreshTokenUsed(id int64, familyID string) (bool, error) {
+	result := DB.Model(&Token{}).
+		Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"used_at":   time.Now(),
+			"family_id": familyID,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// RevokeTokenFamily 吊销令牌族中的全部令牌，返回此前未吊销的记录
+func RevokeTokenFamily(familyID string) ([]*Token, error) {
+	var tokens []*Token
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("family_id = ? AND revoked_at IS NULL", familyID).
+			Find(&tokens).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Token{}).
+			Where("family_id = ? AND revoked_at IS NULL", familyID).
+			Update("revoked_at", time.Now()).Error
+	})
+	return tokens, err
+}
+
 // DeleteToken 删除Token记录
-func DeleteToken(token string) error {
-	return DB.Where("token = ?", token).Delete(&Token{}).Error
+func DeleteToken(digest string) error {
+	return DB.Where("token = ?", digest).Delete(&Token{}).Error
 }
 
-// DeleteTokenByUserID 删除用户的所有Token
-func DeleteTokenByUserID(userID int64) error {
-	return DB.Where("user_id = ?", userID).Delete(&Token{}).Error
+// DeleteTokenByUserID 删除用户的所有Token，返回被删除的记录
+func DeleteTokenByUserID(userID int64) ([]*Token, error) {
+	var tokens []*Token
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Find(&tokens).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&Token{}).Error
+	})
+	return tokens, err
 }
 
 // UpdateToken 更新Token记录
@@ -78,10 +150,47 @@ func GetValidTokenByUserID(userID int64) (*Token, error) {
 }
 
 // IsTokenValid 检查Token是否有效
-func IsTokenValid(token string) (bool, error) {
+func IsTokenValid(digest string) (bool, error) {
 	var count int64
 	err := DB.Model(&Token{}).
-		Where("token = ? AND expired_at > ?", token, time.Now()).
+		Where("token = ? AND expired_at > ?", digest, time.Now()).
 		Count(&count).Error
 	return count > 0, err
 }
+
+// HashPlaintextTokens 将迁移前明文保存的令牌替换为摘要，可重复执行，返回迁移的记录数
+func HashPlaintextTokens(batchSize int) (int, error) {
+	migrated := 0
+	var lastID int64
+	for {
+		var tokens []*Token
+		err := DB.Select("id", "token", "refresh_token").
+			Where("id > ?", lastID).
+			Where("CHAR_LENGTH(token) <> ? OR CHAR_LENGTH(refresh_token) NOT IN (0, ?)", tokenhash.DigestLength, tokenhash.DigestLength).
+			Order("id").
+			Limit(batchSize).
+			Find(&tokens).Error
+		if err != nil || len(tokens) == 0 {
+			return migrated, err
+		}
+		for _, t := range tokens {
+			lastID = t.ID
+			updates := map[string]interface{}{}
+			if !tokenhash.IsDigest(t.Token) {
+				updates["token"] = tokenhash.Digest(t.Token)
+			}
+			if t.RefreshToken != "" && !tokenhash.IsDigest(t.RefreshToken) {
+				updates["refresh_token"] = tokenhash.Digest(t.RefreshToken)
+			}
+			if len(updates) == 0 {
+				continue
+			}
+			// 以原值为条件，多个实例同时迁移时不会对摘要再次计算摘要
+			result := DB.Model(&Token{}).Where("id = ? AND token = ?", t.ID, t.Token).Updates(updates)
+			if result.Error != nil {
+				return migrated, result.Error
+			}
+			migrated += int(result.RowsAffected)
+		}
+	}
+}
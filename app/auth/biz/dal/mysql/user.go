@@ -16,6 +16,15 @@ type User struct {
 	Status    int       `gorm:"column:status;default:1"`
 	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	// 联系方式验证时间，为空表示未验证
+	EmailVerifiedAt *time.Time `gorm:"column:email_verified_at"`
+	PhoneVerifiedAt *time.Time `gorm:"column:phone_verified_at"`
+
+	// 二次验证 (TOTP)
+	TwoFactorEnabled       bool   `gorm:"column:two_factor_enabled;default:false"`
+	TwoFactorSecret        string `gorm:"column:two_factor_secret;size:255"`          // AES-GCM 加密后的密钥
+	TwoFactorRecoveryCodes string `gorm:"column:two_factor_recovery_codes;type:text"` // 恢复码 SHA-256 摘要的 JSON 数组
 }
 
 // TableName specifies the table name for User model
@@ -48,6 +57,26 @@ func GetUserByID(id int64) (*User, error) {
 	return &user, err
 }
 
+// GetUserByEmail 通过邮箱获取用户
+func GetUserByEmail(email string) (*User, error) {
+	var user User
+	err := DB.Where("email = ?", email).First(&user).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &user, err
+}
+
+// GetUserByPhone 通过手机号获取用户
+func GetUserByPhone(phone string) (*User, error) {
+	var user User
+	err := DB.Where("phone = ?", phone).First(&user).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &user, err
+}
+
 // UpdateUser 更新用户信息
 func UpdateUser(user *User) error {
 	return DB.Save(user).Error
@@ -85,3 +114,34 @@ func CheckPhoneExists(phone string) (bool, error) {
 	err := DB.Model(&User{}).Where("phone = ?", phone).Count(&count).Error
 	return count > 0, err
 }
+
+// MarkEmailVerified 标记邮箱已验证，邮箱已变更时不更新，返回是否更新成功
+func MarkEmailVerified(userID int64, email string) (bool, error) {
+	result := DB.Model(&User{}).Where("id = ? AND email = ?", userID, email).
+		Update("email_verified_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+// MarkPhoneVerified 标记手机号已验证，手机号已变更时不更新，返回是否更新成功
+func MarkPhoneVerified(userID int64, phone string) (bool, error) {
+	result := DB.Model(&User{}).Where("id = ? AND phone = ?", userID, phone).
+		Update("phone_verified_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+// UpdateUserTwoFactor 更新用户二次验证配置
+func UpdateUserTwoFactor(userID int64, enabled bool, secret, recoveryCodes string) error {
+	return DB.Model(&User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"two_factor_enabled":        enabled,
+		"two_factor_secret":         secret,
+		"two_factor_recovery_codes": recoveryCodes,
+	}).Error
+}
+
+// ReplaceRecoveryCodes 仅在当前恢复码仍为 current 时替换为 remaining，返回是否更新成功
+// 并发使用同一恢复码时只有一个请求能更新成功
+func ReplaceRecoveryCodes(userID int64, current, remaining string) (bool, error) {
+	result := DB.Model(&User{}).Where("id = ? AND two_factor_recovery_codes = ?", userID, current).
+		Update("two_factor_recovery_codes", remaining)
+	return result.RowsAffected > 0, result.Error
+}
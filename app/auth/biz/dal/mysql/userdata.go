@@ -0,0 +1,92 @@
+package mysql
+
+import (
+	"gorm.io/gorm"
+)
+
+// UserData 认证服务保存的用户个人数据，用于个人数据导出
+type UserData struct {
+	User       *User
+	Identities []*UserIdentity
+	Passkeys   []*WebAuthnCredential
+	APIKeys    []*APIKey
+	Consents   []*OAuthConsent
+	Roles      []string
+	Bans       []*UserBan
+	Sessions   []*Token
+	AuditLogs  []*AuditLog
+}
+
+// GetUserData 查询用户的全部个人数据，用户不存在时 User 为 nil
+func GetUserData(userID int64) (*UserData, error) {
+	data := &UserData{}
+	var user User
+	err := DB.First(&user, userID).Error
+	switch {
+	case err == gorm.ErrRecordNotFound:
+	case err != nil:
+		return nil, err
+	default:
+		data.User = &user
+	}
+
+	queries := []struct {
+		dest  interface{}
+		order string
+	}{
+		{&data.Identities, "id"},
+		{&data.Passkeys, "id"},
+		{&data.APIKeys, "id"},
+		{&data.Consents, "id"},
+		{&data.Bans, "id"},
+		{&data.Sessions, "id"},
+		{&data.AuditLogs, "created_at"},
+	}
+	for _, q := range queries {
+		if err := DB.Where("user_id = ?", userID).Order(q.order).Find(q.dest).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	roles, err := GetUserRoles(userID)
+	if err != nil {
+		return nil, err
+	}
+	data.Roles = roles
+	return data, nil
+}
+
+// EraseUserData 删除用户的登录凭据、第三方绑定和授权，清除二次验证和联系方式验证状态，
+// 审计日志保留事件本身但清除 IP 和 User-Agent，返回被删除的令牌用于加入黑名单
+func EraseUserData(userID int64) ([]*Token, error) {
+	var tokens []*Token
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Find(&tokens).Error; err != nil {
+			return err
+		}
+		for _, model := range []interface{}{
+			&Token{}, &UserIdentity{}, &WebAuthnCredential{}, &APIKey{},
+			&OAuthConsent{}, &PasswordHistory{}, &UserRole{},
+		} {
+			if err := tx.Where("user_id = ?", userID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		err := tx.Model(&User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+			"email_verified_at":         nil,
+			"phone_verified_at":         nil,
+			"two_factor_enabled":        false,
+			"two_factor_secret":         "",
+			"two_factor_recovery_codes": "",
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&AuditLog{}).Where("user_id = ?", userID).Updates(map[string]interface{}{
+			"username":   "",
+			"ip":         "",
+			"user_agent": "",
+		}).Error
+	})
+	return tokens, err
+}
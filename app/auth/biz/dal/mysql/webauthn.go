@@ -0,0 +1,63 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WebAuthnCredential 用户注册的通行密钥（WebAuthn 凭据）
+type WebAuthnCredential struct {
+	ID           int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID       int64      `gorm:"column:user_id;index;not null"`
+	CredentialID []byte     `gorm:"column:credential_id;type:varbinary(1023);uniqueIndex;not null"`
+	UserHandle   []byte     `gorm:"column:user_handle;type:varbinary(64);not null"` // 同一用户的凭据共用的随机句柄
+	PublicKey    []byte     `gorm:"column:public_key;type:blob;not null"`           // COSE 编码的公钥
+	SignCount    uint32     `gorm:"column:sign_count;not null"`
+	AAGUID       []byte     `gorm:"column:aaguid;type:varbinary(16)"`
+	Transports   string     `gorm:"column:transports;size:255"` // 逗号分隔
+	Name         string     `gorm:"column:name;size:64;not null"`
+	LastUsedAt   *time.Time `gorm:"column:last_used_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for WebAuthnCredential model
+func (WebAuthnCredential) TableName() string {
+	return "webauthn_credentials"
+}
+
+// CreateWebAuthnCredential 保存通行密钥
+func CreateWebAuthnCredential(cred *WebAuthnCredential) error {
+	return DB.Create(cred).Error
+}
+
+// GetWebAuthnCredential 通过凭据 ID 获取通行密钥
+func GetWebAuthnCredential(credentialID []byte) (*WebAuthnCredential, error) {
+	var c WebAuthnCredential
+	err := DB.Where("credential_id = ?", credentialID).First(&c).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &c, err
+}
+
+// ListWebAuthnCredentials 获取用户的全部通行密钥，按创建时间倒序
+func ListWebAuthnCredentials(userID int64) ([]*WebAuthnCredential, error) {
+	var creds []*WebAuthnCredential
+	err := DB.Where("user_id = ?", userID).Order("created_at DESC, id DESC").Find(&creds).Error
+	return creds, err
+}
+
+// UpdateWebAuthnCredentialUsage 登录成功后更新签名计数器和最近使用时间
+func UpdateWebAuthnCredentialUsage(id int64, signCount uint32, usedAt time.Time) error {
+	return DB.Model(&WebAuthnCredential{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"sign_count":   signCount,
+		"last_used_at": usedAt,
+	}).Error
+}
+
+// DeleteWebAuthnCredential 删除用户的通行密钥，不存在时返回 false
+func DeleteWebAuthnCredential(userID, id int64) (bool, error) {
+	result := DB.Where("id = ? AND user_id = ?", id, userID).Delete(&WebAuthnCredential{})
+	return result.RowsAffected > 0, result.Error
+}
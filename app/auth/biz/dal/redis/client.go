@@ -0,0 +1,257 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisClient 基于 RDB 的 RedisClient 实现
+type redisClient struct {
+	rdb *redis.Client
+}
+
+func (c *redisClient) CacheToken(ctx context.Context, digest string, userID int64, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", tokenKeyPrefix, digest)
+	return c.rdb.Set(ctx, key, userID, expiration).Err()
+}
+
+func (c *redisClient) GetCachedUserID(ctx context.Context, digest string) (int64, error) {
+	key := fmt.Sprintf("%s%s", tokenKeyPrefix, digest)
+	userID, err := c.rdb.Get(ctx, key).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return userID, err
+}
+
+func (c *redisClient) DeleteToken(ctx context.Context, digest string) error {
+	key := fmt.Sprintf("%s%s", tokenKeyPrefix, digest)
+	return c.rdb.Del(ctx, key).Err()
+}
+
+func (c *redisClient) AddToBlacklist(ctx context.Context, digest string, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", blacklistKeyPrefix, digest)
+	return c.rdb.Set(ctx, key, 1, expiration).Err()
+}
+
+func (c *redisClient) IsInBlacklist(ctx context.Context, digest string) (bool, error) {
+	key := fmt.Sprintf("%s%s", blacklistKeyPrefix, digest)
+	exists, err := c.rdb.Exists(ctx, key).Result()
+	return exists > 0, err
+}
+
+func (c *redisClient) MarkSessionSeen(ctx context.Context, digest string, interval time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s%s", sessionSeenKeyPrefix, digest)
+	return c.rdb.SetNX(ctx, key, 1, interval).Result()
+}
+
+func (c *redisClient) RecordLoginFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	k := fmt.Sprintf("%s%s", loginFailureKeyPrefix, key)
+	now := time.Now()
+	member := fmt.Sprintf("%d-%d", now.UnixNano(), rand.Int63())
+	pipe := c.rdb.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, k, "-inf", strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+	pipe.ZAdd(ctx, k, redis.Z{Score: float64(now.UnixMilli()), Member: member})
+	count := pipe.ZCard(ctx, k)
+	pipe.Expire(ctx, k, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return count.Val(), nil
+}
+
+func (c *redisClient) LockLogin(ctx context.Context, key string, lockouts []time.Duration, memory time.Duration) (time.Duration, error) {
+	levelKey := fmt.Sprintf("%s%s", loginLevelKeyPrefix, key)
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, levelKey)
+	pipe.Expire(ctx, levelKey, memory)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	level := int(incr.Val())
+	if level > len(lockouts) {
+		level = len(lockouts)
+	}
+	lockout := lockouts[level-1]
+
+	pipe = c.rdb.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf("%s%s", loginLockKeyPrefix, key), level, lockout)
+	pipe.Del(ctx, fmt.Sprintf("%s%s", loginFailureKeyPrefix, key))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return lockout, nil
+}
+
+func (c *redisClient) GetLoginLockout(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := c.rdb.PTTL(ctx, fmt.Sprintf("%s%s", loginLockKeyPrefix, key)).Result()
+	if err != nil || ttl < 0 {
+		return 0, err
+	}
+	return ttl, nil
+}
+
+func (c *redisClient) ResetLoginThrottle(ctx context.Context, key string) error {
+	return c.rdb.Del(ctx,
+		fmt.Sprintf("%s%s", loginFailureKeyPrefix, key),
+		fmt.Sprintf("%s%s", loginLockKeyPrefix, key),
+		fmt.Sprintf("%s%s", loginLevelKeyPrefix, key),
+	).Err()
+}
+
+func (c *redisClient) SaveLoginChallenge(ctx context.Context, challenge string, userID int64, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", loginChallengeKeyPrefix, challenge)
+	return c.rdb.Set(ctx, key, userID, expiration).Err()
+}
+
+func (c *redisClient) GetLoginChallenge(ctx context.Context, challenge string) (int64, error) {
+	key := fmt.Sprintf("%s%s", loginChallengeKeyPrefix, challenge)
+	userID, err := c.rdb.Get(ctx, key).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return userID, err
+}
+
+func (c *redisClient) DeleteLoginChallenge(ctx context.Context, challenge string) error {
+	return c.rdb.Del(ctx,
+		fmt.Sprintf("%s%s", loginChallengeKeyPrefix, challenge),
+		fmt.Sprintf("%s%s", loginChallengeAttemptsKeyPrefix, challenge),
+	).Err()
+}
+
+func (c *redisClient) IncrLoginChallengeAttempts(ctx context.Context, challenge string, expiration time.Duration) (int64, error) {
+	key := fmt.Sprintf("%s%s", loginChallengeAttemptsKeyPrefix, challenge)
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, expiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (c *redisClient) MarkTOTPCodeUsed(ctx context.Context, userID int64, code string, expiration time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s%d:%s", totpUsedKeyPrefix, userID, code)
+	return c.rdb.SetNX(ctx, key, 1, expiration).Result()
+}
+
+func (c *redisClient) SaveAuthorizationCode(ctx context.Context, code, payload string, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", authorizationCodeKeyPrefix, code)
+	return c.rdb.Set(ctx, key, payload, expiration).Err()
+}
+
+func (c *redisClient) TakeAuthorizationCode(ctx context.Context, code string) (string, error) {
+	key := fmt.Sprintf("%s%s", authorizationCodeKeyPrefix, code)
+	payload, err := c.rdb.GetDel(ctx, key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return payload, err
+}
+
+func (c *redisClient) SaveOIDCState(ctx context.Context, state, payload string, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", oidcStateKeyPrefix, state)
+	return c.rdb.Set(ctx, key, payload, expiration).Err()
+}
+
+func (c *redisClient) TakeOIDCState(ctx context.Context, state string) (string, error) {
+	key := fmt.Sprintf("%s%s", oidcStateKeyPrefix, state)
+	payload, err := c.rdb.GetDel(ctx, key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return payload, err
+}
+
+func (c *redisClient) SaveWebAuthnSession(ctx context.Context, sessionID, payload string, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", webAuthnSessionKeyPrefix, sessionID)
+	return c.rdb.Set(ctx, key, payload, expiration).Err()
+}
+
+func (c *redisClient) TakeWebAuthnSession(ctx context.Context, sessionID string) (string, error) {
+	key := fmt.Sprintf("%s%s", webAuthnSessionKeyPrefix, sessionID)
+	payload, err := c.rdb.GetDel(ctx, key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return payload, err
+}
+
+func (c *redisClient) SavePasswordResetToken(ctx context.Context, digest string, userID int64, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", passwordResetKeyPrefix, digest)
+	return c.rdb.Set(ctx, key, userID, expiration).Err()
+}
+
+func (c *redisClient) TakePasswordResetToken(ctx context.Context, digest string) (int64, error) {
+	key := fmt.Sprintf("%s%s", passwordResetKeyPrefix, digest)
+	userID, err := c.rdb.GetDel(ctx, key).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return userID, err
+}
+
+func (c *redisClient) SaveVerificationCode(ctx context.Context, target, payload string, expiration time.Duration) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.Set(ctx, verificationCodeKeyPrefix+target, payload, expiration)
+	pipe.Del(ctx, verificationAttemptsKeyPrefix+target)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *redisClient) GetVerificationCode(ctx context.Context, target string) (string, error) {
+	payload, err := c.rdb.Get(ctx, verificationCodeKeyPrefix+target).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return payload, err
+}
+
+func (c *redisClient) DeleteVerificationCode(ctx context.Context, target string) error {
+	return c.rdb.Del(ctx, verificationCodeKeyPrefix+target, verificationAttemptsKeyPrefix+target).Err()
+}
+
+func (c *redisClient) IncrVerificationAttempts(ctx context.Context, target string, expiration time.Duration) (int64, error) {
+	key := verificationAttemptsKeyPrefix + target
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, expiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (c *redisClient) AllowVerificationSend(ctx context.Context, target string, cooldown time.Duration, limit int64, window time.Duration) (bool, error) {
+	ok, err := c.rdb.SetNX(ctx, verificationCooldownKeyPrefix+target, 1, cooldown).Result()
+	if err != nil || !ok {
+		return false, err
+	}
+	// 计数在窗口内首次发送时开始计时，窗口结束后整体过期
+	key := verificationCountKeyPrefix + target
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	return incr.Val() <= limit, nil
+}
+
+func (c *redisClient) AllowSMSLoginSend(ctx context.Context, ip string, limit int64, window time.Duration) (bool, error) {
+	key := smsLoginIPCountKeyPrefix + ip
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	return incr.Val() <= limit, nil
+}
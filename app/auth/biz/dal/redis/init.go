@@ -33,6 +33,8 @@ func Init(addr, password string, db int) error {
 		return fmt.Errorf("ping redis failed: %w", err)
 	}
 
+	Client = &redisClient{rdb: RDB}
+
 	return nil
 }
 
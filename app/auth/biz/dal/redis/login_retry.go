@@ -2,42 +2,44 @@ package redis
 
 import (
 	"context"
-	"fmt"
 	"time"
-
-	"github.com/go-redis/redis/v8"
 )
 
 const (
-	loginRetryKeyPrefix = "login_retry:"
-	retryExpiration     = time.Hour // 重试计数器过期时间
+	// 登录限流相关的Key前缀，Key 后缀为限流维度，如 "user:<用户名>"、"ip:<地址>"、"net:<网段>"
+	loginFailureKeyPrefix = "auth:login:failures:" // 滑动窗口内的失败记录（有序集合）
+	loginLockKeyPrefix    = "auth:login:lock:"     // 锁定标记，TTL 即剩余锁定时间
+	loginLevelKeyPrefix   = "auth:login:level:"    // 已触发的锁定次数，用于逐级延长锁定时间
 )
 
-// GetLoginRetryCount 获取登录重试次数
-func GetLoginRetryCount(ctx context.Context, username string) (int, error) {
+// RecordLoginFailure 记录一次登录失败，返回 window 内的失败次数
+func RecordLoginFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
 	if Client == nil {
 		return 0, nil
 	}
-	key := fmt.Sprintf("%s%s", loginRetryKeyPrefix, username)
-	count, err := RDB.Get(ctx, key).Int()
-	if err == redis.Nil {
+	return Client.RecordLoginFailure(ctx, key, window)
+}
+
+// LockLogin 锁定登录，锁定时长按已触发次数依次取 lockouts 中的值，memory 内未再触发则重新计算
+func LockLogin(ctx context.Context, key string, lockouts []time.Duration, memory time.Duration) (time.Duration, error) {
+	if Client == nil {
 		return 0, nil
 	}
-	return count, err
+	return Client.LockLogin(ctx, key, lockouts, memory)
 }
 
-// IncrLoginRetry 增加登录重试次数
-func IncrLoginRetry(ctx context.Context, username string) (int, error) {
+// GetLoginLockout 获取剩余锁定时间，未锁定时返回 0
+func GetLoginLockout(ctx context.Context, key string) (time.Duration, error) {
 	if Client == nil {
 		return 0, nil
 	}
-	return Client.GetLoginRetryCount(ctx, username)
+	return Client.GetLoginLockout(ctx, key)
 }
 
-// ResetLoginRetry 重置登录重试次数
-func ResetLoginRetry(ctx context.Context, username string) error {
+// ResetLoginThrottle 清除失败记录、锁定标记和锁定次数
+func ResetLoginThrottle(ctx context.Context, key string) error {
 	if Client == nil {
 		return nil
 	}
-	return Client.ResetLoginRetry(ctx, username)
+	return Client.ResetLoginThrottle(ctx, key)
 }
@@ -0,0 +1,27 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// OAuth2 授权码的Key前缀
+	authorizationCodeKeyPrefix = "auth:oauth:code:"
+)
+
+// SaveAuthorizationCode 保存授权码及其关联的授权信息
+func SaveAuthorizationCode(ctx context.Context, code, payload string, expiration time.Duration) error {
+	if Client == nil {
+		return nil
+	}
+	return Client.SaveAuthorizationCode(ctx, code, payload, expiration)
+}
+
+// TakeAuthorizationCode 读取并删除授权码，保证授权码只能使用一次；不存在时返回空字符串
+func TakeAuthorizationCode(ctx context.Context, code string) (string, error) {
+	if Client == nil {
+		return "", nil
+	}
+	return Client.TakeAuthorizationCode(ctx, code)
+}
@@ -0,0 +1,27 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// 外部身份提供方登录 state 的Key前缀
+	oidcStateKeyPrefix = "auth:oidc:state:"
+)
+
+// SaveOIDCState 保存社交登录的 state 及其关联的 nonce 和 PKCE 校验码
+func SaveOIDCState(ctx context.Context, state, payload string, expiration time.Duration) error {
+	if Client == nil {
+		return nil
+	}
+	return Client.SaveOIDCState(ctx, state, payload, expiration)
+}
+
+// TakeOIDCState 读取并删除 state，保证回调只能处理一次；不存在时返回空字符串
+func TakeOIDCState(ctx context.Context, state string) (string, error) {
+	if Client == nil {
+		return "", nil
+	}
+	return Client.TakeOIDCState(ctx, state)
+}
@@ -0,0 +1,27 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// 密码重置令牌的Key前缀，Key 中保存令牌摘要而非明文
+	passwordResetKeyPrefix = "auth:pwreset:"
+)
+
+// SavePasswordResetToken 保存密码重置令牌摘要对应的用户ID
+func SavePasswordResetToken(ctx context.Context, digest string, userID int64, expiration time.Duration) error {
+	if Client == nil {
+		return nil
+	}
+	return Client.SavePasswordResetToken(ctx, digest, userID, expiration)
+}
+
+// TakePasswordResetToken 读取并删除密码重置令牌，保证只能使用一次；不存在时返回 0
+func TakePasswordResetToken(ctx context.Context, digest string) (int64, error) {
+	if Client == nil {
+		return 0, nil
+	}
+	return Client.TakePasswordResetToken(ctx, digest)
+}
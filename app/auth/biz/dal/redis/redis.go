@@ -8,9 +8,33 @@ import (
 var Client RedisClient
 
 type RedisClient interface {
-	CacheToken(ctx context.Context, token string, userID int64, expiration time.Duration) error
-	DeleteToken(ctx context.Context, token string) error
-	AddToBlacklist(ctx context.Context, token string, expiration time.Duration) error
-	GetLoginRetryCount(ctx context.Context, username string) (int, error)
-	ResetLoginRetry(ctx context.Context, username string) error
+	CacheToken(ctx context.Context, digest string, userID int64, expiration time.Duration) error
+	GetCachedUserID(ctx context.Context, digest string) (int64, error)
+	DeleteToken(ctx context.Context, digest string) error
+	AddToBlacklist(ctx context.Context, digest string, expiration time.Duration) error
+	IsInBlacklist(ctx context.Context, digest string) (bool, error)
+	MarkSessionSeen(ctx context.Context, digest string, interval time.Duration) (bool, error)
+	RecordLoginFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	LockLogin(ctx context.Context, key string, lockouts []time.Duration, memory time.Duration) (time.Duration, error)
+	GetLoginLockout(ctx context.Context, key string) (time.Duration, error)
+	ResetLoginThrottle(ctx context.Context, key string) error
+	SaveLoginChallenge(ctx context.Context, challenge string, userID int64, expiration time.Duration) error
+	GetLoginChallenge(ctx context.Context, challenge string) (int64, error)
+	DeleteLoginChallenge(ctx context.Context, challenge string) error
+	IncrLoginChallengeAttempts(ctx context.Context, challenge string, expiration time.Duration) (int64, error)
+	MarkTOTPCodeUsed(ctx context.Context, userID int64, code string, expiration time.Duration) (bool, error)
+	SaveAuthorizationCode(ctx context.Context, code, payload string, expiration time.Duration) error
+	TakeAuthorizationCode(ctx context.Context, code string) (string, error)
+	SaveOIDCState(ctx context.Context, state, payload string, expiration time.Duration) error
+	TakeOIDCState(ctx context.Context, state string) (string, error)
+	SaveWebAuthnSession(ctx context.Context, sessionID, payload string, expiration time.Duration) error
+	TakeWebAuthnSession(ctx context.Context, sessionID string) (string, error)
+	SavePasswordResetToken(ctx context.Context, digest string, userID int64, expiration time.Duration) error
+	TakePasswordResetToken(ctx context.Context, digest string) (int64, error)
+	SaveVerificationCode(ctx context.Context, target, payload string, expiration time.Duration) error
+	GetVerificationCode(ctx context.Context, target string) (string, error)
+	DeleteVerificationCode(ctx context.Context, target string) error
+	IncrVerificationAttempts(ctx context.Context, target string, expiration time.Duration) (int64, error)
+	AllowVerificationSend(ctx context.Context, target string, cooldown time.Duration, limit int64, window time.Duration) (bool, error)
+	AllowSMSLoginSend(ctx context.Context, ip string, limit int64, window time.Duration) (bool, error)
 }
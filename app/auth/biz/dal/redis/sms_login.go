@@ -0,0 +1,19 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// 短信登录按客户端 IP 统计发送次数的Key前缀
+	smsLoginIPCountKeyPrefix = "auth:smslogin:ip:"
+)
+
+// AllowSMSLoginSend 检查同一 IP 在 window 内发送的登录验证码是否超过 limit 次
+func AllowSMSLoginSend(ctx context.Context, ip string, limit int64, window time.Duration) (bool, error) {
+	if Client == nil {
+		return true, nil
+	}
+	return Client.AllowSMSLoginSend(ctx, ip, limit, window)
+}
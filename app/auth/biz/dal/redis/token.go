@@ -2,53 +2,105 @@ package redis
 
 import (
 	"context"
-	"fmt"
+	"strings"
 	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"TikTokMall/pkg/tokenhash"
 )
 
 const (
-	// Token相关的Key前缀
-	tokenKeyPrefix     = "auth:token:"
-	blacklistKeyPrefix = "auth:blacklist:"
+	// Token相关的Key前缀，键中只使用令牌摘要
+	tokenKeyPrefix       = "auth:token:"
+	blacklistKeyPrefix   = "auth:blacklist:"
+	sessionSeenKeyPrefix = "auth:session:seen:"
 )
 
 // CacheToken 缓存Token
-func CacheToken(ctx context.Context, token string, userID int64, expiration time.Duration) error {
+func CacheToken(ctx context.Context, digest string, userID int64, expiration time.Duration) error {
 	if Client == nil {
 		return nil // 测试时直接返回 nil
 	}
-	return Client.CacheToken(ctx, token, userID, expiration)
+	return Client.CacheToken(ctx, digest, userID, expiration)
 }
 
-// GetCachedUserID 获取缓存的用户ID
-func GetCachedUserID(ctx context.Context, token string) (int64, error) {
-	key := fmt.Sprintf("%s%s", tokenKeyPrefix, token)
-	val, err := RDB.Get(ctx, key).Int64()
-	if err != nil {
-		return 0, err
+// GetCachedUserID 获取缓存的用户ID，未命中时返回 0
+func GetCachedUserID(ctx context.Context, digest string) (int64, error) {
+	if Client == nil {
+		return 0, nil
 	}
-	return val, nil
+	return Client.GetCachedUserID(ctx, digest)
 }
 
 // DeleteToken 删除Token缓存
-func DeleteToken(ctx context.Context, token string) error {
+func DeleteToken(ctx context.Context, digest string) error {
 	if Client == nil {
 		return nil
 	}
-	return Client.DeleteToken(ctx, token)
+	return Client.DeleteToken(ctx, digest)
 }
 
 // AddToBlacklist 将Token加入黑名单
-func AddToBlacklist(ctx context.Context, token string, expiration time.Duration) error {
+func AddToBlacklist(ctx context.Context, digest string, expiration time.Duration) error {
 	if Client == nil {
 		return nil
 	}
-	return Client.AddToBlacklist(ctx, token, expiration)
+	return Client.AddToBlacklist(ctx, digest, expiration)
 }
 
 // IsInBlacklist 检查Token是否在黑名单中
-func IsInBlacklist(ctx context.Context, token string) (bool, error) {
-	key := fmt.Sprintf("%s%s", blacklistKeyPrefix, token)
-	exists, err := RDB.Exists(ctx, key).Result()
-	return exists > 0, err
+func IsInBlacklist(ctx context.Context, digest string) (bool, error) {
+	if Client == nil {
+		return false, nil
+	}
+	return Client.IsInBlacklist(ctx, digest)
+}
+
+// MarkSessionSeen 记录令牌在 interval 内已被使用，返回本次是否为 interval 内的首次使用
+func MarkSessionSeen(ctx context.Context, digest string, interval time.Duration) (bool, error) {
+	if Client == nil {
+		return false, nil
+	}
+	return Client.MarkSessionSeen(ctx, digest, interval)
+}
+
+// HashPlaintextTokenKeys 将迁移前以明文令牌为键的缓存、黑名单和会话记录改为摘要键并保留剩余有效期，可重复执行
+func HashPlaintextTokenKeys(ctx context.Context) (int, error) {
+	migrated := 0
+	for _, prefix := range []string{tokenKeyPrefix, blacklistKeyPrefix, sessionSeenKeyPrefix} {
+		iter := RDB.Scan(ctx, 0, prefix+"*", 500).Iterator()
+		for iter.Next(ctx) {
+			key := iter.Val()
+			token := strings.TrimPrefix(key, prefix)
+			if tokenhash.IsDigest(token) {
+				continue
+			}
+			value, err := RDB.Get(ctx, key).Result()
+			if err == redis.Nil {
+				continue
+			}
+			if err != nil {
+				return migrated, err
+			}
+			ttl, err := RDB.PTTL(ctx, key).Result()
+			if err != nil {
+				return migrated, err
+			}
+			if ttl < 0 {
+				ttl = 0 // 未设置过期时间
+			}
+			pipe := RDB.TxPipeline()
+			pipe.Set(ctx, prefix+tokenhash.Digest(token), value, ttl)
+			pipe.Del(ctx, key)
+			if _, err := pipe.Exec(ctx); err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+		if err := iter.Err(); err != nil {
+			return migrated, err
+		}
+	}
+	return migrated, nil
 }
@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// 二次验证相关的Key前缀
+	loginChallengeKeyPrefix         = "auth:2fa:challenge:"
+	loginChallengeAttemptsKeyPrefix = "auth:2fa:attempts:"
+	totpUsedKeyPrefix               = "auth:2fa:used:"
+)
+
+// SaveLoginChallenge 保存二次验证挑战令牌
+func SaveLoginChallenge(ctx context.Context, challenge string, userID int64, expiration time.Duration) error {
+	if Client == nil {
+		return nil
+	}
+	return Client.SaveLoginChallenge(ctx, challenge, userID, expiration)
+}
+
+// GetLoginChallenge 获取挑战令牌对应的用户ID，不存在时返回 0
+func GetLoginChallenge(ctx context.Context, challenge string) (int64, error) {
+	if Client == nil {
+		return 0, nil
+	}
+	return Client.GetLoginChallenge(ctx, challenge)
+}
+
+// DeleteLoginChallenge 删除挑战令牌
+func DeleteLoginChallenge(ctx context.Context, challenge string) error {
+	if Client == nil {
+		return nil
+	}
+	return Client.DeleteLoginChallenge(ctx, challenge)
+}
+
+// IncrLoginChallengeAttempts 增加挑战令牌的验证次数
+func IncrLoginChallengeAttempts(ctx context.Context, challenge string, expiration time.Duration) (int64, error) {
+	if Client == nil {
+		return 0, nil
+	}
+	return Client.IncrLoginChallengeAttempts(ctx, challenge, expiration)
+}
+
+// MarkTOTPCodeUsed 标记 TOTP 验证码已使用，返回 false 表示验证码已被使用过
+func MarkTOTPCodeUsed(ctx context.Context, userID int64, code string, expiration time.Duration) (bool, error) {
+	if Client == nil {
+		return true, nil
+	}
+	return Client.MarkTOTPCodeUsed(ctx, userID, code, expiration)
+}
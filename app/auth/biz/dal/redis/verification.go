@@ -0,0 +1,54 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// 联系方式验证和短信登录相关的Key前缀，Key 后缀为 "<渠道>:<邮箱或手机号>"
+	verificationCodeKeyPrefix     = "auth:verify:code:"
+	verificationAttemptsKeyPrefix = "auth:verify:attempts:"
+	verificationCooldownKeyPrefix = "auth:verify:cooldown:"
+	verificationCountKeyPrefix    = "auth:verify:count:"
+)
+
+// SaveVerificationCode 保存验证码，覆盖旧验证码并清除验证失败次数
+func SaveVerificationCode(ctx context.Context, target, payload string, expiration time.Duration) error {
+	if Client == nil {
+		return nil
+	}
+	return Client.SaveVerificationCode(ctx, target, payload, expiration)
+}
+
+// GetVerificationCode 获取验证码，不存在时返回空字符串
+func GetVerificationCode(ctx context.Context, target string) (string, error) {
+	if Client == nil {
+		return "", nil
+	}
+	return Client.GetVerificationCode(ctx, target)
+}
+
+// DeleteVerificationCode 删除验证码及其验证失败次数
+func DeleteVerificationCode(ctx context.Context, target string) error {
+	if Client == nil {
+		return nil
+	}
+	return Client.DeleteVerificationCode(ctx, target)
+}
+
+// IncrVerificationAttempts 增加验证码的验证失败次数
+func IncrVerificationAttempts(ctx context.Context, target string, expiration time.Duration) (int64, error) {
+	if Client == nil {
+		return 0, nil
+	}
+	return Client.IncrVerificationAttempts(ctx, target, expiration)
+}
+
+// AllowVerificationSend 检查是否允许向目标发送验证码：两次发送间隔不小于 cooldown，window 内最多发送 limit 次
+func AllowVerificationSend(ctx context.Context, target string, cooldown time.Duration, limit int64, window time.Duration) (bool, error) {
+	if Client == nil {
+		return true, nil
+	}
+	return Client.AllowVerificationSend(ctx, target, cooldown, limit, window)
+}
@@ -0,0 +1,27 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// 通行密钥注册和登录会话的Key前缀
+	webAuthnSessionKeyPrefix = "auth:webauthn:session:"
+)
+
+// SaveWebAuthnSession 保存通行密钥仪式的挑战和关联用户
+func SaveWebAuthnSession(ctx context.Context, sessionID, payload string, expiration time.Duration) error {
+	if Client == nil {
+		return nil
+	}
+	return Client.SaveWebAuthnSession(ctx, sessionID, payload, expiration)
+}
+
+// TakeWebAuthnSession 读取并删除会话，保证挑战只能使用一次；不存在时返回空字符串
+func TakeWebAuthnSession(ctx context.Context, sessionID string) (string, error) {
+	if Client == nil {
+		return "", nil
+	}
+	return Client.TakeWebAuthnSession(ctx, sessionID)
+}
@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+// AssignRole 处理授予角色请求
+func (h *AuthHandler) AssignRole(ctx context.Context, c *app.RequestContext) {
+	var req auth.AssignRoleRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.AssignRoleResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.AssignRole(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.AssignRoleResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeRole 处理撤销角色请求
+func (h *AuthHandler) RevokeRole(ctx context.Context, c *app.RequestContext) {
+	var req auth.RevokeRoleRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.RevokeRoleResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.RevokeRole(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.RevokeRoleResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListUserRoles 处理查询用户角色请求
+func (h *AuthHandler) ListUserRoles(ctx context.Context, c *app.RequestContext) {
+	var req auth.ListUserRolesRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ListUserRolesResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.ListUserRoles(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.ListUserRolesResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateUserStatus 处理封禁或解封用户请求
+func (h *AuthHandler) UpdateUserStatus(ctx context.Context, c *app.RequestContext) {
+	var req auth.UpdateUserStatusRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.UpdateUserStatusResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.UpdateUserStatus(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.UpdateUserStatusResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// BanUser 处理限时或永久封禁用户请求
+func (h *AuthHandler) BanUser(ctx context.Context, c *app.RequestContext) {
+	var req auth.BanUserRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.BanUserResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.BanUser(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.BanUserResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UnbanUser 处理解封用户请求
+func (h *AuthHandler) UnbanUser(ctx context.Context, c *app.RequestContext) {
+	var req auth.UnbanUserRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.UnbanUserResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.UnbanUser(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.UnbanUserResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListUserBans 处理查询用户封禁记录请求
+func (h *AuthHandler) ListUserBans(ctx context.Context, c *app.RequestContext) {
+	var req auth.ListUserBansRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ListUserBansResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.ListUserBans(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.ListUserBansResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UnlockLogin 处理解除登录锁定请求
+func (h *AuthHandler) UnlockLogin(ctx context.Context, c *app.RequestContext) {
+	var req auth.UnlockLoginRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.UnlockLoginResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.UnlockLogin(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.UnlockLoginResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListAuditLogs 处理审计日志查询请求
+func (h *AuthHandler) ListAuditLogs(ctx context.Context, c *app.RequestContext) {
+	var req auth.ListAuditLogsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ListAuditLogsResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.ListAuditLogs(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.ListAuditLogsResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+// CreateAPIKey 处理创建 API 密钥请求
+func (h *AuthHandler) CreateAPIKey(ctx context.Context, c *app.RequestContext) {
+	var req auth.CreateAPIKeyRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.CreateAPIKeyResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.CreateAPIKey(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.CreateAPIKeyResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListAPIKeys 处理查询 API 密钥请求
+func (h *AuthHandler) ListAPIKeys(ctx context.Context, c *app.RequestContext) {
+	var req auth.ListAPIKeysRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ListAPIKeysResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.ListAPIKeys(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.ListAPIKeysResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeAPIKey 处理吊销 API 密钥请求
+func (h *AuthHandler) RevokeAPIKey(ctx context.Context, c *app.RequestContext) {
+	var req auth.RevokeAPIKeyRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.RevokeAPIKeyResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.RevokeAPIKey(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.RevokeAPIKeyResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -2,7 +2,8 @@ package handler
 
 import (
 	"context"
-	"strings"
+	"errors"
+	"strconv"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
@@ -10,6 +11,7 @@ import (
 	"TikTokMall/app/auth/biz/service"
 	"TikTokMall/app/auth/kitex_gen/auth"
 	"TikTokMall/app/auth/repository/mysql"
+	"TikTokMall/pkg/websession"
 )
 
 // AuthHandler 认证服务处理器
@@ -41,12 +43,7 @@ func (h *AuthHandler) Register(ctx context.Context, c *app.RequestContext) {
 
 	resp, err := h.svc.Register(ctx, &req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, &auth.RegisterResponse{
-			Base: &auth.BaseResp{
-				Code:    consts.StatusInternalServerError,
-				Message: err.Error(),
-			},
-		})
+		writePasswordError(c, err)
 		return
 	}
 
@@ -68,16 +65,22 @@ func (h *AuthHandler) Login(ctx context.Context, c *app.RequestContext) {
 
 	resp, err := h.svc.Login(ctx, &req)
 	if err != nil {
-		c.JSON(consts.StatusUnauthorized, &auth.LoginResponse{
+		status := consts.StatusUnauthorized
+		var throttled *service.LoginThrottledError
+		if errors.As(err, &throttled) {
+			status = consts.StatusTooManyRequests
+			c.Header("Retry-After", strconv.FormatInt(throttled.RetryAfterSeconds(), 10))
+		}
+		c.JSON(status, &auth.LoginResponse{
 			Base: &auth.BaseResp{
-				Code:    consts.StatusUnauthorized,
+				Code:    int32(status),
 				Message: err.Error(),
 			},
 		})
 		return
 	}
 
-	c.JSON(consts.StatusOK, resp)
+	writeLoginResponse(c, resp)
 }
 
 // RefreshToken 处理令牌刷新请求
@@ -93,20 +96,32 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	// 从Authorization头中获取refreshToken
-	req.RefreshToken = strings.TrimPrefix(req.RefreshToken, "Bearer ")
+	// 从Authorization头中获取refreshToken，Cookie 会话模式从 Cookie 中获取
+	cookieMode := websession.CookieMode(c)
+	if cookieMode {
+		req.RefreshToken = websession.RefreshToken(c)
+	} else {
+		req.RefreshToken = bearerToken(c, req.RefreshToken)
+	}
 
 	resp, err := h.svc.RefreshToken(ctx, &req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, &auth.RefreshTokenResponse{
+		status := errorStatus(err)
+		c.JSON(status, &auth.RefreshTokenResponse{
 			Base: &auth.BaseResp{
-				Code:    consts.StatusInternalServerError,
+				Code:    int32(status),
 				Message: err.Error(),
 			},
 		})
 		return
 	}
 
+	if cookieMode && resp.Data != nil {
+		if !setSessionCookies(c, resp.Data.Token, resp.Data.RefreshToken) {
+			return
+		}
+		resp.Data.Token, resp.Data.RefreshToken = "", ""
+	}
 	c.JSON(consts.StatusOK, resp)
 }
 
@@ -123,7 +138,11 @@ func (h *AuthHandler) Logout(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	req.Token = strings.TrimPrefix(req.Token, "Bearer ")
+	req.Token = bearerToken(c, req.Token)
+	// Cookie 会话无论令牌是否有效都删除 Cookie
+	if websession.CookieMode(c) {
+		websession.ClearSession(c, sessionCookie)
+	}
 
 	resp, err := h.svc.Logout(ctx, &req)
 	if err != nil {
@@ -152,7 +171,7 @@ func (h *AuthHandler) ValidateToken(ctx context.Context, c *app.RequestContext)
 		return
 	}
 
-	req.Token = strings.TrimPrefix(req.Token, "Bearer ")
+	req.Token = bearerToken(c, req.Token)
 
 	resp, err := h.svc.ValidateToken(ctx, &req)
 	if err != nil {
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/biz/service"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/pkg/websession"
+)
+
+// defaultRefreshCookiePath 刷新令牌 Cookie 只发送到认证服务的接口
+const defaultRefreshCookiePath = "/v1/auth"
+
+// sessionCookie 浏览器 Cookie 会话配置
+var sessionCookie = websession.CookieConfig{RefreshPath: defaultRefreshCookiePath}
+
+// SetSessionCookieConfig 设置 Cookie 会话配置，需要在启动服务前调用
+func SetSessionCookieConfig(cfg websession.CookieConfig) {
+	if cfg.RefreshPath == "" {
+		cfg.RefreshPath = defaultRefreshCookiePath
+	}
+	sessionCookie = cfg
+}
+
+// writeLoginResponse 输出登录结果，Cookie 会话模式下令牌写入 Cookie，响应中不返回令牌
+func writeLoginResponse(c *app.RequestContext, resp *auth.LoginResponse) {
+	if websession.CookieMode(c) && resp.Data != nil && resp.Data.Token != "" {
+		if !setSessionCookies(c, resp.Data.Token, resp.Data.RefreshToken) {
+			return
+		}
+		resp.Data.Token, resp.Data.RefreshToken = "", ""
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// setSessionCookies 写入会话 Cookie，失败时输出错误并返回 false
+func setSessionCookies(c *app.RequestContext, access, refresh string) bool {
+	if _, err := websession.SetSession(c, sessionCookie, access, service.TokenExpiration, refresh, service.RefreshTokenExpiration); err != nil {
+		c.JSON(consts.StatusInternalServerError, &auth.BaseResp{
+			Code:    consts.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/service/mock"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/pkg/websession"
+)
+
+// newCookieRequest 创建 Cookie 会话模式的 JSON 请求
+func newCookieRequest(body interface{}) *app.RequestContext {
+	c := app.NewContext(16)
+	c.Request.Header.SetMethod(consts.MethodPost)
+	c.Request.Header.SetContentTypeBytes([]byte("application/json"))
+	c.Request.Header.Set(websession.ModeHeader, "cookie")
+	if body != nil {
+		data, _ := json.Marshal(body)
+		c.Request.SetBody(data)
+	}
+	return c
+}
+
+// cookieValue 读取响应中设置的 Cookie 值
+func cookieValue(t *testing.T, c *app.RequestContext, name string) string {
+	cookie := protocol.AcquireCookie()
+	defer protocol.ReleaseCookie(cookie)
+	cookie.SetKey(name)
+	require.True(t, c.Response.Header.Cookie(cookie), "cookie %s not set", name)
+	return string(cookie.Value())
+}
+
+func TestAuthHandler_CookieSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := mock.NewMockAuthService(ctrl)
+	h := &AuthHandler{svc: svc}
+	ctx := context.Background()
+
+	// 登录后令牌写入 Cookie，响应中不返回令牌
+	svc.EXPECT().Login(gomock.Any(), gomock.Any()).Return(&auth.LoginResponse{
+		Base: &auth.BaseResp{Code: 0, Message: "success"},
+		Data: &auth.LoginData{Token: "access-1", RefreshToken: "refresh-1"},
+	}, nil)
+	c := newCookieRequest(&auth.LoginRequest{Username: "alice", Password: "password123"})
+	h.Login(ctx, c)
+	require.Equal(t, consts.StatusOK, c.Response.StatusCode())
+	var login auth.LoginResponse
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &login))
+	assert.Empty(t, login.Data.Token)
+	assert.Empty(t, login.Data.RefreshToken)
+	assert.Equal(t, "access-1", cookieValue(t, c, websession.AccessCookie))
+	assert.Equal(t, "refresh-1", cookieValue(t, c, websession.RefreshCookie))
+	assert.Equal(t, cookieValue(t, c, websession.CSRFCookie), string(c.Response.Header.Peek(websession.CSRFHeader)))
+
+	// 刷新时从 Cookie 读取刷新令牌，忽略 Authorization 中的访问令牌
+	svc.EXPECT().RefreshToken(gomock.Any(), &auth.RefreshTokenRequest{RefreshToken: "refresh-1"}).Return(&auth.RefreshTokenResponse{
+		Base: &auth.BaseResp{Code: consts.StatusOK, Message: "success"},
+		Data: &auth.RefreshTokenData{Token: "access-2", RefreshToken: "refresh-2"},
+	}, nil)
+	c = newCookieRequest(nil)
+	c.Request.Header.Set("Authorization", "Bearer access-1")
+	c.Request.Header.SetCookie(websession.RefreshCookie, "refresh-1")
+	h.RefreshToken(ctx, c)
+	require.Equal(t, consts.StatusOK, c.Response.StatusCode())
+	var refreshed auth.RefreshTokenResponse
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &refreshed))
+	assert.Empty(t, refreshed.Data.Token)
+	assert.Equal(t, "access-2", cookieValue(t, c, websession.AccessCookie))
+	assert.Equal(t, "refresh-2", cookieValue(t, c, websession.RefreshCookie))
+
+	// 退出登录删除 Cookie
+	svc.EXPECT().Logout(gomock.Any(), &auth.LogoutRequest{Token: "access-2"}).Return(&auth.LogoutResponse{
+		Base: &auth.BaseResp{Code: consts.StatusOK, Message: "success"},
+	}, nil)
+	c = newCookieRequest(nil)
+	c.Request.Header.Set("Authorization", "Bearer access-2")
+	h.Logout(ctx, c)
+	require.Equal(t, consts.StatusOK, c.Response.StatusCode())
+	assert.Empty(t, cookieValue(t, c, websession.AccessCookie))
+	assert.Empty(t, cookieValue(t, c, websession.RefreshCookie))
+}
+
+func TestAuthHandler_LoginWithoutCookieMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := mock.NewMockAuthService(ctrl)
+	h := &AuthHandler{svc: svc}
+
+	svc.EXPECT().Login(gomock.Any(), gomock.Any()).Return(&auth.LoginResponse{
+		Base: &auth.BaseResp{Code: 0, Message: "success"},
+		Data: &auth.LoginData{Token: "access-1", RefreshToken: "refresh-1"},
+	}, nil)
+	c := newCookieRequest(&auth.LoginRequest{Username: "alice", Password: "password123"})
+	c.Request.Header.Del(websession.ModeHeader)
+	h.Login(context.Background(), c)
+
+	var login auth.LoginResponse
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &login))
+	assert.Equal(t, "access-1", login.Data.Token)
+	assert.Empty(t, c.Response.Header.Peek("Set-Cookie"))
+}
@@ -41,9 +41,6 @@ func (h *AuthHTTPHandler) Register(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	// 输出解析后的请求内容进行调试
-	fmt.Printf("Parsed request: %+v\n", req)
-
 	// 验证必填字段
 	if req.Username == "" || req.Password == "" {
 		c.JSON(consts.StatusBadRequest, &auth.RegisterResponse{
@@ -58,12 +55,7 @@ func (h *AuthHTTPHandler) Register(ctx context.Context, c *app.RequestContext) {
 	// 调用 service 层的 Register 方法
 	resp, err := h.svc.Register(ctx, &req)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, &auth.RegisterResponse{
-			Base: &auth.BaseResp{
-				Code:    consts.StatusInternalServerError,
-				Message: err.Error(),
-			},
-		})
+		writePasswordError(c, err)
 		return
 	}
 
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 
 	"TikTokMall/app/auth/biz/service/mock"
 	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/pkg/password"
 )
 
 func TestAuthHTTPHandler_Register(t *testing.T) {
@@ -118,6 +120,35 @@ func TestAuthHTTPHandler_Register(t *testing.T) {
 	}
 }
 
+func TestAuthHTTPHandler_RegisterPasswordPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	violations := []password.Violation{
+		{Code: password.CodeTooShort, Message: "password must be at least 8 characters"},
+		{Code: password.CodeCommon, Message: "password is too common"},
+	}
+	mockService := mock.NewMockAuthService(ctrl)
+	mockService.EXPECT().Register(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("%w: %w", auth.ErrInvalidArgument, &password.PolicyError{Violations: violations}))
+	handler := NewAuthHTTPHandler(mockService)
+
+	hertzCtx := app.NewContext(16)
+	reqBody, _ := json.Marshal(&auth.RegisterRequest{Username: "testuser", Password: "123456"})
+	hertzCtx.Request.SetBody(reqBody)
+	hertzCtx.Request.Header.Set("Content-Type", "application/json")
+	hertzCtx.Request.Header.SetMethod("POST")
+	hertzCtx.Request.SetRequestURI("/v1/auth/register")
+
+	handler.Register(context.Background(), hertzCtx)
+
+	assert.Equal(t, consts.StatusBadRequest, hertzCtx.Response.StatusCode())
+	var got passwordErrorResponse
+	assert.NoError(t, json.Unmarshal(hertzCtx.Response.Body(), &got))
+	assert.Equal(t, int32(consts.StatusBadRequest), got.Base.Code)
+	assert.Equal(t, violations, got.Violations)
+}
+
 func TestAuthHTTPHandler_Login(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
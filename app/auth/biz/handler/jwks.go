@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/pkg/jwt"
+)
+
+// JWKS 返回访问令牌验签公钥 (/.well-known/jwks.json)
+func (h *AuthHandler) JWKS(ctx context.Context, c *app.RequestContext) {
+	// 允许下游服务短暂缓存，轮换时新旧密钥会同时存在
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(consts.StatusOK, jwt.Keys().JWKS())
+}
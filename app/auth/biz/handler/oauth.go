@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/biz/service"
+	"TikTokMall/app/auth/repository/mysql"
+)
+
+// OAuthHandler OAuth2 / OIDC 授权服务处理器
+type OAuthHandler struct {
+	svc      service.OAuthService
+	baseURL  string // 授权服务对外地址
+	loginURL string // 前端登录及授权确认页面
+}
+
+// NewOAuthHandler 创建授权服务处理器，baseURL 为空时根据请求推断
+func NewOAuthHandler(baseURL, loginURL string) *OAuthHandler {
+	return &OAuthHandler{
+		svc:      service.NewOAuthService(mysql.NewAuthRepository()),
+		baseURL:  baseURL,
+		loginURL: loginURL,
+	}
+}
+
+// Discovery 返回 OIDC 发现文档 (/.well-known/openid-configuration)
+func (h *OAuthHandler) Discovery(ctx context.Context, c *app.RequestContext) {
+	baseURL := h.baseURL
+	if baseURL == "" {
+		baseURL = string(c.URI().Scheme()) + "://" + string(c.Host())
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(consts.StatusOK, service.NewDiscoveryDocument(baseURL))
+}
+
+// RegisterClient 处理第三方应用注册请求
+func (h *OAuthHandler) RegisterClient(ctx context.Context, c *app.RequestContext) {
+	var req service.RegisterClientRequest
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &service.OAuthError{Code: "invalid_client_metadata", Description: err.Error()})
+		return
+	}
+
+	resp, err := h.svc.RegisterClient(ctx, bearerToken(c, ""), &req)
+	if err != nil {
+		writeOAuthError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusCreated, resp)
+}
+
+// Authorize 处理授权请求，已授权时跳转回应用，否则返回待确认的授权信息
+// 浏览器直接访问时没有访问令牌，跳转到前端页面完成登录和授权确认后再以 JSON 方式调用
+func (h *OAuthHandler) Authorize(ctx context.Context, c *app.RequestContext) {
+	var req service.AuthorizeRequest
+	if err := c.BindQuery(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &service.OAuthError{Code: "invalid_request", Description: err.Error()})
+		return
+	}
+
+	resp, err := h.svc.Authorize(ctx, bearerToken(c, ""), &req)
+	if err != nil {
+		var oauthErr *service.OAuthError
+		if errors.As(err, &oauthErr) && oauthErr.Code == "login_required" && h.redirectToLogin(c) {
+			return
+		}
+		writeOAuthError(c, err)
+		return
+	}
+	if resp.ConsentRequired && h.redirectToLogin(c) {
+		return
+	}
+	writeAuthorizeResponse(c, resp)
+}
+
+// Consent 处理用户确认授权请求，approve=true 表示同意
+func (h *OAuthHandler) Consent(ctx context.Context, c *app.RequestContext) {
+	var req service.AuthorizeRequest
+	if err := c.BindForm(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &service.OAuthError{Code: "invalid_request", Description: err.Error()})
+		return
+	}
+	approve := string(c.FormValue("approve")) == "true"
+
+	resp, err := h.svc.Consent(ctx, bearerToken(c, ""), &req, approve)
+	if err != nil {
+		writeOAuthError(c, err)
+		return
+	}
+	writeAuthorizeResponse(c, resp)
+}
+
+// Token 令牌端点，支持 HTTP Basic 和表单两种客户端认证方式
+func (h *OAuthHandler) Token(ctx context.Context, c *app.RequestContext) {
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+
+	var req service.TokenRequest
+	if err := c.BindForm(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &service.OAuthError{Code: "invalid_request", Description: err.Error()})
+		return
+	}
+	if id, secret, ok := basicAuth(c); ok {
+		req.ClientID, req.ClientSecret = id, secret
+	}
+
+	resp, err := h.svc.Token(ctx, &req)
+	if err != nil {
+		writeOAuthError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UserInfo 返回当前访问令牌对应的用户信息
+func (h *OAuthHandler) UserInfo(ctx context.Context, c *app.RequestContext) {
+	resp, err := h.svc.UserInfo(ctx, bearerToken(c, ""))
+	if err != nil {
+		writeOAuthError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListConsents 列出当前用户已授权的应用
+func (h *OAuthHandler) ListConsents(ctx context.Context, c *app.RequestContext) {
+	consents, err := h.svc.ListConsents(ctx, bearerToken(c, ""))
+	if err != nil {
+		writeOAuthError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, map[string]interface{}{"consents": consents})
+}
+
+// RevokeConsent 撤销对应用的授权
+func (h *OAuthHandler) RevokeConsent(ctx context.Context, c *app.RequestContext) {
+	if err := h.svc.RevokeConsent(ctx, bearerToken(c, ""), c.Param("client_id")); err != nil {
+		writeOAuthError(c, err)
+		return
+	}
+
+	c.Status(consts.StatusNoContent)
+}
+
+// redirectToLogin 浏览器访问时跳转到前端登录页，原始授权参数通过 authorize 参数传递
+func (h *OAuthHandler) redirectToLogin(c *app.RequestContext) bool {
+	if h.loginURL == "" || wantsJSON(c) {
+		return false
+	}
+	target := h.loginURL + "?authorize=" + url.QueryEscape(string(c.URI().QueryString()))
+	if strings.Contains(h.loginURL, "?") {
+		target = h.loginURL + "&authorize=" + url.QueryEscape(string(c.URI().QueryString()))
+	}
+	c.Redirect(consts.StatusFound, []byte(target))
+	return true
+}
+
+// writeAuthorizeResponse 跳转回应用或返回待确认的授权信息，JSON 调用方自行跳转 redirect_to
+func writeAuthorizeResponse(c *app.RequestContext, resp *service.AuthorizeResponse) {
+	if resp.RedirectTo != "" && !wantsJSON(c) {
+		c.Redirect(consts.StatusFound, []byte(resp.RedirectTo))
+		return
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// wantsJSON 判断调用方是否期望 JSON 响应
+func wantsJSON(c *app.RequestContext) bool {
+	return strings.Contains(string(c.GetHeader("Accept")), "application/json")
+}
+
+// writeOAuthError 按 RFC 6749 / RFC 6750 输出错误
+func writeOAuthError(c *app.RequestContext, err error) {
+	var oauthErr *service.OAuthError
+	if !errors.As(err, &oauthErr) {
+		status := errorStatus(err)
+		code := "server_error"
+		if status == consts.StatusUnauthorized {
+			code = "invalid_token"
+		}
+		oauthErr = &service.OAuthError{Code: code, Description: err.Error()}
+	}
+
+	status := consts.StatusBadRequest
+	switch oauthErr.Code {
+	case "invalid_client", "login_required":
+		status = consts.StatusUnauthorized
+	case "invalid_token":
+		status = consts.StatusUnauthorized
+		c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+	case "insufficient_scope":
+		status = consts.StatusForbidden
+		c.Header("WWW-Authenticate", `Bearer error="insufficient_scope"`)
+	case "server_error":
+		status = consts.StatusInternalServerError
+	}
+	c.JSON(status, oauthErr)
+}
+
+// basicAuth 解析 HTTP Basic 客户端认证，client_id 和 client_secret 按 RFC 6749 2.3.1 进行 URL 编码
+func basicAuth(c *app.RequestContext) (string, string, bool) {
+	header := string(c.GetHeader("Authorization"))
+	if !strings.HasPrefix(header, "Basic ") {
+		return "", "", false
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(header, "Basic "))
+	if err != nil {
+		return "", "", false
+	}
+	id, secret, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return "", "", false
+	}
+	if id, err = url.QueryUnescape(id); err != nil {
+		return "", "", false
+	}
+	if secret, err = url.QueryUnescape(secret); err != nil {
+		return "", "", false
+	}
+	return id, secret, true
+}
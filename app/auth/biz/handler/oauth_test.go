@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/service"
+)
+
+// stubOAuthService 记录令牌请求并返回预设结果
+type stubOAuthService struct {
+	service.OAuthService
+	tokenReq  *service.TokenRequest
+	tokenResp *service.TokenResponse
+	err       error
+}
+
+func (s *stubOAuthService) Token(ctx context.Context, req *service.TokenRequest) (*service.TokenResponse, error) {
+	s.tokenReq = req
+	return s.tokenResp, s.err
+}
+
+func newTokenRequest(authorization string) *app.RequestContext {
+	c := app.NewContext(16)
+	c.Request.Header.SetMethod("POST")
+	c.Request.SetRequestURI("/oauth/token")
+	c.Request.Header.SetContentTypeBytes([]byte("application/x-www-form-urlencoded"))
+	c.Request.SetBodyString("grant_type=client_credentials&scope=order%3Aread")
+	if authorization != "" {
+		c.Request.Header.Set("Authorization", authorization)
+	}
+	return c
+}
+
+func TestOAuthHandler_Token(t *testing.T) {
+	svc := &stubOAuthService{tokenResp: &service.TokenResponse{AccessToken: "at", TokenType: "Bearer"}}
+	h := &OAuthHandler{svc: svc}
+
+	// client_secret 中的特殊字符按 RFC 6749 2.3.1 进行 URL 编码
+	c := newTokenRequest("Basic " + base64.StdEncoding.EncodeToString([]byte("client-1:s%3Acret")))
+	h.Token(context.Background(), c)
+
+	assert.Equal(t, consts.StatusOK, c.Response.StatusCode())
+	assert.Equal(t, "no-store", string(c.Response.Header.Peek("Cache-Control")))
+	require.NotNil(t, svc.tokenReq)
+	assert.Equal(t, "client_credentials", svc.tokenReq.GrantType)
+	assert.Equal(t, "order:read", svc.tokenReq.Scope)
+	assert.Equal(t, "client-1", svc.tokenReq.ClientID)
+	assert.Equal(t, "s:cret", svc.tokenReq.ClientSecret)
+
+	var got service.TokenResponse
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &got))
+	assert.Equal(t, "at", got.AccessToken)
+}
+
+func TestOAuthHandler_TokenError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"invalid_client", &service.OAuthError{Code: "invalid_client"}, consts.StatusUnauthorized, "invalid_client"},
+		{"invalid_grant", &service.OAuthError{Code: "invalid_grant"}, consts.StatusBadRequest, "invalid_grant"},
+		{"internal", assert.AnError, consts.StatusInternalServerError, "server_error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &OAuthHandler{svc: &stubOAuthService{err: tt.err}}
+			c := newTokenRequest("")
+			h.Token(context.Background(), c)
+
+			assert.Equal(t, tt.wantStatus, c.Response.StatusCode())
+			var got service.OAuthError
+			require.NoError(t, json.Unmarshal(c.Response.Body(), &got))
+			assert.Equal(t, tt.wantCode, got.Code)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/biz/service"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/webauthn"
+	"TikTokMall/app/auth/repository/mysql"
+)
+
+// PasskeyHandler 通行密钥注册和登录处理器
+type PasskeyHandler struct {
+	svc service.PasskeyService
+}
+
+// NewPasskeyHandler 创建通行密钥处理器
+func NewPasskeyHandler(rp *webauthn.RelyingParty) *PasskeyHandler {
+	return &PasskeyHandler{
+		svc: service.NewPasskeyService(mysql.NewAuthRepository(), rp),
+	}
+}
+
+// beginPasskeyLoginRequest 开始登录请求，用户名可为空
+type beginPasskeyLoginRequest struct {
+	Username string `json:"username"`
+}
+
+// deletePasskeyRequest 删除通行密钥请求
+type deletePasskeyRequest struct {
+	ID int64 `json:"id"`
+}
+
+// BeginRegistration 为当前用户生成注册参数
+func (h *PasskeyHandler) BeginRegistration(ctx context.Context, c *app.RequestContext) {
+	options, err := h.svc.BeginRegistration(ctx, bearerToken(c, ""))
+	if err != nil {
+		writeBaseError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, options)
+}
+
+// FinishRegistration 校验注册响应并保存凭据
+func (h *PasskeyHandler) FinishRegistration(ctx context.Context, c *app.RequestContext) {
+	var req service.FinishPasskeyRegistrationRequest
+	if err := c.BindJSON(&req); err != nil {
+		writeBadRequest(c, err)
+		return
+	}
+
+	info, err := h.svc.FinishRegistration(ctx, bearerToken(c, ""), &req)
+	if err != nil {
+		writeBaseError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, info)
+}
+
+// BeginLogin 生成登录参数
+func (h *PasskeyHandler) BeginLogin(ctx context.Context, c *app.RequestContext) {
+	var req beginPasskeyLoginRequest
+	if len(c.Request.Body()) > 0 {
+		if err := c.BindJSON(&req); err != nil {
+			writeBadRequest(c, err)
+			return
+		}
+	}
+
+	options, err := h.svc.BeginLogin(ctx, req.Username)
+	if err != nil {
+		writeBaseError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, options)
+}
+
+// FinishLogin 校验认证器签名后返回登录结果
+func (h *PasskeyHandler) FinishLogin(ctx context.Context, c *app.RequestContext) {
+	var req service.FinishPasskeyLoginRequest
+	if err := c.BindJSON(&req); err != nil {
+		writeBadRequest(c, err)
+		return
+	}
+
+	resp, err := h.svc.FinishLogin(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.LoginResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	writeLoginResponse(c, resp)
+}
+
+// List 查询当前用户的通行密钥
+func (h *PasskeyHandler) List(ctx context.Context, c *app.RequestContext) {
+	infos, err := h.svc.ListPasskeys(ctx, bearerToken(c, ""))
+	if err != nil {
+		writeBaseError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, map[string]interface{}{"passkeys": infos})
+}
+
+// Delete 删除当前用户的通行密钥
+func (h *PasskeyHandler) Delete(ctx context.Context, c *app.RequestContext) {
+	var req deletePasskeyRequest
+	if err := c.BindJSON(&req); err != nil {
+		writeBadRequest(c, err)
+		return
+	}
+
+	if err := h.svc.DeletePasskey(ctx, bearerToken(c, ""), req.ID); err != nil {
+		writeBaseError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &auth.BaseResp{
+		Code:    consts.StatusOK,
+		Message: "success",
+	})
+}
+
+// writeBaseError 输出业务错误
+func writeBaseError(c *app.RequestContext, err error) {
+	status := errorStatus(err)
+	c.JSON(status, &auth.BaseResp{
+		Code:    int32(status),
+		Message: err.Error(),
+	})
+}
+
+// writeBadRequest 输出请求参数错误
+func writeBadRequest(c *app.RequestContext, err error) {
+	c.JSON(consts.StatusBadRequest, &auth.BaseResp{
+		Code:    consts.StatusBadRequest,
+		Message: err.Error(),
+	})
+}
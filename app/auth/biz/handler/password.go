@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+// ForgotPassword 处理忘记密码请求
+func (h *AuthHandler) ForgotPassword(ctx context.Context, c *app.RequestContext) {
+	var req auth.ForgotPasswordRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ForgotPasswordResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.svc.ForgotPassword(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.ForgotPasswordResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ResetPassword 处理重置密码请求
+func (h *AuthHandler) ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var req auth.ResetPasswordRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ResetPasswordResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.svc.ResetPassword(ctx, &req)
+	if err != nil {
+		writePasswordError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ChangePassword 处理修改密码请求
+func (h *AuthHandler) ChangePassword(ctx context.Context, c *app.RequestContext) {
+	var req auth.ChangePasswordRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ChangePasswordResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.ChangePassword(ctx, &req)
+	if err != nil {
+		writePasswordError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/biz/service"
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+// RecordClientInfo 记录发起请求的客户端信息，签发令牌时写入登录会话，审计日志同样使用
+// 请求未携带 X-Trace-Id / X-Request-Id 时生成追踪 ID，并通过 X-Trace-Id 响应头返回
+func RecordClientInfo() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		traceID := string(c.GetHeader("X-Trace-Id"))
+		if traceID == "" {
+			traceID = string(c.GetHeader("X-Request-Id"))
+		}
+		if traceID == "" || len(traceID) > 64 {
+			traceID = newTraceID()
+		}
+		c.Header("X-Trace-Id", traceID)
+
+		c.Next(service.WithClientInfo(ctx, service.ClientInfo{
+			DeviceName: string(c.GetHeader("X-Device-Name")),
+			UserAgent:  string(c.UserAgent()),
+			IP:         c.ClientIP(),
+			TraceID:    traceID,
+		}))
+	}
+}
+
+// ClientIPFunc 只采用可信代理转发的 X-Forwarded-For / X-Real-IP，其他请求使用连接的对端地址
+// trustedProxies 为 CIDR 或单个 IP，为空时不信任任何转发头，防止伪造请求头绕过按 IP 的限流
+func ClientIPFunc(trustedProxies []string) (app.ClientIP, error) {
+	cidrs := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				bits = 8 * net.IPv4len
+			}
+			proxy = fmt.Sprintf("%s/%d", proxy, bits)
+		}
+		_, cidr, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return app.ClientIPWithOption(app.ClientIPOptions{
+		RemoteIPHeaders: []string{"X-Forwarded-For", "X-Real-IP"},
+		TrustedCIDRs:    cidrs,
+	}), nil
+}
+
+// newTraceID 生成 16 字节随机追踪 ID
+func newTraceID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// ListSessions 处理查询登录会话请求
+func (h *AuthHandler) ListSessions(ctx context.Context, c *app.RequestContext) {
+	var req auth.ListSessionsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ListSessionsResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.ListSessions(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.ListSessionsResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeSession 处理注销会话请求
+func (h *AuthHandler) RevokeSession(ctx context.Context, c *app.RequestContext) {
+	var req auth.RevokeSessionRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.RevokeSessionResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.RevokeSession(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.RevokeSessionResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// LogoutAll 处理退出所有设备请求
+func (h *AuthHandler) LogoutAll(ctx context.Context, c *app.RequestContext) {
+	var req auth.LogoutAllRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.LogoutAllResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.LogoutAll(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.LogoutAllResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteConn 只提供对端地址的连接
+type remoteConn struct {
+	network.Conn
+	addr net.Addr
+}
+
+func (c *remoteConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// requestFrom 构造来自 remote 的请求，forwardedFor 非空时携带 X-Forwarded-For
+func requestFrom(remote, forwardedFor string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.SetConn(&remoteConn{addr: &net.TCPAddr{IP: net.ParseIP(remote), Port: 34567}})
+	if forwardedFor != "" {
+		c.Request.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	return c
+}
+
+func TestClientIPFunc(t *testing.T) {
+	tests := []struct {
+		name         string
+		trusted      []string
+		remote       string
+		forwardedFor string
+		want         string
+	}{
+		{name: "no_trusted_proxies", remote: "203.0.113.7", forwardedFor: "198.51.100.1", want: "203.0.113.7"},
+		{name: "trusted_proxy", trusted: []string{"10.0.0.0/8"}, remote: "10.1.2.3", forwardedFor: "198.51.100.1", want: "198.51.100.1"},
+		{name: "untrusted_peer", trusted: []string{"10.0.0.0/8"}, remote: "203.0.113.7", forwardedFor: "198.51.100.1", want: "203.0.113.7"},
+		{name: "spoofed_hop_before_proxy", trusted: []string{"10.0.0.1"}, remote: "10.0.0.1", forwardedFor: "1.1.1.1, 198.51.100.1", want: "198.51.100.1"},
+		{name: "ipv6_proxy", trusted: []string{"fd00::1"}, remote: "fd00::1", forwardedFor: "2001:db8::5", want: "2001:db8::5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientIP, err := ClientIPFunc(tt.trusted)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, clientIP(requestFrom(tt.remote, tt.forwardedFor)))
+		})
+	}
+
+	_, err := ClientIPFunc([]string{"not-an-ip"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/biz/service"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/repository/mysql"
+)
+
+// SMSLoginHandler 短信验证码登录处理器
+type SMSLoginHandler struct {
+	svc service.SMSLoginService
+}
+
+// NewSMSLoginHandler 创建短信登录处理器
+func NewSMSLoginHandler(policy service.SMSLoginPolicy) *SMSLoginHandler {
+	return &SMSLoginHandler{
+		svc: service.NewSMSLoginService(mysql.NewAuthRepository(), policy),
+	}
+}
+
+// sendSMSCodeRequest 发送登录验证码请求
+type sendSMSCodeRequest struct {
+	Phone string `json:"phone"`
+}
+
+// smsLoginRequest 短信验证码登录请求
+type smsLoginRequest struct {
+	Phone string `json:"phone"`
+	Code  string `json:"code"`
+}
+
+// SendCode 向手机号发送登录验证码
+func (h *SMSLoginHandler) SendCode(ctx context.Context, c *app.RequestContext) {
+	var req sendSMSCodeRequest
+	if err := c.BindJSON(&req); err != nil {
+		writeBadRequest(c, err)
+		return
+	}
+
+	if err := h.svc.SendCode(ctx, req.Phone); err != nil {
+		writeBaseError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &auth.BaseResp{
+		Code:    consts.StatusOK,
+		Message: "success",
+	})
+}
+
+// Login 校验验证码后返回登录结果
+func (h *SMSLoginHandler) Login(ctx context.Context, c *app.RequestContext) {
+	var req smsLoginRequest
+	if err := c.BindJSON(&req); err != nil {
+		writeBadRequest(c, err)
+		return
+	}
+
+	resp, err := h.svc.Login(ctx, req.Phone, req.Code)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.LoginResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	writeLoginResponse(c, resp)
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/biz/service"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/repository/mysql"
+)
+
+// SocialHandler 外部身份提供方登录处理器
+type SocialHandler struct {
+	svc service.SocialService
+}
+
+// NewSocialHandler 创建社交登录处理器
+func NewSocialHandler(providers []*service.SocialProvider) *SocialHandler {
+	return &SocialHandler{
+		svc: service.NewSocialService(mysql.NewAuthRepository(), providers),
+	}
+}
+
+// Login 跳转到提供方授权页面，携带访问令牌时将外部身份绑定到当前用户
+func (h *SocialHandler) Login(ctx context.Context, c *app.RequestContext) {
+	authURL, err := h.svc.AuthURL(ctx, c.Param("provider"), bearerToken(c, ""))
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.BaseResp{
+			Code:    int32(status),
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if wantsJSON(c) {
+		c.JSON(consts.StatusOK, map[string]string{"auth_url": authURL})
+		return
+	}
+	c.Redirect(consts.StatusFound, []byte(authURL))
+}
+
+// Callback 处理提供方回调，返回登录结果
+func (h *SocialHandler) Callback(ctx context.Context, c *app.RequestContext) {
+	// 用户拒绝授权或提供方出错
+	if errCode := c.Query("error"); errCode != "" {
+		message := errCode
+		if desc := c.Query("error_description"); desc != "" {
+			message += ": " + desc
+		}
+		c.JSON(consts.StatusBadRequest, &auth.LoginResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: message,
+			},
+		})
+		return
+	}
+
+	resp, err := h.svc.Callback(ctx, c.Param("provider"), c.Query("state"), c.Query("code"))
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.LoginResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+// LoginTwoFactor 处理两步验证登录请求
+func (h *AuthHandler) LoginTwoFactor(ctx context.Context, c *app.RequestContext) {
+	var req auth.TwoFactorLoginRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.LoginResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.svc.LoginTwoFactor(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.LoginResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	writeLoginResponse(c, resp)
+}
+
+// EnrollTwoFactor 处理两步验证绑定请求
+func (h *AuthHandler) EnrollTwoFactor(ctx context.Context, c *app.RequestContext) {
+	var req auth.EnrollTwoFactorRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.EnrollTwoFactorResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.EnrollTwoFactor(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.EnrollTwoFactorResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ConfirmTwoFactor 处理两步验证启用确认请求
+func (h *AuthHandler) ConfirmTwoFactor(ctx context.Context, c *app.RequestContext) {
+	var req auth.ConfirmTwoFactorRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.ConfirmTwoFactorResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.ConfirmTwoFactor(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.ConfirmTwoFactorResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DisableTwoFactor 处理关闭两步验证请求
+func (h *AuthHandler) DisableTwoFactor(ctx context.Context, c *app.RequestContext) {
+	var req auth.DisableTwoFactorRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, &auth.DisableTwoFactorResponse{
+			Base: &auth.BaseResp{
+				Code:    consts.StatusBadRequest,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	req.Token = bearerToken(c, req.Token)
+
+	resp, err := h.svc.DisableTwoFactor(ctx, &req)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, &auth.DisableTwoFactorResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(status),
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/pkg/password"
+)
+
+// passwordErrorResponse 设置密码类接口的错误响应，违反密码策略时附带具体违规项
+type passwordErrorResponse struct {
+	Base       *auth.BaseResp       `json:"base,omitempty"`
+	Violations []password.Violation `json:"violations,omitempty"`
+}
+
+// writePasswordError 输出注册、重置和修改密码接口的业务错误
+func writePasswordError(c *app.RequestContext, err error) {
+	status := errorStatus(err)
+	c.JSON(status, &passwordErrorResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(status),
+			Message: err.Error(),
+		},
+		Violations: password.Violations(err),
+	})
+}
+
+// bearerToken 获取访问令牌，请求体未携带时从 Authorization 头读取
+func bearerToken(c *app.RequestContext, token string) string {
+	if token == "" {
+		token = string(c.GetHeader("Authorization"))
+	}
+	return strings.TrimPrefix(token, "Bearer ")
+}
+
+// errorStatus 将业务错误映射为 HTTP 状态码
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, auth.ErrInvalidToken),
+		errors.Is(err, auth.ErrTokenExpired),
+		errors.Is(err, auth.ErrTokenReused),
+		errors.Is(err, auth.ErrInvalidCredentials),
+		errors.Is(err, auth.ErrInvalidTwoFactorCode),
+		errors.Is(err, auth.ErrInvalidAPISignature),
+		errors.Is(err, auth.ErrPasskeyVerification):
+		return consts.StatusUnauthorized
+	case errors.Is(err, auth.ErrUserBanned),
+		errors.Is(err, auth.ErrUserDeleted),
+		errors.Is(err, auth.ErrAccountNotVerified):
+		return consts.StatusForbidden
+	case errors.Is(err, auth.ErrTooManyAttempts):
+		return consts.StatusTooManyRequests
+	case errors.Is(err, auth.ErrTwoFactorAlreadyEnabled),
+		errors.Is(err, auth.ErrTwoFactorNotEnabled),
+		errors.Is(err, auth.ErrTwoFactorNotEnrolled),
+		errors.Is(err, auth.ErrIdentityAlreadyLinked),
+		errors.Is(err, auth.ErrEmailAlreadyUsed),
+		errors.Is(err, auth.ErrPhoneAlreadyUsed),
+		errors.Is(err, auth.ErrAPIKeyLimitExceeded),
+		errors.Is(err, auth.ErrPasskeyAlreadyRegistered),
+		errors.Is(err, auth.ErrPasskeyLimitExceeded):
+		return consts.StatusConflict
+	case errors.Is(err, auth.ErrInvalidLoginState),
+		errors.Is(err, auth.ErrInvalidResetToken),
+		errors.Is(err, auth.ErrInvalidVerificationCode),
+		errors.Is(err, auth.ErrInvalidArgument):
+		return consts.StatusBadRequest
+	case errors.Is(err, auth.ErrIdentityProviderNotFound),
+		errors.Is(err, auth.ErrRoleNotFound),
+		errors.Is(err, auth.ErrSessionNotFound),
+		errors.Is(err, auth.ErrAPIKeyNotFound),
+		errors.Is(err, auth.ErrPasskeyNotFound),
+		errors.Is(err, auth.ErrUserNotFound):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/encrypt"
+	"TikTokMall/pkg/apisign"
+)
+
+// 开放平台 API 密钥可授予的 scope
+const (
+	ScopeOrderRead  = "order:read"
+	ScopeOrderWrite = "order:write"
+)
+
+// APIKeyScopes 创建 API 密钥时允许申请的 scope
+var APIKeyScopes = []string{ScopeOrderRead, ScopeOrderWrite}
+
+const (
+	DefaultAPIKeyExpiresDays = 90
+	MaxAPIKeyExpiresDays     = 365
+	MaxAPIKeysPerUser        = 10
+
+	apiKeyIDPrefix      = "ak_"
+	apiKeySecretPrefix  = "sk_"
+	maxAPIKeyNameLength = 64
+	apiKeyTouchInterval = time.Minute // 最近使用时间的更新间隔，避免每次请求都写数据库
+)
+
+// CreateAPIKey 为当前用户创建 API 密钥，密钥只在响应中返回一次，服务端保存加密后的派生签名密钥
+func (s *authService) CreateAPIKey(ctx context.Context, req *auth.CreateAPIKeyRequest) (resp *auth.CreateAPIKeyResponse, err error) {
+	event := newAuditEvent(ctx, AuditAPIKeyCreate, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	claims, err := s.parseSessionToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID, event.Username = claims.UserID, claims.Username
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxAPIKeyNameLength {
+		return nil, fmt.Errorf("%w: name must be 1-%d characters", auth.ErrInvalidArgument, maxAPIKeyNameLength)
+	}
+	scopes := parseScopes(strings.Join(req.Scopes, " "))
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: scopes required", auth.ErrInvalidArgument)
+	}
+	for _, sc := range scopes {
+		if !containsString(APIKeyScopes, sc) {
+			return nil, fmt.Errorf("%w: unknown scope %q", auth.ErrInvalidArgument, sc)
+		}
+	}
+	if req.ExpiresInDays < 0 || req.ExpiresInDays > MaxAPIKeyExpiresDays {
+		return nil, fmt.Errorf("%w: expires_in_days must be at most %d", auth.ErrInvalidArgument, MaxAPIKeyExpiresDays)
+	}
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = DefaultAPIKeyExpiresDays
+	}
+
+	now := time.Now()
+	active, err := s.repo.CountActiveAPIKeys(claims.UserID, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "count api keys failed")
+	}
+	if active >= MaxAPIKeysPerUser {
+		return nil, auth.ErrAPIKeyLimitExceeded
+	}
+
+	keyID, err := generateClientID()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	keyID, secret = apiKeyIDPrefix+keyID, apiKeySecretPrefix+strings.TrimRight(secret, "=")
+	secretHash, err := encrypt.Encrypt(apisign.DeriveKey(secret))
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt api key failed")
+	}
+
+	key := &mysql.APIKey{
+		KeyID:      keyID,
+		UserID:     claims.UserID,
+		Name:       name,
+		SecretHash: secretHash,
+		Scopes:     strings.Join(scopes, " "),
+		ExpiresAt:  now.AddDate(0, 0, int(days)),
+		CreatedAt:  now,
+	}
+	if err := s.repo.CreateAPIKey(key); err != nil {
+		return nil, errors.Wrap(err, "create api key failed")
+	}
+
+	return &auth.CreateAPIKeyResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Key:    newAPIKeyInfo(key),
+		Secret: secret,
+	}, nil
+}
+
+// ListAPIKeys 查询当前用户的 API 密钥，包含已吊销和已过期的密钥
+func (s *authService) ListAPIKeys(ctx context.Context, req *auth.ListAPIKeysRequest) (*auth.ListAPIKeysResponse, error) {
+	claims, err := s.parseSessionToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.repo.ListAPIKeys(claims.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list api keys failed")
+	}
+	keys := make([]*auth.APIKey, 0, len(records))
+	for _, k := range records {
+		keys = append(keys, newAPIKeyInfo(k))
+	}
+	return &auth.ListAPIKeysResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Keys: keys,
+	}, nil
+}
+
+// RevokeAPIKey 吊销当前用户的 API 密钥，立即生效
+func (s *authService) RevokeAPIKey(ctx context.Context, req *auth.RevokeAPIKeyRequest) (resp *auth.RevokeAPIKeyResponse, err error) {
+	event := newAuditEvent(ctx, AuditAPIKeyRevoke, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	claims, err := s.parseSessionToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID, event.Username = claims.UserID, claims.Username
+
+	revoked, err := s.repo.RevokeAPIKey(claims.UserID, req.KeyId)
+	if err != nil {
+		return nil, errors.Wrap(err, "revoke api key failed")
+	}
+	if !revoked {
+		return nil, auth.ErrAPIKeyNotFound
+	}
+	return &auth.RevokeAPIKeyResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// VerifyAPISignature 校验请求签名，密钥不存在、已吊销、已过期、所属用户被封禁或签名不匹配时返回 valid=false
+func (s *authService) VerifyAPISignature(ctx context.Context, req *auth.VerifyAPISignatureReq) (*auth.VerifyAPISignatureResp, error) {
+	invalid := &auth.VerifyAPISignatureResp{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusUnauthorized),
+			Message: auth.ErrInvalidAPISignature.Error(),
+		},
+	}
+	key, err := s.repo.GetAPIKey(req.KeyId)
+	if err != nil {
+		return nil, errors.Wrap(err, "get api key failed")
+	}
+	now := time.Now()
+	if key == nil || !key.Active(now) {
+		return invalid, nil
+	}
+	signingKey, err := encrypt.Decrypt(key.SecretHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt api key failed")
+	}
+	if !apisign.Verify(signingKey, req.StringToSign, req.Signature) {
+		return invalid, nil
+	}
+	user, err := s.repo.GetUserByID(key.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get api key owner failed")
+	}
+	if checkUserActive(user) != nil {
+		return invalid, nil
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.repo.TouchAPIKey(key.KeyID, now); err != nil {
+			hlog.CtxWarnf(ctx, "update api key last used time failed: %v", err)
+		}
+	}
+
+	return &auth.VerifyAPISignatureResp{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Valid:  true,
+		UserId: key.UserID,
+		Scopes: strings.Fields(key.Scopes),
+	}, nil
+}
+
+// newAPIKeyInfo 转换为接口返回的密钥信息
+func newAPIKeyInfo(k *mysql.APIKey) *auth.APIKey {
+	info := &auth.APIKey{
+		KeyId:     k.KeyID,
+		Name:      k.Name,
+		Scopes:    strings.Fields(k.Scopes),
+		CreatedAt: k.CreatedAt.Unix(),
+		ExpiresAt: k.ExpiresAt.Unix(),
+		Revoked:   k.RevokedAt != nil,
+	}
+	if k.LastUsedAt != nil {
+		info.LastUsedAt = k.LastUsedAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/pkg/apisign"
+)
+
+// apiKeyTestEnv 在权限测试环境的基础上保存 API 密钥
+type apiKeyTestEnv struct {
+	*rbacTestEnv
+	keys    map[string]*mysql.APIKey
+	touched int
+}
+
+func newAPIKeyTestEnv(t *testing.T) *apiKeyTestEnv {
+	env := &apiKeyTestEnv{rbacTestEnv: newRBACTestEnv(t), keys: make(map[string]*mysql.APIKey)}
+	env.svc = NewAuthService(env)
+	return env
+}
+
+func (env *apiKeyTestEnv) CreateAPIKey(key *mysql.APIKey) error {
+	env.keys[key.KeyID] = key
+	return nil
+}
+
+func (env *apiKeyTestEnv) GetAPIKey(keyID string) (*mysql.APIKey, error) {
+	return env.keys[keyID], nil
+}
+
+func (env *apiKeyTestEnv) ListAPIKeys(userID int64) ([]*mysql.APIKey, error) {
+	var keys []*mysql.APIKey
+	for _, k := range env.keys {
+		if k.UserID == userID {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (env *apiKeyTestEnv) CountActiveAPIKeys(userID int64, now time.Time) (int64, error) {
+	var n int64
+	for _, k := range env.keys {
+		if k.UserID == userID && k.Active(now) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (env *apiKeyTestEnv) RevokeAPIKey(userID int64, keyID string) (bool, error) {
+	k, ok := env.keys[keyID]
+	if !ok || k.UserID != userID || k.RevokedAt != nil {
+		return false, nil
+	}
+	now := time.Now()
+	k.RevokedAt = &now
+	return true, nil
+}
+
+func (env *apiKeyTestEnv) TouchAPIKey(keyID string, usedAt time.Time) error {
+	env.touched++
+	env.keys[keyID].LastUsedAt = &usedAt
+	return nil
+}
+
+// verify 使用密钥签名并调用 VerifyAPISignature
+func (env *apiKeyTestEnv) verify(keyID, secret, body string) (*auth.VerifyAPISignatureResp, error) {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	stringToSign := apisign.StringToSign("POST", "/v1/order/list", ts, "nonce-0001", []byte(body))
+	return env.svc.VerifyAPISignature(context.Background(), &auth.VerifyAPISignatureReq{
+		KeyId:        keyID,
+		StringToSign: stringToSign,
+		Signature:    apisign.Sign(apisign.DeriveKey(secret), stringToSign),
+	})
+}
+
+// assertInvalidSignature 签名无效时返回 valid=false 而不是错误
+func assertInvalidSignature(t *testing.T, resp *auth.VerifyAPISignatureResp, err error) {
+	t.Helper()
+	require.NoError(t, err)
+	assert.False(t, resp.Valid)
+	assert.Zero(t, resp.UserId)
+}
+
+func TestAPIKey_CreateAndVerify(t *testing.T) {
+	env := newAPIKeyTestEnv(t)
+	ctx := context.Background()
+	token := env.login(t, 2)
+
+	created, err := env.svc.CreateAPIKey(ctx, &auth.CreateAPIKeyRequest{
+		Token:  token,
+		Name:   "erp",
+		Scopes: []string{ScopeOrderRead, ScopeOrderRead, ScopeOrderWrite},
+	})
+	require.NoError(t, err)
+	assert.Regexp(t, `^ak_[0-9a-f]{32}$`, created.Key.KeyId)
+	assert.Regexp(t, `^sk_`, created.Secret)
+	assert.Equal(t, []string{ScopeOrderRead, ScopeOrderWrite}, created.Key.Scopes)
+	assert.InDelta(t, time.Now().AddDate(0, 0, DefaultAPIKeyExpiresDays).Unix(), created.Key.ExpiresAt, 5)
+
+	// 只保存加密后的派生密钥
+	stored := env.keys[created.Key.KeyId]
+	assert.NotContains(t, stored.SecretHash, created.Secret)
+	assert.NotContains(t, stored.SecretHash, apisign.DeriveKey(created.Secret))
+
+	resp, err := env.verify(created.Key.KeyId, created.Secret, `{"page":1}`)
+	require.NoError(t, err)
+	assert.True(t, resp.Valid)
+	assert.Equal(t, int64(2), resp.UserId)
+	assert.Equal(t, []string{ScopeOrderRead, ScopeOrderWrite}, resp.Scopes)
+	assert.NotNil(t, stored.LastUsedAt)
+
+	// 短时间内重复使用不更新最近使用时间
+	resp, err = env.verify(created.Key.KeyId, created.Secret, `{"page":2}`)
+	require.NoError(t, err)
+	assert.True(t, resp.Valid)
+	assert.Equal(t, 1, env.touched)
+
+	resp, err = env.verify(created.Key.KeyId, "sk_wrong", `{"page":1}`)
+	assertInvalidSignature(t, resp, err)
+	resp, err = env.verify("ak_unknown", created.Secret, `{"page":1}`)
+	assertInvalidSignature(t, resp, err)
+
+	// 过期和所属用户被封禁的密钥不能使用
+	stored.ExpiresAt = time.Now().Add(-time.Second)
+	resp, err = env.verify(created.Key.KeyId, created.Secret, `{"page":1}`)
+	assertInvalidSignature(t, resp, err)
+	stored.ExpiresAt = time.Now().Add(time.Hour)
+	env.users[2].Status = UserStatusBanned
+	resp, err = env.verify(created.Key.KeyId, created.Secret, `{"page":1}`)
+	assertInvalidSignature(t, resp, err)
+}
+
+func TestAPIKey_Validation(t *testing.T) {
+	env := newAPIKeyTestEnv(t)
+	ctx := context.Background()
+	token := env.login(t, 2)
+
+	tests := []struct {
+		name string
+		req  *auth.CreateAPIKeyRequest
+	}{
+		{name: "empty_name", req: &auth.CreateAPIKeyRequest{Name: " ", Scopes: []string{ScopeOrderRead}}},
+		{name: "no_scope", req: &auth.CreateAPIKeyRequest{Name: "erp"}},
+		{name: "unknown_scope", req: &auth.CreateAPIKeyRequest{Name: "erp", Scopes: []string{"user:ban"}}},
+		{name: "too_long", req: &auth.CreateAPIKeyRequest{Name: "erp", Scopes: []string{ScopeOrderRead}, ExpiresInDays: MaxAPIKeyExpiresDays + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Token = token
+			_, err := env.svc.CreateAPIKey(ctx, tt.req)
+			assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+		})
+	}
+
+	// 每个用户的有效密钥数量有上限
+	for i := 0; i < MaxAPIKeysPerUser; i++ {
+		_, err := env.svc.CreateAPIKey(ctx, &auth.CreateAPIKeyRequest{Token: token, Name: "erp", Scopes: []string{ScopeOrderRead}, ExpiresInDays: 1})
+		require.NoError(t, err)
+	}
+	_, err := env.svc.CreateAPIKey(ctx, &auth.CreateAPIKeyRequest{Token: token, Name: "erp", Scopes: []string{ScopeOrderRead}})
+	assert.ErrorIs(t, err, auth.ErrAPIKeyLimitExceeded)
+
+	_, err = env.svc.CreateAPIKey(ctx, &auth.CreateAPIKeyRequest{Token: "invalid", Name: "erp", Scopes: []string{ScopeOrderRead}})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestAPIKey_ListAndRevoke(t *testing.T) {
+	env := newAPIKeyTestEnv(t)
+	ctx := context.Background()
+	shopper, admin := env.login(t, 2), env.login(t, 1)
+
+	created, err := env.svc.CreateAPIKey(ctx, &auth.CreateAPIKeyRequest{Token: shopper, Name: "erp", Scopes: []string{ScopeOrderRead}})
+	require.NoError(t, err)
+
+	// 只能吊销自己的密钥
+	_, err = env.svc.RevokeAPIKey(ctx, &auth.RevokeAPIKeyRequest{Token: admin, KeyId: created.Key.KeyId})
+	assert.ErrorIs(t, err, auth.ErrAPIKeyNotFound)
+	_, err = env.svc.RevokeAPIKey(ctx, &auth.RevokeAPIKeyRequest{Token: shopper, KeyId: created.Key.KeyId})
+	require.NoError(t, err)
+	_, err = env.svc.RevokeAPIKey(ctx, &auth.RevokeAPIKeyRequest{Token: shopper, KeyId: created.Key.KeyId})
+	assert.ErrorIs(t, err, auth.ErrAPIKeyNotFound)
+
+	// 吊销立即生效
+	resp, err := env.verify(created.Key.KeyId, created.Secret, "")
+	assertInvalidSignature(t, resp, err)
+
+	list, err := env.svc.ListAPIKeys(ctx, &auth.ListAPIKeysRequest{Token: shopper})
+	require.NoError(t, err)
+	require.Len(t, list.Keys, 1)
+	assert.True(t, list.Keys[0].Revoked)
+	assert.Equal(t, "erp", list.Keys[0].Name)
+
+	list, err = env.svc.ListAPIKeys(ctx, &auth.ListAPIKeysRequest{Token: admin})
+	require.NoError(t, err)
+	assert.Empty(t, list.Keys)
+}
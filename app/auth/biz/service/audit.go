@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/uber/jaeger-client-go"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/audit"
+)
+
+// 审计事件类型
+const (
+	AuditRegister         = "register"
+	AuditLogin            = "login"
+	AuditLoginChallenge   = "login_challenge" // 密码正确，等待两步验证
+	AuditLoginTwoFactor   = "login_2fa"
+	AuditLoginSocial      = "login_social" // 外部身份提供方登录
+	AuditLoginPasskey     = "login_passkey"
+	AuditLoginSMS         = "login_sms" // 短信验证码登录，自动注册的账号同样记录为该类型
+	AuditRefresh          = "refresh"
+	AuditLogout           = "logout"
+	AuditLogoutAll        = "logout_all"
+	AuditSessionRevoke    = "session_revoke"
+	AuditUserBan          = "user_ban"
+	AuditUserUnban        = "user_unban"
+	AuditPasswordChange   = "password_change"
+	AuditPasswordReset    = "password_reset"
+	AuditTwoFactorEnable  = "2fa_enable"
+	AuditTwoFactorDisable = "2fa_disable"
+	AuditAPIKeyCreate     = "apikey_create"
+	AuditAPIKeyRevoke     = "apikey_revoke"
+	AuditPasskeyRegister  = "passkey_register"
+	AuditPasskeyDelete    = "passkey_delete"
+)
+
+const (
+	defaultAuditPageSize = 20
+	maxAuditPageSize     = 100
+	maxAuditReasonLength = 255
+)
+
+// auditStore 将审计事件写入 audit_logs 表
+type auditStore struct {
+	repo AuthRepository
+}
+
+// NewAuditStore 创建基于数据库的审计日志存储
+func NewAuditStore(repo AuthRepository) audit.Store {
+	return &auditStore{repo: repo}
+}
+
+func (st *auditStore) SaveEvents(ctx context.Context, events []*audit.Event) error {
+	logs := make([]*mysql.AuditLog, len(events))
+	for i, e := range events {
+		logs[i] = &mysql.AuditLog{
+			UserID:     e.UserID,
+			Username:   truncate(e.Username, 64),
+			Event:      e.Type,
+			Outcome:    e.Outcome,
+			Reason:     truncate(e.Reason, maxAuditReasonLength),
+			OperatorID: e.OperatorID,
+			IP:         e.IP,
+			UserAgent:  truncate(e.UserAgent, maxUserAgentLength),
+			TraceID:    e.TraceID,
+			CreatedAt:  e.CreatedAt,
+		}
+	}
+	return st.repo.CreateAuditLogs(logs)
+}
+
+// newAuditEvent 创建带客户端信息和追踪 ID 的审计事件
+func newAuditEvent(ctx context.Context, eventType string, userID int64, username string) *audit.Event {
+	e := &audit.Event{Type: eventType, UserID: userID, Username: username}
+	if info, ok := clientInfoFrom(ctx); ok {
+		e.IP = info.IP
+		e.UserAgent = info.UserAgent
+		e.TraceID = info.TraceID
+	}
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		if sc, ok := span.Context().(jaeger.SpanContext); ok {
+			e.TraceID = sc.TraceID().String()
+		}
+	}
+	return e
+}
+
+// recordAudit 根据业务错误设置事件结果后异步写入审计日志
+func recordAudit(e *audit.Event, err error) {
+	e.Outcome = audit.OutcomeSuccess
+	if err != nil {
+		e.Outcome = audit.OutcomeFailure
+		e.Reason = err.Error()
+	}
+	audit.Record(e)
+}
+
+// ListAuditLogs 按用户、事件类型和时间范围分页查询审计日志
+func (s *authService) ListAuditLogs(ctx context.Context, req *auth.ListAuditLogsRequest) (*auth.ListAuditLogsResponse, error) {
+	if _, err := s.adminOperator(ctx, req.Token); err != nil {
+		return nil, err
+	}
+	if req.Page < 0 || req.PageSize < 0 || (req.EndTime != 0 && req.EndTime < req.StartTime) {
+		return nil, fmt.Errorf("%w: invalid page or time range", auth.ErrInvalidArgument)
+	}
+	page, size := int(req.Page), int(req.PageSize)
+	if page == 0 {
+		page = 1
+	}
+	if size == 0 {
+		size = defaultAuditPageSize
+	}
+	if size > maxAuditPageSize {
+		size = maxAuditPageSize
+	}
+
+	filter := mysql.AuditLogFilter{
+		UserID: req.UserId,
+		Event:  req.Event,
+		Offset: (page - 1) * size,
+		Limit:  size,
+	}
+	if req.StartTime > 0 {
+		filter.Since = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		filter.Until = time.Unix(req.EndTime, 0)
+	}
+	records, total, err := s.repo.ListAuditLogs(filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "list audit logs failed")
+	}
+
+	logs := make([]*auth.AuditLog, 0, len(records))
+	for _, r := range records {
+		logs = append(logs, &auth.AuditLog{
+			Id:         r.ID,
+			UserId:     r.UserID,
+			Username:   r.Username,
+			Event:      r.Event,
+			Outcome:    r.Outcome,
+			Reason:     r.Reason,
+			OperatorId: r.OperatorID,
+			Ip:         r.IP,
+			UserAgent:  r.UserAgent,
+			TraceId:    r.TraceID,
+			CreatedAt:  r.CreatedAt.UnixMilli(),
+		})
+	}
+
+	return &auth.ListAuditLogsResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Logs:  logs,
+		Total: total,
+	}, nil
+}
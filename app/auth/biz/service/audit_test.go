@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/audit"
+)
+
+// auditRecorder 记录写入的审计事件
+type auditRecorder struct {
+	mu     sync.Mutex
+	events []*audit.Event
+}
+
+func (r *auditRecorder) SaveEvents(ctx context.Context, events []*audit.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, events...)
+	return nil
+}
+
+// startAudit 替换默认审计写入器，返回的函数写入剩余事件并恢复
+func startAudit(t *testing.T) func() []*audit.Event {
+	rec := &auditRecorder{}
+	audit.Init(audit.NewWriter(rec, audit.Config{FlushInterval: time.Hour}))
+	stop := func() []*audit.Event {
+		if audit.Default != nil {
+			audit.Default.Close()
+			audit.Init(nil)
+		}
+		return rec.events
+	}
+	t.Cleanup(func() { stop() })
+	return stop
+}
+
+func TestAudit_Login(t *testing.T) {
+	mockRepo := new(servicemock.MockAuthRepository)
+	svc := NewAuthService(mockRepo)
+	redis.Client = &mockRedis{}
+	stop := startAudit(t)
+
+	hashed, _ := hashPassword("password123")
+	mockRepo.On("GetUserByUsername", "audited").Return(&mysql.User{
+		ID:       7,
+		Username: "audited",
+		Password: hashed,
+		Status:   UserStatusNormal,
+	}, nil)
+	mockRepo.On("CreateToken", mock.AnythingOfType("*mysql.Token")).Return(nil)
+
+	ctx := WithClientInfo(context.Background(), ClientInfo{
+		IP:        "203.0.113.5",
+		UserAgent: "audit-test/1.0",
+		TraceID:   "trace-1",
+	})
+	_, err := svc.Login(ctx, &auth.LoginRequest{Username: "audited", Password: "wrong"})
+	require.ErrorIs(t, err, auth.ErrInvalidCredentials)
+	_, err = svc.Login(ctx, &auth.LoginRequest{Username: "audited", Password: "password123"})
+	require.NoError(t, err)
+
+	events := stop()
+	require.Len(t, events, 2)
+	failed, ok := events[0], events[1]
+	assert.Equal(t, AuditLogin, failed.Type)
+	assert.Equal(t, audit.OutcomeFailure, failed.Outcome)
+	assert.Contains(t, failed.Reason, "invalid")
+	assert.Equal(t, int64(7), failed.UserID)
+	assert.Equal(t, "203.0.113.5", failed.IP)
+	assert.Equal(t, "audit-test/1.0", failed.UserAgent)
+	assert.Equal(t, "trace-1", failed.TraceID)
+
+	assert.Equal(t, AuditLogin, ok.Type)
+	assert.Equal(t, audit.OutcomeSuccess, ok.Outcome)
+	assert.Empty(t, ok.Reason)
+	assert.Equal(t, "audited", ok.Username)
+	assert.False(t, ok.CreatedAt.IsZero())
+}
+
+func TestAudit_UserBan(t *testing.T) {
+	env := newRBACTestEnv(t)
+	admin := env.login(t, 1)
+	stop := startAudit(t)
+
+	_, err := env.svc.UpdateUserStatus(context.Background(), &auth.UpdateUserStatusRequest{Token: admin, UserId: 2, Status: UserStatusBanned})
+	require.NoError(t, err)
+
+	events := stop()
+	require.Len(t, events, 1)
+	assert.Equal(t, AuditUserBan, events[0].Type)
+	assert.Equal(t, int64(2), events[0].UserID)
+	assert.Equal(t, int64(1), events[0].OperatorID)
+	assert.Equal(t, audit.OutcomeSuccess, events[0].Outcome)
+}
+
+func TestAudit_ListAuditLogs(t *testing.T) {
+	env := newRBACTestEnv(t)
+	admin := env.login(t, 1)
+	ctx := context.Background()
+
+	created := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	env.On("ListAuditLogs", mysql.AuditLogFilter{
+		UserID: 2,
+		Event:  AuditLogin,
+		Since:  time.Unix(1714521600, 0),
+		Until:  time.Unix(1714608000, 0),
+		Offset: 10,
+		Limit:  10,
+	}).Return([]*mysql.AuditLog{
+		{ID: 11, UserID: 2, Event: AuditLogin, Outcome: audit.OutcomeSuccess, IP: "203.0.113.5", TraceID: "trace-1", CreatedAt: created},
+	}, int64(11), nil).Once()
+
+	resp, err := env.svc.ListAuditLogs(ctx, &auth.ListAuditLogsRequest{
+		Token:     admin,
+		UserId:    2,
+		Event:     AuditLogin,
+		StartTime: 1714521600,
+		EndTime:   1714608000,
+		Page:      2,
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), resp.Total)
+	require.Len(t, resp.Logs, 1)
+	assert.Equal(t, "trace-1", resp.Logs[0].TraceId)
+	assert.Equal(t, created.UnixMilli(), resp.Logs[0].CreatedAt)
+
+	// 默认第一页，每页数量有上限
+	env.On("ListAuditLogs", mysql.AuditLogFilter{Limit: maxAuditPageSize}).Return([]*mysql.AuditLog{}, int64(0), nil).Once()
+	_, err = env.svc.ListAuditLogs(ctx, &auth.ListAuditLogsRequest{Token: admin, PageSize: 1000})
+	require.NoError(t, err)
+
+	_, err = env.svc.ListAuditLogs(ctx, &auth.ListAuditLogsRequest{Token: admin, StartTime: 100, EndTime: 50})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+	_, err = env.svc.ListAuditLogs(ctx, &auth.ListAuditLogsRequest{Token: "invalid"})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	env.AssertExpectations(t)
+}
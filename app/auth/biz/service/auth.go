@@ -4,16 +4,21 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
 
 	"TikTokMall/app/auth/biz/dal/mysql"
 	"TikTokMall/app/auth/biz/dal/redis"
 	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+	"TikTokMall/pkg/tokenhash"
 
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 )
@@ -23,19 +28,43 @@ const (
 	TokenExpiration        = 24 * time.Hour     // 访问令牌有效期
 	RefreshTokenExpiration = 7 * 24 * time.Hour // 刷新令牌有效期
 	TokenLength            = 32                 // 令牌长度（字节）
-	MaxLoginRetries        = 5                  // 最大登录重试次数
-	LoginRetryWindow       = time.Hour          // 登录重试窗口期
 	maxLoginAttempts       = 5                  // 最大登录尝试次数
 
 	// 用户状态
-	UserStatusNormal = 1 // 正常
-	UserStatusBanned = 2 // 禁用
+	UserStatusNormal   = 1 // 正常
+	UserStatusBanned   = 2 // 禁用
+	UserStatusDeleting = 3 // 已申请注销，宽限期内可以在用户服务撤销
+	UserStatusErased   = 4 // 已注销，个人数据已匿名化
+
+	// 默认角色
+	RoleUser = "user"
+
+	// 第三方应用访问令牌有效期
+	OAuthAccessTokenExpiration = time.Hour
 )
 
 type authService struct {
 	repo AuthRepository
 }
 
+// tasks 后台发送通知等任务，多个 authService 实例共用，服务退出前通过 WaitTasks 等待完成
+var tasks sync.WaitGroup
+
+// WaitTasks 等待后台任务完成，ctx 结束时返回 ctx 的错误，未完成的任务会被丢弃
+func WaitTasks(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		tasks.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func NewAuthService(repo AuthRepository) AuthService {
 	return &authService{
 		repo: repo,
@@ -65,51 +94,90 @@ func comparePassword(hashedPassword, password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 }
 
-// validateLoginRetries 验证登录重试次数
-func (s *authService) validateLoginRetries(ctx context.Context, username string, isValidPassword bool) error {
-	// 如果密码正确，跳过重试次数验证
-	if isValidPassword {
-		// 重置重试计数
-		if err := redis.ResetLoginRetry(ctx, username); err != nil {
-			hlog.CtxWarnf(ctx, "reset login retry count failed: %v", err)
-		}
-		return nil
-	}
+// tokenGrant 令牌的授权信息，第一方登录为空，第三方应用令牌携带 client_id 和 scope
+type tokenGrant struct {
+	ClientID string
+	Scope    string
+}
 
-	// 增加重试计数
-	count, err := redis.IncrLoginRetry(ctx, username)
-	if err != nil {
-		return errors.Wrap(err, "increment login retry count failed")
+// accessTokenTTL 访问令牌有效期，第三方应用使用较短的有效期
+func (g tokenGrant) accessTokenTTL() time.Duration {
+	if g.ClientID != "" {
+		return OAuthAccessTokenExpiration
 	}
+	return TokenExpiration
+}
+
+// withRefreshToken 第三方应用只有申请了 offline_access 才签发刷新令牌
+func (g tokenGrant) withRefreshToken() bool {
+	return g.ClientID == "" || hasScope(g.Scope, ScopeOfflineAccess)
+}
 
-	// 检查是否超过重试限制
-	if count >= MaxLoginRetries {
-		return errors.New("too many login attempts")
+// signAccessToken 签发 JWT 访问令牌
+func signAccessToken(user *mysql.User, grant tokenGrant) (string, error) {
+	claims := jwt.NewClaims(user.ID, user.Username, grant.accessTokenTTL())
+	if grant.ClientID != "" {
+		// 第三方应用令牌的权限由 scope 决定
+		claims.ClientID = grant.ClientID
+		claims.Scope = grant.Scope
+	}
+	token, err := jwt.Sign(claims)
+	if err != nil {
+		return "", errors.Wrap(err, "sign access token failed")
 	}
+	return token, nil
+}
 
-	return errors.New("invalid username or password")
+// createAndCacheTokens 创建并缓存令牌，开启新的令牌族
+func (s *authService) createAndCacheTokens(ctx context.Context, user *mysql.User) (token, refreshToken string, err error) {
+	return s.createGrantTokens(ctx, user, tokenGrant{})
 }
 
-// createAndCacheTokens 创建并缓存令牌
-func (s *authService) createAndCacheTokens(ctx context.Context, userID int64) (token, refreshToken string, err error) {
-	// 生成访问令牌
-	token, err = generateToken()
+// createGrantTokens 按授权信息创建令牌，开启新的令牌族
+func (s *authService) createGrantTokens(ctx context.Context, user *mysql.User, grant tokenGrant) (token, refreshToken string, err error) {
+	familyID, err := generateToken()
 	if err != nil {
-		return "", "", errors.Wrap(err, "generate access token failed")
+		return "", "", errors.Wrap(err, "generate token family failed")
 	}
+	return s.issueTokens(ctx, user, grant, familyID, 0)
+}
 
-	// 生成刷新令牌
-	refreshToken, err = generateToken()
+// issueTokens 签发令牌对并记录到令牌族中
+func (s *authService) issueTokens(ctx context.Context, user *mysql.User, grant tokenGrant, familyID string, parentID int64) (token, refreshToken string, err error) {
+	userID := user.ID
+	ttl := grant.accessTokenTTL()
+
+	// 生成访问令牌
+	token, err = signAccessToken(user, grant)
 	if err != nil {
-		return "", "", errors.Wrap(err, "generate refresh token failed")
+		return "", "", err
 	}
 
 	// 创建Token记录
+	now := time.Now()
 	tokenRecord := &mysql.Token{
-		UserID:       userID,
-		Token:        token,
-		RefreshToken: refreshToken,
-		ExpiredAt:    time.Now().Add(TokenExpiration),
+		UserID:     userID,
+		Token:      tokenhash.Digest(token),
+		FamilyID:   familyID,
+		ParentID:   parentID,
+		ExpiredAt:  now.Add(ttl),
+		ClientID:   grant.ClientID,
+		Scope:      grant.Scope,
+		LastSeenAt: &now,
+	}
+	if info, ok := clientInfoFrom(ctx); ok {
+		applyClientInfo(tokenRecord, info)
+	}
+
+	// 生成刷新令牌
+	if grant.withRefreshToken() {
+		refreshToken, err = generateToken()
+		if err != nil {
+			return "", "", errors.Wrap(err, "generate refresh token failed")
+		}
+		refreshExpiredAt := now.Add(RefreshTokenExpiration)
+		tokenRecord.RefreshToken = tokenhash.Digest(refreshToken)
+		tokenRecord.RefreshExpiredAt = &refreshExpiredAt
 	}
 
 	if err := s.repo.CreateToken(tokenRecord); err != nil {
@@ -117,27 +185,46 @@ func (s *authService) createAndCacheTokens(ctx context.Context, userID int64) (t
 	}
 
 	// 缓存Token
-	if err := redis.CacheToken(ctx, token, userID, TokenExpiration); err != nil {
+	if err := redis.CacheToken(ctx, tokenRecord.Token, userID, ttl); err != nil {
 		hlog.CtxWarnf(ctx, "cache token failed: %v", err)
 	}
 
 	return token, refreshToken, nil
 }
 
+// revokeTokenFamily 吊销整个令牌族，并将其中的访问令牌加入黑名单
+func (s *authService) revokeTokenFamily(ctx context.Context, familyID string) error {
+	tokens, err := s.repo.RevokeTokenFamily(familyID)
+	if err != nil {
+		return errors.Wrap(err, "revoke token family failed")
+	}
+	for _, t := range tokens {
+		if err := redis.DeleteToken(ctx, t.Token); err != nil {
+			hlog.CtxWarnf(ctx, "delete token from cache failed: %v", err)
+		}
+		if err := redis.AddToBlacklist(ctx, t.Token, TokenExpiration); err != nil {
+			hlog.CtxWarnf(ctx, "add token to blacklist failed: %v", err)
+		}
+	}
+	return nil
+}
+
 // invalidateTokens 使令牌失效
 func (s *authService) invalidateTokens(ctx context.Context, token string) error {
+	digest := tokenhash.Digest(token)
+
 	// 从数据库删除令牌
-	if err := mysql.DeleteToken(token); err != nil {
+	if err := mysql.DeleteToken(digest); err != nil {
 		return errors.Wrap(err, "delete token from database failed")
 	}
 
 	// 从缓存删除令牌
-	if err := redis.DeleteToken(ctx, token); err != nil {
+	if err := redis.DeleteToken(ctx, digest); err != nil {
 		hlog.CtxWarnf(ctx, "delete token from cache failed: %v", err)
 	}
 
 	// 将令牌加入黑名单
-	if err := redis.AddToBlacklist(ctx, token, TokenExpiration); err != nil {
+	if err := redis.AddToBlacklist(ctx, digest, TokenExpiration); err != nil {
 		hlog.CtxWarnf(ctx, "add token to blacklist failed: %v", err)
 	}
 
@@ -145,7 +232,10 @@ func (s *authService) invalidateTokens(ctx context.Context, token string) error
 }
 
 // Register 实现注册功能
-func (s *authService) Register(ctx context.Context, req *auth.RegisterRequest) (*auth.RegisterResponse, error) {
+func (s *authService) Register(ctx context.Context, req *auth.RegisterRequest) (resp *auth.RegisterResponse, err error) {
+	event := newAuditEvent(ctx, AuditRegister, 0, req.Username)
+	defer func() { recordAudit(event, err) }()
+
 	// 检查用户名是否已存在
 	exists, err := s.repo.GetUserByUsername(req.Username)
 	if err != nil && err != mysql.ErrRecordNotFound {
@@ -155,6 +245,30 @@ func (s *authService) Register(ctx context.Context, req *auth.RegisterRequest) (
 		return nil, errors.New("username already exists")
 	}
 
+	// 邮箱和手机号只能绑定一个账号
+	if req.Email != "" {
+		used, err := s.repo.CheckEmailExists(req.Email)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			return nil, auth.ErrEmailAlreadyUsed
+		}
+	}
+	if req.Phone != "" {
+		used, err := s.repo.CheckPhoneExists(req.Phone)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			return nil, auth.ErrPhoneAlreadyUsed
+		}
+	}
+
+	if err := checkPasswordPolicy(req.Password, req.Username); err != nil {
+		return nil, err
+	}
+
 	// 创建用户
 	hashedPassword, err := hashPassword(req.Password)
 	if err != nil {
@@ -172,21 +286,26 @@ func (s *authService) Register(ctx context.Context, req *auth.RegisterRequest) (
 	if err := s.repo.CreateUser(user); err != nil {
 		return nil, err
 	}
+	event.UserID = user.ID
+	s.recordPasswordHistory(ctx, user.ID, hashedPassword)
+	sendRegistrationCodes(ctx, user)
 
-	// 生成令牌
-	token, err := generateToken()
-	if err != nil {
-		return nil, err
-	}
-
-	// 创建令牌记录
-	tokenRecord := &mysql.Token{
-		UserID:    user.ID,
-		Token:     token,
-		ExpiredAt: time.Now().Add(TokenExpiration),
+	// 要求验证后才能登录时不签发令牌，用户验证后再登录
+	if Verification.RequireForLogin {
+		return &auth.RegisterResponse{
+			Base: &auth.BaseResp{
+				Code:    0,
+				Message: "success",
+			},
+			Data: &auth.RegisterData{
+				UserId: user.ID,
+			},
+		}, nil
 	}
 
-	if err := s.repo.CreateToken(tokenRecord); err != nil {
+	// 与登录相同，保存令牌摘要并写入缓存，退出登录和吊销会话才能找到注册签发的令牌
+	token, _, err := s.createAndCacheTokens(ctx, user)
+	if err != nil {
 		return nil, err
 	}
 
@@ -203,26 +322,45 @@ func (s *authService) Register(ctx context.Context, req *auth.RegisterRequest) (
 }
 
 // Login 实现登录功能
-func (s *authService) Login(ctx context.Context, req *auth.LoginRequest) (*auth.LoginResponse, error) {
+func (s *authService) Login(ctx context.Context, req *auth.LoginRequest) (resp *auth.LoginResponse, err error) {
+	event := newAuditEvent(ctx, AuditLogin, 0, req.Username)
+	defer func() { recordAudit(event, err) }()
+
+	// 先检查限流，锁定期内不查询用户也不校验密码
+	if err := s.validateLoginRetries(ctx, req.Username); err != nil {
+		return nil, err
+	}
+
 	user, err := s.repo.GetUserByUsername(req.Username)
 	if err != nil {
+		// 用户不存在同样计入失败次数，避免借此绕过限流枚举用户名
+		if err == mysql.ErrRecordNotFound || errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, s.recordLoginFailure(ctx, req.Username)
+		}
 		return nil, err
 	}
 
-	if user.Status == UserStatusBanned {
-		return nil, errors.New("user is banned")
+	event.UserID = user.ID
+	if err := checkUserActive(user); err != nil {
+		return nil, err
 	}
 
-	// 先验证密码
-	isValidPassword := comparePassword(user.Password, req.Password) == nil
-
-	// 然后检查重试次数
-	if err := s.validateLoginRetries(ctx, req.Username, isValidPassword); err != nil {
+	if comparePassword(user.Password, req.Password) != nil {
+		return nil, s.recordLoginFailure(ctx, req.Username)
+	}
+	s.resetLoginRetries(ctx, req.Username)
+	if err := checkLoginVerified(user); err != nil {
 		return nil, err
 	}
 
+	// 开启二次验证时返回挑战令牌
+	if user.TwoFactorEnabled {
+		event.Type = AuditLoginChallenge
+		return s.createLoginChallenge(ctx, user)
+	}
+
 	// 如果密码验证通过，创建令牌
-	token, refreshToken, err := s.createAndCacheTokens(ctx, user.ID)
+	token, refreshToken, err := s.createAndCacheTokens(ctx, user)
 	if err != nil {
 		return nil, err
 	}
@@ -239,76 +377,191 @@ func (s *authService) Login(ctx context.Context, req *auth.LoginRequest) (*auth.
 	}, nil
 }
 
-// RefreshToken 实现刷新令牌功能
+// RefreshToken 实现刷新令牌功能，每次刷新轮换令牌对，重复使用旧刷新令牌会吊销整个令牌族
 func (s *authService) RefreshToken(ctx context.Context, req *auth.RefreshTokenRequest) (*auth.RefreshTokenResponse, error) {
+	newToken, newRefreshToken, _, err := s.rotateRefreshToken(ctx, req.RefreshToken, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.RefreshTokenResponse{
+		Base: &auth.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		Data: &auth.RefreshTokenData{
+			Token:        newToken,
+			RefreshToken: newRefreshToken,
+		},
+	}, nil
+}
+
+// rotateRefreshToken 轮换刷新令牌，刷新令牌只能由签发它的应用（第一方为空）使用
+func (s *authService) rotateRefreshToken(ctx context.Context, refreshToken, clientID string) (newToken, newRefreshToken string, record *mysql.Token, err error) {
+	event := newAuditEvent(ctx, AuditRefresh, 0, "")
+	defer func() { recordAudit(event, err) }()
+
 	// 获取令牌记录
-	token, err := s.repo.GetTokenByToken(req.RefreshToken)
+	token, err := s.repo.GetTokenByRefreshToken(tokenhash.Digest(refreshToken))
 	if err != nil {
 		if err == mysql.ErrRecordNotFound {
-			return nil, auth.ErrInvalidToken
+			return "", "", nil, auth.ErrInvalidToken
 		}
-		return nil, err
+		return "", "", nil, err
+	}
+	event.UserID = token.UserID
+
+	// 令牌族已被吊销，或不属于当前应用
+	if token.RevokedAt != nil || token.ClientID != clientID {
+		return "", "", nil, auth.ErrInvalidToken
+	}
+
+	// 旧刷新令牌被再次使用，视为泄露
+	if token.UsedAt != nil {
+		return "", "", nil, s.handleRefreshTokenReuse(ctx, token)
 	}
 
 	// 检查令牌是否过期
-	if token.ExpiredAt.Before(time.Now()) {
-		return nil, auth.ErrTokenExpired
+	refreshExpiredAt := token.ExpiredAt
+	if token.RefreshExpiredAt != nil {
+		refreshExpiredAt = *token.RefreshExpiredAt
+	}
+	if refreshExpiredAt.Before(time.Now()) {
+		return "", "", nil, auth.ErrTokenExpired
+	}
+
+	// 标记旧刷新令牌已使用，历史记录没有令牌族时以此为起点
+	familyID := token.FamilyID
+	if familyID == "" {
+		if familyID, err = generateToken(); err != nil {
+			return "", "", nil, errors.Wrap(err, "generate token family failed")
+		}
+	}
+	marked, err := s.repo.MarkRefreshTokenUsed(token.ID, familyID)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if !marked {
+		// 并发请求抢先使用了该刷新令牌
+		token.FamilyID = familyID
+		return "", "", nil, s.handleRefreshTokenReuse(ctx, token)
+	}
+
+	// 旧访问令牌立即失效
+	if err := redis.DeleteToken(ctx, token.Token); err != nil {
+		hlog.CtxWarnf(ctx, "delete token from cache failed: %v", err)
+	}
+	if err := redis.AddToBlacklist(ctx, token.Token, TokenExpiration); err != nil {
+		hlog.CtxWarnf(ctx, "add token to blacklist failed: %v", err)
 	}
 
 	// 获取用户信息
 	user, err := s.repo.GetUserByID(token.UserID)
 	if err != nil {
-		return nil, err
+		return "", "", nil, err
+	}
+
+	// 生成新的令牌，沿用原有的授权范围，客户端未提供的设备信息沿用原会话
+	grant := tokenGrant{ClientID: token.ClientID, Scope: token.Scope}
+	info, _ := clientInfoFrom(ctx)
+	if info.DeviceName == "" {
+		info.DeviceName = token.DeviceName
 	}
+	if info.UserAgent == "" {
+		info.UserAgent = token.UserAgent
+	}
+	if info.IP == "" {
+		info.IP = token.IP
+	}
+	ctx = WithClientInfo(ctx, info)
+	newToken, newRefreshToken, err = s.issueTokens(ctx, user, grant, familyID, token.ID)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return newToken, newRefreshToken, token, nil
+}
 
-	// 生成新的令牌
-	newToken, newRefreshToken, err := s.createAndCacheTokens(ctx, user.ID)
+// handleRefreshTokenReuse 处理刷新令牌重用：记录事件并吊销整个令牌族
+func (s *authService) handleRefreshTokenReuse(ctx context.Context, token *mysql.Token) error {
+	hlog.CtxWarnf(ctx, "refresh token reuse detected: user_id=%d token_id=%d family=%s", token.UserID, token.ID, token.FamilyID)
+	if token.FamilyID != "" {
+		if err := s.revokeTokenFamily(ctx, token.FamilyID); err != nil {
+			return err
+		}
+	}
+	return auth.ErrTokenReused
+}
+
+// parseAccessToken 验证访问令牌签名、有效期和黑名单
+func (s *authService) parseAccessToken(ctx context.Context, token string) (*jwt.Claims, error) {
+	token = strings.TrimPrefix(token, "Bearer ")
+	claims, err := jwt.Parse(token)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, auth.ErrTokenExpired
+		}
+		return nil, auth.ErrInvalidToken
 	}
 
-	return &auth.RefreshTokenResponse{
-		Base: &auth.BaseResp{
-			Code:    0,
-			Message: "success",
-		},
-		Data: &auth.RefreshTokenData{
-			Token:        newToken,
-			RefreshToken: newRefreshToken,
-		},
-	}, nil
+	// 检查是否已登出
+	blacklisted, err := redis.IsInBlacklist(ctx, tokenhash.Digest(token))
+	if err != nil {
+		hlog.CtxWarnf(ctx, "check token blacklist failed: %v", err)
+	}
+	if blacklisted {
+		return nil, auth.ErrInvalidToken
+	}
+	return claims, nil
 }
 
 // ValidateToken 实现验证令牌功能
 func (s *authService) ValidateToken(ctx context.Context, req *auth.ValidateTokenRequest) (*auth.ValidateTokenResponse, error) {
-	// 获取令牌记录
-	token, err := s.repo.GetTokenByToken(req.Token)
+	claims, err := s.parseAccessToken(ctx, req.Token)
 	if err != nil {
-		if err == mysql.ErrRecordNotFound {
-			return &auth.ValidateTokenResponse{
-				Base: &auth.BaseResp{
-					Code:    int32(consts.StatusUnauthorized),
-					Message: "invalid token",
-				},
-			}, auth.ErrInvalidToken
+		message := "invalid token"
+		if err == auth.ErrTokenExpired {
+			message = "token expired"
 		}
-		return nil, err
+		return &auth.ValidateTokenResponse{
+			Base: &auth.BaseResp{
+				Code:    int32(consts.StatusUnauthorized),
+				Message: message,
+			},
+		}, err
 	}
 
-	// 检查令牌是否过期
-	if token.ExpiredAt.Before(time.Now()) {
+	// 封禁实时生效：令牌虽已在封禁时吊销，仍需拦截缓存或传播延迟中的令牌
+	user, err := s.repo.GetUserByID(claims.UserID)
+	switch {
+	case err == mysql.ErrRecordNotFound || errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && user == nil):
+		err = auth.ErrInvalidToken
+	case err != nil:
+		return nil, err
+	default:
+		err = checkUserActive(user)
+	}
+	if err != nil {
 		return &auth.ValidateTokenResponse{
 			Base: &auth.BaseResp{
 				Code:    int32(consts.StatusUnauthorized),
-				Message: "token expired",
+				Message: err.Error(),
 			},
-		}, auth.ErrTokenExpired
+		}, err
 	}
 
-	// 获取用户信息
-	user, err := s.repo.GetUserByID(token.UserID)
-	if err != nil {
-		return nil, err
+	data := &auth.ValidateTokenData{
+		Valid:    true,
+		UserId:   claims.UserID,
+		Username: claims.Username,
+		ClientId: claims.ClientID,
+		Scope:    claims.Scope,
+	}
+	// 角色实时查询，撤销角色后立即生效；第三方应用令牌不携带用户角色
+	if claims.ClientID == "" {
+		if data.Roles, data.Permissions, err = s.userAuthorization(claims.UserID); err != nil {
+			return nil, err
+		}
+		s.touchSession(ctx, strings.TrimPrefix(req.Token, "Bearer "))
 	}
 
 	return &auth.ValidateTokenResponse{
@@ -316,17 +569,18 @@ func (s *authService) ValidateToken(ctx context.Context, req *auth.ValidateToken
 			Code:    int32(consts.StatusOK),
 			Message: "success",
 		},
-		Data: &auth.ValidateTokenData{
-			UserId:   user.ID,
-			Username: user.Username,
-		},
+		Data: data,
 	}, nil
 }
 
 // Logout 实现登出功能
-func (s *authService) Logout(ctx context.Context, req *auth.LogoutRequest) (*auth.LogoutResponse, error) {
+func (s *authService) Logout(ctx context.Context, req *auth.LogoutRequest) (resp *auth.LogoutResponse, err error) {
+	event := newAuditEvent(ctx, AuditLogout, 0, "")
+	defer func() { recordAudit(event, err) }()
+
 	// 获取令牌记录
-	_, err := s.repo.GetTokenByToken(req.Token)
+	digest := tokenhash.Digest(req.Token)
+	record, err := s.repo.GetTokenByToken(digest)
 	if err != nil {
 		if err == mysql.ErrRecordNotFound {
 			return &auth.LogoutResponse{
@@ -339,13 +593,15 @@ func (s *authService) Logout(ctx context.Context, req *auth.LogoutRequest) (*aut
 		return nil, err
 	}
 
+	event.UserID = record.UserID
+
 	// 删除令牌
-	if err := s.repo.DeleteToken(req.Token); err != nil {
+	if err := s.repo.DeleteToken(digest); err != nil {
 		return nil, err
 	}
 
 	// 将令牌加入黑名单
-	if err := redis.AddToBlacklist(ctx, req.Token, TokenExpiration); err != nil {
+	if err := redis.AddToBlacklist(ctx, digest, TokenExpiration); err != nil {
 		hlog.CtxWarnf(ctx, "add token to blacklist failed: %v", err)
 	}
 
@@ -356,3 +612,125 @@ func (s *authService) Logout(ctx context.Context, req *auth.LogoutRequest) (*aut
 		},
 	}, nil
 }
+
+// DeliverToken 将其他服务签发的令牌写入缓存，使其可以走 VerifyToken 快速路径
+func (s *authService) DeliverToken(ctx context.Context, req *auth.DeliverTokenReq) (*auth.DeliveryResp, error) {
+	if req.UserId <= 0 || req.Token == "" {
+		return &auth.DeliveryResp{
+			Base: &auth.BaseResp{
+				Code:    int32(consts.StatusBadRequest),
+				Message: "user_id and token are required",
+			},
+		}, nil
+	}
+
+	// 只接受本服务可验证的令牌，防止写入伪造的映射
+	userID, ttl, err := s.resolveToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if userID == 0 || userID != req.UserId {
+		return &auth.DeliveryResp{
+			Base: &auth.BaseResp{
+				Code:    int32(consts.StatusUnauthorized),
+				Message: "invalid token",
+			},
+		}, nil
+	}
+
+	if err := redis.CacheToken(ctx, tokenhash.Digest(req.Token), userID, ttl); err != nil {
+		return nil, errors.Wrap(err, "cache token failed")
+	}
+
+	return &auth.DeliveryResp{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Success: true,
+	}, nil
+}
+
+// VerifyToken 供其他服务通过 RPC 校验令牌：黑名单 -> Redis 缓存 -> 签名/数据库
+func (s *authService) VerifyToken(ctx context.Context, req *auth.VerifyTokenReq) (*auth.VerifyResp, error) {
+	invalid := &auth.VerifyResp{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusUnauthorized),
+			Message: "invalid token",
+		},
+	}
+	token := strings.TrimPrefix(req.Token, "Bearer ")
+	if token == "" {
+		return invalid, nil
+	}
+	digest := tokenhash.Digest(token)
+
+	blacklisted, err := redis.IsInBlacklist(ctx, digest)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "check token blacklist failed: %v", err)
+	}
+	if blacklisted {
+		return invalid, nil
+	}
+
+	// 快速路径：缓存命中
+	userID, err := redis.GetCachedUserID(ctx, digest)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "get cached token failed: %v", err)
+	}
+
+	if userID == 0 {
+		var ttl time.Duration
+		userID, ttl, err = s.resolveToken(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		if userID == 0 {
+			return invalid, nil
+		}
+		if err := redis.CacheToken(ctx, digest, userID, ttl); err != nil {
+			hlog.CtxWarnf(ctx, "cache token failed: %v", err)
+		}
+	}
+
+	resp := &auth.VerifyResp{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Valid:  true,
+		UserId: userID,
+	}
+	// 角色实时查询，撤销角色后立即生效；第三方应用令牌不携带用户角色
+	if req.WithRoles {
+		if claims, err := jwt.Parse(token); err != nil || claims.ClientID == "" {
+			if resp.Roles, resp.Permissions, err = s.userAuthorization(userID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return resp, nil
+}
+
+// resolveToken 解析令牌对应的用户及剩余有效期，JWT 直接验签，其他令牌查询数据库；无效时返回 0
+func (s *authService) resolveToken(ctx context.Context, token string) (int64, time.Duration, error) {
+	claims, err := jwt.Parse(token)
+	if err == nil {
+		return claims.UserID, time.Until(claims.ExpiresAt.Time), nil
+	}
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return 0, 0, nil
+	}
+
+	record, err := s.repo.GetTokenByToken(tokenhash.Digest(token))
+	if err != nil {
+		if err == mysql.ErrRecordNotFound || errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	if record.RevokedAt != nil || record.ExpiredAt.Before(time.Now()) {
+		return 0, 0, nil
+	}
+	return record.UserID, time.Until(record.ExpiredAt), nil
+}
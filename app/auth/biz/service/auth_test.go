@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -14,46 +16,267 @@ import (
 	"TikTokMall/app/auth/biz/dal/redis"
 	servicemock "TikTokMall/app/auth/biz/service/mock"
 	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/encrypt"
+	"TikTokMall/app/auth/pkg/jwt"
+	"TikTokMall/pkg/tokenhash"
 
 	"gorm.io/gorm"
 )
 
 // 添加 mock Redis 客户端
 type mockRedis struct {
-	retryCount int
+	failures         map[string]int64
+	locks            map[string]time.Duration
+	lockLevels       map[string]int
+	blacklist        map[string]bool
+	cache            map[string]int64
+	challenges       map[string]int64
+	attempts         map[string]int64
+	usedCodes        map[string]bool
+	authCodes        map[string]string
+	oidcStates       map[string]string
+	resets           map[string]int64
+	codes            map[string]string
+	codeTries        map[string]int64
+	cooldowns        map[string]bool
+	seen             map[string]bool
+	webAuthnSessions map[string]string
+	ipSends          map[string]int64
 }
 
 func (m *mockRedis) CacheToken(ctx context.Context, token string, userID int64, expiration time.Duration) error {
+	if m.cache == nil {
+		m.cache = make(map[string]int64)
+	}
+	m.cache[token] = userID
 	return nil
 }
 
+func (m *mockRedis) GetCachedUserID(ctx context.Context, token string) (int64, error) {
+	return m.cache[token], nil
+}
+
 func (m *mockRedis) DeleteToken(ctx context.Context, token string) error {
+	delete(m.cache, token)
 	return nil
 }
 
 func (m *mockRedis) AddToBlacklist(ctx context.Context, token string, expiration time.Duration) error {
+	if m.blacklist == nil {
+		m.blacklist = make(map[string]bool)
+	}
+	m.blacklist[token] = true
+	return nil
+}
+
+func (m *mockRedis) IsInBlacklist(ctx context.Context, token string) (bool, error) {
+	return m.blacklist[token], nil
+}
+
+func (m *mockRedis) MarkSessionSeen(ctx context.Context, token string, interval time.Duration) (bool, error) {
+	if m.seen == nil {
+		m.seen = make(map[string]bool)
+	}
+	if m.seen[token] {
+		return false, nil
+	}
+	m.seen[token] = true
+	return true, nil
+}
+
+func (m *mockRedis) RecordLoginFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	if m.failures == nil {
+		m.failures = make(map[string]int64)
+	}
+	m.failures[key]++
+	return m.failures[key], nil
+}
+
+func (m *mockRedis) LockLogin(ctx context.Context, key string, lockouts []time.Duration, memory time.Duration) (time.Duration, error) {
+	if m.locks == nil {
+		m.locks = make(map[string]time.Duration)
+		m.lockLevels = make(map[string]int)
+	}
+	m.lockLevels[key]++
+	level := m.lockLevels[key]
+	if level > len(lockouts) {
+		level = len(lockouts)
+	}
+	m.locks[key] = lockouts[level-1]
+	delete(m.failures, key)
+	return m.locks[key], nil
+}
+
+func (m *mockRedis) GetLoginLockout(ctx context.Context, key string) (time.Duration, error) {
+	return m.locks[key], nil
+}
+
+func (m *mockRedis) ResetLoginThrottle(ctx context.Context, key string) error {
+	delete(m.failures, key)
+	delete(m.locks, key)
+	delete(m.lockLevels, key)
+	return nil
+}
+
+func (m *mockRedis) SaveLoginChallenge(ctx context.Context, challenge string, userID int64, expiration time.Duration) error {
+	if m.challenges == nil {
+		m.challenges = make(map[string]int64)
+	}
+	m.challenges[challenge] = userID
+	return nil
+}
+
+func (m *mockRedis) GetLoginChallenge(ctx context.Context, challenge string) (int64, error) {
+	return m.challenges[challenge], nil
+}
+
+func (m *mockRedis) DeleteLoginChallenge(ctx context.Context, challenge string) error {
+	delete(m.challenges, challenge)
+	delete(m.attempts, challenge)
+	return nil
+}
+
+func (m *mockRedis) IncrLoginChallengeAttempts(ctx context.Context, challenge string, expiration time.Duration) (int64, error) {
+	if m.attempts == nil {
+		m.attempts = make(map[string]int64)
+	}
+	m.attempts[challenge]++
+	return m.attempts[challenge], nil
+}
+
+func (m *mockRedis) MarkTOTPCodeUsed(ctx context.Context, userID int64, code string, expiration time.Duration) (bool, error) {
+	if m.usedCodes == nil {
+		m.usedCodes = make(map[string]bool)
+	}
+	key := fmt.Sprintf("%d:%s", userID, code)
+	if m.usedCodes[key] {
+		return false, nil
+	}
+	m.usedCodes[key] = true
+	return true, nil
+}
+
+func (m *mockRedis) SaveAuthorizationCode(ctx context.Context, code, payload string, expiration time.Duration) error {
+	if m.authCodes == nil {
+		m.authCodes = make(map[string]string)
+	}
+	m.authCodes[code] = payload
+	return nil
+}
+
+func (m *mockRedis) TakeAuthorizationCode(ctx context.Context, code string) (string, error) {
+	payload := m.authCodes[code]
+	delete(m.authCodes, code)
+	return payload, nil
+}
+
+func (m *mockRedis) SaveOIDCState(ctx context.Context, state, payload string, expiration time.Duration) error {
+	if m.oidcStates == nil {
+		m.oidcStates = make(map[string]string)
+	}
+	m.oidcStates[state] = payload
+	return nil
+}
+
+func (m *mockRedis) TakeOIDCState(ctx context.Context, state string) (string, error) {
+	payload := m.oidcStates[state]
+	delete(m.oidcStates, state)
+	return payload, nil
+}
+
+func (m *mockRedis) SaveWebAuthnSession(ctx context.Context, sessionID, payload string, expiration time.Duration) error {
+	if m.webAuthnSessions == nil {
+		m.webAuthnSessions = make(map[string]string)
+	}
+	m.webAuthnSessions[sessionID] = payload
 	return nil
 }
 
-func (m *mockRedis) GetLoginRetryCount(ctx context.Context, username string) (int, error) {
-	return m.retryCount, nil
+func (m *mockRedis) TakeWebAuthnSession(ctx context.Context, sessionID string) (string, error) {
+	payload := m.webAuthnSessions[sessionID]
+	delete(m.webAuthnSessions, sessionID)
+	return payload, nil
 }
 
-func (m *mockRedis) IncrLoginRetry(ctx context.Context, username string) (int, error) {
-	m.retryCount++
-	return m.retryCount, nil
+func (m *mockRedis) SavePasswordResetToken(ctx context.Context, digest string, userID int64, expiration time.Duration) error {
+	if m.resets == nil {
+		m.resets = make(map[string]int64)
+	}
+	m.resets[digest] = userID
+	return nil
 }
 
-func (m *mockRedis) ResetLoginRetry(ctx context.Context, username string) error {
-	m.retryCount = 0
+func (m *mockRedis) TakePasswordResetToken(ctx context.Context, digest string) (int64, error) {
+	userID := m.resets[digest]
+	delete(m.resets, digest)
+	return userID, nil
+}
+
+func (m *mockRedis) SaveVerificationCode(ctx context.Context, target, payload string, expiration time.Duration) error {
+	if m.codes == nil {
+		m.codes = make(map[string]string)
+		m.codeTries = make(map[string]int64)
+	}
+	m.codes[target] = payload
+	delete(m.codeTries, target)
 	return nil
 }
 
+func (m *mockRedis) GetVerificationCode(ctx context.Context, target string) (string, error) {
+	return m.codes[target], nil
+}
+
+func (m *mockRedis) DeleteVerificationCode(ctx context.Context, target string) error {
+	delete(m.codes, target)
+	delete(m.codeTries, target)
+	return nil
+}
+
+func (m *mockRedis) IncrVerificationAttempts(ctx context.Context, target string, expiration time.Duration) (int64, error) {
+	m.codeTries[target]++
+	return m.codeTries[target], nil
+}
+
+// AllowVerificationSend 只模拟发送间隔，测试中清空 cooldowns 表示间隔已过
+func (m *mockRedis) AllowVerificationSend(ctx context.Context, target string, cooldown time.Duration, limit int64, window time.Duration) (bool, error) {
+	if m.cooldowns == nil {
+		m.cooldowns = make(map[string]bool)
+	}
+	if m.cooldowns[target] {
+		return false, nil
+	}
+	m.cooldowns[target] = true
+	return true, nil
+}
+
+func (m *mockRedis) AllowSMSLoginSend(ctx context.Context, ip string, limit int64, window time.Duration) (bool, error) {
+	if m.ipSends == nil {
+		m.ipSends = make(map[string]int64)
+	}
+	m.ipSends[ip]++
+	return m.ipSends[ip] <= limit, nil
+}
+
 func init() {
 	// 设置测试用的 DB
 	mysql.DB = &gorm.DB{}
 	// 设置 mock Redis 客户端
 	redis.Client = &mockRedis{}
+	// 使用临时密钥签发 JWT
+	if err := jwt.Init(jwt.Config{AllowEphemeral: true}); err != nil {
+		panic(err)
+	}
+	// 两步验证密钥加密
+	if err := encrypt.Init(base64.StdEncoding.EncodeToString(make([]byte, 32))); err != nil {
+		panic(err)
+	}
+}
+
+// signTestToken 签发测试用访问令牌
+func signTestToken(t *testing.T, userID int64, username string, ttl time.Duration) string {
+	token, err := jwt.Sign(jwt.NewClaims(userID, username, ttl))
+	require.NoError(t, err)
+	return token
 }
 
 func TestMain(m *testing.M) {
@@ -76,20 +299,36 @@ func TestAuthService_Register(t *testing.T) {
 			name: "success",
 			req: &auth.RegisterRequest{
 				Username: "testuser",
-				Password: "password123",
+				Password: "Blue-Sky-42",
 				Email:    "test@example.com",
 				Phone:    "13800138000",
 			},
 			setup: func() {
 				mockRepo.On("GetUserByUsername", "testuser").Return(nil, mysql.ErrRecordNotFound)
+				mockRepo.On("CheckEmailExists", "test@example.com").Return(false, nil)
+				mockRepo.On("CheckPhoneExists", "13800138000").Return(false, nil)
 				mockRepo.On("CreateUser", mock.Anything).Run(func(args mock.Arguments) {
 					user := args.Get(0).(*mysql.User)
 					user.ID = 1
 				}).Return(nil)
+				mockRepo.On("AddPasswordHistory", int64(1), mock.AnythingOfType("string"), 5).Return(nil)
 				mockRepo.On("CreateToken", mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "weak_password",
+			req: &auth.RegisterRequest{
+				Username: "newuser",
+				Password: "password123",
+				Email:    "new@example.com",
+			},
+			setup: func() {
+				mockRepo.On("GetUserByUsername", "newuser").Return(nil, mysql.ErrRecordNotFound)
+				mockRepo.On("CheckEmailExists", "new@example.com").Return(false, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "username_exists",
 			req: &auth.RegisterRequest{
@@ -105,6 +344,35 @@ func TestAuthService_Register(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "email_used",
+			req: &auth.RegisterRequest{
+				Username: "newuser",
+				Password: "password123",
+				Email:    "test@example.com",
+				Phone:    "13800138000",
+			},
+			setup: func() {
+				mockRepo.On("GetUserByUsername", "newuser").Return(nil, mysql.ErrRecordNotFound)
+				mockRepo.On("CheckEmailExists", "test@example.com").Return(true, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "phone_used",
+			req: &auth.RegisterRequest{
+				Username: "newuser",
+				Password: "password123",
+				Email:    "new@example.com",
+				Phone:    "13800138000",
+			},
+			setup: func() {
+				mockRepo.On("GetUserByUsername", "newuser").Return(nil, mysql.ErrRecordNotFound)
+				mockRepo.On("CheckEmailExists", "new@example.com").Return(false, nil)
+				mockRepo.On("CheckPhoneExists", "13800138000").Return(true, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -139,9 +407,7 @@ func TestAuthService_Login(t *testing.T) {
 	svc := NewAuthService(mockRepo)
 
 	// 设置 mock Redis 客户端
-	redisClient := &mockRedis{
-		retryCount: 0,
-	}
+	redisClient := &mockRedis{}
 	redis.Client = redisClient
 
 	tests := []struct {
@@ -242,11 +508,19 @@ func TestAuthService_RefreshToken(t *testing.T) {
 	mockRepo := new(servicemock.MockAuthRepository)
 	svc := NewAuthService(mockRepo)
 
+	redisClient := &mockRedis{}
+	redis.Client = redisClient
+
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-1 * time.Hour)
+	usedAt := time.Now().Add(-1 * time.Minute)
+
 	tests := []struct {
-		name    string
-		req     *auth.RefreshTokenRequest
-		setup   func()
-		wantErr bool
+		name          string
+		req           *auth.RefreshTokenRequest
+		setup         func()
+		wantErr       error
+		wantBlacklist []string
 	}{
 		{
 			name: "success",
@@ -254,18 +528,26 @@ func TestAuthService_RefreshToken(t *testing.T) {
 				RefreshToken: "valid-refresh-token",
 			},
 			setup: func() {
-				mockRepo.On("GetTokenByToken", "valid-refresh-token").Return(&mysql.Token{
-					UserID:    1,
-					ExpiredAt: time.Now().Add(24 * time.Hour),
+				mockRepo.On("GetTokenByRefreshToken", tokenhash.Digest("valid-refresh-token")).Return(&mysql.Token{
+					ID:               10,
+					UserID:           1,
+					Token:            "old-access-token",
+					FamilyID:         "family-1",
+					RefreshExpiredAt: &future,
 				}, nil)
+				mockRepo.On("MarkRefreshTokenUsed", int64(10), "family-1").Return(true, nil)
 				mockRepo.On("GetUserByID", int64(1)).Return(&mysql.User{
 					ID:       1,
 					Username: "testuser",
 					Status:   UserStatusNormal,
 				}, nil)
-				mockRepo.On("CreateToken", mock.Anything).Return(nil)
+				mockRepo.On("CreateToken", mock.MatchedBy(func(t *mysql.Token) bool {
+					// 新令牌只保存摘要
+					return t.FamilyID == "family-1" && t.ParentID == 10 &&
+						tokenhash.IsDigest(t.Token) && tokenhash.IsDigest(t.RefreshToken)
+				})).Return(nil)
 			},
-			wantErr: false,
+			wantBlacklist: []string{"old-access-token"},
 		},
 		{
 			name: "invalid_token",
@@ -273,9 +555,9 @@ func TestAuthService_RefreshToken(t *testing.T) {
 				RefreshToken: "invalid-token",
 			},
 			setup: func() {
-				mockRepo.On("GetTokenByToken", "invalid-token").Return(nil, mysql.ErrRecordNotFound)
+				mockRepo.On("GetTokenByRefreshToken", tokenhash.Digest("invalid-token")).Return(nil, mysql.ErrRecordNotFound)
 			},
-			wantErr: true,
+			wantErr: auth.ErrInvalidToken,
 		},
 		{
 			name: "expired_token",
@@ -283,24 +565,92 @@ func TestAuthService_RefreshToken(t *testing.T) {
 				RefreshToken: "expired-token",
 			},
 			setup: func() {
-				mockRepo.On("GetTokenByToken", "expired-token").Return(&mysql.Token{
-					UserID:    1,
-					ExpiredAt: time.Now().Add(-1 * time.Hour),
+				mockRepo.On("GetTokenByRefreshToken", tokenhash.Digest("expired-token")).Return(&mysql.Token{
+					UserID:           1,
+					FamilyID:         "family-1",
+					RefreshExpiredAt: &past,
 				}, nil)
 			},
-			wantErr: true,
+			wantErr: auth.ErrTokenExpired,
+		},
+		{
+			name: "reused_token_revokes_family",
+			req: &auth.RefreshTokenRequest{
+				RefreshToken: "used-refresh-token",
+			},
+			setup: func() {
+				mockRepo.On("GetTokenByRefreshToken", tokenhash.Digest("used-refresh-token")).Return(&mysql.Token{
+					ID:               10,
+					UserID:           1,
+					FamilyID:         "family-1",
+					RefreshExpiredAt: &future,
+					UsedAt:           &usedAt,
+				}, nil)
+				mockRepo.On("RevokeTokenFamily", "family-1").Return([]*mysql.Token{
+					{ID: 11, Token: "access-11", FamilyID: "family-1"},
+					{ID: 12, Token: "access-12", FamilyID: "family-1"},
+				}, nil)
+			},
+			wantErr:       auth.ErrTokenReused,
+			wantBlacklist: []string{"access-11", "access-12"},
+		},
+		{
+			name: "concurrent_reuse_revokes_family",
+			req: &auth.RefreshTokenRequest{
+				RefreshToken: "racing-refresh-token",
+			},
+			setup: func() {
+				mockRepo.On("GetTokenByRefreshToken", tokenhash.Digest("racing-refresh-token")).Return(&mysql.Token{
+					ID:               10,
+					UserID:           1,
+					FamilyID:         "family-1",
+					RefreshExpiredAt: &future,
+				}, nil)
+				mockRepo.On("MarkRefreshTokenUsed", int64(10), "family-1").Return(false, nil)
+				mockRepo.On("RevokeTokenFamily", "family-1").Return([]*mysql.Token{
+					{ID: 11, Token: "access-11", FamilyID: "family-1"},
+				}, nil)
+			},
+			wantErr:       auth.ErrTokenReused,
+			wantBlacklist: []string{"access-11"},
+		},
+		{
+			name: "revoked_family",
+			req: &auth.RefreshTokenRequest{
+				RefreshToken: "revoked-refresh-token",
+			},
+			setup: func() {
+				mockRepo.On("GetTokenByRefreshToken", tokenhash.Digest("revoked-refresh-token")).Return(&mysql.Token{
+					ID:               10,
+					UserID:           1,
+					FamilyID:         "family-1",
+					RefreshExpiredAt: &future,
+					RevokedAt:        &usedAt,
+				}, nil)
+			},
+			wantErr: auth.ErrInvalidToken,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			// 重置 mock
+			mockRepo = new(servicemock.MockAuthRepository)
+			svc = NewAuthService(mockRepo)
+			redisClient.blacklist = nil
+
 			if tt.setup != nil {
 				tt.setup()
 			}
 
 			resp, err := svc.RefreshToken(context.Background(), tt.req)
-			if tt.wantErr {
-				assert.Error(t, err)
+			for _, token := range tt.wantBlacklist {
+				assert.True(t, redisClient.blacklist[token], "token %s should be blacklisted", token)
+			}
+			mockRepo.AssertExpectations(t)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 				return
 			}
 
@@ -308,8 +658,6 @@ func TestAuthService_RefreshToken(t *testing.T) {
 			assert.NotNil(t, resp)
 			assert.NotEmpty(t, resp.Data.Token)
 			assert.NotEmpty(t, resp.Data.RefreshToken)
-
-			mockRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -330,11 +678,11 @@ func TestAuthService_Logout(t *testing.T) {
 				Token: "valid-token",
 			},
 			setup: func() {
-				mockRepo.On("GetTokenByToken", "valid-token").Return(&mysql.Token{
+				mockRepo.On("GetTokenByToken", tokenhash.Digest("valid-token")).Return(&mysql.Token{
 					UserID: 1,
 					Token:  "valid-token",
 				}, nil)
-				mockRepo.On("DeleteToken", "valid-token").Return(nil)
+				mockRepo.On("DeleteToken", tokenhash.Digest("valid-token")).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -344,7 +692,7 @@ func TestAuthService_Logout(t *testing.T) {
 				Token: "invalid-token",
 			},
 			setup: func() {
-				mockRepo.On("GetTokenByToken", "invalid-token").Return(nil, mysql.ErrRecordNotFound)
+				mockRepo.On("GetTokenByToken", tokenhash.Digest("invalid-token")).Return(nil, mysql.ErrRecordNotFound)
 			},
 			wantErr: true,
 		},
@@ -378,74 +726,73 @@ func TestAuthService_ValidateToken(t *testing.T) {
 	mockRepo := new(servicemock.MockAuthRepository)
 	svc := NewAuthService(mockRepo)
 
+	redisClient := &mockRedis{}
+	redis.Client = redisClient
+
+	validToken := signTestToken(t, 1, "testuser", time.Hour)
+	revokedToken := signTestToken(t, 1, "testuser", time.Hour)
+	_ = redisClient.AddToBlacklist(context.Background(), tokenhash.Digest(revokedToken), time.Hour)
+	mockRepo.On("GetUserByID", int64(1)).Return(&mysql.User{ID: 1, Username: "testuser", Status: UserStatusNormal}, nil)
+	mockRepo.On("GetUserByID", int64(2)).Return(&mysql.User{ID: 2, Username: "banned", Status: UserStatusBanned}, nil)
+	mockRepo.On("GetUserByID", int64(3)).Return(&mysql.User{ID: 3, Username: "leaving", Status: UserStatusDeleting}, nil)
+	mockRepo.On("GetUserRoles", int64(1)).Return([]string{RoleAdmin}, nil)
+	mockRepo.On("GetRolePermissions", []string{RoleUser, RoleAdmin}).Return([]string{PermRoleAssign, PermUserBan}, nil)
+	mockRepo.On("TouchToken", tokenhash.Digest(validToken), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
 	tests := []struct {
 		name    string
 		req     *auth.ValidateTokenRequest
-		setup   func()
-		wantErr bool
+		wantErr error
 	}{
 		{
-			name: "success",
-			req: &auth.ValidateTokenRequest{
-				Token: "valid-token",
-			},
-			setup: func() {
-				// 模拟有效的访问令牌
-				mockRepo.On("GetTokenByToken", "valid-token").Return(&mysql.Token{
-					UserID:    1,
-					ExpiredAt: time.Now().Add(24 * time.Hour),
-				}, nil)
-				mockRepo.On("GetUserByID", int64(1)).Return(&mysql.User{
-					ID:       1,
-					Username: "testuser",
-					Status:   UserStatusNormal,
-				}, nil)
-			},
-			wantErr: false,
+			name:    "banned_user",
+			req:     &auth.ValidateTokenRequest{Token: signTestToken(t, 2, "banned", time.Hour)},
+			wantErr: auth.ErrUserBanned,
 		},
 		{
-			name: "invalid_token",
-			req: &auth.ValidateTokenRequest{
-				Token: "invalid-token",
-			},
-			setup: func() {
-				mockRepo.On("GetTokenByToken", "invalid-token").Return(nil, mysql.ErrRecordNotFound)
-			},
-			wantErr: true,
+			name:    "pending_deletion_user",
+			req:     &auth.ValidateTokenRequest{Token: signTestToken(t, 3, "leaving", time.Hour)},
+			wantErr: auth.ErrUserDeleted,
 		},
 		{
-			name: "expired_token",
-			req: &auth.ValidateTokenRequest{
-				Token: "expired-token",
-			},
-			setup: func() {
-				mockRepo.On("GetTokenByToken", "expired-token").Return(&mysql.Token{
-					UserID:    1,
-					ExpiredAt: time.Now().Add(-1 * time.Hour),
-				}, nil)
-			},
-			wantErr: true,
+			name:    "success",
+			req:     &auth.ValidateTokenRequest{Token: validToken},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid_token",
+			req:     &auth.ValidateTokenRequest{Token: "invalid-token"},
+			wantErr: auth.ErrInvalidToken,
+		},
+		{
+			name:    "expired_token",
+			req:     &auth.ValidateTokenRequest{Token: signTestToken(t, 1, "testuser", -time.Hour)},
+			wantErr: auth.ErrTokenExpired,
+		},
+		{
+			name:    "blacklisted_token",
+			req:     &auth.ValidateTokenRequest{Token: revokedToken},
+			wantErr: auth.ErrInvalidToken,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if tt.setup != nil {
-				tt.setup()
-			}
-
 			resp, err := svc.ValidateToken(context.Background(), tt.req)
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 				return
 			}
 
 			assert.NoError(t, err)
 			assert.NotNil(t, resp)
 			assert.NotNil(t, resp.Data)
-			assert.NotZero(t, resp.Data.UserId)
-			assert.NotEmpty(t, resp.Data.Username)
+			assert.Equal(t, int64(1), resp.Data.UserId)
+			assert.Equal(t, "testuser", resp.Data.Username)
+			assert.Equal(t, []string{RoleUser, RoleAdmin}, resp.Data.Roles)
+			assert.Equal(t, []string{PermRoleAssign, PermUserBan}, resp.Data.Permissions)
 
+			// 令牌由 JWT 验证，角色实时查询
 			mockRepo.AssertExpectations(t)
 		})
 	}
@@ -454,44 +801,92 @@ func TestAuthService_ValidateToken(t *testing.T) {
 func TestAuthService_LoginRetryLimit(t *testing.T) {
 	mockRepo := new(servicemock.MockAuthRepository)
 	svc := NewAuthService(mockRepo)
-	ctx := context.Background()
+	ctx := WithClientInfo(context.Background(), ClientInfo{IP: "192.0.2.10"})
 
 	// 设置 mock 预期
 	hashedPassword, _ := hashPassword("password123")
-	// 使用 Times 来指定调用次数
+	// 锁定期内不查询用户，只有未锁定的尝试会调用
 	mockRepo.On("GetUserByUsername", "testretry").Return(&mysql.User{
 		ID:       1,
 		Username: "testretry",
 		Password: hashedPassword,
 		Status:   UserStatusNormal,
-	}, nil).Times(MaxLoginRetries + 1) // 预期会调用 MaxLoginRetries + 1 次
+	}, nil).Times(2*MaxLoginRetries + 1)
 
-	// 模拟 Redis 重试计数
-	redisClient := &mockRedis{
-		retryCount: 0,
-	}
+	redisClient := &mockRedis{}
 	redis.Client = redisClient
 
-	// 快速尝试登录直到达到最大重试次数
-	for i := 0; i < MaxLoginRetries; i++ {
-		loginReq := &auth.LoginRequest{
-			Username: "testretry",
-			Password: "wrongpassword",
-		}
-		_, err := svc.Login(ctx, loginReq)
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "invalid username or password")
-		redisClient.retryCount++ // 增加重试计数
-	}
-
-	// 最后一次尝试，此时重试计数已经达到上限
-	loginReq := &auth.LoginRequest{
-		Username: "testretry",
-		Password: "wrongpassword",
+	wrong := &auth.LoginRequest{Username: "testretry", Password: "wrongpassword"}
+	for i := 0; i < MaxLoginRetries-1; i++ {
+		_, err := svc.Login(ctx, wrong)
+		require.ErrorIs(t, err, auth.ErrInvalidCredentials)
 	}
-	_, err := svc.Login(ctx, loginReq)
-	require.Error(t, err)
+
+	// 达到上限时锁定并返回重试时间
+	_, err := svc.Login(ctx, wrong)
+	require.ErrorIs(t, err, auth.ErrTooManyAttempts)
 	require.Contains(t, err.Error(), "too many login attempts")
+	var throttled *LoginThrottledError
+	require.ErrorAs(t, err, &throttled)
+	assert.Equal(t, LoginLockouts[0], throttled.RetryAfter)
+	assert.Equal(t, int64(60), throttled.RetryAfterSeconds())
+
+	// 锁定期内即使密码正确也不校验，用户名大小写不影响
+	_, err = svc.Login(ctx, &auth.LoginRequest{Username: "TestRetry", Password: "password123"})
+	require.ErrorIs(t, err, auth.ErrTooManyAttempts)
+
+	// 锁定到期后再次达到上限，锁定时间逐级延长
+	delete(redisClient.locks, loginUserKey("testretry"))
+	for i := 0; i < MaxLoginRetries; i++ {
+		_, err = svc.Login(ctx, wrong)
+	}
+	require.ErrorAs(t, err, &throttled)
+	assert.Equal(t, LoginLockouts[1], throttled.RetryAfter)
+
+	// 管理员解锁后可以登录，登录成功清除用户名维度的记录
+	_, err = svc.UnlockLogin(ctx, &auth.UnlockLoginRequest{Username: "testretry"})
+	require.NoError(t, err)
+	mockRepo.On("CreateToken", mock.AnythingOfType("*mysql.Token")).Return(nil).Once()
+	_, err = svc.Login(ctx, &auth.LoginRequest{Username: "testretry", Password: "password123"})
+	require.NoError(t, err)
+	assert.Zero(t, redisClient.failures[loginUserKey("testretry")])
+	// IP 维度的失败记录保留
+	assert.Equal(t, int64(2*MaxLoginRetries), redisClient.failures["ip:192.0.2.10"])
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestAuthService_LoginThrottleByIP(t *testing.T) {
+	mockRepo := new(servicemock.MockAuthRepository)
+	svc := NewAuthService(mockRepo)
+	redisClient := &mockRedis{}
+	redis.Client = redisClient
+	mockRepo.On("GetUserByUsername", mock.Anything).Return(nil, mysql.ErrRecordNotFound)
+
+	// 同一 IP 尝试不同用户名，用户不存在同样计入失败次数
+	ctx := WithClientInfo(context.Background(), ClientInfo{IP: "198.51.100.7"})
+	var err error
+	for i := 0; i < MaxLoginRetriesPerIP; i++ {
+		_, err = svc.Login(ctx, &auth.LoginRequest{Username: fmt.Sprintf("user%d", i), Password: "guess"})
+	}
+	require.ErrorIs(t, err, auth.ErrTooManyAttempts)
+	_, err = svc.Login(ctx, &auth.LoginRequest{Username: "another", Password: "guess"})
+	require.ErrorIs(t, err, auth.ErrTooManyAttempts)
+
+	// 同网段的其他 IP 不受单个 IP 锁定影响
+	other := WithClientInfo(context.Background(), ClientInfo{IP: "198.51.100.8"})
+	_, err = svc.Login(other, &auth.LoginRequest{Username: "another", Password: "guess"})
+	require.ErrorIs(t, err, auth.ErrInvalidCredentials)
+	assert.Equal(t, int64(MaxLoginRetriesPerIP+1), redisClient.failures["net:198.51.100.0/24"])
+
+	// 按 IP 解锁
+	_, err = svc.UnlockLogin(ctx, &auth.UnlockLoginRequest{Ip: "198.51.100.7"})
+	require.NoError(t, err)
+	_, err = svc.Login(ctx, &auth.LoginRequest{Username: "another", Password: "guess"})
+	require.ErrorIs(t, err, auth.ErrInvalidCredentials)
+
+	_, err = svc.UnlockLogin(ctx, &auth.UnlockLoginRequest{})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+	_, err = svc.UnlockLogin(ctx, &auth.UnlockLoginRequest{Ip: "not-an-ip"})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+const (
+	// 封禁相关配置
+	DefaultBanExpiryInterval = time.Minute // 到期封禁的检查间隔
+	maxBanReasonLength       = 255
+	banExpiryBatchSize       = 100
+)
+
+// BanUser 封禁用户并吊销其所有令牌，duration_seconds 为 0 时永久封禁
+func (s *authService) BanUser(ctx context.Context, req *auth.BanUserRequest) (resp *auth.BanUserResponse, err error) {
+	event := newAuditEvent(ctx, AuditUserBan, req.UserId, "")
+	defer func() { recordAudit(event, err) }()
+
+	operatorID, err := s.adminOperator(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.OperatorID = operatorID
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" || len(reason) > maxBanReasonLength {
+		return nil, fmt.Errorf("%w: reason must be 1-%d characters", auth.ErrInvalidArgument, maxBanReasonLength)
+	}
+	if req.DurationSeconds < 0 {
+		return nil, fmt.Errorf("%w: duration_seconds must not be negative", auth.ErrInvalidArgument)
+	}
+	var duration time.Duration
+	if req.DurationSeconds > 0 {
+		duration = time.Duration(req.DurationSeconds) * time.Second
+	}
+
+	ban, err := s.banUser(ctx, operatorID, req.UserId, reason, duration)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.BanUserResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Ban: newUserBanInfo(ban),
+	}, nil
+}
+
+// UnbanUser 解封用户，用户未被封禁时同样返回成功
+func (s *authService) UnbanUser(ctx context.Context, req *auth.UnbanUserRequest) (resp *auth.UnbanUserResponse, err error) {
+	event := newAuditEvent(ctx, AuditUserUnban, req.UserId, "")
+	defer func() { recordAudit(event, err) }()
+
+	operatorID, err := s.adminOperator(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.OperatorID = operatorID
+
+	reason := strings.TrimSpace(req.Reason)
+	if len(reason) > maxBanReasonLength {
+		return nil, fmt.Errorf("%w: reason must be at most %d characters", auth.ErrInvalidArgument, maxBanReasonLength)
+	}
+	if err := s.unbanUser(operatorID, req.UserId, reason); err != nil {
+		return nil, err
+	}
+	return &auth.UnbanUserResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// ListUserBans 查询用户的封禁记录
+func (s *authService) ListUserBans(ctx context.Context, req *auth.ListUserBansRequest) (*auth.ListUserBansResponse, error) {
+	if _, err := s.adminOperator(ctx, req.Token); err != nil {
+		return nil, err
+	}
+	if err := s.checkTargetUser(req.UserId); err != nil {
+		return nil, err
+	}
+	bans, err := s.repo.ListUserBans(req.UserId)
+	if err != nil {
+		return nil, errors.Wrap(err, "list user bans failed")
+	}
+	infos := make([]*auth.UserBan, 0, len(bans))
+	for _, b := range bans {
+		infos = append(infos, newUserBanInfo(b))
+	}
+	return &auth.ListUserBansResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Bans: infos,
+	}, nil
+}
+
+// banUser 保存封禁记录并吊销用户的所有令牌，duration 为 0 时永久封禁
+func (s *authService) banUser(ctx context.Context, operatorID, userID int64, reason string, duration time.Duration) (*mysql.UserBan, error) {
+	if operatorID == userID {
+		return nil, fmt.Errorf("%w: cannot change own status", auth.ErrInvalidArgument)
+	}
+	if err := s.checkTargetUser(userID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ban := &mysql.UserBan{
+		UserID:     userID,
+		Reason:     reason,
+		OperatorID: operatorID,
+		CreatedAt:  now,
+	}
+	if duration > 0 {
+		expiresAt := now.Add(duration)
+		ban.ExpiresAt = &expiresAt
+	}
+	if err := s.repo.CreateUserBan(ban); err != nil {
+		return nil, errors.Wrap(err, "create user ban failed")
+	}
+	if err := s.revokeUserTokens(ctx, userID); err != nil {
+		return nil, err
+	}
+	return ban, nil
+}
+
+// unbanUser 结束用户所有生效的封禁并恢复用户状态
+func (s *authService) unbanUser(operatorID, userID int64, reason string) error {
+	if operatorID == userID {
+		return fmt.Errorf("%w: cannot change own status", auth.ErrInvalidArgument)
+	}
+	if err := s.checkTargetUser(userID); err != nil {
+		return err
+	}
+	if err := s.repo.LiftUserBans(userID, operatorID, reason, time.Now()); err != nil {
+		return errors.Wrap(err, "lift user bans failed")
+	}
+	return nil
+}
+
+// LiftExpiredBans 解封所有已到期的封禁，返回解封的数量
+func LiftExpiredBans(ctx context.Context, repo AuthRepository) (int, error) {
+	lifted := 0
+	for {
+		now := time.Now()
+		bans, err := repo.ListExpiredUserBans(now, banExpiryBatchSize)
+		if err != nil {
+			return lifted, errors.Wrap(err, "list expired user bans failed")
+		}
+		for _, b := range bans {
+			ok, err := repo.LiftExpiredUserBan(b.ID, now)
+			if err != nil {
+				return lifted, errors.Wrap(err, "lift expired user ban failed")
+			}
+			if ok {
+				lifted++
+				event := newAuditEvent(ctx, AuditUserUnban, b.UserID, "")
+				event.Reason = mysql.BanLiftReasonExpired
+				recordAudit(event, nil)
+			}
+		}
+		if len(bans) < banExpiryBatchSize {
+			return lifted, nil
+		}
+	}
+}
+
+// RunBanExpiry 按 interval 定期解封到期的封禁，ctx 取消后返回
+func RunBanExpiry(ctx context.Context, repo AuthRepository, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultBanExpiryInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if n, err := LiftExpiredBans(ctx, repo); err != nil {
+			hlog.CtxErrorf(ctx, "lift expired bans failed: %v", err)
+		} else if n > 0 {
+			hlog.CtxInfof(ctx, "lifted %d expired bans", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func newUserBanInfo(b *mysql.UserBan) *auth.UserBan {
+	info := &auth.UserBan{
+		Id:         b.ID,
+		UserId:     b.UserID,
+		Reason:     b.Reason,
+		OperatorId: b.OperatorID,
+		CreatedAt:  b.CreatedAt.Unix(),
+		LiftedBy:   b.LiftedBy,
+		LiftReason: b.LiftReason,
+	}
+	if b.ExpiresAt != nil {
+		info.ExpiresAt = b.ExpiresAt.Unix()
+	}
+	if b.LiftedAt != nil {
+		info.LiftedAt = b.LiftedAt.Unix()
+	}
+	return info
+}
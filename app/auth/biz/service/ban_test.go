@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+func TestBan_TimedBan(t *testing.T) {
+	env := newRBACTestEnv(t)
+	ctx := context.Background()
+	admin := env.login(t, 1)
+	shopper := env.login(t, 2)
+
+	resp, err := env.svc.BanUser(ctx, &auth.BanUserRequest{Token: admin, UserId: 2, Reason: "spam", DurationSeconds: 3600})
+	require.NoError(t, err)
+	assert.Equal(t, "spam", resp.Ban.Reason)
+	assert.Equal(t, int64(1), resp.Ban.OperatorId)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), resp.Ban.ExpiresAt, 2)
+	assert.Equal(t, UserStatusBanned, env.users[2].Status)
+
+	// 封禁立即吊销令牌
+	_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: shopper})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	// 未到期时不解封
+	n, err := LiftExpiredBans(ctx, env)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Equal(t, UserStatusBanned, env.users[2].Status)
+
+	// 到期后自动解封，再次执行不会重复解封
+	past := time.Now().Add(-time.Second)
+	env.bans[0].ExpiresAt = &past
+	n, err = LiftExpiredBans(ctx, env)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, UserStatusNormal, env.users[2].Status)
+	assert.Equal(t, mysql.BanLiftReasonExpired, env.bans[0].LiftReason)
+	n, err = LiftExpiredBans(ctx, env)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestBan_UnbanAndHistory(t *testing.T) {
+	env := newRBACTestEnv(t)
+	ctx := context.Background()
+	admin := env.login(t, 1)
+
+	_, err := env.svc.BanUser(ctx, &auth.BanUserRequest{Token: admin, UserId: 2, Reason: "spam", DurationSeconds: 3600})
+	require.NoError(t, err)
+	// 新的封禁替换仍然生效的旧封禁
+	_, err = env.svc.BanUser(ctx, &auth.BanUserRequest{Token: admin, UserId: 2, Reason: "fraud"})
+	require.NoError(t, err)
+
+	_, err = env.svc.UnbanUser(ctx, &auth.UnbanUserRequest{Token: admin, UserId: 2, Reason: "appeal accepted"})
+	require.NoError(t, err)
+	assert.Equal(t, UserStatusNormal, env.users[2].Status)
+	// 重复解封同样成功
+	_, err = env.svc.UnbanUser(ctx, &auth.UnbanUserRequest{Token: admin, UserId: 2})
+	require.NoError(t, err)
+
+	resp, err := env.svc.ListUserBans(ctx, &auth.ListUserBansRequest{Token: admin, UserId: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Bans, 2)
+	assert.Equal(t, "fraud", resp.Bans[0].Reason)
+	assert.Zero(t, resp.Bans[0].ExpiresAt)
+	assert.Equal(t, int64(1), resp.Bans[0].LiftedBy)
+	assert.Equal(t, "appeal accepted", resp.Bans[0].LiftReason)
+	assert.Equal(t, "spam", resp.Bans[1].Reason)
+	assert.Equal(t, mysql.BanLiftReasonSuperseded, resp.Bans[1].LiftReason)
+	assert.NotZero(t, resp.Bans[1].LiftedAt)
+}
+
+func TestBan_Rejects(t *testing.T) {
+	env := newRBACTestEnv(t)
+	ctx := context.Background()
+	admin := env.login(t, 1)
+
+	_, err := env.svc.BanUser(ctx, &auth.BanUserRequest{Token: admin, UserId: 2, Reason: "  "})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+	_, err = env.svc.BanUser(ctx, &auth.BanUserRequest{Token: admin, UserId: 2, Reason: "spam", DurationSeconds: -1})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+	_, err = env.svc.BanUser(ctx, &auth.BanUserRequest{Token: admin, UserId: 1, Reason: "spam"})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+	_, err = env.svc.BanUser(ctx, &auth.BanUserRequest{Token: admin, UserId: 404, Reason: "spam"})
+	assert.ErrorIs(t, err, auth.ErrUserNotFound)
+	_, err = env.svc.UnbanUser(ctx, &auth.UnbanUserRequest{Token: admin, UserId: 404})
+	assert.ErrorIs(t, err, auth.ErrUserNotFound)
+	assert.Empty(t, env.bans)
+}
+
+func TestBan_ValidateTokenRejectsBannedUser(t *testing.T) {
+	env := newRBACTestEnv(t)
+	ctx := context.Background()
+	shopper := env.login(t, 2)
+
+	// 令牌未被吊销时同样拒绝已封禁用户
+	env.users[2].Status = UserStatusBanned
+	resp, err := env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: shopper})
+	assert.ErrorIs(t, err, auth.ErrUserBanned)
+	assert.Equal(t, int32(401), resp.Base.Code)
+
+	delete(env.users, 2)
+	_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: shopper})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
@@ -2,19 +2,19 @@ package service
 
 import (
 	"context"
+
 	auth "TikTokMall/app/auth/kitex_gen/auth"
 )
 
 type DeliverTokenByRPCService struct {
 	ctx context.Context
+	svc AuthService
 } // NewDeliverTokenByRPCService new DeliverTokenByRPCService
-func NewDeliverTokenByRPCService(ctx context.Context) *DeliverTokenByRPCService {
-	return &DeliverTokenByRPCService{ctx: ctx}
+func NewDeliverTokenByRPCService(ctx context.Context, svc AuthService) *DeliverTokenByRPCService {
+	return &DeliverTokenByRPCService{ctx: ctx, svc: svc}
 }
 
-// Run create note info
+// Run 缓存其他服务投递的令牌
 func (s *DeliverTokenByRPCService) Run(req *auth.DeliverTokenReq) (resp *auth.DeliveryResp, err error) {
-	// Finish your business logic.
-
-	return
+	return s.svc.DeliverToken(s.ctx, req)
 }
@@ -3,19 +3,84 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
 	auth "TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/pkg/tokenhash"
 )
 
 func TestDeliverTokenByRPC_Run(t *testing.T) {
 	ctx := context.Background()
-	s := NewDeliverTokenByRPCService(ctx)
-	// init req and assert value
+	redisClient := &mockRedis{}
+	redis.Client = redisClient
+
+	jwtToken := signTestToken(t, 1, "testuser", time.Hour)
 
-	req := &auth.DeliverTokenReq{}
-	resp, err := s.Run(req)
-	t.Logf("err: %v", err)
-	t.Logf("resp: %v", resp)
+	tests := []struct {
+		name        string
+		req         *auth.DeliverTokenReq
+		setup       func(repo *servicemock.MockAuthRepository)
+		wantSuccess bool
+	}{
+		{
+			name:        "jwt_token",
+			req:         &auth.DeliverTokenReq{UserId: 1, Token: jwtToken},
+			wantSuccess: true,
+		},
+		{
+			name:        "jwt_user_mismatch",
+			req:         &auth.DeliverTokenReq{UserId: 2, Token: jwtToken},
+			wantSuccess: false,
+		},
+		{
+			name: "opaque_token_in_db",
+			req:  &auth.DeliverTokenReq{UserId: 1, Token: "opaque-token"},
+			setup: func(repo *servicemock.MockAuthRepository) {
+				repo.On("GetTokenByToken", tokenhash.Digest("opaque-token")).Return(&mysql.Token{
+					UserID:    1,
+					ExpiredAt: time.Now().Add(time.Hour),
+				}, nil)
+			},
+			wantSuccess: true,
+		},
+		{
+			name: "unknown_token",
+			req:  &auth.DeliverTokenReq{UserId: 1, Token: "forged-token"},
+			setup: func(repo *servicemock.MockAuthRepository) {
+				repo.On("GetTokenByToken", tokenhash.Digest("forged-token")).Return(nil, mysql.ErrRecordNotFound)
+			},
+			wantSuccess: false,
+		},
+		{
+			name:        "missing_fields",
+			req:         &auth.DeliverTokenReq{},
+			wantSuccess: false,
+		},
+	}
 
-	// todo: edit your unit test
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(servicemock.MockAuthRepository)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			redisClient.cache = nil
 
+			resp, err := NewDeliverTokenByRPCService(ctx, NewAuthService(repo)).Run(tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSuccess, resp.Success)
+			if tt.wantSuccess {
+				assert.Equal(t, tt.req.UserId, redisClient.cache[tokenhash.Digest(tt.req.Token)])
+			} else {
+				assert.Empty(t, redisClient.cache)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
 }
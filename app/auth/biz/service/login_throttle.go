@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+const (
+	// 登录限流相关配置，失败次数按滑动窗口统计
+	MaxLoginRetries       = 5                // 同一用户名窗口内最多失败次数
+	MaxLoginRetriesPerIP  = 20               // 同一 IP 窗口内最多失败次数
+	MaxLoginRetriesPerNet = 100              // 同一网段（IPv4 /24、IPv6 /64）窗口内最多失败次数
+	LoginRetryWindow      = 15 * time.Minute // 失败次数统计窗口
+	LoginLockoutMemory    = 24 * time.Hour   // 锁定次数的保留时间，超过后锁定时长重新计算
+)
+
+// LoginLockouts 逐级延长的锁定时长，超过级数后保持最后一级
+var LoginLockouts = []time.Duration{time.Minute, 15 * time.Minute, time.Hour}
+
+// LoginThrottledError 登录被限流，调用方应在 RetryAfter 之后重试
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("too many login attempts, retry after %d seconds", e.RetryAfterSeconds())
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return auth.ErrTooManyAttempts
+}
+
+// RetryAfterSeconds 向上取整的重试等待秒数，用于 Retry-After 响应头
+func (e *LoginThrottledError) RetryAfterSeconds() int64 {
+	return int64((e.RetryAfter + time.Second - 1) / time.Second)
+}
+
+// loginThrottleKey 一个限流维度及其失败次数上限
+type loginThrottleKey struct {
+	key   string
+	limit int64
+}
+
+// loginThrottleKeys 登录请求的限流维度：用户名，以及客户端 IP 和所在网段
+func loginThrottleKeys(ctx context.Context, username string) []loginThrottleKey {
+	keys := []loginThrottleKey{{key: loginUserKey(username), limit: MaxLoginRetries}}
+	if info, ok := clientInfoFrom(ctx); ok {
+		if ip := net.ParseIP(info.IP); ip != nil {
+			ipKey, netKey := loginIPKeys(ip)
+			keys = append(keys,
+				loginThrottleKey{key: ipKey, limit: MaxLoginRetriesPerIP},
+				loginThrottleKey{key: netKey, limit: MaxLoginRetriesPerNet},
+			)
+		}
+	}
+	return keys
+}
+
+// loginUserKey 用户名维度的限流 Key，不区分大小写
+func loginUserKey(username string) string {
+	return "user:" + strings.ToLower(strings.TrimSpace(username))
+}
+
+// loginIPKeys IP 和网段维度的限流 Key
+func loginIPKeys(ip net.IP) (ipKey, netKey string) {
+	if v4 := ip.To4(); v4 != nil {
+		return "ip:" + v4.String(), "net:" + v4.Mask(net.CIDRMask(24, 32)).String() + "/24"
+	}
+	return "ip:" + ip.String(), "net:" + ip.Mask(net.CIDRMask(64, 128)).String() + "/64"
+}
+
+// validateLoginRetries 检查登录是否处于锁定期，在查询用户和校验密码之前调用，避免暴力破解消耗 bcrypt 计算资源
+func (s *authService) validateLoginRetries(ctx context.Context, username string) error {
+	var retryAfter time.Duration
+	for _, k := range loginThrottleKeys(ctx, username) {
+		lockout, err := redis.GetLoginLockout(ctx, k.key)
+		if err != nil {
+			hlog.CtxWarnf(ctx, "get login lockout failed: %v", err)
+			continue
+		}
+		if lockout > retryAfter {
+			retryAfter = lockout
+		}
+	}
+	if retryAfter > 0 {
+		return &LoginThrottledError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// recordLoginFailure 记录登录失败，任一维度达到上限时锁定该维度并返回限流错误，否则返回凭据错误
+func (s *authService) recordLoginFailure(ctx context.Context, username string) error {
+	var retryAfter time.Duration
+	for _, k := range loginThrottleKeys(ctx, username) {
+		count, err := redis.RecordLoginFailure(ctx, k.key, LoginRetryWindow)
+		if err != nil {
+			hlog.CtxWarnf(ctx, "record login failure failed: %v", err)
+			continue
+		}
+		if count < k.limit {
+			continue
+		}
+		lockout, err := redis.LockLogin(ctx, k.key, LoginLockouts, LoginLockoutMemory)
+		if err != nil {
+			hlog.CtxWarnf(ctx, "lock login failed: %v", err)
+			continue
+		}
+		hlog.CtxWarnf(ctx, "login locked: key=%s failures=%d lockout=%s", k.key, count, lockout)
+		if lockout > retryAfter {
+			retryAfter = lockout
+		}
+	}
+	if retryAfter > 0 {
+		return &LoginThrottledError{RetryAfter: retryAfter}
+	}
+	return auth.ErrInvalidCredentials
+}
+
+// resetLoginRetries 登录成功或重置密码后清除用户名维度的失败记录，IP 维度不清除，避免用一个账号掩护撞库
+func (s *authService) resetLoginRetries(ctx context.Context, username string) {
+	if err := redis.ResetLoginThrottle(ctx, loginUserKey(username)); err != nil {
+		hlog.CtxWarnf(ctx, "reset login retry count failed: %v", err)
+	}
+}
+
+// UnlockLogin 解除登录锁定（管理接口），可按用户名或 IP 解锁
+func (s *authService) UnlockLogin(ctx context.Context, req *auth.UnlockLoginRequest) (*auth.UnlockLoginResponse, error) {
+	username := strings.TrimSpace(req.Username)
+	address := strings.TrimSpace(req.Ip)
+	if username == "" && address == "" {
+		return nil, fmt.Errorf("%w: username or ip is required", auth.ErrInvalidArgument)
+	}
+
+	var keys []string
+	if username != "" {
+		keys = append(keys, loginUserKey(username))
+	}
+	if address != "" {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return nil, fmt.Errorf("%w: invalid ip address", auth.ErrInvalidArgument)
+		}
+		ipKey, netKey := loginIPKeys(ip)
+		keys = append(keys, ipKey, netKey)
+	}
+	for _, key := range keys {
+		if err := redis.ResetLoginThrottle(ctx, key); err != nil {
+			return nil, errors.Wrap(err, "reset login throttle failed")
+		}
+	}
+
+	return &auth.UnlockLoginResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
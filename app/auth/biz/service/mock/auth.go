@@ -108,4 +108,422 @@ func (mr *MockAuthServiceMockRecorder) Logout(ctx, req interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), ctx, req)
 }
 
-// ... 其他方法的 mock 实现 
\ No newline at end of file
+// DeliverToken mocks base method
+func (m *MockAuthService) DeliverToken(ctx context.Context, req *auth.DeliverTokenReq) (*auth.DeliveryResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverToken", ctx, req)
+	ret0, _ := ret[0].(*auth.DeliveryResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverToken indicates an expected call of DeliverToken
+func (mr *MockAuthServiceMockRecorder) DeliverToken(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverToken", reflect.TypeOf((*MockAuthService)(nil).DeliverToken), ctx, req)
+}
+
+// VerifyToken mocks base method
+func (m *MockAuthService) VerifyToken(ctx context.Context, req *auth.VerifyTokenReq) (*auth.VerifyResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyToken", ctx, req)
+	ret0, _ := ret[0].(*auth.VerifyResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyToken indicates an expected call of VerifyToken
+func (mr *MockAuthServiceMockRecorder) VerifyToken(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyToken", reflect.TypeOf((*MockAuthService)(nil).VerifyToken), ctx, req)
+}
+
+// LoginTwoFactor mocks base method
+func (m *MockAuthService) LoginTwoFactor(ctx context.Context, req *auth.TwoFactorLoginRequest) (*auth.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginTwoFactor", ctx, req)
+	ret0, _ := ret[0].(*auth.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginTwoFactor indicates an expected call of LoginTwoFactor
+func (mr *MockAuthServiceMockRecorder) LoginTwoFactor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginTwoFactor", reflect.TypeOf((*MockAuthService)(nil).LoginTwoFactor), ctx, req)
+}
+
+// EnrollTwoFactor mocks base method
+func (m *MockAuthService) EnrollTwoFactor(ctx context.Context, req *auth.EnrollTwoFactorRequest) (*auth.EnrollTwoFactorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTwoFactor", ctx, req)
+	ret0, _ := ret[0].(*auth.EnrollTwoFactorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTwoFactor indicates an expected call of EnrollTwoFactor
+func (mr *MockAuthServiceMockRecorder) EnrollTwoFactor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTwoFactor", reflect.TypeOf((*MockAuthService)(nil).EnrollTwoFactor), ctx, req)
+}
+
+// ConfirmTwoFactor mocks base method
+func (m *MockAuthService) ConfirmTwoFactor(ctx context.Context, req *auth.ConfirmTwoFactorRequest) (*auth.ConfirmTwoFactorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTwoFactor", ctx, req)
+	ret0, _ := ret[0].(*auth.ConfirmTwoFactorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTwoFactor indicates an expected call of ConfirmTwoFactor
+func (mr *MockAuthServiceMockRecorder) ConfirmTwoFactor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactor", reflect.TypeOf((*MockAuthService)(nil).ConfirmTwoFactor), ctx, req)
+}
+
+// DisableTwoFactor mocks base method
+func (m *MockAuthService) DisableTwoFactor(ctx context.Context, req *auth.DisableTwoFactorRequest) (*auth.DisableTwoFactorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", ctx, req)
+	ret0, _ := ret[0].(*auth.DisableTwoFactorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor
+func (mr *MockAuthServiceMockRecorder) DisableTwoFactor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockAuthService)(nil).DisableTwoFactor), ctx, req)
+}
+
+// ForgotPassword mocks base method
+func (m *MockAuthService) ForgotPassword(ctx context.Context, req *auth.ForgotPasswordRequest) (*auth.ForgotPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", ctx, req)
+	ret0, _ := ret[0].(*auth.ForgotPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword
+func (mr *MockAuthServiceMockRecorder) ForgotPassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockAuthService)(nil).ForgotPassword), ctx, req)
+}
+
+// ResetPassword mocks base method
+func (m *MockAuthService) ResetPassword(ctx context.Context, req *auth.ResetPasswordRequest) (*auth.ResetPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, req)
+	ret0, _ := ret[0].(*auth.ResetPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword
+func (mr *MockAuthServiceMockRecorder) ResetPassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthService)(nil).ResetPassword), ctx, req)
+}
+
+// ChangePassword mocks base method
+func (m *MockAuthService) ChangePassword(ctx context.Context, req *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, req)
+	ret0, _ := ret[0].(*auth.ChangePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword
+func (mr *MockAuthServiceMockRecorder) ChangePassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthService)(nil).ChangePassword), ctx, req)
+}
+
+// ResendVerification mocks base method
+func (m *MockAuthService) ResendVerification(ctx context.Context, req *auth.ResendVerificationRequest) (*auth.ResendVerificationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendVerification", ctx, req)
+	ret0, _ := ret[0].(*auth.ResendVerificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendVerification indicates an expected call of ResendVerification
+func (mr *MockAuthServiceMockRecorder) ResendVerification(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerification", reflect.TypeOf((*MockAuthService)(nil).ResendVerification), ctx, req)
+}
+
+// VerifyContact mocks base method
+func (m *MockAuthService) VerifyContact(ctx context.Context, req *auth.VerifyContactRequest) (*auth.VerifyContactResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyContact", ctx, req)
+	ret0, _ := ret[0].(*auth.VerifyContactResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyContact indicates an expected call of VerifyContact
+func (mr *MockAuthServiceMockRecorder) VerifyContact(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyContact", reflect.TypeOf((*MockAuthService)(nil).VerifyContact), ctx, req)
+}
+
+// GetVerificationStatus mocks base method
+func (m *MockAuthService) GetVerificationStatus(ctx context.Context, req *auth.VerificationStatusReq) (*auth.VerificationStatusResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationStatus", ctx, req)
+	ret0, _ := ret[0].(*auth.VerificationStatusResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationStatus indicates an expected call of GetVerificationStatus
+func (mr *MockAuthServiceMockRecorder) GetVerificationStatus(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationStatus", reflect.TypeOf((*MockAuthService)(nil).GetVerificationStatus), ctx, req)
+}
+
+// AssignRole mocks base method
+func (m *MockAuthService) AssignRole(ctx context.Context, req *auth.AssignRoleRequest) (*auth.AssignRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", ctx, req)
+	ret0, _ := ret[0].(*auth.AssignRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignRole indicates an expected call of AssignRole
+func (mr *MockAuthServiceMockRecorder) AssignRole(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockAuthService)(nil).AssignRole), ctx, req)
+}
+
+// RevokeRole mocks base method
+func (m *MockAuthService) RevokeRole(ctx context.Context, req *auth.RevokeRoleRequest) (*auth.RevokeRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRole", ctx, req)
+	ret0, _ := ret[0].(*auth.RevokeRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeRole indicates an expected call of RevokeRole
+func (mr *MockAuthServiceMockRecorder) RevokeRole(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRole", reflect.TypeOf((*MockAuthService)(nil).RevokeRole), ctx, req)
+}
+
+// ListUserRoles mocks base method
+func (m *MockAuthService) ListUserRoles(ctx context.Context, req *auth.ListUserRolesRequest) (*auth.ListUserRolesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserRoles", ctx, req)
+	ret0, _ := ret[0].(*auth.ListUserRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserRoles indicates an expected call of ListUserRoles
+func (mr *MockAuthServiceMockRecorder) ListUserRoles(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRoles", reflect.TypeOf((*MockAuthService)(nil).ListUserRoles), ctx, req)
+}
+
+// UpdateUserStatus mocks base method
+func (m *MockAuthService) UpdateUserStatus(ctx context.Context, req *auth.UpdateUserStatusRequest) (*auth.UpdateUserStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserStatus", ctx, req)
+	ret0, _ := ret[0].(*auth.UpdateUserStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus
+func (mr *MockAuthServiceMockRecorder) UpdateUserStatus(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockAuthService)(nil).UpdateUserStatus), ctx, req)
+}
+
+// ListSessions mocks base method
+func (m *MockAuthService) ListSessions(ctx context.Context, req *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, req)
+	ret0, _ := ret[0].(*auth.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions
+func (mr *MockAuthServiceMockRecorder) ListSessions(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthService)(nil).ListSessions), ctx, req)
+}
+
+// RevokeSession mocks base method
+func (m *MockAuthService) RevokeSession(ctx context.Context, req *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, req)
+	ret0, _ := ret[0].(*auth.RevokeSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession
+func (mr *MockAuthServiceMockRecorder) RevokeSession(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthService)(nil).RevokeSession), ctx, req)
+}
+
+// LogoutAll mocks base method
+func (m *MockAuthService) LogoutAll(ctx context.Context, req *auth.LogoutAllRequest) (*auth.LogoutAllResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutAll", ctx, req)
+	ret0, _ := ret[0].(*auth.LogoutAllResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogoutAll indicates an expected call of LogoutAll
+func (mr *MockAuthServiceMockRecorder) LogoutAll(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutAll", reflect.TypeOf((*MockAuthService)(nil).LogoutAll), ctx, req)
+}
+
+// UnlockLogin mocks base method
+func (m *MockAuthService) UnlockLogin(ctx context.Context, req *auth.UnlockLoginRequest) (*auth.UnlockLoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockLogin", ctx, req)
+	ret0, _ := ret[0].(*auth.UnlockLoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockLogin indicates an expected call of UnlockLogin
+func (mr *MockAuthServiceMockRecorder) UnlockLogin(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockLogin", reflect.TypeOf((*MockAuthService)(nil).UnlockLogin), ctx, req)
+}
+
+// ListAuditLogs mocks base method
+func (m *MockAuthService) ListAuditLogs(ctx context.Context, req *auth.ListAuditLogsRequest) (*auth.ListAuditLogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogs", ctx, req)
+	ret0, _ := ret[0].(*auth.ListAuditLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogs indicates an expected call of ListAuditLogs
+func (mr *MockAuthServiceMockRecorder) ListAuditLogs(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogs", reflect.TypeOf((*MockAuthService)(nil).ListAuditLogs), ctx, req)
+}
+
+// CreateAPIKey mocks base method
+func (m *MockAuthService) CreateAPIKey(ctx context.Context, req *auth.CreateAPIKeyRequest) (*auth.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, req)
+	ret0, _ := ret[0].(*auth.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey
+func (mr *MockAuthServiceMockRecorder) CreateAPIKey(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAuthService)(nil).CreateAPIKey), ctx, req)
+}
+
+// ListAPIKeys mocks base method
+func (m *MockAuthService) ListAPIKeys(ctx context.Context, req *auth.ListAPIKeysRequest) (*auth.ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, req)
+	ret0, _ := ret[0].(*auth.ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys
+func (mr *MockAuthServiceMockRecorder) ListAPIKeys(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAuthService)(nil).ListAPIKeys), ctx, req)
+}
+
+// RevokeAPIKey mocks base method
+func (m *MockAuthService) RevokeAPIKey(ctx context.Context, req *auth.RevokeAPIKeyRequest) (*auth.RevokeAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, req)
+	ret0, _ := ret[0].(*auth.RevokeAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey
+func (mr *MockAuthServiceMockRecorder) RevokeAPIKey(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAuthService)(nil).RevokeAPIKey), ctx, req)
+}
+
+// VerifyAPISignature mocks base method
+func (m *MockAuthService) VerifyAPISignature(ctx context.Context, req *auth.VerifyAPISignatureReq) (*auth.VerifyAPISignatureResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAPISignature", ctx, req)
+	ret0, _ := ret[0].(*auth.VerifyAPISignatureResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAPISignature indicates an expected call of VerifyAPISignature
+func (mr *MockAuthServiceMockRecorder) VerifyAPISignature(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAPISignature", reflect.TypeOf((*MockAuthService)(nil).VerifyAPISignature), ctx, req)
+}
+
+// BanUser mocks base method
+func (m *MockAuthService) BanUser(ctx context.Context, req *auth.BanUserRequest) (*auth.BanUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanUser", ctx, req)
+	ret0, _ := ret[0].(*auth.BanUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanUser indicates an expected call of BanUser
+func (mr *MockAuthServiceMockRecorder) BanUser(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockAuthService)(nil).BanUser), ctx, req)
+}
+
+// UnbanUser mocks base method
+func (m *MockAuthService) UnbanUser(ctx context.Context, req *auth.UnbanUserRequest) (*auth.UnbanUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanUser", ctx, req)
+	ret0, _ := ret[0].(*auth.UnbanUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnbanUser indicates an expected call of UnbanUser
+func (mr *MockAuthServiceMockRecorder) UnbanUser(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanUser", reflect.TypeOf((*MockAuthService)(nil).UnbanUser), ctx, req)
+}
+
+// ListUserBans mocks base method
+func (m *MockAuthService) ListUserBans(ctx context.Context, req *auth.ListUserBansRequest) (*auth.ListUserBansResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserBans", ctx, req)
+	ret0, _ := ret[0].(*auth.ListUserBansResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserBans indicates an expected call of ListUserBans
+func (mr *MockAuthServiceMockRecorder) ListUserBans(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserBans", reflect.TypeOf((*MockAuthService)(nil).ListUserBans), ctx, req)
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/mock"
 
 	"TikTokMall/app/auth/biz/dal/mysql"
@@ -45,7 +47,340 @@ func (m *MockAuthRepository) GetTokenByToken(token string) (*mysql.Token, error)
 	return args.Get(0).(*mysql.Token), args.Error(1)
 }
 
+func (m *MockAuthRepository) GetTokenByRefreshToken(refreshToken string) (*mysql.Token, error) {
+	args := m.Called(refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.Token), args.Error(1)
+}
+
+func (m *MockAuthRepository) MarkRefreshTokenUsed(id int64, familyID string) (bool, error) {
+	args := m.Called(id, familyID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) RevokeTokenFamily(familyID string) ([]*mysql.Token, error) {
+	args := m.Called(familyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.Token), args.Error(1)
+}
+
 func (m *MockAuthRepository) DeleteToken(token string) error {
 	args := m.Called(token)
 	return args.Error(0)
 }
+
+func (m *MockAuthRepository) UpdateUserTwoFactor(userID int64, enabled bool, secret, recoveryCodes string) error {
+	args := m.Called(userID, enabled, secret, recoveryCodes)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) ReplaceRecoveryCodes(userID int64, current, remaining string) (bool, error) {
+	args := m.Called(userID, current, remaining)
+	// 返回值可以是函数，用于模拟条件更新
+	if fn, ok := args.Get(0).(func(int64, string, string) bool); ok {
+		return fn(userID, current, remaining), args.Error(1)
+	}
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) CreateOAuthClient(client *mysql.OAuthClient) error {
+	args := m.Called(client)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) GetOAuthClient(clientID string) (*mysql.OAuthClient, error) {
+	args := m.Called(clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.OAuthClient), args.Error(1)
+}
+
+func (m *MockAuthRepository) GetOAuthConsent(userID int64, clientID string) (*mysql.OAuthConsent, error) {
+	args := m.Called(userID, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.OAuthConsent), args.Error(1)
+}
+
+func (m *MockAuthRepository) SaveOAuthConsent(consent *mysql.OAuthConsent) error {
+	args := m.Called(consent)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) ListOAuthConsents(userID int64) ([]*mysql.OAuthConsent, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.OAuthConsent), args.Error(1)
+}
+
+func (m *MockAuthRepository) DeleteOAuthConsent(userID int64, clientID string) error {
+	args := m.Called(userID, clientID)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) RevokeClientTokens(userID int64, clientID string) ([]*mysql.Token, error) {
+	args := m.Called(userID, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.Token), args.Error(1)
+}
+
+func (m *MockAuthRepository) GetUserByEmail(email string) (*mysql.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.User), args.Error(1)
+}
+
+func (m *MockAuthRepository) CheckUserExists(username string) (bool, error) {
+	args := m.Called(username)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) GetUserIdentity(provider, subject string) (*mysql.UserIdentity, error) {
+	args := m.Called(provider, subject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.UserIdentity), args.Error(1)
+}
+
+func (m *MockAuthRepository) CreateUserIdentity(identity *mysql.UserIdentity) error {
+	args := m.Called(identity)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) GetUserByPhone(phone string) (*mysql.User, error) {
+	args := m.Called(phone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.User), args.Error(1)
+}
+
+func (m *MockAuthRepository) UpdateUserPassword(userID int64, password string) error {
+	args := m.Called(userID, password)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) DeleteTokenByUserID(userID int64) ([]*mysql.Token, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.Token), args.Error(1)
+}
+
+func (m *MockAuthRepository) CheckEmailExists(email string) (bool, error) {
+	args := m.Called(email)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) CheckPhoneExists(phone string) (bool, error) {
+	args := m.Called(phone)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) MarkEmailVerified(userID int64, email string) (bool, error) {
+	args := m.Called(userID, email)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) MarkPhoneVerified(userID int64, phone string) (bool, error) {
+	args := m.Called(userID, phone)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) GetRoleByName(name string) (*mysql.Role, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.Role), args.Error(1)
+}
+
+func (m *MockAuthRepository) GetUserRoles(userID int64) ([]string, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockAuthRepository) GetRolePermissions(roles []string) ([]string, error) {
+	args := m.Called(roles)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockAuthRepository) AssignUserRole(userID, roleID, grantedBy int64) error {
+	args := m.Called(userID, roleID, grantedBy)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) RevokeUserRole(userID, roleID int64) error {
+	args := m.Called(userID, roleID)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) UpdateUserStatus(userID int64, status int8) error {
+	args := m.Called(userID, status)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) GetTokenByID(id int64) (*mysql.Token, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.Token), args.Error(1)
+}
+
+func (m *MockAuthRepository) ListUserSessions(userID int64) ([]*mysql.Token, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.Token), args.Error(1)
+}
+
+func (m *MockAuthRepository) TouchToken(token string, seenAt time.Time) error {
+	args := m.Called(token, seenAt)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) GetPasswordHistory(userID int64, limit int) ([]string, error) {
+	args := m.Called(userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockAuthRepository) AddPasswordHistory(userID int64, password string, keep int) error {
+	args := m.Called(userID, password, keep)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) CreateAuditLogs(logs []*mysql.AuditLog) error {
+	args := m.Called(logs)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) ListAuditLogs(filter mysql.AuditLogFilter) ([]*mysql.AuditLog, int64, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*mysql.AuditLog), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockAuthRepository) CreateAPIKey(key *mysql.APIKey) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) GetAPIKey(keyID string) (*mysql.APIKey, error) {
+	args := m.Called(keyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.APIKey), args.Error(1)
+}
+
+func (m *MockAuthRepository) ListAPIKeys(userID int64) ([]*mysql.APIKey, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.APIKey), args.Error(1)
+}
+
+func (m *MockAuthRepository) CountActiveAPIKeys(userID int64, now time.Time) (int64, error) {
+	args := m.Called(userID, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAuthRepository) RevokeAPIKey(userID int64, keyID string) (bool, error) {
+	args := m.Called(userID, keyID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) TouchAPIKey(keyID string, usedAt time.Time) error {
+	args := m.Called(keyID, usedAt)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) CreateWebAuthnCredential(cred *mysql.WebAuthnCredential) error {
+	args := m.Called(cred)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) GetWebAuthnCredential(credentialID []byte) (*mysql.WebAuthnCredential, error) {
+	args := m.Called(credentialID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mysql.WebAuthnCredential), args.Error(1)
+}
+
+func (m *MockAuthRepository) ListWebAuthnCredentials(userID int64) ([]*mysql.WebAuthnCredential, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.WebAuthnCredential), args.Error(1)
+}
+
+func (m *MockAuthRepository) UpdateWebAuthnCredentialUsage(id int64, signCount uint32, usedAt time.Time) error {
+	args := m.Called(id, signCount, usedAt)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) DeleteWebAuthnCredential(userID, id int64) (bool, error) {
+	args := m.Called(userID, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) CreateUserBan(ban *mysql.UserBan) error {
+	args := m.Called(ban)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) LiftUserBans(userID, liftedBy int64, reason string, at time.Time) error {
+	args := m.Called(userID, liftedBy, reason, at)
+	return args.Error(0)
+}
+
+func (m *MockAuthRepository) ListExpiredUserBans(now time.Time, limit int) ([]*mysql.UserBan, error) {
+	args := m.Called(now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.UserBan), args.Error(1)
+}
+
+func (m *MockAuthRepository) LiftExpiredUserBan(id int64, at time.Time) (bool, error) {
+	args := m.Called(id, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthRepository) ListUserBans(userID int64) ([]*mysql.UserBan, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*mysql.UserBan), args.Error(1)
+}
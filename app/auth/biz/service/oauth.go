@@ -0,0 +1,889 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+	"TikTokMall/pkg/tokenhash"
+)
+
+const (
+	// OAuth2 相关配置
+	AuthorizationCodeExpiration = 5 * time.Minute // 授权码有效期
+	IDTokenExpiration           = time.Hour       // ID 令牌有效期
+
+	// OIDC 标准 scope
+	ScopeOpenID        = "openid"
+	ScopeProfile       = "profile"
+	ScopeEmail         = "email"
+	ScopePhone         = "phone"
+	ScopeOfflineAccess = "offline_access"
+
+	// 授权类型
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+
+	ResponseTypeCode        = "code"
+	CodeChallengeMethodS256 = "S256"
+
+	// 客户端认证方式
+	AuthMethodClientSecretBasic = "client_secret_basic"
+	AuthMethodClientSecretPost  = "client_secret_post"
+	AuthMethodNone              = "none"
+)
+
+// SupportedScopes 授权服务器支持的 scope，商城业务 scope 由各资源服务根据 ValidateToken 返回的 scope 校验
+var SupportedScopes = []string{
+	ScopeOpenID, ScopeProfile, ScopeEmail, ScopePhone, ScopeOfflineAccess,
+	"product:read", "cart:read", "cart:write", "order:read", "order:write",
+}
+
+// userScopes 代表用户身份的 scope，只能经用户授权获得，client_credentials 不能申请
+var userScopes = map[string]bool{
+	ScopeOpenID:        true,
+	ScopeProfile:       true,
+	ScopeEmail:         true,
+	ScopePhone:         true,
+	ScopeOfflineAccess: true,
+}
+
+// OAuthError RFC 6749 错误响应
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return e.Code + ": " + e.Description
+}
+
+// newOAuthError 创建 OAuth2 错误
+func newOAuthError(code, description string) *OAuthError {
+	return &OAuthError{Code: code, Description: description}
+}
+
+// RegisterClientRequest 注册第三方应用请求 (RFC 7591)
+type RegisterClientRequest struct {
+	ClientName              string   `json:"client_name"`
+	RedirectURIs            []string `json:"redirect_uris"`
+	GrantTypes              []string `json:"grant_types"`
+	Scope                   string   `json:"scope"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method"` // none 表示公开客户端
+}
+
+// RegisterClientResponse 注册第三方应用响应，client_secret 只返回一次
+type RegisterClientResponse struct {
+	ClientID                string   `json:"client_id"`
+	ClientSecret            string   `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64    `json:"client_id_issued_at"`
+	ClientName              string   `json:"client_name"`
+	RedirectURIs            []string `json:"redirect_uris"`
+	GrantTypes              []string `json:"grant_types"`
+	Scope                   string   `json:"scope"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method"`
+}
+
+// AuthorizeRequest 授权请求
+type AuthorizeRequest struct {
+	ResponseType        string `query:"response_type" form:"response_type"`
+	ClientID            string `query:"client_id" form:"client_id"`
+	RedirectURI         string `query:"redirect_uri" form:"redirect_uri"`
+	Scope               string `query:"scope" form:"scope"`
+	State               string `query:"state" form:"state"`
+	Nonce               string `query:"nonce" form:"nonce"`
+	CodeChallenge       string `query:"code_challenge" form:"code_challenge"`
+	CodeChallengeMethod string `query:"code_challenge_method" form:"code_challenge_method"`
+	Prompt              string `query:"prompt" form:"prompt"`
+}
+
+// AuthorizeResponse 授权结果，RedirectTo 非空时跳转回应用，否则需要用户确认授权
+type AuthorizeResponse struct {
+	RedirectTo      string   `json:"redirect_to,omitempty"`
+	ConsentRequired bool     `json:"consent_required"`
+	ClientID        string   `json:"client_id,omitempty"`
+	ClientName      string   `json:"client_name,omitempty"`
+	Scopes          []string `json:"scopes,omitempty"`
+}
+
+// TokenRequest 令牌请求
+type TokenRequest struct {
+	GrantType    string `form:"grant_type"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	Scope        string `form:"scope"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+// TokenResponse 令牌响应
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+}
+
+// UserInfo OIDC 用户信息
+type UserInfo struct {
+	Sub               string `json:"sub"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Email             string `json:"email,omitempty"`
+	PhoneNumber       string `json:"phone_number,omitempty"`
+}
+
+// ConsentInfo 用户已授权的应用
+type ConsentInfo struct {
+	ClientID   string   `json:"client_id"`
+	ClientName string   `json:"client_name"`
+	Scopes     []string `json:"scopes"`
+	GrantedAt  int64    `json:"granted_at"`
+}
+
+// DiscoveryDocument OIDC 发现文档
+type DiscoveryDocument struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	RegistrationEndpoint              string   `json:"registration_endpoint"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// OAuthService 定义 OAuth2 / OIDC 授权服务接口
+type OAuthService interface {
+	RegisterClient(ctx context.Context, accessToken string, req *RegisterClientRequest) (*RegisterClientResponse, error)
+	Authorize(ctx context.Context, accessToken string, req *AuthorizeRequest) (*AuthorizeResponse, error)
+	Consent(ctx context.Context, accessToken string, req *AuthorizeRequest, approve bool) (*AuthorizeResponse, error)
+	Token(ctx context.Context, req *TokenRequest) (*TokenResponse, error)
+	UserInfo(ctx context.Context, accessToken string) (*UserInfo, error)
+	ListConsents(ctx context.Context, accessToken string) ([]*ConsentInfo, error)
+	RevokeConsent(ctx context.Context, accessToken, clientID string) error
+}
+
+// authorizationCode 授权码关联的授权信息，保存在 Redis 中
+type authorizationCode struct {
+	ClientID      string `json:"client_id"`
+	UserID        int64  `json:"user_id"`
+	RedirectURI   string `json:"redirect_uri"`
+	Scope         string `json:"scope"`
+	CodeChallenge string `json:"code_challenge"`
+	Nonce         string `json:"nonce,omitempty"`
+	AuthTime      int64  `json:"auth_time"`
+}
+
+type oauthService struct {
+	*authService
+}
+
+// NewOAuthService 创建 OAuth2 授权服务，与认证服务共用用户表和令牌表
+func NewOAuthService(repo AuthRepository) OAuthService {
+	return &oauthService{authService: &authService{repo: repo}}
+}
+
+// NewDiscoveryDocument 生成 OIDC 发现文档，baseURL 为授权服务对外地址
+func NewDiscoveryDocument(baseURL string) *DiscoveryDocument {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &DiscoveryDocument{
+		Issuer:                            jwt.Issuer(),
+		AuthorizationEndpoint:             baseURL + "/oauth/authorize",
+		TokenEndpoint:                     baseURL + "/oauth/token",
+		UserinfoEndpoint:                  baseURL + "/oauth/userinfo",
+		JWKSURI:                           baseURL + "/.well-known/jwks.json",
+		RegistrationEndpoint:              baseURL + "/oauth/clients",
+		ScopesSupported:                   SupportedScopes,
+		ResponseTypesSupported:            []string{ResponseTypeCode},
+		GrantTypesSupported:               []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  jwt.Algorithms(),
+		TokenEndpointAuthMethodsSupported: []string{AuthMethodClientSecretBasic, AuthMethodClientSecretPost, AuthMethodNone},
+		CodeChallengeMethodsSupported:     []string{CodeChallengeMethodS256},
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "preferred_username", "email", "phone_number"},
+	}
+}
+
+// RegisterClient 注册第三方应用，注册人记为应用所有者
+func (s *oauthService) RegisterClient(ctx context.Context, accessToken string, req *RegisterClientRequest) (*RegisterClientResponse, error) {
+	claims, err := s.firstPartyClaims(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.ClientName) == "" {
+		return nil, newOAuthError("invalid_client_metadata", "client_name is required")
+	}
+
+	authMethod := req.TokenEndpointAuthMethod
+	if authMethod == "" {
+		authMethod = AuthMethodClientSecretBasic
+	}
+	if authMethod != AuthMethodClientSecretBasic && authMethod != AuthMethodClientSecretPost && authMethod != AuthMethodNone {
+		return nil, newOAuthError("invalid_client_metadata", "unsupported token_endpoint_auth_method")
+	}
+	public := authMethod == AuthMethodNone
+
+	grantTypes := req.GrantTypes
+	if len(grantTypes) == 0 {
+		grantTypes = []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken}
+	}
+	for _, gt := range grantTypes {
+		switch gt {
+		case GrantTypeAuthorizationCode, GrantTypeRefreshToken:
+		case GrantTypeClientCredentials:
+			if public {
+				return nil, newOAuthError("invalid_client_metadata", "public clients cannot use client_credentials")
+			}
+		default:
+			return nil, newOAuthError("invalid_client_metadata", "unsupported grant_type "+gt)
+		}
+	}
+
+	if containsString(grantTypes, GrantTypeAuthorizationCode) && len(req.RedirectURIs) == 0 {
+		return nil, newOAuthError("invalid_redirect_uri", "redirect_uris is required")
+	}
+	for _, uri := range req.RedirectURIs {
+		if err := validateRedirectURI(uri); err != nil {
+			return nil, err
+		}
+	}
+
+	scope := req.Scope
+	if scope == "" {
+		scope = ScopeOpenID + " " + ScopeProfile
+	}
+	for _, sc := range parseScopes(scope) {
+		if !containsString(SupportedScopes, sc) {
+			return nil, newOAuthError("invalid_client_metadata", "unsupported scope "+sc)
+		}
+	}
+
+	clientID, err := generateClientID()
+	if err != nil {
+		return nil, err
+	}
+	client := &mysql.OAuthClient{
+		ClientID:     clientID,
+		Name:         req.ClientName,
+		RedirectURIs: strings.Join(req.RedirectURIs, " "),
+		GrantTypes:   strings.Join(grantTypes, " "),
+		Scopes:       joinScopes(parseScopes(scope)),
+		OwnerID:      claims.UserID,
+	}
+
+	var secret string
+	if !public {
+		if secret, err = generateToken(); err != nil {
+			return nil, err
+		}
+		if client.ClientSecretHash, err = hashPassword(secret); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.CreateOAuthClient(client); err != nil {
+		return nil, errors.Wrap(err, "create oauth client failed")
+	}
+
+	return &RegisterClientResponse{
+		ClientID:                clientID,
+		ClientSecret:            secret,
+		ClientIDIssuedAt:        time.Now().Unix(),
+		ClientName:              client.Name,
+		RedirectURIs:            req.RedirectURIs,
+		GrantTypes:              grantTypes,
+		Scope:                   client.Scopes,
+		TokenEndpointAuthMethod: authMethod,
+	}, nil
+}
+
+// Authorize 处理授权请求，用户已授权全部 scope 时直接签发授权码，否则返回待确认的授权信息
+func (s *oauthService) Authorize(ctx context.Context, accessToken string, req *AuthorizeRequest) (*AuthorizeResponse, error) {
+	client, err := s.authorizeClient(req)
+	if err != nil {
+		return nil, err
+	}
+	scopes, oauthErr := validateAuthorizeRequest(client, req)
+	if oauthErr != nil {
+		return errorRedirect(req, oauthErr), nil
+	}
+
+	claims, err := s.firstPartyClaims(ctx, accessToken)
+	if err != nil {
+		if req.Prompt == "none" {
+			return errorRedirect(req, newOAuthError("login_required", "")), nil
+		}
+		return nil, newOAuthError("login_required", "user authentication required")
+	}
+
+	if req.Prompt != "consent" {
+		consent, err := s.repo.GetOAuthConsent(claims.UserID, client.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		if consent != nil && coversScopes(consent.Scopes, scopes) {
+			return s.issueAuthorizationCode(ctx, claims, req, scopes)
+		}
+	}
+
+	if req.Prompt == "none" {
+		return errorRedirect(req, newOAuthError("consent_required", "")), nil
+	}
+
+	return &AuthorizeResponse{
+		ConsentRequired: true,
+		ClientID:        client.ClientID,
+		ClientName:      client.Name,
+		Scopes:          scopes,
+	}, nil
+}
+
+// Consent 记录用户对授权请求的确认结果，同意后签发授权码
+func (s *oauthService) Consent(ctx context.Context, accessToken string, req *AuthorizeRequest, approve bool) (*AuthorizeResponse, error) {
+	client, err := s.authorizeClient(req)
+	if err != nil {
+		return nil, err
+	}
+	scopes, oauthErr := validateAuthorizeRequest(client, req)
+	if oauthErr != nil {
+		return errorRedirect(req, oauthErr), nil
+	}
+
+	claims, err := s.firstPartyClaims(ctx, accessToken)
+	if err != nil {
+		return nil, newOAuthError("login_required", "user authentication required")
+	}
+
+	if !approve {
+		return errorRedirect(req, newOAuthError("access_denied", "user denied the request")), nil
+	}
+
+	// 合并此前已授权的 scope
+	granted := scopes
+	consent, err := s.repo.GetOAuthConsent(claims.UserID, client.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if consent != nil {
+		granted = append(parseScopes(consent.Scopes), scopes...)
+	}
+	if err := s.repo.SaveOAuthConsent(&mysql.OAuthConsent{
+		UserID:   claims.UserID,
+		ClientID: client.ClientID,
+		Scopes:   joinScopes(granted),
+	}); err != nil {
+		return nil, errors.Wrap(err, "save oauth consent failed")
+	}
+
+	return s.issueAuthorizationCode(ctx, claims, req, scopes)
+}
+
+// Token 令牌端点，支持 authorization_code、refresh_token 和 client_credentials
+func (s *oauthService) Token(ctx context.Context, req *TokenRequest) (*TokenResponse, error) {
+	client, err := s.authenticateClient(req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if req.GrantType == "" {
+		return nil, newOAuthError("invalid_request", "grant_type is required")
+	}
+	if !containsString(strings.Fields(client.GrantTypes), req.GrantType) {
+		switch req.GrantType {
+		case GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials:
+			return nil, newOAuthError("unauthorized_client", "grant_type not allowed for this client")
+		default:
+			return nil, newOAuthError("unsupported_grant_type", "")
+		}
+	}
+
+	switch req.GrantType {
+	case GrantTypeAuthorizationCode:
+		return s.exchangeAuthorizationCode(ctx, client, req)
+	case GrantTypeRefreshToken:
+		return s.refreshClientToken(ctx, client, req)
+	case GrantTypeClientCredentials:
+		return s.clientCredentials(ctx, client, req)
+	default:
+		return nil, newOAuthError("unsupported_grant_type", "")
+	}
+}
+
+// UserInfo 返回访问令牌授权范围内的用户信息
+func (s *oauthService) UserInfo(ctx context.Context, accessToken string) (*UserInfo, error) {
+	claims, err := s.parseAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, newOAuthError("invalid_token", err.Error())
+	}
+	if claims.UserID == 0 || !hasScope(claims.Scope, ScopeOpenID) {
+		return nil, newOAuthError("insufficient_scope", "openid scope required")
+	}
+
+	user, err := s.repo.GetUserByID(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return newUserInfo(user, claims.Scope), nil
+}
+
+// ListConsents 列出当前用户已授权的应用
+func (s *oauthService) ListConsents(ctx context.Context, accessToken string) ([]*ConsentInfo, error) {
+	claims, err := s.firstPartyClaims(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	consents, err := s.repo.ListOAuthConsents(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*ConsentInfo, 0, len(consents))
+	for _, c := range consents {
+		info := &ConsentInfo{
+			ClientID:  c.ClientID,
+			Scopes:    parseScopes(c.Scopes),
+			GrantedAt: c.UpdatedAt.Unix(),
+		}
+		if client, err := s.repo.GetOAuthClient(c.ClientID); err == nil {
+			info.ClientName = client.Name
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// RevokeConsent 撤销对应用的授权，并吊销该应用持有的全部令牌
+func (s *oauthService) RevokeConsent(ctx context.Context, accessToken, clientID string) error {
+	claims, err := s.firstPartyClaims(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteOAuthConsent(claims.UserID, clientID); err != nil {
+		return errors.Wrap(err, "delete oauth consent failed")
+	}
+
+	tokens, err := s.repo.RevokeClientTokens(claims.UserID, clientID)
+	if err != nil {
+		return errors.Wrap(err, "revoke client tokens failed")
+	}
+	for _, t := range tokens {
+		if err := redis.DeleteToken(ctx, t.Token); err != nil {
+			hlog.CtxWarnf(ctx, "delete token from cache failed: %v", err)
+		}
+		if err := redis.AddToBlacklist(ctx, t.Token, OAuthAccessTokenExpiration); err != nil {
+			hlog.CtxWarnf(ctx, "add token to blacklist failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// firstPartyClaims 校验用户自身登录签发的访问令牌，第三方应用令牌不能用于管理授权
+func (s *oauthService) firstPartyClaims(ctx context.Context, accessToken string) (*jwt.Claims, error) {
+	claims, err := s.parseAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.ClientID != "" || claims.UserID == 0 {
+		return nil, auth.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// authorizeClient 校验授权请求中的应用和回调地址，失败时不能跳转回应用
+func (s *oauthService) authorizeClient(req *AuthorizeRequest) (*mysql.OAuthClient, error) {
+	if req.ClientID == "" {
+		return nil, newOAuthError("invalid_request", "client_id is required")
+	}
+	client, err := s.repo.GetOAuthClient(req.ClientID)
+	if err != nil {
+		if err == mysql.ErrRecordNotFound {
+			return nil, newOAuthError("invalid_client", "unknown client")
+		}
+		return nil, err
+	}
+
+	redirectURIs := strings.Fields(client.RedirectURIs)
+	if req.RedirectURI == "" && len(redirectURIs) == 1 {
+		req.RedirectURI = redirectURIs[0]
+	}
+	if !containsString(redirectURIs, req.RedirectURI) {
+		return nil, newOAuthError("invalid_request", "redirect_uri is not registered")
+	}
+	return client, nil
+}
+
+// validateAuthorizeRequest 校验授权请求参数，返回本次申请的 scope
+func validateAuthorizeRequest(client *mysql.OAuthClient, req *AuthorizeRequest) ([]string, *OAuthError) {
+	if req.ResponseType != ResponseTypeCode {
+		return nil, newOAuthError("unsupported_response_type", "only code is supported")
+	}
+	if !containsString(strings.Fields(client.GrantTypes), GrantTypeAuthorizationCode) {
+		return nil, newOAuthError("unauthorized_client", "authorization_code not allowed for this client")
+	}
+	// 所有客户端都必须使用 PKCE
+	if req.CodeChallenge == "" {
+		return nil, newOAuthError("invalid_request", "code_challenge is required")
+	}
+	if req.CodeChallengeMethod != CodeChallengeMethodS256 {
+		return nil, newOAuthError("invalid_request", "code_challenge_method must be S256")
+	}
+
+	scopes := parseScopes(req.Scope)
+	if len(scopes) == 0 {
+		return nil, newOAuthError("invalid_scope", "scope is required")
+	}
+	allowed := parseScopes(client.Scopes)
+	for _, sc := range scopes {
+		if !containsString(allowed, sc) {
+			return nil, newOAuthError("invalid_scope", "scope "+sc+" not allowed for this client")
+		}
+	}
+	return scopes, nil
+}
+
+// issueAuthorizationCode 签发授权码并生成回调地址
+func (s *oauthService) issueAuthorizationCode(ctx context.Context, claims *jwt.Claims, req *AuthorizeRequest, scopes []string) (*AuthorizeResponse, error) {
+	code, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	authTime := time.Now().Unix()
+	if claims.IssuedAt != nil {
+		authTime = claims.IssuedAt.Unix()
+	}
+	payload, err := json.Marshal(&authorizationCode{
+		ClientID:      req.ClientID,
+		UserID:        claims.UserID,
+		RedirectURI:   req.RedirectURI,
+		Scope:         joinScopes(scopes),
+		CodeChallenge: req.CodeChallenge,
+		Nonce:         req.Nonce,
+		AuthTime:      authTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := redis.SaveAuthorizationCode(ctx, code, string(payload), AuthorizationCodeExpiration); err != nil {
+		return nil, errors.Wrap(err, "save authorization code failed")
+	}
+
+	return &AuthorizeResponse{
+		RedirectTo: buildRedirect(req.RedirectURI, map[string]string{
+			"code":  code,
+			"state": req.State,
+			"iss":   jwt.Issuer(),
+		}),
+	}, nil
+}
+
+// authenticateClient 校验应用身份，公开客户端只需 client_id
+func (s *oauthService) authenticateClient(clientID, clientSecret string) (*mysql.OAuthClient, error) {
+	if clientID == "" {
+		return nil, newOAuthError("invalid_client", "client authentication required")
+	}
+	client, err := s.repo.GetOAuthClient(clientID)
+	if err != nil {
+		if err == mysql.ErrRecordNotFound {
+			return nil, newOAuthError("invalid_client", "unknown client")
+		}
+		return nil, err
+	}
+	if !client.Public() && (clientSecret == "" || comparePassword(client.ClientSecretHash, clientSecret) != nil) {
+		return nil, newOAuthError("invalid_client", "client authentication failed")
+	}
+	return client, nil
+}
+
+// exchangeAuthorizationCode 使用授权码和 PKCE 校验码换取令牌
+func (s *oauthService) exchangeAuthorizationCode(ctx context.Context, client *mysql.OAuthClient, req *TokenRequest) (*TokenResponse, error) {
+	if req.Code == "" || req.CodeVerifier == "" {
+		return nil, newOAuthError("invalid_request", "code and code_verifier are required")
+	}
+
+	payload, err := redis.TakeAuthorizationCode(ctx, req.Code)
+	if err != nil {
+		return nil, errors.Wrap(err, "get authorization code failed")
+	}
+	if payload == "" {
+		return nil, newOAuthError("invalid_grant", "invalid or expired authorization code")
+	}
+	var code authorizationCode
+	if err := json.Unmarshal([]byte(payload), &code); err != nil {
+		return nil, errors.Wrap(err, "decode authorization code failed")
+	}
+
+	if code.ClientID != client.ClientID || code.RedirectURI != req.RedirectURI {
+		return nil, newOAuthError("invalid_grant", "authorization code was issued to another client or redirect_uri")
+	}
+	if !verifyCodeChallenge(req.CodeVerifier, code.CodeChallenge) {
+		return nil, newOAuthError("invalid_grant", "code_verifier does not match code_challenge")
+	}
+
+	user, err := s.repo.GetUserByID(code.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserActive(user); err != nil {
+		return nil, newOAuthError("invalid_grant", err.Error())
+	}
+
+	grant := tokenGrant{ClientID: client.ClientID, Scope: code.Scope}
+	token, refreshToken, err := s.createGrantTokens(ctx, user, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &TokenResponse{
+		AccessToken:  token,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(OAuthAccessTokenExpiration / time.Second),
+		RefreshToken: refreshToken,
+		Scope:        code.Scope,
+	}
+	if hasScope(code.Scope, ScopeOpenID) {
+		if resp.IDToken, err = signIDToken(user, client.ClientID, code.Scope, code.Nonce, code.AuthTime); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// refreshClientToken 轮换第三方应用的刷新令牌
+func (s *oauthService) refreshClientToken(ctx context.Context, client *mysql.OAuthClient, req *TokenRequest) (*TokenResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, newOAuthError("invalid_request", "refresh_token is required")
+	}
+
+	token, refreshToken, record, err := s.rotateRefreshToken(ctx, req.RefreshToken, client.ClientID)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrTokenExpired) || errors.Is(err, auth.ErrTokenReused) {
+			return nil, newOAuthError("invalid_grant", err.Error())
+		}
+		return nil, err
+	}
+
+	return &TokenResponse{
+		AccessToken:  token,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(OAuthAccessTokenExpiration / time.Second),
+		RefreshToken: refreshToken,
+		Scope:        record.Scope,
+	}, nil
+}
+
+// clientCredentials 为应用自身签发访问令牌，令牌不代表任何用户
+func (s *oauthService) clientCredentials(ctx context.Context, client *mysql.OAuthClient, req *TokenRequest) (*TokenResponse, error) {
+	if client.Public() {
+		return nil, newOAuthError("unauthorized_client", "public clients cannot use client_credentials")
+	}
+
+	allowed := parseScopes(client.Scopes)
+	scopes := parseScopes(req.Scope)
+	if len(scopes) == 0 {
+		for _, sc := range allowed {
+			if !userScopes[sc] {
+				scopes = append(scopes, sc)
+			}
+		}
+	}
+	for _, sc := range scopes {
+		if userScopes[sc] || !containsString(allowed, sc) {
+			return nil, newOAuthError("invalid_scope", "scope "+sc+" not allowed for client_credentials")
+		}
+	}
+	scope := joinScopes(scopes)
+
+	claims := jwt.NewClaims(0, "", OAuthAccessTokenExpiration)
+	claims.Subject = client.ClientID
+	claims.ClientID = client.ClientID
+	claims.Scope = scope
+	token, err := jwt.Sign(claims)
+	if err != nil {
+		return nil, errors.Wrap(err, "sign access token failed")
+	}
+
+	if err := s.repo.CreateToken(&mysql.Token{
+		Token:     tokenhash.Digest(token),
+		ExpiredAt: claims.ExpiresAt.Time,
+		ClientID:  client.ClientID,
+		Scope:     scope,
+	}); err != nil {
+		return nil, errors.Wrap(err, "create token record failed")
+	}
+
+	return &TokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(OAuthAccessTokenExpiration / time.Second),
+		Scope:       scope,
+	}, nil
+}
+
+// signIDToken 签发 OIDC ID 令牌，用户信息按 scope 披露
+func signIDToken(user *mysql.User, clientID, scope, nonce string, authTime int64) (string, error) {
+	now := time.Now()
+	info := newUserInfo(user, scope)
+	token, err := jwt.SignIDToken(&jwt.IDTokenClaims{
+		Nonce:             nonce,
+		AuthTime:          authTime,
+		PreferredUsername: info.PreferredUsername,
+		Email:             info.Email,
+		PhoneNumber:       info.PhoneNumber,
+		RegisteredClaims: gojwt.RegisteredClaims{
+			Subject:   info.Sub,
+			Audience:  gojwt.ClaimStrings{clientID},
+			IssuedAt:  gojwt.NewNumericDate(now),
+			ExpiresAt: gojwt.NewNumericDate(now.Add(IDTokenExpiration)),
+		},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "sign id token failed")
+	}
+	return token, nil
+}
+
+// newUserInfo 按 scope 生成用户信息
+func newUserInfo(user *mysql.User, scope string) *UserInfo {
+	info := &UserInfo{Sub: strconv.FormatInt(user.ID, 10)}
+	if hasScope(scope, ScopeProfile) {
+		info.PreferredUsername = user.Username
+	}
+	if hasScope(scope, ScopeEmail) {
+		info.Email = user.Email
+	}
+	if hasScope(scope, ScopePhone) {
+		info.PhoneNumber = user.Phone
+	}
+	return info
+}
+
+// verifyCodeChallenge 校验 PKCE：BASE64URL(SHA256(code_verifier)) == code_challenge
+func verifyCodeChallenge(verifier, challenge string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// validateRedirectURI 回调地址必须是不带 fragment 的绝对地址，非本机地址必须使用 https
+func validateRedirectURI(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" || u.Host == "" || u.Fragment != "" {
+		return newOAuthError("invalid_redirect_uri", "invalid redirect_uri "+uri)
+	}
+	host := u.Hostname()
+	if u.Scheme != "https" && !(u.Scheme == "http" && (host == "localhost" || host == "127.0.0.1")) {
+		return newOAuthError("invalid_redirect_uri", "redirect_uri must use https: "+uri)
+	}
+	return nil
+}
+
+// errorRedirect 将错误通过回调地址返回给应用
+func errorRedirect(req *AuthorizeRequest, err *OAuthError) *AuthorizeResponse {
+	return &AuthorizeResponse{
+		RedirectTo: buildRedirect(req.RedirectURI, map[string]string{
+			"error":             err.Code,
+			"error_description": err.Description,
+			"state":             req.State,
+			"iss":               jwt.Issuer(),
+		}),
+	}
+}
+
+// buildRedirect 在回调地址上追加查询参数，忽略空值
+func buildRedirect(redirectURI string, params map[string]string) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	q := u.Query()
+	for k, v := range params {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// generateClientID 生成应用ID
+func generateClientID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generate client id failed")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// parseScopes 解析空格分隔的 scope 并去重
+func parseScopes(scope string) []string {
+	var scopes []string
+	for _, sc := range strings.Fields(scope) {
+		if !containsString(scopes, sc) {
+			scopes = append(scopes, sc)
+		}
+	}
+	return scopes
+}
+
+// joinScopes 合并 scope 并去重
+func joinScopes(scopes []string) string {
+	return strings.Join(parseScopes(strings.Join(scopes, " ")), " ")
+}
+
+// hasScope 判断 scope 中是否包含指定项
+func hasScope(scope, target string) bool {
+	return containsString(strings.Fields(scope), target)
+}
+
+// coversScopes 判断已授权的 scope 是否覆盖本次申请的全部 scope
+func coversScopes(granted string, requested []string) bool {
+	grantedScopes := strings.Fields(granted)
+	for _, sc := range requested {
+		if !containsString(grantedScopes, sc) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsString 判断切片中是否包含指定字符串
+func containsString(list []string, target string) bool {
+	for _, s := range list {
+		if s == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,455 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+)
+
+const testRedirectURI = "https://partner.example.com/callback"
+
+// oauthTestEnv 带状态的 OAuth 测试环境，应用、授权记录和令牌记录保存在内存中，其余方法由 mock 处理
+type oauthTestEnv struct {
+	*servicemock.MockAuthRepository
+	svc      OAuthService
+	user     *mysql.User
+	token    string
+	clients  map[string]*mysql.OAuthClient
+	consents map[string]*mysql.OAuthConsent
+	tokens   []*mysql.Token
+}
+
+func newOAuthTestEnv(t *testing.T) *oauthTestEnv {
+	env := &oauthTestEnv{
+		MockAuthRepository: new(servicemock.MockAuthRepository),
+		user: &mysql.User{
+			ID:       1,
+			Username: "testuser",
+			Email:    "test@example.com",
+			Phone:    "13800138000",
+			Status:   UserStatusNormal,
+		},
+		clients:  make(map[string]*mysql.OAuthClient),
+		consents: make(map[string]*mysql.OAuthConsent),
+	}
+	env.On("GetUserByID", int64(1)).Return(env.user, nil)
+
+	redis.Client = &mockRedis{}
+	env.svc = NewOAuthService(env)
+	env.token = signTestToken(t, 1, "testuser", time.Minute)
+	return env
+}
+
+func (env *oauthTestEnv) CreateOAuthClient(client *mysql.OAuthClient) error {
+	env.clients[client.ClientID] = client
+	return nil
+}
+
+func (env *oauthTestEnv) GetOAuthClient(clientID string) (*mysql.OAuthClient, error) {
+	if c, ok := env.clients[clientID]; ok {
+		return c, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *oauthTestEnv) GetOAuthConsent(userID int64, clientID string) (*mysql.OAuthConsent, error) {
+	return env.consents[clientID], nil
+}
+
+func (env *oauthTestEnv) SaveOAuthConsent(consent *mysql.OAuthConsent) error {
+	consent.UpdatedAt = time.Now()
+	env.consents[consent.ClientID] = consent
+	return nil
+}
+
+func (env *oauthTestEnv) ListOAuthConsents(userID int64) ([]*mysql.OAuthConsent, error) {
+	var list []*mysql.OAuthConsent
+	for _, c := range env.consents {
+		list = append(list, c)
+	}
+	return list, nil
+}
+
+func (env *oauthTestEnv) DeleteOAuthConsent(userID int64, clientID string) error {
+	delete(env.consents, clientID)
+	return nil
+}
+
+func (env *oauthTestEnv) RevokeClientTokens(userID int64, clientID string) ([]*mysql.Token, error) {
+	var revoked []*mysql.Token
+	now := time.Now()
+	for _, tk := range env.tokens {
+		if tk.UserID == userID && tk.ClientID == clientID && tk.RevokedAt == nil {
+			tk.RevokedAt = &now
+			revoked = append(revoked, tk)
+		}
+	}
+	return revoked, nil
+}
+
+func (env *oauthTestEnv) CreateToken(token *mysql.Token) error {
+	token.ID = int64(len(env.tokens) + 1)
+	env.tokens = append(env.tokens, token)
+	return nil
+}
+
+func (env *oauthTestEnv) GetTokenByRefreshToken(refreshToken string) (*mysql.Token, error) {
+	for _, tk := range env.tokens {
+		if refreshToken != "" && tk.RefreshToken == refreshToken {
+			return tk, nil
+		}
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *oauthTestEnv) MarkRefreshTokenUsed(id int64, familyID string) (bool, error) {
+	now := time.Now()
+	env.tokens[id-1].UsedAt = &now
+	return true, nil
+}
+
+// registerClient 注册测试应用
+func (env *oauthTestEnv) registerClient(t *testing.T, req *RegisterClientRequest) *RegisterClientResponse {
+	resp, err := env.svc.RegisterClient(context.Background(), env.token, req)
+	require.NoError(t, err)
+	return resp
+}
+
+// pkce 生成 PKCE 校验码和挑战值
+func pkce() (verifier, challenge string) {
+	verifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// authorizeCode 完成授权确认并返回授权码
+func (env *oauthTestEnv) authorizeCode(t *testing.T, clientID, scope, challenge string) string {
+	ctx := context.Background()
+	req := &AuthorizeRequest{
+		ResponseType:        ResponseTypeCode,
+		ClientID:            clientID,
+		RedirectURI:         testRedirectURI,
+		Scope:               scope,
+		State:               "xyz",
+		Nonce:               "n-0S6_WzA2Mj",
+		CodeChallenge:       challenge,
+		CodeChallengeMethod: CodeChallengeMethodS256,
+	}
+
+	resp, err := env.svc.Authorize(ctx, env.token, req)
+	require.NoError(t, err)
+	if resp.ConsentRequired {
+		resp, err = env.svc.Consent(ctx, env.token, req, true)
+		require.NoError(t, err)
+	}
+
+	u, err := url.Parse(resp.RedirectTo)
+	require.NoError(t, err)
+	assert.Equal(t, "xyz", u.Query().Get("state"))
+	require.NotEmpty(t, u.Query().Get("code"), resp.RedirectTo)
+	return u.Query().Get("code")
+}
+
+func TestOAuth_RegisterClient(t *testing.T) {
+	env := newOAuthTestEnv(t)
+	ctx := context.Background()
+
+	confidential := env.registerClient(t, &RegisterClientRequest{
+		ClientName:   "Live Tool",
+		RedirectURIs: []string{testRedirectURI},
+		Scope:        "openid profile order:read",
+	})
+	assert.NotEmpty(t, confidential.ClientID)
+	assert.NotEmpty(t, confidential.ClientSecret)
+	assert.NotEqual(t, confidential.ClientSecret, env.clients[confidential.ClientID].ClientSecretHash)
+	assert.Equal(t, int64(1), env.clients[confidential.ClientID].OwnerID)
+
+	public := env.registerClient(t, &RegisterClientRequest{
+		ClientName:              "Mobile App",
+		RedirectURIs:            []string{"http://localhost:8080/cb"},
+		TokenEndpointAuthMethod: AuthMethodNone,
+	})
+	assert.Empty(t, public.ClientSecret)
+
+	tests := []struct {
+		name string
+		req  *RegisterClientRequest
+	}{
+		{"missing_name", &RegisterClientRequest{RedirectURIs: []string{testRedirectURI}}},
+		{"http_redirect", &RegisterClientRequest{ClientName: "x", RedirectURIs: []string{"http://partner.example.com/cb"}}},
+		{"fragment_redirect", &RegisterClientRequest{ClientName: "x", RedirectURIs: []string{testRedirectURI + "#a"}}},
+		{"unknown_scope", &RegisterClientRequest{ClientName: "x", RedirectURIs: []string{testRedirectURI}, Scope: "admin"}},
+		{"public_client_credentials", &RegisterClientRequest{ClientName: "x", GrantTypes: []string{GrantTypeClientCredentials}, TokenEndpointAuthMethod: AuthMethodNone}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := env.svc.RegisterClient(ctx, env.token, tt.req)
+			var oauthErr *OAuthError
+			assert.ErrorAs(t, err, &oauthErr)
+		})
+	}
+
+	_, err := env.svc.RegisterClient(ctx, "", &RegisterClientRequest{ClientName: "x", RedirectURIs: []string{testRedirectURI}})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestOAuth_AuthorizationCodeFlow(t *testing.T) {
+	env := newOAuthTestEnv(t)
+	ctx := context.Background()
+	client := env.registerClient(t, &RegisterClientRequest{
+		ClientName:   "Live Tool",
+		RedirectURIs: []string{testRedirectURI},
+		Scope:        "openid profile email offline_access order:read",
+	})
+	verifier, challenge := pkce()
+
+	code := env.authorizeCode(t, client.ClientID, "openid profile email offline_access order:read", challenge)
+	require.Contains(t, env.consents, client.ClientID)
+
+	// 错误的 PKCE 校验码，授权码同时被消耗
+	_, err := env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeAuthorizationCode, Code: code, RedirectURI: testRedirectURI,
+		CodeVerifier: "wrong-verifier", ClientID: client.ClientID, ClientSecret: client.ClientSecret,
+	})
+	assert.Equal(t, "invalid_grant", err.(*OAuthError).Code)
+
+	// 已授权的应用再次请求时无需确认
+	code = env.authorizeCode(t, client.ClientID, "openid profile", challenge)
+	code2 := env.authorizeCode(t, client.ClientID, "openid profile email offline_access order:read", challenge)
+
+	// 错误的客户端密钥
+	_, err = env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeAuthorizationCode, Code: code, RedirectURI: testRedirectURI,
+		CodeVerifier: verifier, ClientID: client.ClientID, ClientSecret: "wrong",
+	})
+	assert.Equal(t, "invalid_client", err.(*OAuthError).Code)
+
+	resp, err := env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeAuthorizationCode, Code: code2, RedirectURI: testRedirectURI,
+		CodeVerifier: verifier, ClientID: client.ClientID, ClientSecret: client.ClientSecret,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer", resp.TokenType)
+	assert.NotEmpty(t, resp.RefreshToken)
+	assert.Equal(t, "openid profile email offline_access order:read", resp.Scope)
+
+	// 访问令牌携带 client_id 和 scope
+	claims, err := jwt.Parse(resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, client.ClientID, claims.ClientID)
+	assert.Equal(t, resp.Scope, claims.Scope)
+	assert.Equal(t, client.ClientID, env.tokens[len(env.tokens)-1].ClientID)
+
+	idToken, err := jwt.ParseIDToken(resp.IDToken, client.ClientID)
+	require.NoError(t, err)
+	assert.Equal(t, "1", idToken.Subject)
+	assert.Equal(t, "n-0S6_WzA2Mj", idToken.Nonce)
+	assert.Equal(t, "testuser", idToken.PreferredUsername)
+	assert.Equal(t, "test@example.com", idToken.Email)
+	assert.Empty(t, idToken.PhoneNumber)
+
+	// 授权码只能使用一次
+	_, err = env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeAuthorizationCode, Code: code2, RedirectURI: testRedirectURI,
+		CodeVerifier: verifier, ClientID: client.ClientID, ClientSecret: client.ClientSecret,
+	})
+	assert.Equal(t, "invalid_grant", err.(*OAuthError).Code)
+
+	info, err := env.svc.UserInfo(ctx, resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, &UserInfo{Sub: "1", PreferredUsername: "testuser", Email: "test@example.com"}, info)
+
+	// 第一方令牌没有 openid scope
+	_, err = env.svc.UserInfo(ctx, env.token)
+	assert.Equal(t, "insufficient_scope", err.(*OAuthError).Code)
+
+	// ValidateToken 返回授权范围供资源服务校验
+	validated, err := env.svc.(*oauthService).ValidateToken(ctx, &auth.ValidateTokenRequest{Token: resp.AccessToken})
+	require.NoError(t, err)
+	assert.Equal(t, client.ClientID, validated.Data.ClientId)
+	assert.Equal(t, resp.Scope, validated.Data.Scope)
+
+	// 刷新令牌只能由签发它的应用使用
+	_, err = env.svc.(*oauthService).RefreshToken(ctx, &auth.RefreshTokenRequest{RefreshToken: resp.RefreshToken})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	refreshed, err := env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeRefreshToken, RefreshToken: resp.RefreshToken,
+		ClientID: client.ClientID, ClientSecret: client.ClientSecret,
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, resp.RefreshToken, refreshed.RefreshToken)
+	assert.Equal(t, resp.Scope, refreshed.Scope)
+}
+
+func TestOAuth_AuthorizeErrors(t *testing.T) {
+	env := newOAuthTestEnv(t)
+	ctx := context.Background()
+	client := env.registerClient(t, &RegisterClientRequest{
+		ClientName:   "Live Tool",
+		RedirectURIs: []string{testRedirectURI},
+		Scope:        "openid profile",
+	})
+	_, challenge := pkce()
+
+	valid := func() *AuthorizeRequest {
+		return &AuthorizeRequest{
+			ResponseType:        ResponseTypeCode,
+			ClientID:            client.ClientID,
+			RedirectURI:         testRedirectURI,
+			Scope:               "openid",
+			State:               "xyz",
+			CodeChallenge:       challenge,
+			CodeChallengeMethod: CodeChallengeMethodS256,
+		}
+	}
+
+	// 回调地址未注册时不能跳转
+	req := valid()
+	req.RedirectURI = "https://evil.example.com/cb"
+	_, err := env.svc.Authorize(ctx, env.token, req)
+	assert.Equal(t, "invalid_request", err.(*OAuthError).Code)
+
+	req = valid()
+	req.ClientID = "unknown"
+	_, err = env.svc.Authorize(ctx, env.token, req)
+	assert.Equal(t, "invalid_client", err.(*OAuthError).Code)
+
+	// 其他错误通过回调地址返回
+	redirectErrors := []struct {
+		name   string
+		modify func(r *AuthorizeRequest)
+		token  string
+		want   string
+	}{
+		{"missing_pkce", func(r *AuthorizeRequest) { r.CodeChallenge = "" }, env.token, "invalid_request"},
+		{"plain_pkce", func(r *AuthorizeRequest) { r.CodeChallengeMethod = "plain" }, env.token, "invalid_request"},
+		{"scope_not_allowed", func(r *AuthorizeRequest) { r.Scope = "openid order:write" }, env.token, "invalid_scope"},
+		{"response_type", func(r *AuthorizeRequest) { r.ResponseType = "token" }, env.token, "unsupported_response_type"},
+		{"prompt_none_without_login", func(r *AuthorizeRequest) { r.Prompt = "none" }, "", "login_required"},
+		{"prompt_none_without_consent", func(r *AuthorizeRequest) { r.Prompt = "none" }, env.token, "consent_required"},
+	}
+	for _, tt := range redirectErrors {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			resp, err := env.svc.Authorize(ctx, tt.token, req)
+			require.NoError(t, err)
+			u, err := url.Parse(resp.RedirectTo)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, u.Query().Get("error"))
+			assert.Equal(t, "xyz", u.Query().Get("state"))
+		})
+	}
+
+	_, err = env.svc.Authorize(ctx, "", valid())
+	assert.Equal(t, "login_required", err.(*OAuthError).Code)
+
+	resp, err := env.svc.Consent(ctx, env.token, valid(), false)
+	require.NoError(t, err)
+	assert.Contains(t, resp.RedirectTo, "error=access_denied")
+	assert.NotContains(t, env.consents, client.ClientID)
+}
+
+func TestOAuth_ClientCredentials(t *testing.T) {
+	env := newOAuthTestEnv(t)
+	ctx := context.Background()
+	client := env.registerClient(t, &RegisterClientRequest{
+		ClientName: "ERP",
+		GrantTypes: []string{GrantTypeClientCredentials},
+		Scope:      "product:read order:read",
+	})
+
+	resp, err := env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeClientCredentials, ClientID: client.ClientID, ClientSecret: client.ClientSecret,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.RefreshToken)
+	assert.Empty(t, resp.IDToken)
+	assert.Equal(t, "product:read order:read", resp.Scope)
+
+	claims, err := jwt.Parse(resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), claims.UserID)
+	assert.Equal(t, client.ClientID, claims.Subject)
+
+	// 应用令牌不代表任何用户
+	verified, err := env.svc.(*oauthService).VerifyToken(ctx, &auth.VerifyTokenReq{Token: resp.AccessToken})
+	require.NoError(t, err)
+	assert.False(t, verified.Valid)
+
+	_, err = env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeClientCredentials, Scope: "openid", ClientID: client.ClientID, ClientSecret: client.ClientSecret,
+	})
+	assert.Equal(t, "invalid_scope", err.(*OAuthError).Code)
+
+	_, err = env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeAuthorizationCode, ClientID: client.ClientID, ClientSecret: client.ClientSecret,
+	})
+	assert.Equal(t, "unauthorized_client", err.(*OAuthError).Code)
+
+	_, err = env.svc.Token(ctx, &TokenRequest{
+		GrantType: "password", ClientID: client.ClientID, ClientSecret: client.ClientSecret,
+	})
+	assert.Equal(t, "unsupported_grant_type", err.(*OAuthError).Code)
+}
+
+func TestOAuth_RevokeConsent(t *testing.T) {
+	env := newOAuthTestEnv(t)
+	ctx := context.Background()
+	client := env.registerClient(t, &RegisterClientRequest{
+		ClientName:              "Mobile App",
+		RedirectURIs:            []string{testRedirectURI},
+		Scope:                   "openid offline_access",
+		TokenEndpointAuthMethod: AuthMethodNone,
+	})
+	verifier, challenge := pkce()
+
+	code := env.authorizeCode(t, client.ClientID, "openid offline_access", challenge)
+	resp, err := env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeAuthorizationCode, Code: code, RedirectURI: testRedirectURI,
+		CodeVerifier: verifier, ClientID: client.ClientID,
+	})
+	require.NoError(t, err)
+
+	consents, err := env.svc.ListConsents(ctx, env.token)
+	require.NoError(t, err)
+	require.Len(t, consents, 1)
+	assert.Equal(t, "Mobile App", consents[0].ClientName)
+
+	// 第三方应用令牌不能管理授权
+	_, err = env.svc.ListConsents(ctx, resp.AccessToken)
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	require.NoError(t, env.svc.RevokeConsent(ctx, env.token, client.ClientID))
+	assert.Empty(t, env.consents)
+
+	// 撤销后访问令牌和刷新令牌立即失效
+	_, err = env.svc.UserInfo(ctx, resp.AccessToken)
+	assert.Equal(t, "invalid_token", err.(*OAuthError).Code)
+	_, err = env.svc.Token(ctx, &TokenRequest{
+		GrantType: GrantTypeRefreshToken, RefreshToken: resp.RefreshToken, ClientID: client.ClientID,
+	})
+	assert.Equal(t, "invalid_grant", err.(*OAuthError).Code)
+}
+
+func TestNewDiscoveryDocument(t *testing.T) {
+	doc := NewDiscoveryDocument("https://auth.example.com/")
+	assert.Equal(t, jwt.Issuer(), doc.Issuer)
+	assert.Equal(t, "https://auth.example.com/oauth/token", doc.TokenEndpoint)
+	assert.Equal(t, "https://auth.example.com/.well-known/jwks.json", doc.JWKSURI)
+	assert.Equal(t, []string{CodeChallengeMethodS256}, doc.CodeChallengeMethodsSupported)
+	assert.NotEmpty(t, doc.IDTokenSigningAlgValuesSupported)
+}
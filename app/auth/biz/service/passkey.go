@@ -0,0 +1,408 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/webauthn"
+)
+
+const (
+	DefaultPasskeyName   = "Passkey"
+	MaxPasskeysPerUser   = 10
+	maxPasskeyNameLength = 64
+	passkeyHandleLength  = 32
+
+	passkeyCeremonyRegister = "register"
+	passkeyCeremonyLogin    = "login"
+)
+
+// PasskeyService 定义通行密钥（WebAuthn）注册和无密码登录接口
+type PasskeyService interface {
+	// BeginRegistration 为当前用户生成注册参数
+	BeginRegistration(ctx context.Context, accessToken string) (*PasskeyOptions, error)
+	// FinishRegistration 校验认证器的注册响应并保存凭据
+	FinishRegistration(ctx context.Context, accessToken string, req *FinishPasskeyRegistrationRequest) (*PasskeyInfo, error)
+	// BeginLogin 生成登录参数，username 为空时由认证器选择可发现凭据
+	BeginLogin(ctx context.Context, username string) (*PasskeyOptions, error)
+	// FinishLogin 校验认证器签名后登录，结果与密码登录相同
+	FinishLogin(ctx context.Context, req *FinishPasskeyLoginRequest) (*auth.LoginResponse, error)
+	// ListPasskeys 查询当前用户的通行密钥
+	ListPasskeys(ctx context.Context, accessToken string) ([]*PasskeyInfo, error)
+	// DeletePasskey 删除当前用户的通行密钥
+	DeletePasskey(ctx context.Context, accessToken string, id int64) error
+}
+
+// PasskeyOptions 仪式参数，PublicKey 原样传给 navigator.credentials.create() / get()
+type PasskeyOptions struct {
+	SessionID string      `json:"session_id"`
+	PublicKey interface{} `json:"publicKey"`
+}
+
+// FinishPasskeyRegistrationRequest 完成注册请求
+type FinishPasskeyRegistrationRequest struct {
+	SessionID  string                         `json:"session_id"`
+	Name       string                         `json:"name"`
+	Credential *webauthn.RegistrationResponse `json:"credential"`
+}
+
+// FinishPasskeyLoginRequest 完成登录请求
+type FinishPasskeyLoginRequest struct {
+	SessionID  string                      `json:"session_id"`
+	Credential *webauthn.AssertionResponse `json:"credential"`
+}
+
+// PasskeyInfo 通行密钥信息
+type PasskeyInfo struct {
+	ID         int64    `json:"id"`
+	Name       string   `json:"name"`
+	Transports []string `json:"transports"`
+	CreatedAt  int64    `json:"created_at"`
+	LastUsedAt int64    `json:"last_used_at,omitempty"`
+}
+
+// passkeySession 仪式开始时保存的挑战，登录时 UserID 为 0 表示使用可发现凭据
+type passkeySession struct {
+	Ceremony   string `json:"ceremony"`
+	Challenge  []byte `json:"challenge"`
+	UserID     int64  `json:"user_id,omitempty"`
+	UserHandle []byte `json:"user_handle,omitempty"`
+}
+
+type passkeyService struct {
+	*authService
+	rp *webauthn.RelyingParty
+}
+
+// NewPasskeyService 创建通行密钥服务
+func NewPasskeyService(repo AuthRepository, rp *webauthn.RelyingParty) PasskeyService {
+	return &passkeyService{authService: &authService{repo: repo}, rp: rp}
+}
+
+// BeginRegistration 生成注册参数，已注册的凭据放入排除列表
+func (s *passkeyService) BeginRegistration(ctx context.Context, accessToken string) (*PasskeyOptions, error) {
+	claims, err := s.parseSessionToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repo.GetUserByID(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := s.repo.ListWebAuthnCredentials(user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list passkeys failed")
+	}
+	if len(creds) >= MaxPasskeysPerUser {
+		return nil, auth.ErrPasskeyLimitExceeded
+	}
+
+	// 同一用户的凭据共用一个句柄，认证器据此覆盖同一账号的旧凭据
+	var handle []byte
+	if len(creds) > 0 {
+		handle = creds[0].UserHandle
+	} else {
+		handle = make([]byte, passkeyHandleLength)
+		if _, err := rand.Read(handle); err != nil {
+			return nil, errors.Wrap(err, "generate user handle failed")
+		}
+	}
+
+	options, challenge, err := s.rp.BeginRegistration(webauthn.User{
+		Handle:      handle,
+		Name:        user.Username,
+		DisplayName: user.Username,
+	}, credentialDescriptors(creds))
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := s.saveSession(ctx, &passkeySession{
+		Ceremony:   passkeyCeremonyRegister,
+		Challenge:  challenge,
+		UserID:     user.ID,
+		UserHandle: handle,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &PasskeyOptions{SessionID: sessionID, PublicKey: options}, nil
+}
+
+// FinishRegistration 校验注册响应，会话必须由当前用户发起
+func (s *passkeyService) FinishRegistration(ctx context.Context, accessToken string, req *FinishPasskeyRegistrationRequest) (info *PasskeyInfo, err error) {
+	event := newAuditEvent(ctx, AuditPasskeyRegister, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	claims, err := s.parseSessionToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID, event.Username = claims.UserID, claims.Username
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = DefaultPasskeyName
+	}
+	if len(name) > maxPasskeyNameLength {
+		return nil, fmt.Errorf("%w: name must be at most %d characters", auth.ErrInvalidArgument, maxPasskeyNameLength)
+	}
+
+	sess, err := s.takeSession(ctx, req.SessionID, passkeyCeremonyRegister)
+	if err != nil {
+		return nil, err
+	}
+	if sess.UserID != claims.UserID {
+		return nil, auth.ErrInvalidLoginState
+	}
+
+	cred, err := s.rp.FinishRegistration(sess.Challenge, req.Credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", auth.ErrPasskeyVerification, err)
+	}
+	existing, err := s.repo.GetWebAuthnCredential(cred.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get passkey failed")
+	}
+	if existing != nil {
+		return nil, auth.ErrPasskeyAlreadyRegistered
+	}
+
+	record := &mysql.WebAuthnCredential{
+		UserID:       claims.UserID,
+		CredentialID: cred.ID,
+		UserHandle:   sess.UserHandle,
+		PublicKey:    cred.PublicKey,
+		SignCount:    cred.SignCount,
+		AAGUID:       cred.AAGUID,
+		Transports:   strings.Join(cred.Transports, ","),
+		Name:         name,
+		CreatedAt:    time.Now(),
+	}
+	if err := s.repo.CreateWebAuthnCredential(record); err != nil {
+		return nil, errors.Wrap(err, "create passkey failed")
+	}
+	return newPasskeyInfo(record), nil
+}
+
+// BeginLogin 生成登录参数，指定用户名时只允许该用户的凭据
+func (s *passkeyService) BeginLogin(ctx context.Context, username string) (*PasskeyOptions, error) {
+	sess := &passkeySession{Ceremony: passkeyCeremonyLogin}
+	var allow []webauthn.CredentialDescriptor
+	if username = strings.TrimSpace(username); username != "" {
+		user, err := s.repo.GetUserByUsername(username)
+		if err != nil && err != mysql.ErrRecordNotFound {
+			return nil, err
+		}
+		// 用户不存在或没有通行密钥时与可发现凭据登录相同，避免暴露用户名是否存在
+		if user != nil {
+			creds, err := s.repo.ListWebAuthnCredentials(user.ID)
+			if err != nil {
+				return nil, errors.Wrap(err, "list passkeys failed")
+			}
+			if len(creds) > 0 {
+				sess.UserID = user.ID
+				allow = credentialDescriptors(creds)
+			}
+		}
+	}
+
+	options, challenge, err := s.rp.BeginLogin(allow)
+	if err != nil {
+		return nil, err
+	}
+	sess.Challenge = challenge
+	sessionID, err := s.saveSession(ctx, sess)
+	if err != nil {
+		return nil, err
+	}
+	return &PasskeyOptions{SessionID: sessionID, PublicKey: options}, nil
+}
+
+// FinishLogin 校验签名和签名计数器后登录，开启两步验证的用户未完成用户验证时仍需输入验证码
+func (s *passkeyService) FinishLogin(ctx context.Context, req *FinishPasskeyLoginRequest) (resp *auth.LoginResponse, err error) {
+	event := newAuditEvent(ctx, AuditLoginPasskey, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	sess, err := s.takeSession(ctx, req.SessionID, passkeyCeremonyLogin)
+	if err != nil {
+		return nil, err
+	}
+	if req.Credential == nil {
+		return nil, auth.ErrPasskeyVerification
+	}
+	credentialID, err := webauthn.DecodeID(req.Credential.RawID)
+	if err != nil || len(credentialID) == 0 {
+		return nil, auth.ErrPasskeyVerification
+	}
+	record, err := s.repo.GetWebAuthnCredential(credentialID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get passkey failed")
+	}
+	if record == nil || (sess.UserID != 0 && record.UserID != sess.UserID) {
+		return nil, auth.ErrPasskeyVerification
+	}
+	if req.Credential.Response.UserHandle != "" {
+		handle, err := webauthn.DecodeID(req.Credential.Response.UserHandle)
+		if err != nil || !bytes.Equal(handle, record.UserHandle) {
+			return nil, auth.ErrPasskeyVerification
+		}
+	}
+
+	user, err := s.repo.GetUserByID(record.UserID)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID, event.Username = user.ID, user.Username
+
+	assertion, err := s.rp.FinishLogin(sess.Challenge, &webauthn.Credential{
+		ID:        record.CredentialID,
+		PublicKey: record.PublicKey,
+		SignCount: record.SignCount,
+	}, req.Credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", auth.ErrPasskeyVerification, err)
+	}
+	if err := s.repo.UpdateWebAuthnCredentialUsage(record.ID, assertion.SignCount, time.Now()); err != nil {
+		return nil, errors.Wrap(err, "update passkey usage failed")
+	}
+
+	if err := checkUserActive(user); err != nil {
+		return nil, err
+	}
+	if err := checkLoginVerified(user); err != nil {
+		return nil, err
+	}
+	// 完成用户验证的通行密钥本身即为多因素认证
+	if user.TwoFactorEnabled && !assertion.UserVerified {
+		event.Type = AuditLoginChallenge
+		return s.createLoginChallenge(ctx, user)
+	}
+
+	accessToken, refreshToken, err := s.createAndCacheTokens(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.LoginResponse{
+		Base: &auth.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		Data: &auth.LoginData{
+			Token:        accessToken,
+			RefreshToken: refreshToken,
+		},
+	}, nil
+}
+
+// ListPasskeys 查询当前用户的通行密钥
+func (s *passkeyService) ListPasskeys(ctx context.Context, accessToken string) ([]*PasskeyInfo, error) {
+	claims, err := s.parseSessionToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := s.repo.ListWebAuthnCredentials(claims.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list passkeys failed")
+	}
+	infos := make([]*PasskeyInfo, 0, len(creds))
+	for _, c := range creds {
+		infos = append(infos, newPasskeyInfo(c))
+	}
+	return infos, nil
+}
+
+// DeletePasskey 删除当前用户的通行密钥
+func (s *passkeyService) DeletePasskey(ctx context.Context, accessToken string, id int64) (err error) {
+	event := newAuditEvent(ctx, AuditPasskeyDelete, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	claims, err := s.parseSessionToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+	event.UserID, event.Username = claims.UserID, claims.Username
+
+	deleted, err := s.repo.DeleteWebAuthnCredential(claims.UserID, id)
+	if err != nil {
+		return errors.Wrap(err, "delete passkey failed")
+	}
+	if !deleted {
+		return auth.ErrPasskeyNotFound
+	}
+	return nil
+}
+
+// saveSession 保存仪式会话，有效期与仪式超时时间相同
+func (s *passkeyService) saveSession(ctx context.Context, sess *passkeySession) (string, error) {
+	sessionID, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(sess)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal passkey session failed")
+	}
+	if err := redis.SaveWebAuthnSession(ctx, sessionID, string(payload), s.rp.Timeout()); err != nil {
+		return "", errors.Wrap(err, "save passkey session failed")
+	}
+	return sessionID, nil
+}
+
+// takeSession 读取并删除仪式会话，挑战只能使用一次
+func (s *passkeyService) takeSession(ctx context.Context, sessionID, ceremony string) (*passkeySession, error) {
+	if sessionID == "" {
+		return nil, auth.ErrInvalidLoginState
+	}
+	payload, err := redis.TakeWebAuthnSession(ctx, sessionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get passkey session failed")
+	}
+	var sess passkeySession
+	if payload == "" || json.Unmarshal([]byte(payload), &sess) != nil || sess.Ceremony != ceremony {
+		return nil, auth.ErrInvalidLoginState
+	}
+	return &sess, nil
+}
+
+// credentialDescriptors 将已保存的凭据转换为排除列表或允许列表
+func credentialDescriptors(creds []*mysql.WebAuthnCredential) []webauthn.CredentialDescriptor {
+	descriptors := make([]webauthn.CredentialDescriptor, 0, len(creds))
+	for _, c := range creds {
+		descriptors = append(descriptors, webauthn.CredentialDescriptor{
+			Type:       "public-key",
+			ID:         webauthn.EncodeID(c.CredentialID),
+			Transports: splitTransports(c.Transports),
+		})
+	}
+	return descriptors
+}
+
+func splitTransports(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
+}
+
+func newPasskeyInfo(c *mysql.WebAuthnCredential) *PasskeyInfo {
+	info := &PasskeyInfo{
+		ID:         c.ID,
+		Name:       c.Name,
+		Transports: splitTransports(c.Transports),
+		CreatedAt:  c.CreatedAt.Unix(),
+	}
+	if c.LastUsedAt != nil {
+		info.LastUsedAt = c.LastUsedAt.Unix()
+	}
+	return info
+}
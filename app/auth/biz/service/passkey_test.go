@@ -0,0 +1,301 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+	"TikTokMall/app/auth/pkg/webauthn"
+	"TikTokMall/app/auth/pkg/webauthn/webauthntest"
+)
+
+const passkeyTestOrigin = "https://mall.example.com"
+
+// passkeyTestEnv 带状态的通行密钥测试环境，用户、凭据和令牌保存在内存中
+type passkeyTestEnv struct {
+	*servicemock.MockAuthRepository
+	svc    PasskeyService
+	users  map[int64]*mysql.User
+	creds  []*mysql.WebAuthnCredential
+	tokens []*mysql.Token
+}
+
+func newPasskeyTestEnv(t *testing.T) *passkeyTestEnv {
+	rp, err := webauthn.New(webauthn.Config{
+		RPID:    "mall.example.com",
+		Origins: []string{passkeyTestOrigin},
+	})
+	require.NoError(t, err)
+
+	env := &passkeyTestEnv{
+		MockAuthRepository: new(servicemock.MockAuthRepository),
+		users: map[int64]*mysql.User{
+			1: {ID: 1, Username: "fakeuser", Status: UserStatusNormal},
+			2: {ID: 2, Username: "shopper", Status: UserStatusNormal},
+		},
+	}
+	redis.Client = &mockRedis{}
+	env.svc = NewPasskeyService(env, rp)
+	return env
+}
+
+func (env *passkeyTestEnv) GetUserByID(id int64) (*mysql.User, error) {
+	if u, ok := env.users[id]; ok {
+		return u, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *passkeyTestEnv) GetUserByUsername(username string) (*mysql.User, error) {
+	for _, u := range env.users {
+		if u.Username == username {
+			return u, nil
+		}
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *passkeyTestEnv) CreateWebAuthnCredential(cred *mysql.WebAuthnCredential) error {
+	cred.ID = int64(len(env.creds) + 1)
+	env.creds = append(env.creds, cred)
+	return nil
+}
+
+func (env *passkeyTestEnv) GetWebAuthnCredential(credentialID []byte) (*mysql.WebAuthnCredential, error) {
+	for _, c := range env.creds {
+		if bytes.Equal(c.CredentialID, credentialID) {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (env *passkeyTestEnv) ListWebAuthnCredentials(userID int64) ([]*mysql.WebAuthnCredential, error) {
+	var creds []*mysql.WebAuthnCredential
+	for _, c := range env.creds {
+		if c.UserID == userID {
+			creds = append(creds, c)
+		}
+	}
+	return creds, nil
+}
+
+func (env *passkeyTestEnv) UpdateWebAuthnCredentialUsage(id int64, signCount uint32, usedAt time.Time) error {
+	for _, c := range env.creds {
+		if c.ID == id {
+			c.SignCount, c.LastUsedAt = signCount, &usedAt
+		}
+	}
+	return nil
+}
+
+func (env *passkeyTestEnv) DeleteWebAuthnCredential(userID, id int64) (bool, error) {
+	for i, c := range env.creds {
+		if c.ID == id && c.UserID == userID {
+			env.creds = append(env.creds[:i], env.creds[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (env *passkeyTestEnv) CreateToken(token *mysql.Token) error {
+	env.tokens = append(env.tokens, token)
+	return nil
+}
+
+// register 使用软件认证器为用户注册通行密钥
+func (env *passkeyTestEnv) register(t *testing.T, authenticator *webauthntest.Authenticator, accessToken string) *PasskeyInfo {
+	ctx := context.Background()
+	options, err := env.svc.BeginRegistration(ctx, accessToken)
+	require.NoError(t, err)
+	credential, err := authenticator.Create(options.PublicKey.(*webauthn.CreationOptions))
+	require.NoError(t, err)
+
+	info, err := env.svc.FinishRegistration(ctx, accessToken, &FinishPasskeyRegistrationRequest{
+		SessionID:  options.SessionID,
+		Name:       "laptop",
+		Credential: credential,
+	})
+	require.NoError(t, err)
+	return info
+}
+
+// assert 使用软件认证器生成登录响应
+func (env *passkeyTestEnv) assert(t *testing.T, authenticator *webauthntest.Authenticator, username string) *FinishPasskeyLoginRequest {
+	options, err := env.svc.BeginLogin(context.Background(), username)
+	require.NoError(t, err)
+	credential, err := authenticator.Get(options.PublicKey.(*webauthn.RequestOptions))
+	require.NoError(t, err)
+	return &FinishPasskeyLoginRequest{SessionID: options.SessionID, Credential: credential}
+}
+
+func TestPasskey_RegisterAndLogin(t *testing.T) {
+	env := newPasskeyTestEnv(t)
+	ctx := context.Background()
+	authenticator := webauthntest.NewAuthenticator(passkeyTestOrigin)
+	token := signTestToken(t, 2, "shopper", time.Minute)
+
+	info := env.register(t, authenticator, token)
+	assert.Equal(t, "laptop", info.Name)
+	assert.Equal(t, []string{"internal"}, info.Transports)
+	require.Len(t, env.creds, 1)
+	assert.Len(t, env.creds[0].UserHandle, passkeyHandleLength)
+
+	// 同一用户的凭据共用句柄，已注册的凭据在排除列表中
+	options, err := env.svc.BeginRegistration(ctx, token)
+	require.NoError(t, err)
+	creation := options.PublicKey.(*webauthn.CreationOptions)
+	assert.Equal(t, webauthn.EncodeID(env.creds[0].UserHandle), creation.User.ID)
+	require.Len(t, creation.ExcludeCredentials, 1)
+	_, err = authenticator.Create(creation)
+	assert.Error(t, err)
+
+	// 指定用户名和使用可发现凭据都能登录，签发的令牌属于凭据所属用户
+	for _, username := range []string{"shopper", ""} {
+		resp, err := env.svc.FinishLogin(ctx, env.assert(t, authenticator, username))
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Data.Token)
+		assert.NotEmpty(t, resp.Data.RefreshToken)
+		claims, err := jwt.Parse(resp.Data.Token)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), claims.UserID)
+	}
+	assert.Equal(t, uint32(2), env.creds[0].SignCount)
+	assert.NotNil(t, env.creds[0].LastUsedAt)
+	assert.Len(t, env.tokens, 2)
+
+	infos, err := env.svc.ListPasskeys(ctx, token)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	assert.NotZero(t, infos[0].LastUsedAt)
+}
+
+func TestPasskey_RegisterRejects(t *testing.T) {
+	env := newPasskeyTestEnv(t)
+	ctx := context.Background()
+	authenticator := webauthntest.NewAuthenticator(passkeyTestOrigin)
+	token := signTestToken(t, 2, "shopper", time.Minute)
+
+	_, err := env.svc.BeginRegistration(ctx, "invalid")
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	options, err := env.svc.BeginRegistration(ctx, token)
+	require.NoError(t, err)
+	credential, err := authenticator.Create(options.PublicKey.(*webauthn.CreationOptions))
+	require.NoError(t, err)
+
+	// 其他用户不能使用该会话
+	other := signTestToken(t, 1, "fakeuser", time.Minute)
+	_, err = env.svc.FinishRegistration(ctx, other, &FinishPasskeyRegistrationRequest{SessionID: options.SessionID, Credential: credential})
+	assert.ErrorIs(t, err, auth.ErrInvalidLoginState)
+
+	// 会话只能使用一次
+	_, err = env.svc.FinishRegistration(ctx, token, &FinishPasskeyRegistrationRequest{SessionID: options.SessionID, Credential: credential})
+	assert.ErrorIs(t, err, auth.ErrInvalidLoginState)
+	assert.Empty(t, env.creds)
+
+	// 来源不匹配
+	options, err = env.svc.BeginRegistration(ctx, token)
+	require.NoError(t, err)
+	phishing := webauthntest.NewAuthenticator("https://mall.example.com.evil.test")
+	credential, err = phishing.Create(options.PublicKey.(*webauthn.CreationOptions))
+	require.NoError(t, err)
+	_, err = env.svc.FinishRegistration(ctx, token, &FinishPasskeyRegistrationRequest{SessionID: options.SessionID, Credential: credential})
+	assert.ErrorIs(t, err, auth.ErrPasskeyVerification)
+	assert.Empty(t, env.creds)
+}
+
+func TestPasskey_LoginRejects(t *testing.T) {
+	env := newPasskeyTestEnv(t)
+	ctx := context.Background()
+	authenticator := webauthntest.NewAuthenticator(passkeyTestOrigin)
+	env.register(t, authenticator, signTestToken(t, 2, "shopper", time.Minute))
+
+	// 会话只能使用一次
+	req := env.assert(t, authenticator, "shopper")
+	_, err := env.svc.FinishLogin(ctx, req)
+	require.NoError(t, err)
+	_, err = env.svc.FinishLogin(ctx, req)
+	assert.ErrorIs(t, err, auth.ErrInvalidLoginState)
+
+	// 为其他用户发起的登录不能使用该凭据
+	other := webauthntest.NewAuthenticator(passkeyTestOrigin)
+	env.register(t, other, signTestToken(t, 1, "fakeuser", time.Minute))
+	options, err := env.svc.BeginLogin(ctx, "fakeuser")
+	require.NoError(t, err)
+	options.PublicKey.(*webauthn.RequestOptions).AllowCredentials = nil
+	credential, err := authenticator.Get(options.PublicKey.(*webauthn.RequestOptions))
+	require.NoError(t, err)
+	_, err = env.svc.FinishLogin(ctx, &FinishPasskeyLoginRequest{SessionID: options.SessionID, Credential: credential})
+	assert.ErrorIs(t, err, auth.ErrPasskeyVerification)
+
+	// 篡改用户句柄
+	req = env.assert(t, authenticator, "")
+	req.Credential.Response.UserHandle = webauthn.EncodeID([]byte("someone-else"))
+	_, err = env.svc.FinishLogin(ctx, req)
+	assert.ErrorIs(t, err, auth.ErrPasskeyVerification)
+
+	// 签名计数器回退说明认证器可能被克隆
+	env.creds[0].SignCount = 100
+	_, err = env.svc.FinishLogin(ctx, env.assert(t, authenticator, ""))
+	assert.ErrorIs(t, err, auth.ErrPasskeyVerification)
+	assert.Equal(t, uint32(100), env.creds[0].SignCount)
+}
+
+func TestPasskey_BannedAndTwoFactor(t *testing.T) {
+	env := newPasskeyTestEnv(t)
+	ctx := context.Background()
+	authenticator := webauthntest.NewAuthenticator(passkeyTestOrigin)
+	env.register(t, authenticator, signTestToken(t, 2, "shopper", time.Minute))
+
+	// 完成用户验证的通行密钥满足两步验证
+	env.users[2].TwoFactorEnabled = true
+	resp, err := env.svc.FinishLogin(ctx, env.assert(t, authenticator, ""))
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Data.Token)
+
+	// 未完成用户验证时仍需输入验证码
+	authenticator.UserVerified = false
+	resp, err = env.svc.FinishLogin(ctx, env.assert(t, authenticator, ""))
+	require.NoError(t, err)
+	assert.True(t, resp.Data.TwoFactorRequired)
+	assert.NotEmpty(t, resp.Data.ChallengeToken)
+	assert.Empty(t, resp.Data.Token)
+
+	env.users[2].Status = UserStatusBanned
+	_, err = env.svc.FinishLogin(ctx, env.assert(t, authenticator, ""))
+	assert.ErrorIs(t, err, auth.ErrUserBanned)
+}
+
+func TestPasskey_Delete(t *testing.T) {
+	env := newPasskeyTestEnv(t)
+	ctx := context.Background()
+	authenticator := webauthntest.NewAuthenticator(passkeyTestOrigin)
+	token := signTestToken(t, 2, "shopper", time.Minute)
+	info := env.register(t, authenticator, token)
+
+	// 不能删除其他用户的通行密钥
+	err := env.svc.DeletePasskey(ctx, signTestToken(t, 1, "fakeuser", time.Minute), info.ID)
+	assert.ErrorIs(t, err, auth.ErrPasskeyNotFound)
+
+	require.NoError(t, env.svc.DeletePasskey(ctx, token, info.ID))
+	assert.Empty(t, env.creds)
+
+	// 删除后凭据不能再登录
+	options, err := env.svc.BeginLogin(ctx, "")
+	require.NoError(t, err)
+	credential, err := authenticator.Get(options.PublicKey.(*webauthn.RequestOptions))
+	require.NoError(t, err)
+	_, err = env.svc.FinishLogin(ctx, &FinishPasskeyLoginRequest{SessionID: options.SessionID, Credential: credential})
+	assert.ErrorIs(t, err, auth.ErrPasskeyVerification)
+}
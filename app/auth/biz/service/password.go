@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/notify"
+	"TikTokMall/pkg/password"
+)
+
+const (
+	// 密码重置相关配置
+	PasswordResetExpiration = 30 * time.Minute // 重置令牌有效期
+)
+
+var (
+	// PasswordResetURL 前端重置密码页面地址，重置令牌通过 token 参数传递；为空时通知中只包含令牌
+	PasswordResetURL string
+	// PasswordPolicy 注册和修改密码时使用的密码策略，启动时根据配置替换
+	PasswordPolicy = password.Default()
+)
+
+// ForgotPassword 向账号绑定的邮箱或手机发送一次性重置令牌，账号不存在时同样返回成功，避免泄露注册信息
+// 发送频率按邮箱或手机号在查询账号之前检查；查询账号和发送在后台进行，响应时间和结果与账号是否存在无关
+func (s *authService) ForgotPassword(ctx context.Context, req *auth.ForgotPasswordRequest) (*auth.ForgotPasswordResponse, error) {
+	email := strings.TrimSpace(req.Email)
+	phone := strings.TrimSpace(req.Phone)
+	if email == "" && phone == "" {
+		return nil, fmt.Errorf("%w: email or phone is required", auth.ErrInvalidArgument)
+	}
+
+	msg := &notify.Message{Subject: "重置 TikTokMall 账号密码"}
+	channel, destination := VerificationChannelEmail, strings.ToLower(email)
+	if email != "" {
+		msg.Channel, msg.To = notify.ChannelEmail, email
+	} else {
+		msg.Channel, msg.To = notify.ChannelSMS, phone
+		channel, destination = VerificationChannelPhone, phone
+	}
+	if err := allowPasswordResetSend(ctx, channel, destination); err != nil {
+		return nil, err
+	}
+
+	tasks.Add(1)
+	go func() {
+		defer tasks.Done()
+		ctx := context.WithoutCancel(ctx)
+		if err := s.sendPasswordReset(ctx, msg); err != nil {
+			hlog.CtxErrorf(ctx, "send password reset to %s failed: %v", msg.Channel, err)
+		}
+	}()
+
+	return &auth.ForgotPasswordResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// allowPasswordResetSend 检查同一邮箱或手机号的重置通知发送频率，与验证码分别计数
+func allowPasswordResetSend(ctx context.Context, channel, destination string) error {
+	ok, err := redis.AllowVerificationSend(ctx, "pwreset:"+verificationTarget(channel, destination),
+		VerificationResendCooldown, VerificationSendLimit, VerificationSendWindow)
+	if err != nil {
+		return errors.Wrap(err, "check password reset send rate failed")
+	}
+	if !ok {
+		return auth.ErrTooManyAttempts
+	}
+	return nil
+}
+
+// sendPasswordReset 查询通知目标对应的账号，账号存在且状态正常时保存重置令牌并发送通知
+func (s *authService) sendPasswordReset(ctx context.Context, msg *notify.Message) error {
+	var user *mysql.User
+	var err error
+	if msg.Channel == notify.ChannelEmail {
+		user, err = s.repo.GetUserByEmail(msg.To)
+	} else {
+		user, err = s.repo.GetUserByPhone(msg.To)
+	}
+	if err == mysql.ErrRecordNotFound || (err == nil && checkUserActive(user) != nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return err
+	}
+	if err := redis.SavePasswordResetToken(ctx, resetTokenDigest(token), user.ID, PasswordResetExpiration); err != nil {
+		return errors.Wrap(err, "save password reset token failed")
+	}
+
+	msg.Body = passwordResetBody(token)
+	if err := notify.Send(ctx, msg); err != nil {
+		return errors.Wrap(err, "send password reset notification failed")
+	}
+	return nil
+}
+
+// ResetPassword 使用重置令牌设置新密码，并吊销用户的所有会话
+func (s *authService) ResetPassword(ctx context.Context, req *auth.ResetPasswordRequest) (resp *auth.ResetPasswordResponse, err error) {
+	event := newAuditEvent(ctx, AuditPasswordReset, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	// 先校验与用户无关的密码规则，避免明显不合格的密码消耗重置令牌
+	if err := checkPasswordPolicy(req.NewPassword, ""); err != nil {
+		return nil, err
+	}
+
+	userID, err := redis.TakePasswordResetToken(ctx, resetTokenDigest(req.ResetToken))
+	if err != nil {
+		return nil, errors.Wrap(err, "get password reset token failed")
+	}
+	if userID == 0 {
+		return nil, auth.ErrInvalidResetToken
+	}
+	event.UserID = userID
+
+	user, err := s.repo.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserActive(user); err != nil {
+		return nil, err
+	}
+
+	if err := s.setPassword(ctx, user, req.NewPassword); err != nil {
+		return nil, err
+	}
+	// 找回密码后解除用户名维度的登录锁定
+	s.resetLoginRetries(ctx, user.Username)
+
+	return &auth.ResetPasswordResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// ChangePassword 校验原密码后修改密码，并吊销用户的所有会话
+func (s *authService) ChangePassword(ctx context.Context, req *auth.ChangePasswordRequest) (resp *auth.ChangePasswordResponse, err error) {
+	event := newAuditEvent(ctx, AuditPasswordChange, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	claims, err := s.parseAccessToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID, event.Username = claims.UserID, claims.Username
+	// 第三方应用令牌不能修改密码
+	if claims.ClientID != "" {
+		return nil, auth.ErrInvalidToken
+	}
+	user, err := s.repo.GetUserByID(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if comparePassword(user.Password, req.OldPassword) != nil {
+		return nil, auth.ErrInvalidCredentials
+	}
+
+	if err := s.setPassword(ctx, user, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	return &auth.ChangePasswordResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// setPassword 校验密码策略和历史密码后保存新密码，并吊销用户的所有会话
+func (s *authService) setPassword(ctx context.Context, user *mysql.User, newPassword string) error {
+	if err := checkPasswordPolicy(newPassword, user.Username); err != nil {
+		return err
+	}
+	if err := s.checkPasswordHistory(user, newPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := hashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.UpdateUserPassword(user.ID, hashedPassword); err != nil {
+		return errors.Wrap(err, "update password failed")
+	}
+	user.Password = hashedPassword
+	s.recordPasswordHistory(ctx, user.ID, hashedPassword)
+	return s.revokeUserTokens(ctx, user.ID)
+}
+
+// revokeUserTokens 删除用户的所有令牌，并将其中的访问令牌加入黑名单
+func (s *authService) revokeUserTokens(ctx context.Context, userID int64) error {
+	tokens, err := s.repo.DeleteTokenByUserID(userID)
+	if err != nil {
+		return errors.Wrap(err, "delete user tokens failed")
+	}
+	blacklistTokens(ctx, tokens)
+	return nil
+}
+
+// blacklistTokens 清理已删除令牌的缓存，并将未过期的访问令牌加入黑名单
+func blacklistTokens(ctx context.Context, tokens []*mysql.Token) {
+	for _, t := range tokens {
+		if err := redis.DeleteToken(ctx, t.Token); err != nil {
+			hlog.CtxWarnf(ctx, "delete token from cache failed: %v", err)
+		}
+		if time.Now().Before(t.ExpiredAt) {
+			if err := redis.AddToBlacklist(ctx, t.Token, time.Until(t.ExpiredAt)); err != nil {
+				hlog.CtxWarnf(ctx, "add token to blacklist failed: %v", err)
+			}
+		}
+	}
+}
+
+// checkPasswordPolicy 校验密码强度，违规时返回的错误同时匹配 ErrInvalidArgument 和 password.ErrPolicyViolation
+func checkPasswordPolicy(pw, username string) error {
+	if err := PasswordPolicy.Check(pw, username); err != nil {
+		return fmt.Errorf("%w: %w", auth.ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+// checkPasswordHistory 新密码不能与当前密码和最近使用过的密码相同
+func (s *authService) checkPasswordHistory(user *mysql.User, pw string) error {
+	size := PasswordPolicy.HistorySize()
+	if size <= 0 {
+		return nil
+	}
+	hashes, err := s.repo.GetPasswordHistory(user.ID, size)
+	if err != nil {
+		return errors.Wrap(err, "get password history failed")
+	}
+	// 启用历史记录前注册的用户没有历史，当前密码同样不能重复使用
+	if user.Password != "" && (len(hashes) == 0 || hashes[0] != user.Password) {
+		hashes = append([]string{user.Password}, hashes...)
+	}
+	if err := PasswordPolicy.CheckHistory(pw, hashes); err != nil {
+		return fmt.Errorf("%w: %w", auth.ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+// recordPasswordHistory 记录用户的新密码哈希，失败不影响密码修改
+func (s *authService) recordPasswordHistory(ctx context.Context, userID int64, hashedPassword string) {
+	size := PasswordPolicy.HistorySize()
+	if size <= 0 {
+		return
+	}
+	if err := s.repo.AddPasswordHistory(userID, hashedPassword, size); err != nil {
+		hlog.CtxWarnf(ctx, "add password history failed: %v", err)
+	}
+}
+
+// resetTokenDigest 重置令牌只以摘要形式保存
+func resetTokenDigest(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// passwordResetBody 生成重置密码通知内容
+func passwordResetBody(token string) string {
+	minutes := int(PasswordResetExpiration / time.Minute)
+	if PasswordResetURL == "" {
+		return fmt.Sprintf("您的密码重置令牌为 %s，%d 分钟内有效。如非本人操作请忽略。", token, minutes)
+	}
+	link := PasswordResetURL + "?token=" + url.QueryEscape(token)
+	if strings.Contains(PasswordResetURL, "?") {
+		link = PasswordResetURL + "&token=" + url.QueryEscape(token)
+	}
+	return fmt.Sprintf("请在 %d 分钟内访问以下链接重置密码：\n%s\n如非本人操作请忽略。", minutes, link)
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/notify"
+	"TikTokMall/pkg/password"
+	"TikTokMall/pkg/tokenhash"
+)
+
+// recordingNotifier 记录发送的通知
+type recordingNotifier struct {
+	messages []*notify.Message
+}
+
+func (n *recordingNotifier) Send(ctx context.Context, msg *notify.Message) error {
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+// failingNotifier 发送总是失败的通知器
+type failingNotifier struct{}
+
+func (failingNotifier) Send(ctx context.Context, msg *notify.Message) error {
+	return errors.New("gateway unavailable")
+}
+
+// passwordTestEnv 带状态的密码测试环境，用户和令牌保存在内存中
+type passwordTestEnv struct {
+	*servicemock.MockAuthRepository
+	svc      AuthService
+	redis    *mockRedis
+	notifier *recordingNotifier
+	user     *mysql.User
+	tokens   []*mysql.Token
+	history  []string // 按时间倒序
+}
+
+func newPasswordTestEnv(t *testing.T) *passwordTestEnv {
+	hashed, err := hashPassword("oldpassword")
+	require.NoError(t, err)
+
+	env := &passwordTestEnv{
+		MockAuthRepository: new(servicemock.MockAuthRepository),
+		redis:              &mockRedis{},
+		notifier:           &recordingNotifier{},
+		user: &mysql.User{
+			ID:       1,
+			Username: "testuser",
+			Password: hashed,
+			Email:    "test@example.com",
+			Phone:    "13800138000",
+			Status:   UserStatusNormal,
+		},
+	}
+	redis.Client = env.redis
+	notify.Init(env.notifier)
+	t.Cleanup(func() { notify.Init(nil) })
+	env.svc = NewAuthService(env)
+	return env
+}
+
+func (env *passwordTestEnv) GetUserByID(id int64) (*mysql.User, error) {
+	if id == env.user.ID {
+		return env.user, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *passwordTestEnv) GetUserByEmail(email string) (*mysql.User, error) {
+	if email == env.user.Email {
+		return env.user, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *passwordTestEnv) GetUserByPhone(phone string) (*mysql.User, error) {
+	if phone == env.user.Phone {
+		return env.user, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *passwordTestEnv) UpdateUserPassword(userID int64, password string) error {
+	env.user.Password = password
+	return nil
+}
+
+func (env *passwordTestEnv) CreateToken(token *mysql.Token) error {
+	env.tokens = append(env.tokens, token)
+	return nil
+}
+
+func (env *passwordTestEnv) DeleteTokenByUserID(userID int64) ([]*mysql.Token, error) {
+	deleted := env.tokens
+	env.tokens = nil
+	return deleted, nil
+}
+
+func (env *passwordTestEnv) GetPasswordHistory(userID int64, limit int) ([]string, error) {
+	if len(env.history) > limit {
+		return env.history[:limit], nil
+	}
+	return env.history, nil
+}
+
+func (env *passwordTestEnv) AddPasswordHistory(userID int64, password string, keep int) error {
+	env.history = append([]string{password}, env.history...)
+	if len(env.history) > keep {
+		env.history = env.history[:keep]
+	}
+	return nil
+}
+
+// login 创建一个会话并返回访问令牌
+func (env *passwordTestEnv) login(t *testing.T) string {
+	token, _, err := env.svc.(*authService).createAndCacheTokens(context.Background(), env.user)
+	require.NoError(t, err)
+	return token
+}
+
+// forgotPassword 发起找回密码并等待后台发送完成
+func (env *passwordTestEnv) forgotPassword(req *auth.ForgotPasswordRequest) (*auth.ForgotPasswordResponse, error) {
+	resp, err := env.svc.ForgotPassword(context.Background(), req)
+	tasks.Wait()
+	return resp, err
+}
+
+func TestWaitTasks(t *testing.T) {
+	release := make(chan struct{})
+	tasks.Add(1)
+	go func() {
+		defer tasks.Done()
+		<-release
+	}()
+
+	// 任务未完成时超时返回
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, WaitTasks(ctx), context.DeadlineExceeded)
+
+	close(release)
+	assert.NoError(t, WaitTasks(context.Background()))
+}
+
+// resetTokenFrom 从通知内容中取出重置令牌
+func resetTokenFrom(t *testing.T, msg *notify.Message) string {
+	m := regexp.MustCompile(`token=([A-Za-z0-9_%-]+)|令牌为 (\S+)，`).FindStringSubmatch(msg.Body)
+	require.NotNil(t, m, msg.Body)
+	if m[1] != "" {
+		token, err := url.QueryUnescape(m[1])
+		require.NoError(t, err)
+		return token
+	}
+	return m[2]
+}
+
+func TestPassword_ForgotAndReset(t *testing.T) {
+	env := newPasswordTestEnv(t)
+	ctx := context.Background()
+	session := env.login(t)
+
+	_, err := env.forgotPassword(&auth.ForgotPasswordRequest{Email: "test@example.com"})
+	require.NoError(t, err)
+	require.Len(t, env.notifier.messages, 1)
+	msg := env.notifier.messages[0]
+	assert.Equal(t, notify.ChannelEmail, msg.Channel)
+	assert.Equal(t, "test@example.com", msg.To)
+	token := resetTokenFrom(t, msg)
+	// Redis 中只保存摘要
+	assert.NotContains(t, env.redis.resets, token)
+
+	// 新密码不合法时不消耗重置令牌
+	_, err = env.svc.ResetPassword(ctx, &auth.ResetPasswordRequest{ResetToken: token, NewPassword: "short"})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+
+	_, err = env.svc.ResetPassword(ctx, &auth.ResetPasswordRequest{ResetToken: token, NewPassword: "New-Pass-2024"})
+	require.NoError(t, err)
+	assert.NoError(t, comparePassword(env.user.Password, "New-Pass-2024"))
+	assert.Empty(t, env.tokens)
+	assert.True(t, env.redis.blacklist[tokenhash.Digest(session)])
+
+	// 重置令牌只能使用一次
+	_, err = env.svc.ResetPassword(ctx, &auth.ResetPasswordRequest{ResetToken: token, NewPassword: "Another-Pass-1"})
+	assert.ErrorIs(t, err, auth.ErrInvalidResetToken)
+}
+
+func TestPassword_ForgotBySMS(t *testing.T) {
+	env := newPasswordTestEnv(t)
+	ctx := context.Background()
+	PasswordResetURL = "https://mall.example.com/reset"
+	defer func() { PasswordResetURL = "" }()
+
+	_, err := env.forgotPassword(&auth.ForgotPasswordRequest{Phone: "13800138000"})
+	require.NoError(t, err)
+	require.Len(t, env.notifier.messages, 1)
+	msg := env.notifier.messages[0]
+	assert.Equal(t, notify.ChannelSMS, msg.Channel)
+	assert.Contains(t, msg.Body, "https://mall.example.com/reset?token=")
+
+	_, err = env.svc.ResetPassword(ctx, &auth.ResetPasswordRequest{ResetToken: resetTokenFrom(t, msg), NewPassword: "New-Pass-2024"})
+	assert.NoError(t, err)
+}
+
+func TestPassword_ForgotDoesNotRevealAccounts(t *testing.T) {
+	env := newPasswordTestEnv(t)
+
+	resp, err := env.forgotPassword(&auth.ForgotPasswordRequest{Email: "nobody@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Base.Message)
+
+	env.user.Status = UserStatusBanned
+	_, err = env.forgotPassword(&auth.ForgotPasswordRequest{Email: "test@example.com"})
+	require.NoError(t, err)
+	assert.Empty(t, env.notifier.messages)
+
+	_, err = env.forgotPassword(&auth.ForgotPasswordRequest{})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+}
+
+func TestPassword_ForgotRateLimit(t *testing.T) {
+	env := newPasswordTestEnv(t)
+
+	_, err := env.forgotPassword(&auth.ForgotPasswordRequest{Email: "test@example.com"})
+	require.NoError(t, err)
+	require.Len(t, env.notifier.messages, 1)
+
+	// 同一邮箱在冷却期内不再发送，大小写不同视为同一邮箱
+	_, err = env.forgotPassword(&auth.ForgotPasswordRequest{Email: "Test@Example.com"})
+	assert.ErrorIs(t, err, auth.ErrTooManyAttempts)
+	assert.Len(t, env.notifier.messages, 1)
+
+	// 未注册的邮箱同样限流，结果与已注册邮箱一致
+	_, err = env.forgotPassword(&auth.ForgotPasswordRequest{Email: "nobody@example.com"})
+	require.NoError(t, err)
+	_, err = env.forgotPassword(&auth.ForgotPasswordRequest{Email: "nobody@example.com"})
+	assert.ErrorIs(t, err, auth.ErrTooManyAttempts)
+}
+
+func TestPassword_ForgotSendFailure(t *testing.T) {
+	env := newPasswordTestEnv(t)
+	notify.Init(failingNotifier{})
+
+	// 发送失败只记录日志，不通过响应暴露账号存在
+	resp, err := env.forgotPassword(&auth.ForgotPasswordRequest{Email: "test@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Base.Message)
+}
+
+func TestPassword_Change(t *testing.T) {
+	env := newPasswordTestEnv(t)
+	ctx := context.Background()
+	session := env.login(t)
+	other := env.login(t)
+
+	_, err := env.svc.ChangePassword(ctx, &auth.ChangePasswordRequest{Token: session, OldPassword: "wrong", NewPassword: "New-Pass-2024"})
+	assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+	assert.Len(t, env.tokens, 2)
+
+	_, err = env.svc.ChangePassword(ctx, &auth.ChangePasswordRequest{Token: session, OldPassword: "oldpassword", NewPassword: "New-Pass-2024"})
+	require.NoError(t, err)
+	assert.NoError(t, comparePassword(env.user.Password, "New-Pass-2024"))
+
+	// 所有会话立即失效
+	for _, token := range []string{session, other} {
+		_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: token})
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	}
+
+	expired := signTestToken(t, 1, "testuser", -time.Minute)
+	_, err = env.svc.ChangePassword(ctx, &auth.ChangePasswordRequest{Token: expired, OldPassword: "New-Pass-2024", NewPassword: "Other-Pass-1"})
+	assert.ErrorIs(t, err, auth.ErrTokenExpired)
+}
+
+func TestPassword_Policy(t *testing.T) {
+	env := newPasswordTestEnv(t)
+	ctx := context.Background()
+	session := env.login(t)
+
+	_, err := env.svc.ChangePassword(ctx, &auth.ChangePasswordRequest{Token: session, OldPassword: "oldpassword", NewPassword: "testuser-2024"})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+	assert.ErrorIs(t, err, password.ErrPolicyViolation)
+	require.Len(t, password.Violations(err), 1)
+	assert.Equal(t, password.CodeContainsUsername, password.Violations(err)[0].Code)
+
+	_, err = env.svc.ChangePassword(ctx, &auth.ChangePasswordRequest{Token: session, OldPassword: "oldpassword", NewPassword: "qwerty123"})
+	require.Len(t, password.Violations(err), 1)
+	assert.Equal(t, password.CodeCommon, password.Violations(err)[0].Code)
+	// 违反策略时密码和会话不变
+	assert.NoError(t, comparePassword(env.user.Password, "oldpassword"))
+	assert.Len(t, env.tokens, 1)
+}
+
+func TestPassword_History(t *testing.T) {
+	env := newPasswordTestEnv(t)
+	ctx := context.Background()
+	change := func(oldPassword, newPassword string) error {
+		_, err := env.svc.ChangePassword(ctx, &auth.ChangePasswordRequest{Token: env.login(t), OldPassword: oldPassword, NewPassword: newPassword})
+		return err
+	}
+
+	require.NoError(t, change("oldpassword", "Spring-Pass-1"))
+	err := change("Spring-Pass-1", "Spring-Pass-1")
+	require.Len(t, password.Violations(err), 1)
+	assert.Equal(t, password.CodeReused, password.Violations(err)[0].Code)
+
+	passwords := []string{"Spring-Pass-1", "Summer-Pass-2", "Autumn-Pass-3", "Winter-Pass-4", "Frost-Pass-5", "Storm-Pass-6"}
+	for i := 1; i < len(passwords); i++ {
+		require.NoError(t, change(passwords[i-1], passwords[i]))
+	}
+	require.Len(t, env.history, PasswordPolicy.HistorySize())
+
+	// 最近 5 个密码不能重复使用，更早的密码可以
+	err = change("Storm-Pass-6", "Summer-Pass-2")
+	assert.ErrorIs(t, err, password.ErrPolicyViolation)
+	assert.NoError(t, change("Storm-Pass-6", "Spring-Pass-1"))
+}
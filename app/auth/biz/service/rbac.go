@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"gorm.io/gorm"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/pkg/rbac"
+)
+
+const (
+	// 内置角色，所有用户默认拥有 RoleUser
+	RoleSupport = "support"
+	RoleAdmin   = "admin"
+
+	// 内置权限
+	PermUserRead    = "user:read"
+	PermUserBan     = "user:ban"
+	PermRoleAssign  = "role:assign"
+	PermOrderRefund = "order:refund"
+	PermAuditRead   = "audit:read"
+)
+
+// AdminRules 管理接口（RPC 方法名）需要的权限，Hertz 路由和 Kitex 中间件共用
+var AdminRules = map[string][]string{
+	"AssignRole":       {PermRoleAssign},
+	"RevokeRole":       {PermRoleAssign},
+	"ListUserRoles":    {PermUserRead},
+	"UpdateUserStatus": {PermUserBan},
+	"BanUser":          {PermUserBan},
+	"UnbanUser":        {PermUserBan},
+	"ListUserBans":     {PermUserRead},
+	"UnlockLogin":      {PermUserBan},
+	"ListAuditLogs":    {PermAuditRead},
+}
+
+// adminOperator 返回管理接口的操作人
+// 经过 rbac 中间件时以已授权的调用方为准，请求中另带的令牌属于其他用户时拒绝，避免以未授权的身份记录操作；
+// 未经过中间件时（如服务内部直接调用）解析请求中的令牌
+func (s *authService) adminOperator(ctx context.Context, token string) (int64, error) {
+	p, ok := rbac.FromContext(ctx)
+	if !ok {
+		claims, err := s.parseAccessToken(ctx, token)
+		if err != nil {
+			return 0, err
+		}
+		return claims.UserID, nil
+	}
+	if token != "" {
+		claims, err := s.parseAccessToken(ctx, token)
+		if err != nil {
+			return 0, err
+		}
+		if claims.UserID != p.UserID {
+			return 0, fmt.Errorf("%w: request token does not belong to the authorized operator", auth.ErrInvalidToken)
+		}
+	}
+	return p.UserID, nil
+}
+
+// AssignRole 授予用户角色
+func (s *authService) AssignRole(ctx context.Context, req *auth.AssignRoleRequest) (*auth.AssignRoleResponse, error) {
+	operatorID, err := s.adminOperator(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	role, err := s.resolveRoleChange(req.UserId, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.AssignUserRole(req.UserId, role.ID, operatorID); err != nil {
+		return nil, err
+	}
+	return &auth.AssignRoleResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// RevokeRole 撤销用户角色，不能撤销自己的角色，避免管理员误操作后无人可以授权
+func (s *authService) RevokeRole(ctx context.Context, req *auth.RevokeRoleRequest) (*auth.RevokeRoleResponse, error) {
+	operatorID, err := s.adminOperator(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if operatorID == req.UserId {
+		return nil, fmt.Errorf("%w: cannot revoke own role", auth.ErrInvalidArgument)
+	}
+	role, err := s.resolveRoleChange(req.UserId, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.RevokeUserRole(req.UserId, role.ID); err != nil {
+		return nil, err
+	}
+	return &auth.RevokeRoleResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// ListUserRoles 查询用户的角色和权限
+func (s *authService) ListUserRoles(ctx context.Context, req *auth.ListUserRolesRequest) (*auth.ListUserRolesResponse, error) {
+	if err := s.checkTargetUser(req.UserId); err != nil {
+		return nil, err
+	}
+	roles, permissions, err := s.userAuthorization(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListUserRolesResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Roles:       roles,
+		Permissions: permissions,
+	}, nil
+}
+
+// UpdateUserStatus 永久封禁或解封用户，封禁时吊销用户的所有会话
+func (s *authService) UpdateUserStatus(ctx context.Context, req *auth.UpdateUserStatusRequest) (resp *auth.UpdateUserStatusResponse, err error) {
+	eventType := AuditUserBan
+	if req.Status == UserStatusNormal {
+		eventType = AuditUserUnban
+	}
+	event := newAuditEvent(ctx, eventType, req.UserId, "")
+	defer func() { recordAudit(event, err) }()
+
+	operatorID, err := s.adminOperator(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.OperatorID = operatorID
+	if req.Status != UserStatusNormal && req.Status != UserStatusBanned {
+		return nil, fmt.Errorf("%w: unknown status %d", auth.ErrInvalidArgument, req.Status)
+	}
+	if req.Status == UserStatusBanned {
+		_, err = s.banUser(ctx, operatorID, req.UserId, "", 0)
+	} else {
+		err = s.unbanUser(operatorID, req.UserId, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &auth.UpdateUserStatusResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// resolveRoleChange 校验角色变更的目标用户和角色，默认角色不能授予或撤销
+func (s *authService) resolveRoleChange(userID int64, name string) (*mysql.Role, error) {
+	if name == RoleUser {
+		return nil, fmt.Errorf("%w: role %q is implicit", auth.ErrInvalidArgument, name)
+	}
+	role, err := s.repo.GetRoleByName(name)
+	if err != nil {
+		if err == mysql.ErrRecordNotFound {
+			return nil, auth.ErrRoleNotFound
+		}
+		return nil, err
+	}
+	if err := s.checkTargetUser(userID); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// checkTargetUser 检查管理操作的目标用户存在
+func (s *authService) checkTargetUser(userID int64) error {
+	_, err := s.repo.GetUserByID(userID)
+	if err == mysql.ErrRecordNotFound || errors.Is(err, gorm.ErrRecordNotFound) {
+		return auth.ErrUserNotFound
+	}
+	return err
+}
+
+// userAuthorization 查询用户当前的角色和权限，包含默认的 user 角色
+func (s *authService) userAuthorization(userID int64) (roles, permissions []string, err error) {
+	granted, err := s.repo.GetUserRoles(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	roles = []string{RoleUser}
+	for _, r := range granted {
+		if r != RoleUser {
+			roles = append(roles, r)
+		}
+	}
+	permissions, err = s.repo.GetRolePermissions(roles)
+	if err != nil {
+		return nil, nil, err
+	}
+	return roles, permissions, nil
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/pkg/rbac"
+)
+
+// rbacTestEnv 带状态的权限测试环境，用户 1 为管理员，用户 2 为普通用户
+type rbacTestEnv struct {
+	*servicemock.MockAuthRepository
+	svc       AuthService
+	users     map[int64]*mysql.User
+	roles     map[string]*mysql.Role
+	rolePerms map[string][]string
+	userRoles map[int64]map[int64]int64 // 用户 -> 角色 -> 授予人
+	tokens    []*mysql.Token
+	bans      []*mysql.UserBan
+}
+
+func newRBACTestEnv(t *testing.T) *rbacTestEnv {
+	env := &rbacTestEnv{
+		MockAuthRepository: new(servicemock.MockAuthRepository),
+		users: map[int64]*mysql.User{
+			1: {ID: 1, Username: "admin", Status: UserStatusNormal},
+			2: {ID: 2, Username: "shopper", Status: UserStatusNormal},
+		},
+		roles: map[string]*mysql.Role{
+			RoleUser:    {ID: 1, Name: RoleUser},
+			RoleSupport: {ID: 2, Name: RoleSupport},
+			RoleAdmin:   {ID: 3, Name: RoleAdmin},
+		},
+		rolePerms: map[string][]string{
+			RoleSupport: {PermUserRead, PermOrderRefund},
+			RoleAdmin:   {PermUserRead, PermUserBan, PermRoleAssign, PermOrderRefund},
+		},
+		userRoles: map[int64]map[int64]int64{1: {3: 0}},
+	}
+	redis.Client = &mockRedis{}
+	env.svc = NewAuthService(env)
+	return env
+}
+
+func (env *rbacTestEnv) GetUserByID(id int64) (*mysql.User, error) {
+	if u, ok := env.users[id]; ok {
+		return u, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *rbacTestEnv) GetRoleByName(name string) (*mysql.Role, error) {
+	if r, ok := env.roles[name]; ok {
+		return r, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *rbacTestEnv) GetUserRoles(userID int64) ([]string, error) {
+	var names []string
+	for _, r := range env.roles {
+		if _, ok := env.userRoles[userID][r.ID]; ok {
+			names = append(names, r.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (env *rbacTestEnv) GetRolePermissions(roles []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var perms []string
+	for _, r := range roles {
+		for _, p := range env.rolePerms[r] {
+			if !seen[p] {
+				seen[p] = true
+				perms = append(perms, p)
+			}
+		}
+	}
+	sort.Strings(perms)
+	return perms, nil
+}
+
+func (env *rbacTestEnv) AssignUserRole(userID, roleID, grantedBy int64) error {
+	if env.userRoles[userID] == nil {
+		env.userRoles[userID] = make(map[int64]int64)
+	}
+	if _, ok := env.userRoles[userID][roleID]; !ok {
+		env.userRoles[userID][roleID] = grantedBy
+	}
+	return nil
+}
+
+func (env *rbacTestEnv) RevokeUserRole(userID, roleID int64) error {
+	delete(env.userRoles[userID], roleID)
+	return nil
+}
+
+func (env *rbacTestEnv) CreateUserBan(ban *mysql.UserBan) error {
+	env.liftBans(ban.UserID, ban.OperatorID, mysql.BanLiftReasonSuperseded, ban.CreatedAt)
+	ban.ID = int64(len(env.bans) + 1)
+	env.bans = append(env.bans, ban)
+	env.users[ban.UserID].Status = UserStatusBanned
+	return nil
+}
+
+func (env *rbacTestEnv) LiftUserBans(userID, liftedBy int64, reason string, at time.Time) error {
+	env.liftBans(userID, liftedBy, reason, at)
+	env.users[userID].Status = UserStatusNormal
+	return nil
+}
+
+func (env *rbacTestEnv) ListExpiredUserBans(now time.Time, limit int) ([]*mysql.UserBan, error) {
+	var expired []*mysql.UserBan
+	for _, b := range env.bans {
+		if b.LiftedAt == nil && b.ExpiresAt != nil && !b.ExpiresAt.After(now) && len(expired) < limit {
+			expired = append(expired, b)
+		}
+	}
+	return expired, nil
+}
+
+func (env *rbacTestEnv) LiftExpiredUserBan(id int64, at time.Time) (bool, error) {
+	b := env.bans[id-1]
+	if b.LiftedAt != nil {
+		return false, nil
+	}
+	b.LiftedAt, b.LiftReason = &at, mysql.BanLiftReasonExpired
+	env.users[b.UserID].Status = UserStatusNormal
+	return true, nil
+}
+
+func (env *rbacTestEnv) ListUserBans(userID int64) ([]*mysql.UserBan, error) {
+	var bans []*mysql.UserBan
+	for i := len(env.bans) - 1; i >= 0; i-- {
+		if env.bans[i].UserID == userID {
+			bans = append(bans, env.bans[i])
+		}
+	}
+	return bans, nil
+}
+
+// liftBans 结束用户所有生效的封禁
+func (env *rbacTestEnv) liftBans(userID, liftedBy int64, reason string, at time.Time) {
+	for _, b := range env.bans {
+		if b.UserID == userID && b.LiftedAt == nil {
+			lifted := at
+			b.LiftedAt, b.LiftedBy, b.LiftReason = &lifted, liftedBy, reason
+		}
+	}
+}
+
+func (env *rbacTestEnv) CreateToken(token *mysql.Token) error {
+	env.tokens = append(env.tokens, token)
+	return nil
+}
+
+func (env *rbacTestEnv) DeleteTokenByUserID(userID int64) ([]*mysql.Token, error) {
+	var deleted, kept []*mysql.Token
+	for _, t := range env.tokens {
+		if t.UserID == userID {
+			deleted = append(deleted, t)
+		} else {
+			kept = append(kept, t)
+		}
+	}
+	env.tokens = kept
+	return deleted, nil
+}
+
+func (env *rbacTestEnv) TouchToken(token string, seenAt time.Time) error {
+	return nil
+}
+
+// login 为用户创建会话并返回访问令牌
+func (env *rbacTestEnv) login(t *testing.T, userID int64) string {
+	token, _, err := env.svc.(*authService).createAndCacheTokens(context.Background(), env.users[userID])
+	require.NoError(t, err)
+	return token
+}
+
+func TestRBAC_ValidateTokenIncludesRoles(t *testing.T) {
+	env := newRBACTestEnv(t)
+	ctx := context.Background()
+
+	resp, err := env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: env.login(t, 1)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{RoleUser, RoleAdmin}, resp.Data.Roles)
+	assert.Contains(t, resp.Data.Permissions, PermRoleAssign)
+
+	resp, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: env.login(t, 2)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{RoleUser}, resp.Data.Roles)
+	assert.Empty(t, resp.Data.Permissions)
+}
+
+func TestRBAC_AssignAndRevokeRole(t *testing.T) {
+	env := newRBACTestEnv(t)
+	ctx := context.Background()
+	admin := env.login(t, 1)
+	shopper := env.login(t, 2)
+
+	_, err := env.svc.AssignRole(ctx, &auth.AssignRoleRequest{Token: admin, UserId: 2, Role: RoleSupport})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), env.userRoles[2][2])
+
+	// 角色变更立即生效，无需重新登录
+	resp, err := env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: shopper})
+	require.NoError(t, err)
+	assert.Equal(t, []string{RoleUser, RoleSupport}, resp.Data.Roles)
+	assert.Equal(t, []string{PermOrderRefund, PermUserRead}, resp.Data.Permissions)
+
+	list, err := env.svc.ListUserRoles(ctx, &auth.ListUserRolesRequest{Token: admin, UserId: 2})
+	require.NoError(t, err)
+	assert.Equal(t, resp.Data.Roles, list.Roles)
+
+	_, err = env.svc.RevokeRole(ctx, &auth.RevokeRoleRequest{Token: admin, UserId: 2, Role: RoleSupport})
+	require.NoError(t, err)
+	resp, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: shopper})
+	require.NoError(t, err)
+	assert.Equal(t, []string{RoleUser}, resp.Data.Roles)
+
+	_, err = env.svc.AssignRole(ctx, &auth.AssignRoleRequest{Token: admin, UserId: 2, Role: "root"})
+	assert.ErrorIs(t, err, auth.ErrRoleNotFound)
+	_, err = env.svc.AssignRole(ctx, &auth.AssignRoleRequest{Token: admin, UserId: 99, Role: RoleSupport})
+	assert.ErrorIs(t, err, auth.ErrUserNotFound)
+	_, err = env.svc.RevokeRole(ctx, &auth.RevokeRoleRequest{Token: admin, UserId: 2, Role: RoleUser})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+	// 不能撤销自己的角色
+	_, err = env.svc.RevokeRole(ctx, &auth.RevokeRoleRequest{Token: admin, UserId: 1, Role: RoleAdmin})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+}
+
+func TestRBAC_UpdateUserStatus(t *testing.T) {
+	env := newRBACTestEnv(t)
+	ctx := context.Background()
+	admin := env.login(t, 1)
+	shopper := env.login(t, 2)
+
+	_, err := env.svc.UpdateUserStatus(ctx, &auth.UpdateUserStatusRequest{Token: admin, UserId: 2, Status: UserStatusBanned})
+	require.NoError(t, err)
+	assert.Equal(t, UserStatusBanned, env.users[2].Status)
+
+	// 封禁后会话立即失效，管理员的会话不受影响
+	_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: shopper})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: admin})
+	assert.NoError(t, err)
+
+	_, err = env.svc.UpdateUserStatus(ctx, &auth.UpdateUserStatusRequest{Token: admin, UserId: 2, Status: UserStatusNormal})
+	require.NoError(t, err)
+	assert.Equal(t, UserStatusNormal, env.users[2].Status)
+
+	_, err = env.svc.UpdateUserStatus(ctx, &auth.UpdateUserStatusRequest{Token: admin, UserId: 1, Status: UserStatusBanned})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+	_, err = env.svc.UpdateUserStatus(ctx, &auth.UpdateUserStatusRequest{Token: admin, UserId: 2, Status: 9})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+
+	expired := signTestToken(t, 1, "admin", -time.Minute)
+	_, err = env.svc.UpdateUserStatus(ctx, &auth.UpdateUserStatusRequest{Token: expired, UserId: 2, Status: UserStatusBanned})
+	assert.ErrorIs(t, err, auth.ErrTokenExpired)
+}
+
+func TestRBAC_OperatorFromPrincipal(t *testing.T) {
+	env := newRBACTestEnv(t)
+	shopper := env.login(t, 2)
+
+	// 经过 rbac 中间件时操作人为已授权的调用方
+	ctx := rbac.WithPrincipal(context.Background(), &rbac.Principal{UserID: 1, Roles: []string{RoleUser, RoleAdmin}})
+	_, err := env.svc.AssignRole(ctx, &auth.AssignRoleRequest{UserId: 2, Role: RoleSupport})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), env.userRoles[2][2])
+
+	// 请求中的令牌属于其他用户时拒绝
+	_, err = env.svc.AssignRole(ctx, &auth.AssignRoleRequest{Token: shopper, UserId: 2, Role: RoleAdmin})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	_, err = env.svc.UpdateUserStatus(ctx, &auth.UpdateUserStatusRequest{Token: shopper, UserId: 2, Status: UserStatusBanned})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	assert.Equal(t, UserStatusNormal, env.users[2].Status)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"TikTokMall/app/auth/biz/dal/mysql"
 	"TikTokMall/app/auth/kitex_gen/auth"
@@ -14,6 +15,34 @@ type AuthService interface {
 	RefreshToken(ctx context.Context, req *auth.RefreshTokenRequest) (*auth.RefreshTokenResponse, error)
 	ValidateToken(ctx context.Context, req *auth.ValidateTokenRequest) (*auth.ValidateTokenResponse, error)
 	Logout(ctx context.Context, req *auth.LogoutRequest) (*auth.LogoutResponse, error)
+	DeliverToken(ctx context.Context, req *auth.DeliverTokenReq) (*auth.DeliveryResp, error)
+	VerifyToken(ctx context.Context, req *auth.VerifyTokenReq) (*auth.VerifyResp, error)
+	LoginTwoFactor(ctx context.Context, req *auth.TwoFactorLoginRequest) (*auth.LoginResponse, error)
+	EnrollTwoFactor(ctx context.Context, req *auth.EnrollTwoFactorRequest) (*auth.EnrollTwoFactorResponse, error)
+	ConfirmTwoFactor(ctx context.Context, req *auth.ConfirmTwoFactorRequest) (*auth.ConfirmTwoFactorResponse, error)
+	DisableTwoFactor(ctx context.Context, req *auth.DisableTwoFactorRequest) (*auth.DisableTwoFactorResponse, error)
+	ForgotPassword(ctx context.Context, req *auth.ForgotPasswordRequest) (*auth.ForgotPasswordResponse, error)
+	ResetPassword(ctx context.Context, req *auth.ResetPasswordRequest) (*auth.ResetPasswordResponse, error)
+	ChangePassword(ctx context.Context, req *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error)
+	ResendVerification(ctx context.Context, req *auth.ResendVerificationRequest) (*auth.ResendVerificationResponse, error)
+	VerifyContact(ctx context.Context, req *auth.VerifyContactRequest) (*auth.VerifyContactResponse, error)
+	GetVerificationStatus(ctx context.Context, req *auth.VerificationStatusReq) (*auth.VerificationStatusResp, error)
+	AssignRole(ctx context.Context, req *auth.AssignRoleRequest) (*auth.AssignRoleResponse, error)
+	RevokeRole(ctx context.Context, req *auth.RevokeRoleRequest) (*auth.RevokeRoleResponse, error)
+	ListUserRoles(ctx context.Context, req *auth.ListUserRolesRequest) (*auth.ListUserRolesResponse, error)
+	UpdateUserStatus(ctx context.Context, req *auth.UpdateUserStatusRequest) (*auth.UpdateUserStatusResponse, error)
+	BanUser(ctx context.Context, req *auth.BanUserRequest) (*auth.BanUserResponse, error)
+	UnbanUser(ctx context.Context, req *auth.UnbanUserRequest) (*auth.UnbanUserResponse, error)
+	ListUserBans(ctx context.Context, req *auth.ListUserBansRequest) (*auth.ListUserBansResponse, error)
+	UnlockLogin(ctx context.Context, req *auth.UnlockLoginRequest) (*auth.UnlockLoginResponse, error)
+	ListAuditLogs(ctx context.Context, req *auth.ListAuditLogsRequest) (*auth.ListAuditLogsResponse, error)
+	ListSessions(ctx context.Context, req *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, req *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error)
+	LogoutAll(ctx context.Context, req *auth.LogoutAllRequest) (*auth.LogoutAllResponse, error)
+	CreateAPIKey(ctx context.Context, req *auth.CreateAPIKeyRequest) (*auth.CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, req *auth.ListAPIKeysRequest) (*auth.ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, req *auth.RevokeAPIKeyRequest) (*auth.RevokeAPIKeyResponse, error)
+	VerifyAPISignature(ctx context.Context, req *auth.VerifyAPISignatureReq) (*auth.VerifyAPISignatureResp, error)
 }
 
 // AuthRepository 定义数据访问接口
@@ -22,6 +51,58 @@ type AuthRepository interface {
 	GetUserByUsername(username string) (*mysql.User, error)
 	GetUserByID(id int64) (*mysql.User, error)
 	CreateToken(token *mysql.Token) error
-	GetTokenByToken(token string) (*mysql.Token, error)
-	DeleteToken(token string) error
+	GetTokenByToken(digest string) (*mysql.Token, error) // 令牌均以 tokenhash.Digest 摘要查询
+	GetTokenByRefreshToken(digest string) (*mysql.Token, error)
+	MarkRefreshTokenUsed(id int64, familyID string) (bool, error)
+	RevokeTokenFamily(familyID string) ([]*mysql.Token, error)
+	DeleteToken(digest string) error
+	UpdateUserTwoFactor(userID int64, enabled bool, secret, recoveryCodes string) error
+	ReplaceRecoveryCodes(userID int64, current, remaining string) (bool, error) // 恢复码已被修改时返回 false
+	CreateOAuthClient(client *mysql.OAuthClient) error
+	GetOAuthClient(clientID string) (*mysql.OAuthClient, error)
+	GetOAuthConsent(userID int64, clientID string) (*mysql.OAuthConsent, error) // 不存在时返回 nil
+	SaveOAuthConsent(consent *mysql.OAuthConsent) error
+	ListOAuthConsents(userID int64) ([]*mysql.OAuthConsent, error)
+	DeleteOAuthConsent(userID int64, clientID string) error
+	RevokeClientTokens(userID int64, clientID string) ([]*mysql.Token, error)
+	GetUserByEmail(email string) (*mysql.User, error)
+	CheckUserExists(username string) (bool, error)
+	GetUserIdentity(provider, subject string) (*mysql.UserIdentity, error)
+	CreateUserIdentity(identity *mysql.UserIdentity) error
+	GetUserByPhone(phone string) (*mysql.User, error)
+	UpdateUserPassword(userID int64, password string) error
+	DeleteTokenByUserID(userID int64) ([]*mysql.Token, error) // 返回被删除的令牌，用于加入黑名单
+	CheckEmailExists(email string) (bool, error)
+	CheckPhoneExists(phone string) (bool, error)
+	MarkEmailVerified(userID int64, email string) (bool, error) // 联系方式已变更时返回 false
+	MarkPhoneVerified(userID int64, phone string) (bool, error)
+	GetRoleByName(name string) (*mysql.Role, error)
+	GetUserRoles(userID int64) ([]string, error) // 不包含所有用户默认拥有的 user 角色
+	GetRolePermissions(roles []string) ([]string, error)
+	AssignUserRole(userID, roleID, grantedBy int64) error
+	RevokeUserRole(userID, roleID int64) error
+	UpdateUserStatus(userID int64, status int8) error
+	GetTokenByID(id int64) (*mysql.Token, error)
+	ListUserSessions(userID int64) ([]*mysql.Token, error)
+	TouchToken(digest string, seenAt time.Time) error
+	GetPasswordHistory(userID int64, limit int) ([]string, error) // 按时间倒序
+	AddPasswordHistory(userID int64, password string, keep int) error
+	CreateAuditLogs(logs []*mysql.AuditLog) error
+	ListAuditLogs(filter mysql.AuditLogFilter) ([]*mysql.AuditLog, int64, error)
+	CreateAPIKey(key *mysql.APIKey) error
+	GetAPIKey(keyID string) (*mysql.APIKey, error) // 不存在时返回 nil
+	ListAPIKeys(userID int64) ([]*mysql.APIKey, error)
+	CountActiveAPIKeys(userID int64, now time.Time) (int64, error)
+	RevokeAPIKey(userID int64, keyID string) (bool, error) // 密钥不存在或已吊销时返回 false
+	TouchAPIKey(keyID string, usedAt time.Time) error
+	CreateWebAuthnCredential(cred *mysql.WebAuthnCredential) error
+	GetWebAuthnCredential(credentialID []byte) (*mysql.WebAuthnCredential, error) // 不存在时返回 nil
+	ListWebAuthnCredentials(userID int64) ([]*mysql.WebAuthnCredential, error)
+	UpdateWebAuthnCredentialUsage(id int64, signCount uint32, usedAt time.Time) error
+	DeleteWebAuthnCredential(userID, id int64) (bool, error) // 凭据不存在时返回 false
+	CreateUserBan(ban *mysql.UserBan) error                  // 同时结束旧封禁并将用户状态置为禁用
+	LiftUserBans(userID, liftedBy int64, reason string, at time.Time) error
+	ListExpiredUserBans(now time.Time, limit int) ([]*mysql.UserBan, error)
+	LiftExpiredUserBan(id int64, at time.Time) (bool, error) // 封禁已被解除时返回 false
+	ListUserBans(userID int64) ([]*mysql.UserBan, error)
 }
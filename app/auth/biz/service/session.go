@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+	"TikTokMall/pkg/tokenhash"
+)
+
+const (
+	// SessionTouchInterval 同一令牌最近使用时间的最小更新间隔，避免每次校验都写数据库
+	SessionTouchInterval = 5 * time.Minute
+
+	maxDeviceNameLength = 128
+	maxUserAgentLength  = 512
+)
+
+// ClientInfo 发起登录的客户端信息，签发令牌时记录到会话
+type ClientInfo struct {
+	DeviceName string
+	UserAgent  string
+	IP         string
+	TraceID    string
+}
+
+type clientInfoKey struct{}
+
+// WithClientInfo 将客户端信息保存到 context，由 HTTP 中间件在请求入口调用
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// clientInfoFrom 获取 context 中的客户端信息
+func clientInfoFrom(ctx context.Context) (ClientInfo, bool) {
+	info, ok := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info, ok
+}
+
+// applyClientInfo 将客户端信息写入令牌记录
+func applyClientInfo(record *mysql.Token, info ClientInfo) {
+	record.DeviceName = truncate(info.DeviceName, maxDeviceNameLength)
+	record.UserAgent = truncate(info.UserAgent, maxUserAgentLength)
+	record.IP = info.IP
+}
+
+// truncate 按字节截断字符串，不截断多字节字符
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// parseSessionToken 解析管理会话所用的访问令牌，第三方应用令牌不能管理用户会话
+func (s *authService) parseSessionToken(ctx context.Context, token string) (*jwt.Claims, error) {
+	claims, err := s.parseAccessToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.ClientID != "" {
+		return nil, auth.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// ListSessions 查询当前用户的登录会话，同一次登录轮换出的令牌视为一个会话
+func (s *authService) ListSessions(ctx context.Context, req *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error) {
+	claims, err := s.parseSessionToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.repo.ListUserSessions(claims.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list user sessions failed")
+	}
+	current := tokenhash.Digest(req.Token)
+	sessions := make([]*auth.Session, 0, len(records))
+	for _, r := range records {
+		session := &auth.Session{
+			Id:         r.ID,
+			DeviceName: r.DeviceName,
+			UserAgent:  r.UserAgent,
+			Ip:         r.IP,
+			CreatedAt:  r.CreatedAt.Unix(),
+			Current:    r.Token == current,
+		}
+		if r.LastSeenAt != nil {
+			session.LastSeenAt = r.LastSeenAt.Unix()
+		}
+		sessions = append(sessions, session)
+	}
+
+	return &auth.ListSessionsResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Sessions: sessions,
+	}, nil
+}
+
+// RevokeSession 注销当前用户的指定会话，会话中的访问令牌立即失效
+func (s *authService) RevokeSession(ctx context.Context, req *auth.RevokeSessionRequest) (resp *auth.RevokeSessionResponse, err error) {
+	event := newAuditEvent(ctx, AuditSessionRevoke, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	claims, err := s.parseSessionToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID = claims.UserID
+
+	record, err := s.repo.GetTokenByID(req.SessionId)
+	if err != nil {
+		if err == mysql.ErrRecordNotFound || errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, auth.ErrSessionNotFound
+		}
+		return nil, err
+	}
+	// 不能注销其他用户的会话，第三方应用的令牌通过撤销授权处理
+	if record.UserID != claims.UserID || record.ClientID != "" || record.RevokedAt != nil {
+		return nil, auth.ErrSessionNotFound
+	}
+
+	if record.FamilyID != "" {
+		if err := s.revokeTokenFamily(ctx, record.FamilyID); err != nil {
+			return nil, err
+		}
+	} else {
+		// 没有令牌族的历史记录只包含单个令牌
+		if err := s.repo.DeleteToken(record.Token); err != nil {
+			return nil, errors.Wrap(err, "delete token failed")
+		}
+		if err := redis.DeleteToken(ctx, record.Token); err != nil {
+			hlog.CtxWarnf(ctx, "delete token from cache failed: %v", err)
+		}
+		if err := redis.AddToBlacklist(ctx, record.Token, TokenExpiration); err != nil {
+			hlog.CtxWarnf(ctx, "add token to blacklist failed: %v", err)
+		}
+	}
+
+	return &auth.RevokeSessionResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// LogoutAll 退出所有设备，删除用户的全部令牌并将访问令牌加入黑名单
+func (s *authService) LogoutAll(ctx context.Context, req *auth.LogoutAllRequest) (resp *auth.LogoutAllResponse, err error) {
+	event := newAuditEvent(ctx, AuditLogoutAll, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	claims, err := s.parseSessionToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID = claims.UserID
+	if err := s.revokeUserTokens(ctx, claims.UserID); err != nil {
+		return nil, err
+	}
+
+	return &auth.LogoutAllResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// touchSession 记录令牌的最近使用时间，同一令牌在 SessionTouchInterval 内只更新一次
+func (s *authService) touchSession(ctx context.Context, token string) {
+	digest := tokenhash.Digest(token)
+	first, err := redis.MarkSessionSeen(ctx, digest, SessionTouchInterval)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "mark session seen failed: %v", err)
+		return
+	}
+	if !first {
+		return
+	}
+	if err := s.repo.TouchToken(digest, time.Now()); err != nil {
+		hlog.CtxWarnf(ctx, "update session last seen failed: %v", err)
+	}
+}
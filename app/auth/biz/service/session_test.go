@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/kitex_gen/auth"
+)
+
+// sessionTestEnv 在密码测试环境的基础上支持按会话查询、吊销和轮换令牌
+type sessionTestEnv struct {
+	*passwordTestEnv
+	nextID int64
+}
+
+func newSessionTestEnv(t *testing.T) *sessionTestEnv {
+	env := &sessionTestEnv{passwordTestEnv: newPasswordTestEnv(t)}
+	env.svc = NewAuthService(env)
+	return env
+}
+
+func (env *sessionTestEnv) CreateToken(token *mysql.Token) error {
+	env.nextID++
+	token.ID = env.nextID
+	token.CreatedAt = time.Now()
+	env.tokens = append(env.tokens, token)
+	return nil
+}
+
+func (env *sessionTestEnv) GetTokenByID(id int64) (*mysql.Token, error) {
+	for _, t := range env.tokens {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *sessionTestEnv) GetTokenByRefreshToken(refreshToken string) (*mysql.Token, error) {
+	for _, t := range env.tokens {
+		if t.RefreshToken == refreshToken {
+			return t, nil
+		}
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *sessionTestEnv) MarkRefreshTokenUsed(id int64, familyID string) (bool, error) {
+	t, _ := env.GetTokenByID(id)
+	if t == nil || t.UsedAt != nil {
+		return false, nil
+	}
+	now := time.Now()
+	t.UsedAt = &now
+	return true, nil
+}
+
+func (env *sessionTestEnv) RevokeTokenFamily(familyID string) ([]*mysql.Token, error) {
+	var revoked []*mysql.Token
+	now := time.Now()
+	for _, t := range env.tokens {
+		if t.FamilyID == familyID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+			revoked = append(revoked, t)
+		}
+	}
+	return revoked, nil
+}
+
+func (env *sessionTestEnv) ListUserSessions(userID int64) ([]*mysql.Token, error) {
+	var sessions []*mysql.Token
+	for _, t := range env.tokens {
+		if t.UserID == userID && t.ClientID == "" && t.UsedAt == nil && t.RevokedAt == nil {
+			sessions = append(sessions, t)
+		}
+	}
+	return sessions, nil
+}
+
+func (env *sessionTestEnv) TouchToken(token string, seenAt time.Time) error {
+	for _, t := range env.tokens {
+		if t.Token == token {
+			t.LastSeenAt = &seenAt
+		}
+	}
+	return nil
+}
+
+func (env *sessionTestEnv) GetUserRoles(userID int64) ([]string, error) {
+	return nil, nil
+}
+
+func (env *sessionTestEnv) GetRolePermissions(roles []string) ([]string, error) {
+	return nil, nil
+}
+
+// loginFrom 模拟指定设备登录，返回访问令牌和刷新令牌
+func (env *sessionTestEnv) loginFrom(t *testing.T, device string) (string, string) {
+	ctx := WithClientInfo(context.Background(), ClientInfo{DeviceName: device, UserAgent: "test-agent", IP: "10.0.0.1"})
+	token, refreshToken, err := env.svc.(*authService).createAndCacheTokens(ctx, env.user)
+	require.NoError(t, err)
+	return token, refreshToken
+}
+
+func TestSession_ListAndRevoke(t *testing.T) {
+	env := newSessionTestEnv(t)
+	ctx := context.Background()
+	phone, _ := env.loginFrom(t, "iPhone")
+	laptop, laptopRefresh := env.loginFrom(t, "MacBook")
+
+	// 刷新后仍是同一个会话，未提供的设备信息沿用原会话
+	refreshed, err := env.svc.RefreshToken(ctx, &auth.RefreshTokenRequest{RefreshToken: laptopRefresh})
+	require.NoError(t, err)
+	laptop = refreshed.Data.Token
+
+	resp, err := env.svc.ListSessions(ctx, &auth.ListSessionsRequest{Token: "Bearer " + phone})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 2)
+	devices := map[string]*auth.Session{}
+	for _, s := range resp.Sessions {
+		devices[s.DeviceName] = s
+	}
+	require.Contains(t, devices, "iPhone")
+	require.Contains(t, devices, "MacBook")
+	assert.True(t, devices["iPhone"].Current)
+	assert.False(t, devices["MacBook"].Current)
+	assert.Equal(t, "test-agent", devices["MacBook"].UserAgent)
+	assert.Equal(t, "10.0.0.1", devices["MacBook"].Ip)
+	assert.NotZero(t, devices["MacBook"].LastSeenAt)
+
+	// 注销笔记本的会话后其令牌立即失效，其他会话不受影响
+	_, err = env.svc.RevokeSession(ctx, &auth.RevokeSessionRequest{Token: phone, SessionId: devices["MacBook"].Id})
+	require.NoError(t, err)
+	_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: laptop})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: phone})
+	require.NoError(t, err)
+
+	resp, err = env.svc.ListSessions(ctx, &auth.ListSessionsRequest{Token: phone})
+	require.NoError(t, err)
+	assert.Len(t, resp.Sessions, 1)
+
+	// 已注销或不存在的会话
+	_, err = env.svc.RevokeSession(ctx, &auth.RevokeSessionRequest{Token: phone, SessionId: devices["MacBook"].Id})
+	assert.ErrorIs(t, err, auth.ErrSessionNotFound)
+	_, err = env.svc.RevokeSession(ctx, &auth.RevokeSessionRequest{Token: phone, SessionId: 99})
+	assert.ErrorIs(t, err, auth.ErrSessionNotFound)
+}
+
+func TestSession_RevokeOtherUsersSession(t *testing.T) {
+	env := newSessionTestEnv(t)
+	ctx := context.Background()
+	token, _ := env.loginFrom(t, "iPhone")
+	env.tokens = append(env.tokens, &mysql.Token{ID: 50, UserID: 2, Token: "other", FamilyID: "family"})
+
+	_, err := env.svc.RevokeSession(ctx, &auth.RevokeSessionRequest{Token: token, SessionId: 50})
+	assert.ErrorIs(t, err, auth.ErrSessionNotFound)
+	assert.Nil(t, env.tokens[len(env.tokens)-1].RevokedAt)
+}
+
+func TestSession_LastSeen(t *testing.T) {
+	env := newSessionTestEnv(t)
+	ctx := context.Background()
+	token, _ := env.loginFrom(t, "iPhone")
+	record := env.tokens[0]
+	past := time.Now().Add(-time.Hour)
+	record.LastSeenAt = &past
+
+	_, err := env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: token})
+	require.NoError(t, err)
+	require.NotNil(t, record.LastSeenAt)
+	assert.True(t, record.LastSeenAt.After(past))
+
+	// 更新间隔内不再写数据库
+	record.LastSeenAt = &past
+	_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: token})
+	require.NoError(t, err)
+	assert.Equal(t, past, *record.LastSeenAt)
+}
+
+func TestSession_LogoutAll(t *testing.T) {
+	env := newSessionTestEnv(t)
+	ctx := context.Background()
+	phone, _ := env.loginFrom(t, "iPhone")
+	laptop, _ := env.loginFrom(t, "MacBook")
+
+	_, err := env.svc.LogoutAll(ctx, &auth.LogoutAllRequest{Token: phone})
+	require.NoError(t, err)
+	assert.Empty(t, env.tokens)
+	for _, token := range []string{phone, laptop} {
+		_, err = env.svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: token})
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	}
+
+	_, err = env.svc.LogoutAll(ctx, &auth.LogoutAllRequest{Token: phone})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
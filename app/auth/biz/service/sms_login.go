@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/notify"
+)
+
+const (
+	// 短信登录相关配置，同一手机号的发送间隔和次数与联系方式验证相同
+	DefaultSMSLoginIPSendLimit  = 20
+	DefaultSMSLoginIPSendWindow = time.Hour
+
+	// smsLoginChannel 登录验证码在 Redis 中与联系方式验证码分开保存
+	smsLoginChannel = "login"
+)
+
+// phonePattern 手机号格式，与注册接口的校验规则相同
+var phonePattern = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
+// SMSLoginPolicy 短信登录策略
+type SMSLoginPolicy struct {
+	AutoRegister bool          // 手机号未注册时自动创建账号
+	IPSendLimit  int64         // 同一 IP 在 IPSendWindow 内最多发送次数
+	IPSendWindow time.Duration // IP 发送次数统计窗口
+}
+
+// SMSLoginService 定义短信验证码登录接口
+type SMSLoginService interface {
+	// SendCode 向手机号发送登录验证码，未注册且未开启自动注册时同样返回成功，避免泄露注册信息
+	SendCode(ctx context.Context, phone string) error
+	// Login 校验验证码后登录，结果与密码登录相同
+	Login(ctx context.Context, phone, code string) (*auth.LoginResponse, error)
+}
+
+type smsLoginService struct {
+	*authService
+	policy SMSLoginPolicy
+}
+
+// NewSMSLoginService 创建短信登录服务，未设置的 IP 限流参数使用默认值
+func NewSMSLoginService(repo AuthRepository, policy SMSLoginPolicy) SMSLoginService {
+	if policy.IPSendLimit <= 0 {
+		policy.IPSendLimit = DefaultSMSLoginIPSendLimit
+	}
+	if policy.IPSendWindow <= 0 {
+		policy.IPSendWindow = DefaultSMSLoginIPSendWindow
+	}
+	return &smsLoginService{authService: &authService{repo: repo}, policy: policy}
+}
+
+// SendCode 发送登录验证码，先按 IP 和手机号限流再查询账号
+func (s *smsLoginService) SendCode(ctx context.Context, phone string) error {
+	phone = strings.TrimSpace(phone)
+	if !phonePattern.MatchString(phone) {
+		return fmt.Errorf("%w: invalid phone number", auth.ErrInvalidArgument)
+	}
+	if info, ok := clientInfoFrom(ctx); ok && info.IP != "" {
+		allowed, err := redis.AllowSMSLoginSend(ctx, info.IP, s.policy.IPSendLimit, s.policy.IPSendWindow)
+		if err != nil {
+			return errors.Wrap(err, "check sms login send rate failed")
+		}
+		if !allowed {
+			return auth.ErrTooManyAttempts
+		}
+	}
+	if err := allowVerificationSend(ctx, smsLoginChannel, phone); err != nil {
+		return err
+	}
+
+	user, err := s.repo.GetUserByPhone(phone)
+	if err != nil && err != mysql.ErrRecordNotFound {
+		return err
+	}
+	if (user == nil && !s.policy.AutoRegister) || (user != nil && checkUserActive(user) != nil) {
+		return nil
+	}
+
+	code, err := generateVerificationCode()
+	if err != nil {
+		return err
+	}
+	target := verificationTarget(smsLoginChannel, phone)
+	payload, err := json.Marshal(verificationCode{Digest: verificationCodeDigest(target, code)})
+	if err != nil {
+		return errors.Wrap(err, "marshal verification code failed")
+	}
+	if err := redis.SaveVerificationCode(ctx, target, string(payload), VerificationCodeExpiration); err != nil {
+		return errors.Wrap(err, "save verification code failed")
+	}
+	if err := notify.Send(ctx, &notify.Message{
+		Channel: notify.ChannelSMS,
+		To:      phone,
+		Body: fmt.Sprintf("您的登录验证码为 %s，%d 分钟内有效。请勿泄露给他人。",
+			code, int(VerificationCodeExpiration/time.Minute)),
+	}); err != nil {
+		return errors.Wrap(err, "send login code failed")
+	}
+	return nil
+}
+
+// Login 校验验证码后登录，手机号视为已验证
+func (s *smsLoginService) Login(ctx context.Context, phone, code string) (resp *auth.LoginResponse, err error) {
+	event := newAuditEvent(ctx, AuditLoginSMS, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	phone = strings.TrimSpace(phone)
+	if !phonePattern.MatchString(phone) {
+		return nil, fmt.Errorf("%w: invalid phone number", auth.ErrInvalidArgument)
+	}
+	if _, err := consumeVerificationCode(ctx, verificationTarget(smsLoginChannel, phone), code); err != nil {
+		return nil, err
+	}
+
+	user, err := s.repo.GetUserByPhone(phone)
+	if err != nil && err != mysql.ErrRecordNotFound {
+		return nil, err
+	}
+	if user == nil {
+		if !s.policy.AutoRegister {
+			return nil, auth.ErrInvalidVerificationCode
+		}
+		if user, err = s.registerPhoneUser(phone); err != nil {
+			return nil, err
+		}
+	} else if user.PhoneVerifiedAt == nil {
+		if _, err := s.repo.MarkPhoneVerified(user.ID, phone); err != nil {
+			return nil, errors.Wrap(err, "mark phone verified failed")
+		}
+		now := time.Now()
+		user.PhoneVerifiedAt = &now
+	}
+	event.UserID, event.Username = user.ID, user.Username
+
+	if err := checkUserActive(user); err != nil {
+		return nil, err
+	}
+	if err := checkLoginVerified(user); err != nil {
+		return nil, err
+	}
+	if user.TwoFactorEnabled {
+		event.Type = AuditLoginChallenge
+		return s.createLoginChallenge(ctx, user)
+	}
+
+	accessToken, refreshToken, err := s.createAndCacheTokens(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.LoginResponse{
+		Base: &auth.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		Data: &auth.LoginData{
+			Token:        accessToken,
+			RefreshToken: refreshToken,
+		},
+	}, nil
+}
+
+// registerPhoneUser 使用已验证的手机号注册用户，用户名随机生成，密码为随机值，用户可通过重置密码设置密码
+func (s *smsLoginService) registerPhoneUser(phone string) (*mysql.User, error) {
+	var username string
+	for i := 0; i < usernameSuffixAttempts && username == ""; i++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(100000000))
+		if err != nil {
+			return nil, errors.Wrap(err, "generate username failed")
+		}
+		candidate := fmt.Sprintf("user_%08d", n.Int64())
+		exists, err := s.repo.CheckUserExists(candidate)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			username = candidate
+		}
+	}
+	if username == "" {
+		return nil, errors.New("generate unique username failed")
+	}
+
+	secret, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := hashPassword(secret)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	user := &mysql.User{
+		Username:        username,
+		Password:        hashedPassword,
+		Phone:           phone,
+		PhoneVerifiedAt: &now,
+		Status:          UserStatusNormal,
+	}
+	if err := s.repo.CreateUser(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
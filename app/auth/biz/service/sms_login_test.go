@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+)
+
+// smsLoginTestEnv 在联系方式验证测试环境的基础上创建短信登录服务
+type smsLoginTestEnv struct {
+	*verificationTestEnv
+	sms SMSLoginService
+}
+
+func newSMSLoginTestEnv(t *testing.T, policy SMSLoginPolicy) *smsLoginTestEnv {
+	env := &smsLoginTestEnv{verificationTestEnv: newVerificationTestEnv(t)}
+	env.sms = NewSMSLoginService(env, policy)
+	return env
+}
+
+func (env *smsLoginTestEnv) CheckUserExists(username string) (bool, error) {
+	return username == env.user.Username, nil
+}
+
+// sendCode 发送登录验证码并返回短信中的验证码
+func (env *smsLoginTestEnv) sendCode(t *testing.T, phone string) string {
+	env.redis.cooldowns = nil
+	sent := len(env.notifier.messages)
+	require.NoError(t, env.sms.SendCode(context.Background(), phone))
+	require.Len(t, env.notifier.messages, sent+1)
+	assert.Equal(t, phone, env.notifier.messages[sent].To)
+	return verificationCodeFrom(t, env.notifier.messages[sent])
+}
+
+func TestSMSLogin_Login(t *testing.T) {
+	env := newSMSLoginTestEnv(t, SMSLoginPolicy{})
+	ctx := context.Background()
+
+	code := env.sendCode(t, "13800138000")
+	// Redis 中只保存摘要，与联系方式验证码分开保存
+	assert.NotContains(t, env.redis.codes["login:13800138000"], code)
+	_, err := env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "phone", Destination: "13800138000", Code: code})
+	assert.ErrorIs(t, err, auth.ErrInvalidVerificationCode)
+
+	resp, err := env.sms.Login(ctx, "13800138000", code)
+	require.NoError(t, err)
+	claims, err := jwt.Parse(resp.Data.Token)
+	require.NoError(t, err)
+	assert.Equal(t, env.user.ID, claims.UserID)
+	assert.NotEmpty(t, resp.Data.RefreshToken)
+	// 验证码登录证明持有该手机号
+	assert.NotNil(t, env.user.PhoneVerifiedAt)
+
+	// 验证码只能使用一次
+	_, err = env.sms.Login(ctx, "13800138000", code)
+	assert.ErrorIs(t, err, auth.ErrInvalidVerificationCode)
+
+	// 开启两步验证时返回挑战令牌
+	env.user.TwoFactorEnabled = true
+	resp, err = env.sms.Login(ctx, "13800138000", env.sendCode(t, "13800138000"))
+	require.NoError(t, err)
+	assert.True(t, resp.Data.TwoFactorRequired)
+	assert.Empty(t, resp.Data.Token)
+
+	// 封禁用户不发送验证码
+	env.user.Status = UserStatusBanned
+	env.redis.cooldowns = nil
+	require.NoError(t, env.sms.SendCode(ctx, "13800138000"))
+	assert.Len(t, env.notifier.messages, 2)
+}
+
+func TestSMSLogin_Rejects(t *testing.T) {
+	env := newSMSLoginTestEnv(t, SMSLoginPolicy{})
+	ctx := context.Background()
+
+	assert.ErrorIs(t, env.sms.SendCode(ctx, "12345"), auth.ErrInvalidArgument)
+	_, err := env.sms.Login(ctx, "not-a-phone", "123456")
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+
+	// 错误次数用尽后验证码作废
+	code := env.sendCode(t, "13800138000")
+	for i := 0; i < maxVerificationAttempts; i++ {
+		_, err := env.sms.Login(ctx, "13800138000", "abcdef")
+		assert.ErrorIs(t, err, auth.ErrInvalidVerificationCode)
+	}
+	_, err = env.sms.Login(ctx, "13800138000", code)
+	assert.ErrorIs(t, err, auth.ErrInvalidVerificationCode)
+
+	// 同一手机号发送间隔内不能重新发送
+	assert.ErrorIs(t, env.sms.SendCode(ctx, "13800138000"), auth.ErrTooManyAttempts)
+
+	// 未开启自动注册时，未注册的手机号同样返回成功但不发送验证码
+	sent := len(env.notifier.messages)
+	require.NoError(t, env.sms.SendCode(ctx, "13900139000"))
+	assert.Len(t, env.notifier.messages, sent)
+}
+
+func TestSMSLogin_IPRateLimit(t *testing.T) {
+	env := newSMSLoginTestEnv(t, SMSLoginPolicy{IPSendLimit: 2})
+	ctx := WithClientInfo(context.Background(), ClientInfo{IP: "203.0.113.7"})
+
+	require.NoError(t, env.sms.SendCode(ctx, "13800138000"))
+	require.NoError(t, env.sms.SendCode(ctx, "13900139000"))
+	assert.ErrorIs(t, env.sms.SendCode(ctx, "13700137000"), auth.ErrTooManyAttempts)
+
+	// 其他 IP 不受影响
+	other := WithClientInfo(context.Background(), ClientInfo{IP: "198.51.100.1"})
+	require.NoError(t, env.sms.SendCode(other, "13700137000"))
+}
+
+func TestSMSLogin_AutoRegister(t *testing.T) {
+	env := newSMSLoginTestEnv(t, SMSLoginPolicy{AutoRegister: true})
+	ctx := context.Background()
+
+	resp, err := env.sms.Login(ctx, "13900139000", env.sendCode(t, "13900139000"))
+	require.NoError(t, err)
+	assert.Equal(t, "13900139000", env.user.Phone)
+	assert.NotNil(t, env.user.PhoneVerifiedAt)
+	assert.Regexp(t, `^user_\d{8}$`, env.user.Username)
+	claims, err := jwt.Parse(resp.Data.Token)
+	require.NoError(t, err)
+	assert.Equal(t, env.user.ID, claims.UserID)
+}
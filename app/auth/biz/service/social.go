@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/oidc"
+)
+
+const (
+	// 社交登录相关配置
+	SocialLoginStateExpiration = 10 * time.Minute // 跳转到提供方到回调之间的最长时间
+	maxUsernameLength          = 64
+	usernameSuffixAttempts     = 5
+)
+
+// usernameInvalidChars 自动生成用户名时去除的字符
+var usernameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// SocialProvider 社交登录提供方
+type SocialProvider struct {
+	*oidc.Provider
+	// LinkByEmail 提供方确认邮箱已验证时，按邮箱关联已有用户
+	LinkByEmail bool
+}
+
+// SocialService 定义外部身份提供方登录接口
+type SocialService interface {
+	// AuthURL 生成跳转到提供方的授权地址，accessToken 不为空时回调将身份绑定到当前用户
+	AuthURL(ctx context.Context, provider, accessToken string) (string, error)
+	// Callback 处理提供方回调，验证 state 和 ID 令牌后登录或注册
+	Callback(ctx context.Context, provider, state, code string) (*auth.LoginResponse, error)
+}
+
+// socialLoginState 跳转到提供方前保存的登录状态
+type socialLoginState struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+	LinkUserID   int64  `json:"link_user_id,omitempty"`
+}
+
+type socialService struct {
+	*authService
+	providers map[string]*SocialProvider
+}
+
+// NewSocialService 创建社交登录服务
+func NewSocialService(repo AuthRepository, providers []*SocialProvider) SocialService {
+	m := make(map[string]*SocialProvider, len(providers))
+	for _, p := range providers {
+		m[p.Name()] = p
+	}
+	return &socialService{authService: &authService{repo: repo}, providers: m}
+}
+
+// AuthURL 生成跳转到提供方的授权地址
+func (s *socialService) AuthURL(ctx context.Context, provider, accessToken string) (string, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return "", auth.ErrIdentityProviderNotFound
+	}
+
+	st := socialLoginState{Provider: provider}
+	if accessToken != "" {
+		claims, err := s.parseAccessToken(ctx, accessToken)
+		if err != nil {
+			return "", err
+		}
+		if claims.ClientID != "" {
+			return "", auth.ErrInvalidToken
+		}
+		st.LinkUserID = claims.UserID
+	}
+
+	state, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+	if st.Nonce, err = generateToken(); err != nil {
+		return "", err
+	}
+	if st.CodeVerifier, err = generateToken(); err != nil {
+		return "", err
+	}
+	authURL, err := p.AuthCodeURL(ctx, state, st.Nonce, st.CodeVerifier)
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(st)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal login state failed")
+	}
+	if err := redis.SaveOIDCState(ctx, state, string(payload), SocialLoginStateExpiration); err != nil {
+		return "", errors.Wrap(err, "save login state failed")
+	}
+	return authURL, nil
+}
+
+// Callback 处理提供方回调
+func (s *socialService) Callback(ctx context.Context, provider, state, code string) (resp *auth.LoginResponse, err error) {
+	event := newAuditEvent(ctx, AuditLoginSocial, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, auth.ErrIdentityProviderNotFound
+	}
+
+	// state 只能使用一次，且必须由同一提供方发起
+	payload, err := redis.TakeOIDCState(ctx, state)
+	if err != nil {
+		return nil, errors.Wrap(err, "get login state failed")
+	}
+	var st socialLoginState
+	if payload == "" || json.Unmarshal([]byte(payload), &st) != nil || st.Provider != provider {
+		return nil, auth.ErrInvalidLoginState
+	}
+
+	token, err := p.Exchange(ctx, code, st.CodeVerifier)
+	if err != nil {
+		return nil, errors.Wrap(err, "exchange authorization code failed")
+	}
+	claims, err := p.VerifyIDToken(ctx, token.IDToken, st.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", auth.ErrInvalidToken, err)
+	}
+
+	user, err := s.resolveUser(p, claims, st.LinkUserID)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID, event.Username = user.ID, user.Username
+	if err := checkUserActive(user); err != nil {
+		return nil, err
+	}
+	if err := checkLoginVerified(user); err != nil {
+		return nil, err
+	}
+	if user.TwoFactorEnabled {
+		event.Type = AuditLoginChallenge
+		return s.createLoginChallenge(ctx, user)
+	}
+
+	accessToken, refreshToken, err := s.createAndCacheTokens(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.LoginResponse{
+		Base: &auth.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		Data: &auth.LoginData{
+			Token:        accessToken,
+			RefreshToken: refreshToken,
+		},
+	}, nil
+}
+
+// resolveUser 查找外部身份对应的用户：已绑定的用户、发起绑定的当前用户、邮箱相同的用户，都没有时注册新用户
+func (s *socialService) resolveUser(p *SocialProvider, claims *oidc.IDTokenClaims, linkUserID int64) (*mysql.User, error) {
+	identity, err := s.repo.GetUserIdentity(p.Name(), claims.Subject)
+	if err != nil && err != mysql.ErrRecordNotFound {
+		return nil, err
+	}
+	if identity != nil {
+		if linkUserID != 0 && identity.UserID != linkUserID {
+			return nil, auth.ErrIdentityAlreadyLinked
+		}
+		return s.repo.GetUserByID(identity.UserID)
+	}
+
+	var user *mysql.User
+	switch {
+	case linkUserID != 0:
+		if user, err = s.repo.GetUserByID(linkUserID); err != nil {
+			return nil, err
+		}
+	case p.LinkByEmail && claims.EmailVerified && claims.Email != "":
+		if user, err = s.repo.GetUserByEmail(claims.Email); err != nil && err != mysql.ErrRecordNotFound {
+			return nil, err
+		}
+	}
+	if user == nil {
+		if user, err = s.registerSocialUser(claims); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.CreateUserIdentity(&mysql.UserIdentity{
+		UserID:   user.ID,
+		Provider: p.Name(),
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+	}); err != nil {
+		return nil, errors.Wrap(err, "create user identity failed")
+	}
+	return user, nil
+}
+
+// registerSocialUser 使用外部身份信息注册用户，密码为随机值，用户只能通过社交登录或重置密码登录
+func (s *socialService) registerSocialUser(claims *oidc.IDTokenClaims) (*mysql.User, error) {
+	username, err := s.uniqueUsername(claims)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := hashPassword(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &mysql.User{
+		Username: username,
+		Password: hashedPassword,
+		Status:   UserStatusNormal,
+	}
+	// 只保存已验证且未被占用的邮箱
+	if claims.EmailVerified && claims.Email != "" {
+		existing, err := s.repo.GetUserByEmail(claims.Email)
+		if err != nil && err != mysql.ErrRecordNotFound {
+			return nil, err
+		}
+		if existing == nil {
+			// 提供方已验证邮箱，无需再次验证
+			now := time.Now()
+			user.Email = claims.Email
+			user.EmailVerifiedAt = &now
+		}
+	}
+
+	if err := s.repo.CreateUser(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// uniqueUsername 根据外部身份生成未被占用的用户名，冲突时追加随机后缀
+func (s *socialService) uniqueUsername(claims *oidc.IDTokenClaims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+	base = usernameInvalidChars.ReplaceAllString(base, "")
+	if base == "" {
+		base = "user"
+	}
+	if len(base) > maxUsernameLength-7 {
+		base = base[:maxUsernameLength-7]
+	}
+
+	candidate := base
+	for i := 0; i < usernameSuffixAttempts; i++ {
+		exists, err := s.repo.CheckUserExists(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+		if err != nil {
+			return "", errors.Wrap(err, "generate username suffix failed")
+		}
+		candidate = fmt.Sprintf("%s_%06d", base, n.Int64())
+	}
+	return "", errors.New("generate unique username failed")
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+	"TikTokMall/app/auth/pkg/oidc"
+	"TikTokMall/app/auth/pkg/oidc/oidctest"
+)
+
+// socialTestEnv 带状态的社交登录测试环境，用户、绑定关系和令牌保存在内存中，身份提供方在进程内运行
+type socialTestEnv struct {
+	*servicemock.MockAuthRepository
+	svc        SocialService
+	idp        *oidctest.Server
+	users      map[int64]*mysql.User
+	identities []*mysql.UserIdentity
+	tokens     []*mysql.Token
+}
+
+func newSocialTestEnv(t *testing.T, linkByEmail bool) *socialTestEnv {
+	idp := oidctest.NewServer("mall", "mall-secret")
+	t.Cleanup(idp.Close)
+
+	env := &socialTestEnv{
+		MockAuthRepository: new(servicemock.MockAuthRepository),
+		idp:                idp,
+		users: map[int64]*mysql.User{
+			1: {ID: 1, Username: "fakeuser", Email: "fake@example.com", Status: UserStatusNormal},
+		},
+	}
+	provider := oidc.NewProvider(oidc.Config{
+		Name:         "fake",
+		Issuer:       idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RedirectURL:  "https://mall.example.com/v1/auth/oidc/fake/callback",
+	}, idp.Client())
+
+	redis.Client = &mockRedis{}
+	env.svc = NewSocialService(env, []*SocialProvider{{Provider: provider, LinkByEmail: linkByEmail}})
+	return env
+}
+
+func (env *socialTestEnv) GetUserByID(id int64) (*mysql.User, error) {
+	if u, ok := env.users[id]; ok {
+		return u, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *socialTestEnv) GetUserByEmail(email string) (*mysql.User, error) {
+	for _, u := range env.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *socialTestEnv) CheckUserExists(username string) (bool, error) {
+	for _, u := range env.users {
+		if u.Username == username {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (env *socialTestEnv) CreateUser(user *mysql.User) error {
+	user.ID = int64(len(env.users) + 1)
+	env.users[user.ID] = user
+	return nil
+}
+
+func (env *socialTestEnv) GetUserIdentity(provider, subject string) (*mysql.UserIdentity, error) {
+	for _, identity := range env.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *socialTestEnv) CreateUserIdentity(identity *mysql.UserIdentity) error {
+	env.identities = append(env.identities, identity)
+	return nil
+}
+
+func (env *socialTestEnv) CreateToken(token *mysql.Token) error {
+	env.tokens = append(env.tokens, token)
+	return nil
+}
+
+// authorize 获取授权地址并模拟浏览器在提供方完成登录，返回回调中的 state 和授权码
+func (env *socialTestEnv) authorize(t *testing.T, accessToken string) (state, code string) {
+	authURL, err := env.svc.AuthURL(context.Background(), "fake", accessToken)
+	require.NoError(t, err)
+
+	client := env.idp.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	return location.Query().Get("state"), location.Query().Get("code")
+}
+
+// login 完成一次社交登录，返回访问令牌对应的用户 ID
+func (env *socialTestEnv) login(t *testing.T, accessToken string) int64 {
+	state, code := env.authorize(t, accessToken)
+	resp, err := env.svc.Callback(context.Background(), "fake", state, code)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Data.Token)
+	assert.NotEmpty(t, resp.Data.RefreshToken)
+
+	claims, err := jwt.Parse(resp.Data.Token)
+	require.NoError(t, err)
+	return claims.UserID
+}
+
+func TestSocial_RegisterNewUser(t *testing.T) {
+	env := newSocialTestEnv(t, false)
+
+	// 未开启邮箱关联时注册新用户，用户名冲突追加后缀，已被占用的邮箱不保存
+	userID := env.login(t, "")
+	require.Equal(t, int64(2), userID)
+	user := env.users[userID]
+	assert.Regexp(t, `^fakeuser_\d{6}$`, user.Username)
+	assert.Empty(t, user.Email)
+	assert.NotEmpty(t, user.Password)
+	require.Len(t, env.identities, 1)
+	assert.Equal(t, "fake-user-1", env.identities[0].Subject)
+
+	// 再次登录使用已绑定的用户
+	assert.Equal(t, userID, env.login(t, ""))
+	assert.Len(t, env.users, 2)
+	assert.Len(t, env.identities, 1)
+}
+
+func TestSocial_LinkByEmail(t *testing.T) {
+	env := newSocialTestEnv(t, true)
+
+	assert.Equal(t, int64(1), env.login(t, ""))
+	assert.Len(t, env.users, 1)
+
+	// 未验证的邮箱不关联已有用户
+	env.idp.SetUser(oidctest.User{Subject: "fake-user-2", Email: "fake@example.com", PreferredUsername: "other"})
+	userID := env.login(t, "")
+	assert.Equal(t, int64(2), userID)
+	assert.Equal(t, "other", env.users[userID].Username)
+}
+
+func TestSocial_LinkCurrentUser(t *testing.T) {
+	env := newSocialTestEnv(t, false)
+	ctx := context.Background()
+	env.users[2] = &mysql.User{ID: 2, Username: "shopper", Status: UserStatusNormal}
+	token := signTestToken(t, 2, "shopper", time.Minute)
+
+	assert.Equal(t, int64(2), env.login(t, token))
+	require.Len(t, env.identities, 1)
+	assert.Equal(t, int64(2), env.identities[0].UserID)
+
+	// 已绑定到其他用户的身份不能再次绑定
+	other := signTestToken(t, 1, "fakeuser", time.Minute)
+	state, code := env.authorize(t, other)
+	_, err := env.svc.Callback(ctx, "fake", state, code)
+	assert.ErrorIs(t, err, auth.ErrIdentityAlreadyLinked)
+
+	// 无效的访问令牌不能发起绑定
+	_, err = env.svc.AuthURL(ctx, "fake", "invalid")
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestSocial_CallbackRejects(t *testing.T) {
+	env := newSocialTestEnv(t, false)
+	ctx := context.Background()
+
+	_, err := env.svc.AuthURL(ctx, "unknown", "")
+	assert.ErrorIs(t, err, auth.ErrIdentityProviderNotFound)
+
+	// state 只能使用一次
+	state, code := env.authorize(t, "")
+	_, err = env.svc.Callback(ctx, "fake", state, code)
+	require.NoError(t, err)
+	_, err = env.svc.Callback(ctx, "fake", state, code)
+	assert.ErrorIs(t, err, auth.ErrInvalidLoginState)
+
+	// 伪造的 state
+	_, code = env.authorize(t, "")
+	_, err = env.svc.Callback(ctx, "fake", "forged", code)
+	assert.ErrorIs(t, err, auth.ErrInvalidLoginState)
+
+	// ID 令牌中的 nonce 与发起登录时不一致
+	env.idp.Mutate = func(c gojwt.MapClaims) { c["nonce"] = "replayed" }
+	state, code = env.authorize(t, "")
+	_, err = env.svc.Callback(ctx, "fake", state, code)
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestSocial_BannedAndTwoFactor(t *testing.T) {
+	env := newSocialTestEnv(t, true)
+	ctx := context.Background()
+
+	env.users[1].TwoFactorEnabled = true
+	state, code := env.authorize(t, "")
+	resp, err := env.svc.Callback(ctx, "fake", state, code)
+	require.NoError(t, err)
+	assert.True(t, resp.Data.TwoFactorRequired)
+	assert.NotEmpty(t, resp.Data.ChallengeToken)
+	assert.Empty(t, resp.Data.Token)
+
+	env.users[1].Status = UserStatusBanned
+	state, code = env.authorize(t, "")
+	_, err = env.svc.Callback(ctx, "fake", state, code)
+	assert.ErrorIs(t, err, auth.ErrUserBanned)
+}
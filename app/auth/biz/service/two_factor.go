@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/pkg/errors"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/encrypt"
+)
+
+const (
+	// 二次验证相关配置
+	TwoFactorIssuer              = "TikTokMall"    // otpauth URI 中的签发方
+	TwoFactorChallengeExpiration = 5 * time.Minute // 挑战令牌有效期
+	MaxTwoFactorAttempts         = 5               // 单个挑战令牌最大尝试次数
+	RecoveryCodeCount            = 10              // 恢复码数量
+	totpPeriod                   = 30              // TOTP 时间步长（秒）
+	totpSkew                     = 1               // 允许前后各一个时间步长
+)
+
+// createLoginChallenge 创建二次验证挑战令牌
+func (s *authService) createLoginChallenge(ctx context.Context, user *mysql.User) (*auth.LoginResponse, error) {
+	challenge, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	if err := redis.SaveLoginChallenge(ctx, challenge, user.ID, TwoFactorChallengeExpiration); err != nil {
+		return nil, errors.Wrap(err, "save login challenge failed")
+	}
+
+	return &auth.LoginResponse{
+		Base: &auth.BaseResp{
+			Code:    0,
+			Message: "two-factor authentication required",
+		},
+		Data: &auth.LoginData{
+			TwoFactorRequired: true,
+			ChallengeToken:    challenge,
+		},
+	}, nil
+}
+
+// LoginTwoFactor 使用挑战令牌和验证码完成登录
+func (s *authService) LoginTwoFactor(ctx context.Context, req *auth.TwoFactorLoginRequest) (resp *auth.LoginResponse, err error) {
+	event := newAuditEvent(ctx, AuditLoginTwoFactor, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	userID, err := redis.GetLoginChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "get login challenge failed")
+	}
+	if userID == 0 {
+		return nil, auth.ErrInvalidToken
+	}
+	event.UserID = userID
+
+	attempts, err := redis.IncrLoginChallengeAttempts(ctx, req.ChallengeToken, TwoFactorChallengeExpiration)
+	if err != nil {
+		return nil, errors.Wrap(err, "increment challenge attempts failed")
+	}
+	if attempts > MaxTwoFactorAttempts {
+		if err := redis.DeleteLoginChallenge(ctx, req.ChallengeToken); err != nil {
+			hlog.CtxWarnf(ctx, "delete login challenge failed: %v", err)
+		}
+		return nil, auth.ErrTooManyAttempts
+	}
+
+	user, err := s.repo.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserActive(user); err != nil {
+		return nil, err
+	}
+
+	ok, err := s.verifyTwoFactorCode(ctx, user, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, auth.ErrInvalidTwoFactorCode
+	}
+
+	// 挑战令牌只能使用一次
+	if err := redis.DeleteLoginChallenge(ctx, req.ChallengeToken); err != nil {
+		hlog.CtxWarnf(ctx, "delete login challenge failed: %v", err)
+	}
+
+	token, refreshToken, err := s.createAndCacheTokens(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.LoginResponse{
+		Base: &auth.BaseResp{
+			Code:    0,
+			Message: "success",
+		},
+		Data: &auth.LoginData{
+			Token:        token,
+			RefreshToken: refreshToken,
+		},
+	}, nil
+}
+
+// EnrollTwoFactor 生成 TOTP 密钥和恢复码，确认后生效
+func (s *authService) EnrollTwoFactor(ctx context.Context, req *auth.EnrollTwoFactorRequest) (*auth.EnrollTwoFactorResponse, error) {
+	user, err := s.currentUser(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if user.TwoFactorEnabled {
+		return nil, auth.ErrTwoFactorAlreadyEnabled
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      TwoFactorIssuer,
+		AccountName: user.Username,
+		Period:      totpPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "generate totp key failed")
+	}
+
+	encryptedSecret, err := encrypt.Encrypt(key.Secret())
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt totp secret failed")
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateUserTwoFactor(user.ID, false, encryptedSecret, hashes); err != nil {
+		return nil, err
+	}
+
+	return &auth.EnrollTwoFactorResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		Data: &auth.EnrollTwoFactorData{
+			Secret:        key.Secret(),
+			OtpauthUri:    key.URL(),
+			RecoveryCodes: codes,
+		},
+	}, nil
+}
+
+// ConfirmTwoFactor 校验首个验证码后开启二次验证
+func (s *authService) ConfirmTwoFactor(ctx context.Context, req *auth.ConfirmTwoFactorRequest) (resp *auth.ConfirmTwoFactorResponse, err error) {
+	event := newAuditEvent(ctx, AuditTwoFactorEnable, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	user, err := s.currentUser(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID, event.Username = user.ID, user.Username
+	if user.TwoFactorEnabled {
+		return nil, auth.ErrTwoFactorAlreadyEnabled
+	}
+	if user.TwoFactorSecret == "" {
+		return nil, auth.ErrTwoFactorNotEnrolled
+	}
+
+	// 确认阶段只接受 TOTP 验证码，保证用户已正确添加到验证器
+	ok, err := s.verifyTOTP(ctx, user, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, auth.ErrInvalidTwoFactorCode
+	}
+
+	if err := s.repo.UpdateUserTwoFactor(user.ID, true, user.TwoFactorSecret, user.TwoFactorRecoveryCodes); err != nil {
+		return nil, err
+	}
+
+	return &auth.ConfirmTwoFactorResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// DisableTwoFactor 校验验证码或恢复码后关闭二次验证
+func (s *authService) DisableTwoFactor(ctx context.Context, req *auth.DisableTwoFactorRequest) (resp *auth.DisableTwoFactorResponse, err error) {
+	event := newAuditEvent(ctx, AuditTwoFactorDisable, 0, "")
+	defer func() { recordAudit(event, err) }()
+
+	user, err := s.currentUser(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID, event.Username = user.ID, user.Username
+	if !user.TwoFactorEnabled {
+		return nil, auth.ErrTwoFactorNotEnabled
+	}
+
+	// 必须提供当前的 TOTP 验证码或恢复码，RPC 调用不经过参数校验
+	if req.Code == "" {
+		return nil, auth.ErrInvalidTwoFactorCode
+	}
+	ok, err := s.verifyTwoFactorCode(ctx, user, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, auth.ErrInvalidTwoFactorCode
+	}
+
+	if err := s.repo.UpdateUserTwoFactor(user.ID, false, "", ""); err != nil {
+		return nil, err
+	}
+
+	return &auth.DisableTwoFactorResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// currentUser 根据访问令牌获取当前用户，第三方应用令牌不能管理两步验证
+func (s *authService) currentUser(ctx context.Context, token string) (*mysql.User, error) {
+	claims, err := s.parseSessionToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetUserByID(claims.UserID)
+}
+
+// verifyTwoFactorCode 校验 TOTP 验证码，失败时尝试使用恢复码
+func (s *authService) verifyTwoFactorCode(ctx context.Context, user *mysql.User, code string) (bool, error) {
+	ok, err := s.verifyTOTP(ctx, user, code)
+	if err != nil || ok {
+		return ok, err
+	}
+	return s.useRecoveryCode(user, code)
+}
+
+// verifyTOTP 校验 TOTP 验证码，同一验证码在有效窗口内只能使用一次
+func (s *authService) verifyTOTP(ctx context.Context, user *mysql.User, code string) (bool, error) {
+	secret, err := encrypt.Decrypt(user.TwoFactorSecret)
+	if err != nil {
+		return false, errors.Wrap(err, "decrypt totp secret failed")
+	}
+
+	code = strings.TrimSpace(code)
+	valid, err := totp.ValidateCustom(code, secret, time.Now(), totp.ValidateOpts{
+		Period:    totpPeriod,
+		Skew:      totpSkew,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	if err != nil || !valid {
+		return false, nil
+	}
+
+	// 防止验证码在有效窗口内被重放
+	fresh, err := redis.MarkTOTPCodeUsed(ctx, user.ID, code, time.Duration(totpPeriod*(2*totpSkew+1))*time.Second)
+	if err != nil {
+		return false, errors.Wrap(err, "mark totp code used failed")
+	}
+	return fresh, nil
+}
+
+// useRecoveryCode 校验并消耗恢复码
+func (s *authService) useRecoveryCode(user *mysql.User, code string) (bool, error) {
+	if user.TwoFactorRecoveryCodes == "" {
+		return false, nil
+	}
+	var hashes []string
+	if err := json.Unmarshal([]byte(user.TwoFactorRecoveryCodes), &hashes); err != nil {
+		return false, errors.Wrap(err, "decode recovery codes failed")
+	}
+
+	target := hashRecoveryCode(code)
+	for i, h := range hashes {
+		if h != target {
+			continue
+		}
+		remaining := append(hashes[:i:i], hashes[i+1:]...)
+		data, err := json.Marshal(remaining)
+		if err != nil {
+			return false, err
+		}
+		// 以读取到的恢复码为条件更新，并发使用同一恢复码时只有一个请求成功
+		ok, err := s.repo.ReplaceRecoveryCodes(user.ID, user.TwoFactorRecoveryCodes, string(data))
+		if err != nil || !ok {
+			return false, err
+		}
+		user.TwoFactorRecoveryCodes = string(data)
+		return true, nil
+	}
+	return false, nil
+}
+
+// generateRecoveryCodes 生成恢复码，返回明文及其摘要的 JSON
+func generateRecoveryCodes() ([]string, string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+	for i := 0; i < RecoveryCodeCount; i++ {
+		b := make([]byte, 8)
+		if _, err := rand.Read(b); err != nil {
+			return nil, "", errors.Wrap(err, "generate recovery code failed")
+		}
+		raw := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)[:10]
+		code := raw[:5] + "-" + raw[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	data, err := json.Marshal(hashes)
+	if err != nil {
+		return nil, "", err
+	}
+	return codes, string(data), nil
+}
+
+// hashRecoveryCode 计算恢复码摘要，忽略大小写和分隔符
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
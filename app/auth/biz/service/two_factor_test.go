@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+)
+
+// newTwoFactorTestService 创建带有状态的 mock 仓库，UpdateUserTwoFactor 会直接修改返回的用户
+func newTwoFactorTestService(t *testing.T) (AuthService, *mysql.User, string) {
+	hashedPassword, err := hashPassword("password123")
+	require.NoError(t, err)
+	user := &mysql.User{
+		ID:       1,
+		Username: "testuser",
+		Password: hashedPassword,
+		Status:   UserStatusNormal,
+	}
+
+	mockRepo := new(servicemock.MockAuthRepository)
+	mockRepo.On("GetUserByID", int64(1)).Return(user, nil)
+	mockRepo.On("GetUserByUsername", "testuser").Return(user, nil)
+	mockRepo.On("CreateToken", mock.Anything).Return(nil)
+	mockRepo.On("UpdateUserTwoFactor", int64(1), mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		user.TwoFactorEnabled = args.Bool(1)
+		user.TwoFactorSecret = args.String(2)
+		user.TwoFactorRecoveryCodes = args.String(3)
+	}).Return(nil)
+	mockRepo.On("ReplaceRecoveryCodes", int64(1), mock.Anything, mock.Anything).Return(func(_ int64, current, remaining string) bool {
+		if user.TwoFactorRecoveryCodes != current {
+			return false
+		}
+		user.TwoFactorRecoveryCodes = remaining
+		return true
+	}, nil)
+
+	redis.Client = &mockRedis{}
+	return NewAuthService(mockRepo), user, signTestToken(t, 1, "testuser", time.Minute)
+}
+
+// enableTwoFactor 完成绑定和确认，返回 TOTP 密钥和恢复码
+func enableTwoFactor(t *testing.T, svc AuthService, token string) (string, []string) {
+	ctx := context.Background()
+	enroll, err := svc.EnrollTwoFactor(ctx, &auth.EnrollTwoFactorRequest{Token: token})
+	require.NoError(t, err)
+	require.Len(t, enroll.Data.RecoveryCodes, RecoveryCodeCount)
+	assert.Contains(t, enroll.Data.OtpauthUri, "otpauth://totp/")
+
+	code, err := totp.GenerateCode(enroll.Data.Secret, time.Now())
+	require.NoError(t, err)
+	_, err = svc.ConfirmTwoFactor(ctx, &auth.ConfirmTwoFactorRequest{Token: token, Code: code})
+	require.NoError(t, err)
+	return enroll.Data.Secret, enroll.Data.RecoveryCodes
+}
+
+// loginChallenge 使用密码登录并返回挑战令牌
+func loginChallenge(t *testing.T, svc AuthService) string {
+	resp, err := svc.Login(context.Background(), &auth.LoginRequest{Username: "testuser", Password: "password123"})
+	require.NoError(t, err)
+	require.True(t, resp.Data.TwoFactorRequired)
+	assert.Empty(t, resp.Data.Token)
+	require.NotEmpty(t, resp.Data.ChallengeToken)
+	return resp.Data.ChallengeToken
+}
+
+func TestTwoFactor_EnrollAndLogin(t *testing.T) {
+	svc, user, token := newTwoFactorTestService(t)
+	ctx := context.Background()
+
+	secret, _ := enableTwoFactor(t, svc, token)
+	assert.True(t, user.TwoFactorEnabled)
+	assert.NotEqual(t, secret, user.TwoFactorSecret, "secret must be stored encrypted")
+
+	challenge := loginChallenge(t, svc)
+
+	_, err := svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: challenge, Code: "000000"})
+	assert.ErrorIs(t, err, auth.ErrInvalidTwoFactorCode)
+
+	// 确认时使用过的验证码不能再次使用，等待下一个时间步长的验证码
+	code, err := totp.GenerateCode(secret, time.Now().Add(totpPeriod*time.Second))
+	require.NoError(t, err)
+	resp, err := svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: challenge, Code: code})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Data.Token)
+	assert.NotEmpty(t, resp.Data.RefreshToken)
+
+	// 挑战令牌只能使用一次
+	_, err = svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: challenge, Code: code})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestTwoFactor_ReplayedCode(t *testing.T) {
+	svc, _, token := newTwoFactorTestService(t)
+	ctx := context.Background()
+
+	secret, _ := enableTwoFactor(t, svc, token)
+	code, err := totp.GenerateCode(secret, time.Now().Add(totpPeriod*time.Second))
+	require.NoError(t, err)
+
+	_, err = svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: loginChallenge(t, svc), Code: code})
+	require.NoError(t, err)
+
+	_, err = svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: loginChallenge(t, svc), Code: code})
+	assert.ErrorIs(t, err, auth.ErrInvalidTwoFactorCode)
+}
+
+func TestTwoFactor_RecoveryCode(t *testing.T) {
+	svc, user, token := newTwoFactorTestService(t)
+	ctx := context.Background()
+
+	_, codes := enableTwoFactor(t, svc, token)
+
+	resp, err := svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: loginChallenge(t, svc), Code: codes[0]})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Data.Token)
+	assert.NotContains(t, user.TwoFactorRecoveryCodes, hashRecoveryCode(codes[0]))
+
+	// 恢复码只能使用一次
+	_, err = svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: loginChallenge(t, svc), Code: codes[0]})
+	assert.ErrorIs(t, err, auth.ErrInvalidTwoFactorCode)
+}
+
+func TestTwoFactor_RecoveryCodeConcurrentUse(t *testing.T) {
+	svc, user, token := newTwoFactorTestService(t)
+	_, codes := enableTwoFactor(t, svc, token)
+
+	// 两个请求读取到相同的恢复码列表后先后消耗同一个恢复码
+	first, second := *user, *user
+	ok, err := svc.(*authService).useRecoveryCode(&first, codes[0])
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = svc.(*authService).useRecoveryCode(&second, codes[0])
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestTwoFactor_TooManyAttempts(t *testing.T) {
+	svc, _, token := newTwoFactorTestService(t)
+	ctx := context.Background()
+
+	enableTwoFactor(t, svc, token)
+	challenge := loginChallenge(t, svc)
+
+	for i := 0; i < MaxTwoFactorAttempts; i++ {
+		_, err := svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: challenge, Code: "000000"})
+		assert.ErrorIs(t, err, auth.ErrInvalidTwoFactorCode)
+	}
+	_, err := svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: challenge, Code: "000000"})
+	assert.ErrorIs(t, err, auth.ErrTooManyAttempts)
+
+	// 超过次数后挑战令牌失效
+	_, err = svc.LoginTwoFactor(ctx, &auth.TwoFactorLoginRequest{ChallengeToken: challenge, Code: "000000"})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestTwoFactor_Disable(t *testing.T) {
+	svc, user, token := newTwoFactorTestService(t)
+	ctx := context.Background()
+
+	_, err := svc.DisableTwoFactor(ctx, &auth.DisableTwoFactorRequest{Token: token, Code: "000000"})
+	assert.ErrorIs(t, err, auth.ErrTwoFactorNotEnabled)
+
+	_, codes := enableTwoFactor(t, svc, token)
+
+	_, err = svc.EnrollTwoFactor(ctx, &auth.EnrollTwoFactorRequest{Token: token})
+	assert.ErrorIs(t, err, auth.ErrTwoFactorAlreadyEnabled)
+
+	_, err = svc.DisableTwoFactor(ctx, &auth.DisableTwoFactorRequest{Token: token, Code: "000000"})
+	assert.ErrorIs(t, err, auth.ErrInvalidTwoFactorCode)
+	_, err = svc.DisableTwoFactor(ctx, &auth.DisableTwoFactorRequest{Token: token})
+	assert.ErrorIs(t, err, auth.ErrInvalidTwoFactorCode)
+
+	_, err = svc.DisableTwoFactor(ctx, &auth.DisableTwoFactorRequest{Token: token, Code: codes[1]})
+	require.NoError(t, err)
+	assert.False(t, user.TwoFactorEnabled)
+	assert.Empty(t, user.TwoFactorSecret)
+
+	// 关闭后直接返回令牌
+	resp, err := svc.Login(ctx, &auth.LoginRequest{Username: "testuser", Password: "password123"})
+	require.NoError(t, err)
+	assert.False(t, resp.Data.TwoFactorRequired)
+	assert.NotEmpty(t, resp.Data.Token)
+}
+
+func TestTwoFactor_RejectsClientToken(t *testing.T) {
+	svc, user, token := newTwoFactorTestService(t)
+	ctx := context.Background()
+
+	// 第三方应用令牌不能开启或关闭两步验证
+	claims := jwt.NewClaims(1, "testuser", time.Minute)
+	claims.ClientID = "erp"
+	appToken, err := jwt.Sign(claims)
+	require.NoError(t, err)
+
+	_, err = svc.EnrollTwoFactor(ctx, &auth.EnrollTwoFactorRequest{Token: appToken})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	secret, _ := enableTwoFactor(t, svc, token)
+	code, err := totp.GenerateCode(secret, time.Now().Add(totpPeriod*time.Second))
+	require.NoError(t, err)
+	_, err = svc.DisableTwoFactor(ctx, &auth.DisableTwoFactorRequest{Token: appToken, Code: code})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	assert.True(t, user.TwoFactorEnabled)
+}
+
+func TestTwoFactor_ConfirmRequiresEnroll(t *testing.T) {
+	svc, _, token := newTwoFactorTestService(t)
+
+	_, err := svc.ConfirmTwoFactor(context.Background(), &auth.ConfirmTwoFactorRequest{Token: token, Code: "123456"})
+	assert.ErrorIs(t, err, auth.ErrTwoFactorNotEnrolled)
+}
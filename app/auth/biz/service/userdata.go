@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+)
+
+// UserDataRepository 个人数据导出和注销使用的存储
+type UserDataRepository interface {
+	GetUserData(userID int64) (*mysql.UserData, error)
+	EraseUserData(userID int64) ([]*mysql.Token, error) // 返回被删除的令牌，用于加入黑名单
+}
+
+// UserDataHandler 处理用户服务发布的个人数据事件，实现 userdata.Handler
+type UserDataHandler struct {
+	repo UserDataRepository
+}
+
+// NewUserDataHandler 创建个人数据事件处理器
+func NewUserDataHandler(repo UserDataRepository) *UserDataHandler {
+	return &UserDataHandler{repo: repo}
+}
+
+// authExport 认证服务导出的数据，不包含密码哈希、密钥和令牌摘要
+type authExport struct {
+	EmailVerifiedAt  *time.Time           `json:"email_verified_at,omitempty"`
+	PhoneVerifiedAt  *time.Time           `json:"phone_verified_at,omitempty"`
+	TwoFactorEnabled bool                 `json:"two_factor_enabled"`
+	Roles            []string             `json:"roles"`
+	Identities       []identityExport     `json:"identities"`
+	Passkeys         []passkeyExport      `json:"passkeys"`
+	APIKeys          []apiKeyExport       `json:"api_keys"`
+	OAuthConsents    []oauthConsentExport `json:"oauth_consents"`
+	Bans             []banExport          `json:"bans"`
+	Sessions         []sessionExport      `json:"sessions"`
+	AuditLogs        []auditLogExport     `json:"audit_logs"`
+}
+
+type identityExport struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type passkeyExport struct {
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+type apiKeyExport struct {
+	KeyID      string     `json:"key_id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type oauthConsentExport struct {
+	ClientID  string    `json:"client_id"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type banExport struct {
+	Reason    string     `json:"reason"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	LiftedAt  *time.Time `json:"lifted_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type sessionExport struct {
+	DeviceName string     `json:"device_name,omitempty"`
+	UserAgent  string     `json:"user_agent,omitempty"`
+	IP         string     `json:"ip,omitempty"`
+	ClientID   string     `json:"client_id,omitempty"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	ExpiredAt  time.Time  `json:"expired_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type auditLogExport struct {
+	Event     string    `json:"event"`
+	Outcome   string    `json:"outcome"`
+	Reason    string    `json:"reason,omitempty"`
+	IP        string    `json:"ip,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Export 导出用户的认证数据
+func (h *UserDataHandler) Export(ctx context.Context, userID int64) (interface{}, error) {
+	data, err := h.repo.GetUserData(userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user data failed")
+	}
+
+	result := &authExport{
+		Roles:         append([]string{RoleUser}, data.Roles...),
+		Identities:    []identityExport{},
+		Passkeys:      []passkeyExport{},
+		APIKeys:       []apiKeyExport{},
+		OAuthConsents: []oauthConsentExport{},
+		Bans:          []banExport{},
+		Sessions:      []sessionExport{},
+		AuditLogs:     []auditLogExport{},
+	}
+	if data.User != nil {
+		result.EmailVerifiedAt = data.User.EmailVerifiedAt
+		result.PhoneVerifiedAt = data.User.PhoneVerifiedAt
+		result.TwoFactorEnabled = data.User.TwoFactorEnabled
+	}
+	for _, i := range data.Identities {
+		result.Identities = append(result.Identities, identityExport{
+			Provider:  i.Provider,
+			Subject:   i.Subject,
+			Email:     i.Email,
+			CreatedAt: i.CreatedAt,
+		})
+	}
+	for _, p := range data.Passkeys {
+		result.Passkeys = append(result.Passkeys, passkeyExport{
+			Name:       p.Name,
+			CreatedAt:  p.CreatedAt,
+			LastUsedAt: p.LastUsedAt,
+		})
+	}
+	for _, k := range data.APIKeys {
+		result.APIKeys = append(result.APIKeys, apiKeyExport{
+			KeyID:      k.KeyID,
+			Name:       k.Name,
+			Scopes:     strings.Fields(k.Scopes),
+			ExpiresAt:  k.ExpiresAt,
+			LastUsedAt: k.LastUsedAt,
+			RevokedAt:  k.RevokedAt,
+			CreatedAt:  k.CreatedAt,
+		})
+	}
+	for _, c := range data.Consents {
+		result.OAuthConsents = append(result.OAuthConsents, oauthConsentExport{
+			ClientID:  c.ClientID,
+			Scopes:    strings.Fields(c.Scopes),
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+		})
+	}
+	for _, b := range data.Bans {
+		result.Bans = append(result.Bans, banExport{
+			Reason:    b.Reason,
+			ExpiresAt: b.ExpiresAt,
+			LiftedAt:  b.LiftedAt,
+			CreatedAt: b.CreatedAt,
+		})
+	}
+	for _, t := range data.Sessions {
+		result.Sessions = append(result.Sessions, sessionExport{
+			DeviceName: t.DeviceName,
+			UserAgent:  t.UserAgent,
+			IP:         t.IP,
+			ClientID:   t.ClientID,
+			LastSeenAt: t.LastSeenAt,
+			ExpiredAt:  t.ExpiredAt,
+			CreatedAt:  t.CreatedAt,
+		})
+	}
+	for _, l := range data.AuditLogs {
+		result.AuditLogs = append(result.AuditLogs, auditLogExport{
+			Event:     l.Event,
+			Outcome:   l.Outcome,
+			Reason:    l.Reason,
+			IP:        l.IP,
+			UserAgent: l.UserAgent,
+			CreatedAt: l.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+// Erase 删除用户的凭据和授权，并吊销仍然有效的令牌
+func (h *UserDataHandler) Erase(ctx context.Context, userID int64) error {
+	tokens, err := h.repo.EraseUserData(userID)
+	if err != nil {
+		return errors.Wrap(err, "erase user data failed")
+	}
+	blacklistTokens(ctx, tokens)
+	return nil
+}
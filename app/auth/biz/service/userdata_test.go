@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+)
+
+// memUserDataRepository 内存个人数据存储
+type memUserDataRepository struct {
+	data   map[int64]*mysql.UserData
+	erased []int64
+}
+
+func (m *memUserDataRepository) GetUserData(userID int64) (*mysql.UserData, error) {
+	if d, ok := m.data[userID]; ok {
+		return d, nil
+	}
+	return &mysql.UserData{}, nil
+}
+
+func (m *memUserDataRepository) EraseUserData(userID int64) ([]*mysql.Token, error) {
+	m.erased = append(m.erased, userID)
+	d, ok := m.data[userID]
+	if !ok {
+		return nil, nil
+	}
+	tokens := d.Sessions
+	delete(m.data, userID)
+	return tokens, nil
+}
+
+func TestUserDataHandler_ExportOmitsSecrets(t *testing.T) {
+	now := time.Now()
+	repo := &memUserDataRepository{data: map[int64]*mysql.UserData{
+		1: {
+			User:     &mysql.User{ID: 1, Password: "bcrypt-hash", TwoFactorEnabled: true, TwoFactorSecret: "totp-secret"},
+			Roles:    []string{RoleAdmin},
+			APIKeys:  []*mysql.APIKey{{KeyID: "ak_1", Name: "ci", SecretHash: "secret-hash", Scopes: "read write", ExpiresAt: now}},
+			Passkeys: []*mysql.WebAuthnCredential{{Name: "laptop", PublicKey: []byte("public-key")}},
+			Sessions: []*mysql.Token{{Token: "access-digest", RefreshToken: "refresh-digest", DeviceName: "phone", ExpiredAt: now}},
+		},
+	}}
+	h := NewUserDataHandler(repo)
+
+	data, err := h.Export(context.Background(), 1)
+	require.NoError(t, err)
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	var decoded struct {
+		TwoFactorEnabled bool     `json:"two_factor_enabled"`
+		Roles            []string `json:"roles"`
+		APIKeys          []struct {
+			KeyID  string   `json:"key_id"`
+			Scopes []string `json:"scopes"`
+		} `json:"api_keys"`
+		Sessions []struct {
+			DeviceName string `json:"device_name"`
+		} `json:"sessions"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.True(t, decoded.TwoFactorEnabled)
+	assert.Equal(t, []string{RoleUser, RoleAdmin}, decoded.Roles)
+	require.Len(t, decoded.APIKeys, 1)
+	assert.Equal(t, []string{"read", "write"}, decoded.APIKeys[0].Scopes)
+	require.Len(t, decoded.Sessions, 1)
+	assert.Equal(t, "phone", decoded.Sessions[0].DeviceName)
+
+	for _, secret := range []string{"bcrypt-hash", "totp-secret", "secret-hash", "access-digest", "refresh-digest"} {
+		assert.NotContains(t, string(raw), secret)
+	}
+}
+
+func TestUserDataHandler_EraseRevokesTokens(t *testing.T) {
+	redisClient := &mockRedis{}
+	redis.Client = redisClient
+
+	repo := &memUserDataRepository{data: map[int64]*mysql.UserData{
+		1: {Sessions: []*mysql.Token{
+			{Token: "valid", ExpiredAt: time.Now().Add(time.Hour)},
+			{Token: "expired", ExpiredAt: time.Now().Add(-time.Hour)},
+		}},
+	}}
+	h := NewUserDataHandler(repo)
+
+	require.NoError(t, h.Erase(context.Background(), 1))
+	assert.True(t, redisClient.blacklist["valid"])
+	assert.False(t, redisClient.blacklist["expired"])
+
+	// 重复投递的注销事件不会出错
+	require.NoError(t, h.Erase(context.Background(), 1))
+	assert.Equal(t, []int64{1, 1}, repo.erased)
+}
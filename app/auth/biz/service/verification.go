@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/notify"
+)
+
+const (
+	// 联系方式验证相关配置
+	VerificationCodeExpiration = 10 * time.Minute // 验证码有效期
+	VerificationResendCooldown = time.Minute      // 同一邮箱或手机号两次发送的最小间隔
+	VerificationSendWindow     = time.Hour        // 发送次数统计窗口
+	VerificationSendLimit      = 5                // 窗口内同一邮箱或手机号最多发送次数
+	maxVerificationAttempts    = 5                // 同一验证码最多尝试次数
+	verificationCodeDigits     = 6
+
+	// 验证渠道
+	VerificationChannelEmail = "email"
+	VerificationChannelPhone = "phone"
+)
+
+// VerificationPolicy 未验证账号的限制策略，账号填写的邮箱和手机号都验证后才算已验证
+type VerificationPolicy struct {
+	RequireForLogin    bool // 未验证账号不能登录
+	RequireForCheckout bool // 未验证账号不能下单，由结算服务通过 GetVerificationStatus 查询
+}
+
+// Verification 当前生效的验证策略
+var Verification VerificationPolicy
+
+// verificationCode 保存在 Redis 中的验证码，只保存摘要
+type verificationCode struct {
+	UserID int64  `json:"user_id"`
+	Digest string `json:"digest"`
+}
+
+// ResendVerification 重新发送验证码，账号不存在或已验证时同样返回成功，避免泄露注册信息
+func (s *authService) ResendVerification(ctx context.Context, req *auth.ResendVerificationRequest) (*auth.ResendVerificationResponse, error) {
+	destination := strings.TrimSpace(req.Destination)
+	if err := validateVerificationTarget(req.Channel, destination); err != nil {
+		return nil, err
+	}
+	// 先限流再查询账号，限流结果与账号是否存在无关
+	if err := allowVerificationSend(ctx, req.Channel, destination); err != nil {
+		return nil, err
+	}
+
+	var user *mysql.User
+	var err error
+	if req.Channel == VerificationChannelEmail {
+		user, err = s.repo.GetUserByEmail(destination)
+	} else {
+		user, err = s.repo.GetUserByPhone(destination)
+	}
+	if err != nil && err != mysql.ErrRecordNotFound {
+		return nil, err
+	}
+
+	success := &auth.ResendVerificationResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}
+	if user == nil || checkUserActive(user) != nil || contactVerified(user, req.Channel) {
+		return success, nil
+	}
+	if err := issueVerificationCode(ctx, user.ID, req.Channel, destination); err != nil {
+		return nil, err
+	}
+	return success, nil
+}
+
+// VerifyContact 校验验证码并标记邮箱或手机号已验证
+func (s *authService) VerifyContact(ctx context.Context, req *auth.VerifyContactRequest) (*auth.VerifyContactResponse, error) {
+	destination := strings.TrimSpace(req.Destination)
+	if err := validateVerificationTarget(req.Channel, destination); err != nil {
+		return nil, err
+	}
+	code, err := consumeVerificationCode(ctx, verificationTarget(req.Channel, destination), req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	var marked bool
+	if req.Channel == VerificationChannelEmail {
+		marked, err = s.repo.MarkEmailVerified(code.UserID, destination)
+	} else {
+		marked, err = s.repo.MarkPhoneVerified(code.UserID, destination)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "mark contact verified failed")
+	}
+	// 发送验证码后账号更换了联系方式
+	if !marked {
+		return nil, auth.ErrInvalidVerificationCode
+	}
+
+	return &auth.VerifyContactResponse{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+	}, nil
+}
+
+// GetVerificationStatus 查询用户的验证状态以及验证策略的判定结果
+func (s *authService) GetVerificationStatus(ctx context.Context, req *auth.VerificationStatusReq) (*auth.VerificationStatusResp, error) {
+	user, err := s.repo.GetUserByID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	verified := accountVerified(user)
+	return &auth.VerificationStatusResp{
+		Base: &auth.BaseResp{
+			Code:    int32(consts.StatusOK),
+			Message: "success",
+		},
+		EmailVerified:   user.EmailVerifiedAt != nil,
+		PhoneVerified:   user.PhoneVerifiedAt != nil,
+		LoginAllowed:    verified || !Verification.RequireForLogin,
+		CheckoutAllowed: verified || !Verification.RequireForCheckout,
+	}, nil
+}
+
+// sendRegistrationCodes 注册后向填写的邮箱和手机号发送验证码，发送失败只记录日志，用户可稍后重新发送
+func sendRegistrationCodes(ctx context.Context, user *mysql.User) {
+	for channel, destination := range map[string]string{
+		VerificationChannelEmail: user.Email,
+		VerificationChannelPhone: user.Phone,
+	} {
+		if destination == "" {
+			continue
+		}
+		if err := allowVerificationSend(ctx, channel, destination); err != nil {
+			hlog.CtxWarnf(ctx, "send %s verification code skipped: %v", channel, err)
+			continue
+		}
+		if err := issueVerificationCode(ctx, user.ID, channel, destination); err != nil {
+			hlog.CtxWarnf(ctx, "send %s verification code failed: %v", channel, err)
+		}
+	}
+}
+
+// checkUserActive 封禁、注销中和已注销的用户不能登录或使用令牌
+func checkUserActive(user *mysql.User) error {
+	switch user.Status {
+	case UserStatusBanned:
+		return auth.ErrUserBanned
+	case UserStatusDeleting, UserStatusErased:
+		return auth.ErrUserDeleted
+	}
+	return nil
+}
+
+// checkLoginVerified 按验证策略检查账号是否允许登录
+func checkLoginVerified(user *mysql.User) error {
+	if Verification.RequireForLogin && !accountVerified(user) {
+		return auth.ErrAccountNotVerified
+	}
+	return nil
+}
+
+// accountVerified 账号填写的邮箱和手机号是否都已验证
+func accountVerified(user *mysql.User) bool {
+	return (user.Email == "" || user.EmailVerifiedAt != nil) &&
+		(user.Phone == "" || user.PhoneVerifiedAt != nil)
+}
+
+// contactVerified 指定渠道的联系方式是否已验证
+func contactVerified(user *mysql.User, channel string) bool {
+	if channel == VerificationChannelEmail {
+		return user.EmailVerifiedAt != nil
+	}
+	return user.PhoneVerifiedAt != nil
+}
+
+// allowVerificationSend 检查同一邮箱或手机号的发送频率
+func allowVerificationSend(ctx context.Context, channel, destination string) error {
+	ok, err := redis.AllowVerificationSend(ctx, verificationTarget(channel, destination),
+		VerificationResendCooldown, VerificationSendLimit, VerificationSendWindow)
+	if err != nil {
+		return errors.Wrap(err, "check verification send rate failed")
+	}
+	if !ok {
+		return auth.ErrTooManyAttempts
+	}
+	return nil
+}
+
+// issueVerificationCode 生成验证码，保存摘要后通过对应渠道发送
+func issueVerificationCode(ctx context.Context, userID int64, channel, destination string) error {
+	code, err := generateVerificationCode()
+	if err != nil {
+		return err
+	}
+	target := verificationTarget(channel, destination)
+	payload, err := json.Marshal(verificationCode{UserID: userID, Digest: verificationCodeDigest(target, code)})
+	if err != nil {
+		return errors.Wrap(err, "marshal verification code failed")
+	}
+	if err := redis.SaveVerificationCode(ctx, target, string(payload), VerificationCodeExpiration); err != nil {
+		return errors.Wrap(err, "save verification code failed")
+	}
+
+	msg := &notify.Message{
+		Channel: notify.ChannelEmail,
+		To:      destination,
+		Subject: "验证您的 TikTokMall 账号",
+		Body: fmt.Sprintf("您的验证码为 %s，%d 分钟内有效。如非本人操作请忽略。",
+			code, int(VerificationCodeExpiration/time.Minute)),
+	}
+	if channel == VerificationChannelPhone {
+		msg.Channel = notify.ChannelSMS
+	}
+	if err := notify.Send(ctx, msg); err != nil {
+		return errors.Wrap(err, "send verification code failed")
+	}
+	return nil
+}
+
+// consumeVerificationCode 校验验证码，通过后删除；错误次数达到上限后验证码作废
+func consumeVerificationCode(ctx context.Context, target, input string) (*verificationCode, error) {
+	payload, err := redis.GetVerificationCode(ctx, target)
+	if err != nil {
+		return nil, errors.Wrap(err, "get verification code failed")
+	}
+	var code verificationCode
+	if payload == "" || json.Unmarshal([]byte(payload), &code) != nil {
+		return nil, auth.ErrInvalidVerificationCode
+	}
+
+	digest := verificationCodeDigest(target, input)
+	if subtle.ConstantTimeCompare([]byte(digest), []byte(code.Digest)) != 1 {
+		attempts, err := redis.IncrVerificationAttempts(ctx, target, VerificationCodeExpiration)
+		if err != nil {
+			return nil, errors.Wrap(err, "increase verification attempts failed")
+		}
+		// 尝试次数用尽后验证码作废，需要重新发送
+		if attempts >= maxVerificationAttempts {
+			if err := redis.DeleteVerificationCode(ctx, target); err != nil {
+				hlog.CtxWarnf(ctx, "delete verification code failed: %v", err)
+			}
+		}
+		return nil, auth.ErrInvalidVerificationCode
+	}
+	if err := redis.DeleteVerificationCode(ctx, target); err != nil {
+		hlog.CtxWarnf(ctx, "delete verification code failed: %v", err)
+	}
+	return &code, nil
+}
+
+// validateVerificationTarget 校验验证渠道和目标
+func validateVerificationTarget(channel, destination string) error {
+	if channel != VerificationChannelEmail && channel != VerificationChannelPhone {
+		return fmt.Errorf("%w: channel must be email or phone", auth.ErrInvalidArgument)
+	}
+	if destination == "" {
+		return fmt.Errorf("%w: destination is required", auth.ErrInvalidArgument)
+	}
+	return nil
+}
+
+// verificationTarget 验证码在 Redis 中的 Key 后缀
+func verificationTarget(channel, destination string) string {
+	return channel + ":" + destination
+}
+
+// verificationCodeDigest 验证码摘要绑定验证目标，同一验证码不能用于其他邮箱或手机号
+func verificationCodeDigest(target, code string) string {
+	sum := sha256.Sum256([]byte(target + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateVerificationCode 生成 6 位数字验证码
+func generateVerificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", errors.Wrap(err, "generate verification code failed")
+	}
+	return fmt.Sprintf("%0*d", verificationCodeDigits, n.Int64()), nil
+}
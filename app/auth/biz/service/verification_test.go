@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/notify"
+	"TikTokMall/pkg/tokenhash"
+)
+
+// verificationTestEnv 在密码测试环境的基础上支持注册和标记验证状态，注册的用户替换预置用户
+type verificationTestEnv struct {
+	*passwordTestEnv
+}
+
+func newVerificationTestEnv(t *testing.T) *verificationTestEnv {
+	env := &verificationTestEnv{passwordTestEnv: newPasswordTestEnv(t)}
+	env.svc = NewAuthService(env)
+	t.Cleanup(func() { Verification = VerificationPolicy{} })
+	return env
+}
+
+func (env *verificationTestEnv) GetUserByUsername(username string) (*mysql.User, error) {
+	if username == env.user.Username {
+		return env.user, nil
+	}
+	return nil, mysql.ErrRecordNotFound
+}
+
+func (env *verificationTestEnv) CheckEmailExists(email string) (bool, error) {
+	return email == env.user.Email, nil
+}
+
+func (env *verificationTestEnv) CheckPhoneExists(phone string) (bool, error) {
+	return phone == env.user.Phone, nil
+}
+
+func (env *verificationTestEnv) CreateUser(user *mysql.User) error {
+	user.ID = env.user.ID + 1
+	env.user = user
+	return nil
+}
+
+func (env *verificationTestEnv) MarkEmailVerified(userID int64, email string) (bool, error) {
+	if userID != env.user.ID || email != env.user.Email {
+		return false, nil
+	}
+	now := time.Now()
+	env.user.EmailVerifiedAt = &now
+	return true, nil
+}
+
+func (env *verificationTestEnv) MarkPhoneVerified(userID int64, phone string) (bool, error) {
+	if userID != env.user.ID || phone != env.user.Phone {
+		return false, nil
+	}
+	now := time.Now()
+	env.user.PhoneVerifiedAt = &now
+	return true, nil
+}
+
+// register 注册新用户，返回发往邮箱和手机的验证码
+func (env *verificationTestEnv) register(t *testing.T) (*auth.RegisterResponse, map[notify.Channel]string) {
+	resp, err := env.svc.Register(context.Background(), &auth.RegisterRequest{
+		Username: "newuser",
+		Password: "Blue-Sky-42",
+		Email:    "new@example.com",
+		Phone:    "13900139000",
+	})
+	require.NoError(t, err)
+
+	codes := make(map[notify.Channel]string)
+	for _, msg := range env.notifier.messages {
+		codes[msg.Channel] = verificationCodeFrom(t, msg)
+	}
+	require.Len(t, codes, 2)
+	return resp, codes
+}
+
+// verificationCodeFrom 从通知内容中取出验证码
+func verificationCodeFrom(t *testing.T, msg *notify.Message) string {
+	m := regexp.MustCompile(`验证码为 (\d{6})，`).FindStringSubmatch(msg.Body)
+	require.NotNil(t, m, msg.Body)
+	return m[1]
+}
+
+func TestVerification_RegisterAndVerify(t *testing.T) {
+	env := newVerificationTestEnv(t)
+	ctx := context.Background()
+
+	// 邮箱和手机号已被占用
+	_, err := env.svc.Register(ctx, &auth.RegisterRequest{Username: "other", Password: "Blue-Sky-42", Email: "test@example.com"})
+	assert.ErrorIs(t, err, auth.ErrEmailAlreadyUsed)
+	_, err = env.svc.Register(ctx, &auth.RegisterRequest{Username: "other", Password: "Blue-Sky-42", Phone: "13800138000"})
+	assert.ErrorIs(t, err, auth.ErrPhoneAlreadyUsed)
+
+	resp, codes := env.register(t)
+	assert.NotEmpty(t, resp.Data.Token)
+	// Redis 中只保存摘要
+	assert.NotContains(t, env.redis.codes["email:new@example.com"], codes[notify.ChannelEmail])
+
+	// 验证码只对发送的目标有效
+	_, err = env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "email", Destination: "test@example.com", Code: codes[notify.ChannelEmail]})
+	assert.ErrorIs(t, err, auth.ErrInvalidVerificationCode)
+
+	_, err = env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "email", Destination: "new@example.com", Code: codes[notify.ChannelEmail]})
+	require.NoError(t, err)
+	assert.NotNil(t, env.user.EmailVerifiedAt)
+	assert.Nil(t, env.user.PhoneVerifiedAt)
+
+	// 验证码只能使用一次
+	_, err = env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "email", Destination: "new@example.com", Code: codes[notify.ChannelEmail]})
+	assert.ErrorIs(t, err, auth.ErrInvalidVerificationCode)
+
+	status, err := env.svc.GetVerificationStatus(ctx, &auth.VerificationStatusReq{UserId: env.user.ID})
+	require.NoError(t, err)
+	assert.True(t, status.EmailVerified)
+	assert.False(t, status.PhoneVerified)
+	assert.True(t, status.CheckoutAllowed)
+}
+
+// 注册签发的令牌与登录一致，只保存摘要并写入缓存，可以退出登录，也会在修改密码时被吊销
+func TestVerification_RegisterTokenRevocable(t *testing.T) {
+	ctx := context.Background()
+
+	env := newVerificationTestEnv(t)
+	resp, _ := env.register(t)
+	token := resp.Data.Token
+	digest := tokenhash.Digest(token)
+	require.Len(t, env.tokens, 1)
+	assert.Equal(t, digest, env.tokens[0].Token)
+	assert.Equal(t, env.user.ID, env.redis.cache[digest])
+
+	env.On("GetTokenByToken", digest).Return(env.tokens[0], nil)
+	env.On("DeleteToken", digest).Return(nil)
+	_, err := env.svc.Logout(ctx, &auth.LogoutRequest{Token: token})
+	require.NoError(t, err)
+	verify, err := env.svc.VerifyToken(ctx, &auth.VerifyTokenReq{Token: token})
+	require.NoError(t, err)
+	assert.False(t, verify.Valid)
+
+	env = newVerificationTestEnv(t)
+	resp, _ = env.register(t)
+	token = resp.Data.Token
+	session := env.login(t)
+	_, err = env.svc.ChangePassword(ctx, &auth.ChangePasswordRequest{Token: session, OldPassword: "Blue-Sky-42", NewPassword: "New-Pass-2024"})
+	require.NoError(t, err)
+	verify, err = env.svc.VerifyToken(ctx, &auth.VerifyTokenReq{Token: token})
+	require.NoError(t, err)
+	assert.False(t, verify.Valid)
+}
+
+func TestVerification_AttemptsAndResend(t *testing.T) {
+	env := newVerificationTestEnv(t)
+	ctx := context.Background()
+	_, codes := env.register(t)
+
+	// 错误次数用尽后验证码作废
+	for i := 0; i < maxVerificationAttempts; i++ {
+		_, err := env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "phone", Destination: "13900139000", Code: "abcdef"})
+		assert.ErrorIs(t, err, auth.ErrInvalidVerificationCode)
+	}
+	_, err := env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "phone", Destination: "13900139000", Code: codes[notify.ChannelSMS]})
+	assert.ErrorIs(t, err, auth.ErrInvalidVerificationCode)
+
+	// 发送间隔内不能重新发送
+	req := &auth.ResendVerificationRequest{Channel: "phone", Destination: "13900139000"}
+	_, err = env.svc.ResendVerification(ctx, req)
+	assert.ErrorIs(t, err, auth.ErrTooManyAttempts)
+
+	env.redis.cooldowns = nil
+	_, err = env.svc.ResendVerification(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, env.notifier.messages, 3)
+	code := verificationCodeFrom(t, env.notifier.messages[2])
+	_, err = env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "phone", Destination: "13900139000", Code: code})
+	require.NoError(t, err)
+	assert.NotNil(t, env.user.PhoneVerifiedAt)
+
+	// 已验证或不存在的联系方式不发送验证码，但同样返回成功
+	env.redis.cooldowns = nil
+	_, err = env.svc.ResendVerification(ctx, req)
+	require.NoError(t, err)
+	_, err = env.svc.ResendVerification(ctx, &auth.ResendVerificationRequest{Channel: "email", Destination: "nobody@example.com"})
+	require.NoError(t, err)
+	assert.Len(t, env.notifier.messages, 3)
+
+	_, err = env.svc.ResendVerification(ctx, &auth.ResendVerificationRequest{Channel: "fax", Destination: "1"})
+	assert.ErrorIs(t, err, auth.ErrInvalidArgument)
+}
+
+func TestVerification_Policy(t *testing.T) {
+	env := newVerificationTestEnv(t)
+	ctx := context.Background()
+	Verification = VerificationPolicy{RequireForLogin: true, RequireForCheckout: true}
+
+	// 要求验证后才能登录时注册不签发令牌
+	resp, codes := env.register(t)
+	assert.Empty(t, resp.Data.Token)
+	assert.NotZero(t, resp.Data.UserId)
+
+	login := &auth.LoginRequest{Username: "newuser", Password: "Blue-Sky-42"}
+	_, err := env.svc.Login(ctx, login)
+	assert.ErrorIs(t, err, auth.ErrAccountNotVerified)
+
+	status, err := env.svc.GetVerificationStatus(ctx, &auth.VerificationStatusReq{UserId: env.user.ID})
+	require.NoError(t, err)
+	assert.False(t, status.LoginAllowed)
+	assert.False(t, status.CheckoutAllowed)
+
+	// 邮箱和手机号都验证后才能登录
+	_, err = env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "email", Destination: "new@example.com", Code: codes[notify.ChannelEmail]})
+	require.NoError(t, err)
+	_, err = env.svc.Login(ctx, login)
+	assert.ErrorIs(t, err, auth.ErrAccountNotVerified)
+
+	_, err = env.svc.VerifyContact(ctx, &auth.VerifyContactRequest{Channel: "phone", Destination: "13900139000", Code: codes[notify.ChannelSMS]})
+	require.NoError(t, err)
+	loginResp, err := env.svc.Login(ctx, login)
+	require.NoError(t, err)
+	assert.NotEmpty(t, loginResp.Data.Token)
+
+	status, err = env.svc.GetVerificationStatus(ctx, &auth.VerificationStatusReq{UserId: env.user.ID})
+	require.NoError(t, err)
+	assert.True(t, status.LoginAllowed)
+	assert.True(t, status.CheckoutAllowed)
+}
@@ -2,19 +2,19 @@ package service
 
 import (
 	"context"
+
 	auth "TikTokMall/app/auth/kitex_gen/auth"
 )
 
 type VerifyTokenByRPCService struct {
 	ctx context.Context
+	svc AuthService
 } // NewVerifyTokenByRPCService new VerifyTokenByRPCService
-func NewVerifyTokenByRPCService(ctx context.Context) *VerifyTokenByRPCService {
-	return &VerifyTokenByRPCService{ctx: ctx}
+func NewVerifyTokenByRPCService(ctx context.Context, svc AuthService) *VerifyTokenByRPCService {
+	return &VerifyTokenByRPCService{ctx: ctx, svc: svc}
 }
 
-// Run create note info
+// Run 校验令牌并返回对应用户
 func (s *VerifyTokenByRPCService) Run(req *auth.VerifyTokenReq) (resp *auth.VerifyResp, err error) {
-	// Finish your business logic.
-
-	return
+	return s.svc.VerifyToken(s.ctx, req)
 }
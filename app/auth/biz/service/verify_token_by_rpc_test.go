@@ -3,19 +3,149 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/biz/dal/mysql"
+	"TikTokMall/app/auth/biz/dal/redis"
+	servicemock "TikTokMall/app/auth/biz/service/mock"
 	auth "TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/pkg/jwt"
+	"TikTokMall/pkg/tokenhash"
 )
 
 func TestVerifyTokenByRPC_Run(t *testing.T) {
 	ctx := context.Background()
-	s := NewVerifyTokenByRPCService(ctx)
-	// init req and assert value
+	redisClient := &mockRedis{}
+	redis.Client = redisClient
+
+	jwtToken := signTestToken(t, 1, "testuser", time.Hour)
+
+	tests := []struct {
+		name       string
+		token      string
+		setup      func(repo *servicemock.MockAuthRepository)
+		wantValid  bool
+		wantUserID int64
+	}{
+		{
+			name:  "cache_hit",
+			token: "cached-token",
+			setup: func(repo *servicemock.MockAuthRepository) {
+				redisClient.cache = map[string]int64{tokenhash.Digest("cached-token"): 7}
+			},
+			wantValid:  true,
+			wantUserID: 7,
+		},
+		{
+			name:       "jwt_cache_miss",
+			token:      "Bearer " + jwtToken,
+			wantValid:  true,
+			wantUserID: 1,
+		},
+		{
+			name:  "opaque_token_from_db",
+			token: "opaque-token",
+			setup: func(repo *servicemock.MockAuthRepository) {
+				repo.On("GetTokenByToken", tokenhash.Digest("opaque-token")).Return(&mysql.Token{
+					UserID:    3,
+					ExpiredAt: time.Now().Add(time.Hour),
+				}, nil)
+			},
+			wantValid:  true,
+			wantUserID: 3,
+		},
+		{
+			name:  "expired_db_token",
+			token: "expired-token",
+			setup: func(repo *servicemock.MockAuthRepository) {
+				repo.On("GetTokenByToken", tokenhash.Digest("expired-token")).Return(&mysql.Token{
+					UserID:    3,
+					ExpiredAt: time.Now().Add(-time.Hour),
+				}, nil)
+			},
+			wantValid: false,
+		},
+		{
+			name:      "expired_jwt",
+			token:     signTestToken(t, 1, "testuser", -time.Hour),
+			wantValid: false,
+		},
+		{
+			name:  "blacklisted",
+			token: "cached-token",
+			setup: func(repo *servicemock.MockAuthRepository) {
+				redisClient.cache = map[string]int64{tokenhash.Digest("cached-token"): 7}
+				redisClient.blacklist = map[string]bool{tokenhash.Digest("cached-token"): true}
+			},
+			wantValid: false,
+		},
+		{
+			name:      "empty",
+			token:     "",
+			wantValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(servicemock.MockAuthRepository)
+			redisClient.cache = nil
+			redisClient.blacklist = nil
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+
+			resp, err := NewVerifyTokenByRPCService(ctx, NewAuthService(repo)).Run(&auth.VerifyTokenReq{Token: tt.token})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantValid, resp.Valid)
+			assert.Equal(t, tt.wantUserID, resp.UserId)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVerifyTokenByRPC_CachesResolvedToken(t *testing.T) {
+	redisClient := &mockRedis{}
+	redis.Client = redisClient
+	jwtToken := signTestToken(t, 1, "testuser", time.Hour)
+
+	_, err := NewVerifyTokenByRPCService(context.Background(), NewAuthService(new(servicemock.MockAuthRepository))).
+		Run(&auth.VerifyTokenReq{Token: jwtToken})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), redisClient.cache[tokenhash.Digest(jwtToken)])
+	assert.NotContains(t, redisClient.cache, jwtToken)
+}
+
+func TestVerifyTokenByRPC_WithRoles(t *testing.T) {
+	redis.Client = &mockRedis{}
+	repo := new(servicemock.MockAuthRepository)
+	repo.On("GetUserRoles", int64(1)).Return([]string{RoleSupport}, nil)
+	repo.On("GetRolePermissions", []string{RoleUser, RoleSupport}).Return([]string{PermUserRead, PermOrderRefund}, nil)
+	svc := NewVerifyTokenByRPCService(context.Background(), NewAuthService(repo))
 
-	req := &auth.VerifyTokenReq{}
-	resp, err := s.Run(req)
-	t.Logf("err: %v", err)
-	t.Logf("resp: %v", resp)
+	token := signTestToken(t, 1, "testuser", time.Hour)
+	resp, err := svc.Run(&auth.VerifyTokenReq{Token: token, WithRoles: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{RoleUser, RoleSupport}, resp.Roles)
+	assert.Equal(t, []string{PermUserRead, PermOrderRefund}, resp.Permissions)
 
-	// todo: edit your unit test
+	// 未请求角色时不查询
+	resp, err = svc.Run(&auth.VerifyTokenReq{Token: token})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Roles)
+	repo.AssertNumberOfCalls(t, "GetUserRoles", 1)
 
+	// 第三方应用令牌不携带用户角色
+	claims := jwt.NewClaims(1, "testuser", time.Hour)
+	claims.ClientID = "erp"
+	appToken, err := jwt.Sign(claims)
+	require.NoError(t, err)
+	resp, err = svc.Run(&auth.VerifyTokenReq{Token: appToken, WithRoles: true})
+	require.NoError(t, err)
+	assert.True(t, resp.Valid)
+	assert.Empty(t, resp.Roles)
+	repo.AssertNumberOfCalls(t, "GetUserRoles", 1)
 }
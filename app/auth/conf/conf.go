@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/kr/pretty"
@@ -17,15 +18,30 @@ var (
 )
 
 type Config struct {
-	Env        string           `mapstructure:"env"`
-	Service    ServiceConfig    `mapstructure:"service"`
-	MySQL      MySQLConfig      `mapstructure:"mysql"`
-	Redis      RedisConfig      `mapstructure:"redis"`
-	Registry   RegistryConfig   `mapstructure:"registry"`
-	Log        LogConfig        `mapstructure:"log"`
-	Jaeger     JaegerConfig     `mapstructure:"jaeger"`
-	Prometheus PrometheusConfig `mapstructure:"prometheus"`
-	TLS        TLSConfig        `mapstructure:"tls"`
+	Env          string             `mapstructure:"env"`
+	Service      ServiceConfig      `mapstructure:"service"`
+	Kitex        Kitex              `mapstructure:"kitex"`
+	MySQL        MySQLConfig        `mapstructure:"mysql"`
+	Redis        RedisConfig        `mapstructure:"redis"`
+	Registry     RegistryConfig     `mapstructure:"registry"`
+	Log          LogConfig          `mapstructure:"log"`
+	Jaeger       JaegerConfig       `mapstructure:"jaeger"`
+	Prometheus   PrometheusConfig   `mapstructure:"prometheus"`
+	TLS          TLSConfig          `mapstructure:"tls"`
+	JWT          JWTConfig          `mapstructure:"jwt"`
+	TwoFactor    TwoFactorConfig    `mapstructure:"two_factor"`
+	OAuth        OAuthConfig        `mapstructure:"oauth"`
+	OIDC         OIDCConfig         `mapstructure:"oidc"`
+	WebAuthn     WebAuthnConfig     `mapstructure:"webauthn"`
+	Notify       NotifyConfig       `mapstructure:"notify"`
+	Password     PasswordConfig     `mapstructure:"password"`
+	Verification VerificationConfig `mapstructure:"verification"`
+	SMSLogin     SMSLoginConfig     `mapstructure:"sms_login"`
+	Audit        AuditConfig        `mapstructure:"audit"`
+	CORS         CORSConfig         `mapstructure:"cors"`
+	Session      SessionConfig      `mapstructure:"session"`
+	// TrustedProxies 可信反向代理的 CIDR 或 IP，只有来自这些地址的 X-Forwarded-For / X-Real-IP 会被采用
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 type ServiceConfig struct {
@@ -90,6 +106,133 @@ type TLSConfig struct {
 	ClientKey  string `mapstructure:"client_key"`
 }
 
+// JWTConfig 访问令牌签名配置，keys 中保留旧密钥用于验证轮换前签发的令牌
+type JWTConfig struct {
+	Issuer    string         `mapstructure:"issuer"`
+	Algorithm string         `mapstructure:"algorithm"`
+	ActiveKID string         `mapstructure:"active_kid"`
+	Keys      []JWTKeyConfig `mapstructure:"keys"`
+}
+
+type JWTKeyConfig struct {
+	KID            string `mapstructure:"kid"`
+	Algorithm      string `mapstructure:"algorithm"`
+	PrivateKeyPath string `mapstructure:"private_key_path"`
+}
+
+// TwoFactorConfig 两步验证配置，encryption_key 为 base64 编码的 32 字节 AES 密钥
+type TwoFactorConfig struct {
+	EncryptionKey string `mapstructure:"encryption_key"`
+}
+
+// OAuthConfig OAuth2 授权服务配置
+type OAuthConfig struct {
+	BaseURL  string `mapstructure:"base_url"`  // 对外地址，用于发现文档，为空时根据请求推断
+	LoginURL string `mapstructure:"login_url"` // 前端登录及授权确认页面
+}
+
+// OIDCConfig 外部身份提供方登录配置
+type OIDCConfig struct {
+	Providers []OIDCProviderConfig `mapstructure:"providers"`
+}
+
+// OIDCProviderConfig 外部身份提供方配置，client_secret 可通过 OIDC_<NAME>_CLIENT_SECRET 覆盖
+type OIDCProviderConfig struct {
+	Name         string   `mapstructure:"name"`
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+	LinkByEmail  bool     `mapstructure:"link_by_email"` // 提供方已验证邮箱时按邮箱关联已有用户
+}
+
+// WebAuthnConfig 通行密钥配置，rp_id 为空时不开启通行密钥
+type WebAuthnConfig struct {
+	RPID             string        `mapstructure:"rp_id"` // 依赖方 ID，为站点的可注册域名
+	RPName           string        `mapstructure:"rp_name"`
+	Origins          []string      `mapstructure:"origins"`           // 允许发起注册和登录的页面来源
+	UserVerification string        `mapstructure:"user_verification"` // required、preferred 或 discouraged
+	Timeout          time.Duration `mapstructure:"timeout"`
+}
+
+// NotifyConfig 邮件和短信通知配置，未配置 smtp.host / sms.endpoint 的渠道写入 file_path（为空时写日志）
+type NotifyConfig struct {
+	FilePath string           `mapstructure:"file_path"`
+	SMTP     NotifySMTPConfig `mapstructure:"smtp"`
+	SMS      NotifySMSConfig  `mapstructure:"sms"`
+}
+
+// NotifySMTPConfig 邮件服务器配置，password 可通过 SMTP_PASSWORD 覆盖
+type NotifySMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+// NotifySMSConfig 短信网关配置，api_key 可通过 SMS_API_KEY 覆盖
+type NotifySMSConfig struct {
+	Endpoint string `mapstructure:"endpoint"`
+	APIKey   string `mapstructure:"api_key"`
+	Sender   string `mapstructure:"sender"`
+}
+
+// PasswordConfig 密码找回和密码策略配置
+type PasswordConfig struct {
+	ResetURL string               `mapstructure:"reset_url"` // 前端重置密码页面，为空时通知中只发送重置令牌
+	Policy   PasswordPolicyConfig `mapstructure:"policy"`
+}
+
+// PasswordPolicyConfig 密码策略配置，banned 与内置常见密码列表合并
+type PasswordPolicyConfig struct {
+	MinLength        int      `mapstructure:"min_length"`
+	MaxLength        int      `mapstructure:"max_length"`
+	RequireLower     bool     `mapstructure:"require_lower"`
+	RequireUpper     bool     `mapstructure:"require_upper"`
+	RequireDigit     bool     `mapstructure:"require_digit"`
+	RequireSymbol    bool     `mapstructure:"require_symbol"`
+	MinClasses       int      `mapstructure:"min_classes"`
+	DisallowUsername bool     `mapstructure:"disallow_username"`
+	Banned           []string `mapstructure:"banned"`
+	BreachedListPath string   `mapstructure:"breached_list_path"` // 每行一个明文密码或 SHA-1 摘要
+	BreachedFPRate   float64  `mapstructure:"breached_fp_rate"`
+	HistorySize      int      `mapstructure:"history_size"`
+}
+
+// VerificationConfig 联系方式验证策略配置
+type VerificationConfig struct {
+	RequireForLogin    bool `mapstructure:"require_for_login"`    // 未验证邮箱和手机号的账号不能登录
+	RequireForCheckout bool `mapstructure:"require_for_checkout"` // 未验证邮箱和手机号的账号不能下单
+}
+
+// SMSLoginConfig 短信验证码登录配置，短信通过 notify.sms 发送，未配置网关时写入 notify.file_path
+type SMSLoginConfig struct {
+	AutoRegister bool          `mapstructure:"auto_register"` // 手机号未注册时自动创建账号
+	IPSendLimit  int64         `mapstructure:"ip_send_limit"` // 同一 IP 在 ip_send_window 内最多发送次数
+	IPSendWindow time.Duration `mapstructure:"ip_send_window"`
+}
+
+// CORSConfig 跨域配置，只有 allow_origins 中的来源可以携带 Cookie 访问，"*" 允许所有来源但不允许携带 Cookie
+type CORSConfig struct {
+	AllowOrigins []string `mapstructure:"allow_origins"`
+}
+
+// SessionConfig 浏览器 Cookie 会话配置，请求头 X-Session-Mode: cookie 的登录和刷新接口将令牌写入 Cookie
+type SessionConfig struct {
+	CookieDomain string `mapstructure:"cookie_domain"` // 为空时只发送到认证服务的主机，多个服务共用会话时设置为公共父域名
+	Secure       bool   `mapstructure:"secure"`        // 只通过 HTTPS 发送，本地开发环境可关闭
+	SameSite     string `mapstructure:"same_site"`     // lax、strict 或 none
+}
+
+// AuditConfig 审计日志异步写入配置，未配置时使用默认值
+type AuditConfig struct {
+	QueueSize     int           `mapstructure:"queue_size"` // 队列满时丢弃事件，不阻塞请求
+	BatchSize     int           `mapstructure:"batch_size"`
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+}
+
 // GetConf gets configuration instance
 func GetConf() *Config {
 	return conf
@@ -10,18 +10,25 @@ import (
 )
 
 // AuthServiceImpl implements the last service interface defined in the IDL.
-type AuthServiceImpl struct{}
+type AuthServiceImpl struct {
+	svc service.AuthService
+}
+
+// NewAuthServiceImpl 创建 RPC 处理器
+func NewAuthServiceImpl(svc service.AuthService) *AuthServiceImpl {
+	return &AuthServiceImpl{svc: svc}
+}
 
 // DeliverTokenByRPC implements the AuthServiceImpl interface.
 func (s *AuthServiceImpl) DeliverTokenByRPC(ctx context.Context, req *auth.DeliverTokenReq) (resp *auth.DeliveryResp, err error) {
-	resp, err = service.NewDeliverTokenByRPCService(ctx).Run(req)
+	resp, err = service.NewDeliverTokenByRPCService(ctx, s.svc).Run(req)
 
 	return resp, err
 }
 
 // VerifyTokenByRPC implements the AuthServiceImpl interface.
 func (s *AuthServiceImpl) VerifyTokenByRPC(ctx context.Context, req *auth.VerifyTokenReq) (resp *auth.VerifyResp, err error) {
-	resp, err = service.NewVerifyTokenByRPCService(ctx).Run(req)
+	resp, err = service.NewVerifyTokenByRPCService(ctx, s.svc).Run(req)
 
 	return resp, err
 }
@@ -61,6 +68,26 @@ func (s *AuthServiceImpl) ValidateToken(ctx context.Context, req *auth.ValidateT
 	return resp, err
 }
 
+// LoginTwoFactor implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) LoginTwoFactor(ctx context.Context, req *auth.TwoFactorLoginRequest) (resp *auth.LoginResponse, err error) {
+	return s.svc.LoginTwoFactor(ctx, req)
+}
+
+// EnrollTwoFactor implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) EnrollTwoFactor(ctx context.Context, req *auth.EnrollTwoFactorRequest) (resp *auth.EnrollTwoFactorResponse, err error) {
+	return s.svc.EnrollTwoFactor(ctx, req)
+}
+
+// ConfirmTwoFactor implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ConfirmTwoFactor(ctx context.Context, req *auth.ConfirmTwoFactorRequest) (resp *auth.ConfirmTwoFactorResponse, err error) {
+	return s.svc.ConfirmTwoFactor(ctx, req)
+}
+
+// DisableTwoFactor implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) DisableTwoFactor(ctx context.Context, req *auth.DisableTwoFactorRequest) (resp *auth.DisableTwoFactorResponse, err error) {
+	return s.svc.DisableTwoFactor(ctx, req)
+}
+
 // HealthCheck implements the AuthServiceImpl interface.
 func (s *AuthServiceImpl) HealthCheck(ctx context.Context) error {
 	// 检查MySQL连接
@@ -75,3 +102,113 @@ func (s *AuthServiceImpl) HealthCheck(ctx context.Context) error {
 
 	return nil
 }
+
+// ForgotPassword implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ForgotPassword(ctx context.Context, req *auth.ForgotPasswordRequest) (resp *auth.ForgotPasswordResponse, err error) {
+	return s.svc.ForgotPassword(ctx, req)
+}
+
+// ResetPassword implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ResetPassword(ctx context.Context, req *auth.ResetPasswordRequest) (resp *auth.ResetPasswordResponse, err error) {
+	return s.svc.ResetPassword(ctx, req)
+}
+
+// ChangePassword implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ChangePassword(ctx context.Context, req *auth.ChangePasswordRequest) (resp *auth.ChangePasswordResponse, err error) {
+	return s.svc.ChangePassword(ctx, req)
+}
+
+// ResendVerification implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ResendVerification(ctx context.Context, req *auth.ResendVerificationRequest) (resp *auth.ResendVerificationResponse, err error) {
+	return s.svc.ResendVerification(ctx, req)
+}
+
+// VerifyContact implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) VerifyContact(ctx context.Context, req *auth.VerifyContactRequest) (resp *auth.VerifyContactResponse, err error) {
+	return s.svc.VerifyContact(ctx, req)
+}
+
+// GetVerificationStatus implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) GetVerificationStatus(ctx context.Context, req *auth.VerificationStatusReq) (resp *auth.VerificationStatusResp, err error) {
+	return s.svc.GetVerificationStatus(ctx, req)
+}
+
+// AssignRole implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) AssignRole(ctx context.Context, req *auth.AssignRoleRequest) (resp *auth.AssignRoleResponse, err error) {
+	return s.svc.AssignRole(ctx, req)
+}
+
+// RevokeRole implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) RevokeRole(ctx context.Context, req *auth.RevokeRoleRequest) (resp *auth.RevokeRoleResponse, err error) {
+	return s.svc.RevokeRole(ctx, req)
+}
+
+// ListUserRoles implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ListUserRoles(ctx context.Context, req *auth.ListUserRolesRequest) (resp *auth.ListUserRolesResponse, err error) {
+	return s.svc.ListUserRoles(ctx, req)
+}
+
+// UpdateUserStatus implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) UpdateUserStatus(ctx context.Context, req *auth.UpdateUserStatusRequest) (resp *auth.UpdateUserStatusResponse, err error) {
+	return s.svc.UpdateUserStatus(ctx, req)
+}
+
+// BanUser implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) BanUser(ctx context.Context, req *auth.BanUserRequest) (resp *auth.BanUserResponse, err error) {
+	return s.svc.BanUser(ctx, req)
+}
+
+// UnbanUser implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) UnbanUser(ctx context.Context, req *auth.UnbanUserRequest) (resp *auth.UnbanUserResponse, err error) {
+	return s.svc.UnbanUser(ctx, req)
+}
+
+// ListUserBans implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ListUserBans(ctx context.Context, req *auth.ListUserBansRequest) (resp *auth.ListUserBansResponse, err error) {
+	return s.svc.ListUserBans(ctx, req)
+}
+
+// ListSessions implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ListSessions(ctx context.Context, req *auth.ListSessionsRequest) (resp *auth.ListSessionsResponse, err error) {
+	return s.svc.ListSessions(ctx, req)
+}
+
+// RevokeSession implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) RevokeSession(ctx context.Context, req *auth.RevokeSessionRequest) (resp *auth.RevokeSessionResponse, err error) {
+	return s.svc.RevokeSession(ctx, req)
+}
+
+// LogoutAll implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) LogoutAll(ctx context.Context, req *auth.LogoutAllRequest) (resp *auth.LogoutAllResponse, err error) {
+	return s.svc.LogoutAll(ctx, req)
+}
+
+// UnlockLogin implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) UnlockLogin(ctx context.Context, req *auth.UnlockLoginRequest) (resp *auth.UnlockLoginResponse, err error) {
+	return s.svc.UnlockLogin(ctx, req)
+}
+
+// ListAuditLogs implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ListAuditLogs(ctx context.Context, req *auth.ListAuditLogsRequest) (resp *auth.ListAuditLogsResponse, err error) {
+	return s.svc.ListAuditLogs(ctx, req)
+}
+
+// CreateAPIKey implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) CreateAPIKey(ctx context.Context, req *auth.CreateAPIKeyRequest) (resp *auth.CreateAPIKeyResponse, err error) {
+	return s.svc.CreateAPIKey(ctx, req)
+}
+
+// ListAPIKeys implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) ListAPIKeys(ctx context.Context, req *auth.ListAPIKeysRequest) (resp *auth.ListAPIKeysResponse, err error) {
+	return s.svc.ListAPIKeys(ctx, req)
+}
+
+// RevokeAPIKey implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) RevokeAPIKey(ctx context.Context, req *auth.RevokeAPIKeyRequest) (resp *auth.RevokeAPIKeyResponse, err error) {
+	return s.svc.RevokeAPIKey(ctx, req)
+}
+
+// VerifyAPISignature implements the AuthServiceImpl interface.
+func (s *AuthServiceImpl) VerifyAPISignature(ctx context.Context, req *auth.VerifyAPISignatureReq) (resp *auth.VerifyAPISignatureResp, err error) {
+	return s.svc.VerifyAPISignature(ctx, req)
+}
@@ -130,6 +130,11 @@ func (x *VerifyTokenReq) FastRead(buf []byte, _type int8, number int32) (offset
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -148,6 +153,11 @@ func (x *VerifyTokenReq) fastReadField1(buf []byte, _type int8) (offset int, err
 	return offset, err
 }
 
+func (x *VerifyTokenReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.WithRoles, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
 func (x *VerifyResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -165,6 +175,16 @@ func (x *VerifyResp) FastRead(buf []byte, _type int8, number int32) (offset int,
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -198,6 +218,26 @@ func (x *VerifyResp) fastReadField3(buf []byte, _type int8) (offset int, err err
 	return offset, err
 }
 
+func (x *VerifyResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Roles = append(x.Roles, v)
+	return offset, err
+}
+
+func (x *VerifyResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Permissions = append(x.Permissions, v)
+	return offset, err
+}
+
 func (x *RegisterRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -425,6 +465,16 @@ func (x *LoginData) FastRead(buf []byte, _type int8, number int32) (offset int,
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -448,13 +498,28 @@ func (x *LoginData) fastReadField2(buf []byte, _type int8) (offset int, err erro
 	return offset, err
 }
 
-func (x *RefreshTokenRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *LoginData) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.TwoFactorRequired, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *LoginData) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ChallengeToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TwoFactorLoginRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -465,15 +530,45 @@ func (x *RefreshTokenRequest) FastRead(buf []byte, _type int8, number int32) (of
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenRequest[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TwoFactorLoginRequest[number], err)
 }
 
-func (x *RefreshTokenRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+func (x *TwoFactorLoginRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ChallengeToken, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *RefreshTokenResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *TwoFactorLoginRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollTwoFactorRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EnrollTwoFactorRequest[number], err)
+}
+
+func (x *EnrollTwoFactorRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollTwoFactorResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -495,10 +590,10 @@ func (x *RefreshTokenResponse) FastRead(buf []byte, _type int8, number int32) (o
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenResponse[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EnrollTwoFactorResponse[number], err)
 }
 
-func (x *RefreshTokenResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+func (x *EnrollTwoFactorResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
 	var v BaseResp
 	offset, err = fastpb.ReadMessage(buf, _type, &v)
 	if err != nil {
@@ -508,8 +603,8 @@ func (x *RefreshTokenResponse) fastReadField1(buf []byte, _type int8) (offset in
 	return offset, nil
 }
 
-func (x *RefreshTokenResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	var v RefreshTokenData
+func (x *EnrollTwoFactorResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v EnrollTwoFactorData
 	offset, err = fastpb.ReadMessage(buf, _type, &v)
 	if err != nil {
 		return offset, err
@@ -518,7 +613,7 @@ func (x *RefreshTokenResponse) fastReadField2(buf []byte, _type int8) (offset in
 	return offset, nil
 }
 
-func (x *RefreshTokenData) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *EnrollTwoFactorData) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -530,6 +625,11 @@ func (x *RefreshTokenData) FastRead(buf []byte, _type int8, number int32) (offse
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -540,26 +640,41 @@ func (x *RefreshTokenData) FastRead(buf []byte, _type int8, number int32) (offse
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenData[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EnrollTwoFactorData[number], err)
 }
 
-func (x *RefreshTokenData) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.Token, offset, err = fastpb.ReadString(buf, _type)
+func (x *EnrollTwoFactorData) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Secret, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *RefreshTokenData) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+func (x *EnrollTwoFactorData) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OtpauthUri, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *LogoutRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *EnrollTwoFactorData) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.RecoveryCodes = append(x.RecoveryCodes, v)
+	return offset, err
+}
+
+func (x *ConfirmTwoFactorRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -570,15 +685,20 @@ func (x *LogoutRequest) FastRead(buf []byte, _type int8, number int32) (offset i
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutRequest[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmTwoFactorRequest[number], err)
 }
 
-func (x *LogoutRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+func (x *ConfirmTwoFactorRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
 	x.Token, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *LogoutResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *ConfirmTwoFactorRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmTwoFactorResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -595,10 +715,10 @@ func (x *LogoutResponse) FastRead(buf []byte, _type int8, number int32) (offset
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutResponse[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmTwoFactorResponse[number], err)
 }
 
-func (x *LogoutResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+func (x *ConfirmTwoFactorResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
 	var v BaseResp
 	offset, err = fastpb.ReadMessage(buf, _type, &v)
 	if err != nil {
@@ -608,13 +728,18 @@ func (x *LogoutResponse) fastReadField1(buf []byte, _type int8) (offset int, err
 	return offset, nil
 }
 
-func (x *ValidateTokenRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *DisableTwoFactorRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -625,26 +750,26 @@ func (x *ValidateTokenRequest) FastRead(buf []byte, _type int8, number int32) (o
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ValidateTokenRequest[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DisableTwoFactorRequest[number], err)
 }
 
-func (x *ValidateTokenRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+func (x *DisableTwoFactorRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
 	x.Token, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *ValidateTokenResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *DisableTwoFactorRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DisableTwoFactorResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
 		if err != nil {
 			goto ReadFieldError
 		}
-	case 2:
-		offset, err = x.fastReadField2(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -655,10 +780,10 @@ func (x *ValidateTokenResponse) FastRead(buf []byte, _type int8, number int32) (
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ValidateTokenResponse[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DisableTwoFactorResponse[number], err)
 }
 
-func (x *ValidateTokenResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+func (x *DisableTwoFactorResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
 	var v BaseResp
 	offset, err = fastpb.ReadMessage(buf, _type, &v)
 	if err != nil {
@@ -668,17 +793,7 @@ func (x *ValidateTokenResponse) fastReadField1(buf []byte, _type int8) (offset i
 	return offset, nil
 }
 
-func (x *ValidateTokenResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	var v ValidateTokenData
-	offset, err = fastpb.ReadMessage(buf, _type, &v)
-	if err != nil {
-		return offset, err
-	}
-	x.Data = &v
-	return offset, nil
-}
-
-func (x *ValidateTokenData) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *ForgotPasswordRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -700,477 +815,6407 @@ func (x *ValidateTokenData) FastRead(buf []byte, _type int8, number int32) (offs
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ValidateTokenData[number], err)
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ForgotPasswordRequest[number], err)
 }
 
-func (x *ValidateTokenData) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+func (x *ForgotPasswordRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *ValidateTokenData) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.Username, offset, err = fastpb.ReadString(buf, _type)
+func (x *ForgotPasswordRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Phone, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *BaseResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ForgotPasswordResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ForgotPasswordResponse[number], err)
 }
 
-func (x *BaseResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Code == 0 {
-		return offset
+func (x *ForgotPasswordResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetCode())
-	return offset
+	x.Base = &v
+	return offset, nil
 }
 
-func (x *BaseResp) fastWriteField2(buf []byte) (offset int) {
-	if x.Message == "" {
-		return offset
+func (x *ResetPasswordRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetMessage())
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ResetPasswordRequest[number], err)
 }
 
-func (x *DeliverTokenReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+func (x *ResetPasswordRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ResetToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *DeliverTokenReq) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
-	}
-	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
-	return offset
+func (x *ResetPasswordRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.NewPassword, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *DeliverTokenReq) fastWriteField2(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *ResetPasswordResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetToken())
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ResetPasswordResponse[number], err)
 }
 
-func (x *DeliveryResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ResetPasswordResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	x.Base = &v
+	return offset, nil
 }
 
-func (x *DeliveryResp) fastWriteField1(buf []byte) (offset int) {
+func (x *ChangePasswordRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ChangePasswordRequest[number], err)
+}
+
+func (x *ChangePasswordRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChangePasswordRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OldPassword, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChangePasswordRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.NewPassword, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChangePasswordResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ChangePasswordResponse[number], err)
+}
+
+func (x *ChangePasswordResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ResendVerificationRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ResendVerificationRequest[number], err)
+}
+
+func (x *ResendVerificationRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Channel, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ResendVerificationRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Destination, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ResendVerificationResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ResendVerificationResponse[number], err)
+}
+
+func (x *ResendVerificationResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *VerifyContactRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyContactRequest[number], err)
+}
+
+func (x *VerifyContactRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Channel, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyContactRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Destination, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyContactRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyContactResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyContactResponse[number], err)
+}
+
+func (x *VerifyContactResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *AssignRoleRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AssignRoleRequest[number], err)
+}
+
+func (x *AssignRoleRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AssignRoleRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *AssignRoleRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Role, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AssignRoleResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AssignRoleResponse[number], err)
+}
+
+func (x *AssignRoleResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *RevokeRoleRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeRoleRequest[number], err)
+}
+
+func (x *RevokeRoleRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeRoleRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeRoleRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Role, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeRoleResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeRoleResponse[number], err)
+}
+
+func (x *RevokeRoleResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListUserRolesRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListUserRolesRequest[number], err)
+}
+
+func (x *ListUserRolesRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListUserRolesRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListUserRolesResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListUserRolesResponse[number], err)
+}
+
+func (x *ListUserRolesResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListUserRolesResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Roles = append(x.Roles, v)
+	return offset, err
+}
+
+func (x *ListUserRolesResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Permissions = append(x.Permissions, v)
+	return offset, err
+}
+
+func (x *UpdateUserStatusRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateUserStatusRequest[number], err)
+}
+
+func (x *UpdateUserStatusRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateUserStatusRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateUserStatusRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Status, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateUserStatusResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateUserStatusResponse[number], err)
+}
+
+func (x *UpdateUserStatusResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *UserBan) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UserBan[number], err)
+}
+
+func (x *UserBan) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserBan) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserBan) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UserBan) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.OperatorId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserBan) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserBan) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ExpiresAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserBan) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.LiftedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserBan) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.LiftedBy, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UserBan) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.LiftReason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *BanUserRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_BanUserRequest[number], err)
+}
+
+func (x *BanUserRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *BanUserRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *BanUserRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *BanUserRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.DurationSeconds, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *BanUserResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_BanUserResponse[number], err)
+}
+
+func (x *BanUserResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *BanUserResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v UserBan
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Ban = &v
+	return offset, nil
+}
+
+func (x *UnbanUserRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UnbanUserRequest[number], err)
+}
+
+func (x *UnbanUserRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UnbanUserRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UnbanUserRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UnbanUserResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UnbanUserResponse[number], err)
+}
+
+func (x *UnbanUserResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListUserBansRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListUserBansRequest[number], err)
+}
+
+func (x *ListUserBansRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListUserBansRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListUserBansResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListUserBansResponse[number], err)
+}
+
+func (x *ListUserBansResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListUserBansResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v UserBan
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Bans = append(x.Bans, &v)
+	return offset, nil
+}
+
+func (x *UnlockLoginRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UnlockLoginRequest[number], err)
+}
+
+func (x *UnlockLoginRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UnlockLoginRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Username, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UnlockLoginRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Ip, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UnlockLoginResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UnlockLoginResponse[number], err)
+}
+
+func (x *UnlockLoginResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *AuditLog) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AuditLog[number], err)
+}
+
+func (x *AuditLog) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Username, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Event, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Outcome, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.OperatorId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Ip, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.UserAgent, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.TraceId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AuditLog) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListAuditLogsRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListAuditLogsRequest[number], err)
+}
+
+func (x *ListAuditLogsRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListAuditLogsRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListAuditLogsRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Event, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListAuditLogsRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.StartTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListAuditLogsRequest) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.EndTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListAuditLogsRequest) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListAuditLogsRequest) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListAuditLogsResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListAuditLogsResponse[number], err)
+}
+
+func (x *ListAuditLogsResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListAuditLogsResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v AuditLog
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Logs = append(x.Logs, &v)
+	return offset, nil
+}
+
+func (x *ListAuditLogsResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *APIKey) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_APIKey[number], err)
+}
+
+func (x *APIKey) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.KeyId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *APIKey) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *APIKey) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Scopes = append(x.Scopes, v)
+	return offset, err
+}
+
+func (x *APIKey) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *APIKey) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.ExpiresAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *APIKey) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.LastUsedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *APIKey) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Revoked, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAPIKeyRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateAPIKeyRequest[number], err)
+}
+
+func (x *CreateAPIKeyRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAPIKeyRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAPIKeyRequest) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Scopes = append(x.Scopes, v)
+	return offset, err
+}
+
+func (x *CreateAPIKeyRequest) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ExpiresInDays, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAPIKeyResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateAPIKeyResponse[number], err)
+}
+
+func (x *CreateAPIKeyResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *CreateAPIKeyResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v APIKey
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Key = &v
+	return offset, nil
+}
+
+func (x *CreateAPIKeyResponse) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Secret, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListAPIKeysRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListAPIKeysRequest[number], err)
+}
+
+func (x *ListAPIKeysRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListAPIKeysResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListAPIKeysResponse[number], err)
+}
+
+func (x *ListAPIKeysResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListAPIKeysResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v APIKey
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Keys = append(x.Keys, &v)
+	return offset, nil
+}
+
+func (x *RevokeAPIKeyRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeAPIKeyRequest[number], err)
+}
+
+func (x *RevokeAPIKeyRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeAPIKeyRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.KeyId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeAPIKeyResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeAPIKeyResponse[number], err)
+}
+
+func (x *RevokeAPIKeyResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *VerifyAPISignatureReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyAPISignatureReq[number], err)
+}
+
+func (x *VerifyAPISignatureReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.KeyId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyAPISignatureReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.StringToSign, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyAPISignatureReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Signature, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyAPISignatureResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyAPISignatureResp[number], err)
+}
+
+func (x *VerifyAPISignatureResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *VerifyAPISignatureResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Valid, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyAPISignatureResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyAPISignatureResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Scopes = append(x.Scopes, v)
+	return offset, err
+}
+
+func (x *VerificationStatusReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerificationStatusReq[number], err)
+}
+
+func (x *VerificationStatusReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *VerificationStatusResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerificationStatusResp[number], err)
+}
+
+func (x *VerificationStatusResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *VerificationStatusResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.EmailVerified, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *VerificationStatusResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PhoneVerified, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *VerificationStatusResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.LoginAllowed, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *VerificationStatusResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.CheckoutAllowed, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RefreshTokenRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenRequest[number], err)
+}
+
+func (x *RefreshTokenRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefreshTokenResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenResponse[number], err)
+}
+
+func (x *RefreshTokenResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *RefreshTokenResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v RefreshTokenData
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Data = &v
+	return offset, nil
+}
+
+func (x *RefreshTokenData) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenData[number], err)
+}
+
+func (x *RefreshTokenData) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefreshTokenData) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LogoutRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutRequest[number], err)
+}
+
+func (x *LogoutRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LogoutResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutResponse[number], err)
+}
+
+func (x *LogoutResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *Session) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Session[number], err)
+}
+
+func (x *Session) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.DeviceName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UserAgent, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Ip, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.LastSeenAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Session) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Current, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListSessionsRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListSessionsRequest[number], err)
+}
+
+func (x *ListSessionsRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListSessionsResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListSessionsResponse[number], err)
+}
+
+func (x *ListSessionsResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListSessionsResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Session
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Sessions = append(x.Sessions, &v)
+	return offset, nil
+}
+
+func (x *RevokeSessionRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeSessionRequest[number], err)
+}
+
+func (x *RevokeSessionRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeSessionRequest) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.SessionId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeSessionResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeSessionResponse[number], err)
+}
+
+func (x *RevokeSessionResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *LogoutAllRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutAllRequest[number], err)
+}
+
+func (x *LogoutAllRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LogoutAllResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutAllResponse[number], err)
+}
+
+func (x *LogoutAllResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ValidateTokenRequest) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ValidateTokenRequest[number], err)
+}
+
+func (x *ValidateTokenRequest) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ValidateTokenResponse) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ValidateTokenResponse[number], err)
+}
+
+func (x *ValidateTokenResponse) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ValidateTokenResponse) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v ValidateTokenData
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Data = &v
+	return offset, nil
+}
+
+func (x *ValidateTokenData) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ValidateTokenData[number], err)
+}
+
+func (x *ValidateTokenData) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Valid, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ValidateTokenData) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ValidateTokenData) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Username, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ValidateTokenData) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ClientId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ValidateTokenData) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Scope, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ValidateTokenData) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Roles = append(x.Roles, v)
+	return offset, err
+}
+
+func (x *ValidateTokenData) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Permissions = append(x.Permissions, v)
+	return offset, err
+}
+
+func (x *BaseResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *BaseResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Code == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetCode())
+	return offset
+}
+
+func (x *BaseResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Message == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetMessage())
+	return offset
+}
+
+func (x *DeliverTokenReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DeliverTokenReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *DeliverTokenReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetToken())
+	return offset
+}
+
+func (x *DeliveryResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DeliveryResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *DeliveryResp) fastWriteField2(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 2, x.GetSuccess())
+	return offset
+}
+
+func (x *VerifyTokenReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *VerifyTokenReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *VerifyTokenReq) fastWriteField2(buf []byte) (offset int) {
+	if !x.WithRoles {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 2, x.GetWithRoles())
+	return offset
+}
+
+func (x *VerifyResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *VerifyResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *VerifyResp) fastWriteField2(buf []byte) (offset int) {
+	if !x.Valid {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 2, x.GetValid())
+	return offset
+}
+
+func (x *VerifyResp) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetUserId())
+	return offset
+}
+
+func (x *VerifyResp) fastWriteField4(buf []byte) (offset int) {
+	if len(x.Roles) == 0 {
+		return offset
+	}
+	for i := range x.GetRoles() {
+		offset += fastpb.WriteString(buf[offset:], 4, x.GetRoles()[i])
+	}
+	return offset
+}
+
+func (x *VerifyResp) fastWriteField5(buf []byte) (offset int) {
+	if len(x.Permissions) == 0 {
+		return offset
+	}
+	for i := range x.GetPermissions() {
+		offset += fastpb.WriteString(buf[offset:], 5, x.GetPermissions()[i])
+	}
+	return offset
+}
+
+func (x *RegisterRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *RegisterRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
+	return offset
+}
+
+func (x *RegisterRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *RegisterRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetEmail())
+	return offset
+}
+
+func (x *RegisterRequest) fastWriteField4(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetPhone())
+	return offset
+}
+
+func (x *RegisterResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RegisterResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *RegisterResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Data == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
+	return offset
+}
+
+func (x *RegisterData) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RegisterData) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *RegisterData) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetToken())
+	return offset
+}
+
+func (x *LoginRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *LoginRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
+	return offset
+}
+
+func (x *LoginRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *LoginResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *LoginResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *LoginResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Data == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
+	return offset
+}
+
+func (x *LoginData) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *LoginData) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *LoginData) fastWriteField2(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetRefreshToken())
+	return offset
+}
+
+func (x *LoginData) fastWriteField3(buf []byte) (offset int) {
+	if !x.TwoFactorRequired {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 3, x.GetTwoFactorRequired())
+	return offset
+}
+
+func (x *LoginData) fastWriteField4(buf []byte) (offset int) {
+	if x.ChallengeToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetChallengeToken())
+	return offset
+}
+
+func (x *TwoFactorLoginRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *TwoFactorLoginRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.ChallengeToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetChallengeToken())
+	return offset
+}
+
+func (x *TwoFactorLoginRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCode())
+	return offset
+}
+
+func (x *EnrollTwoFactorRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *EnrollTwoFactorRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *EnrollTwoFactorResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *EnrollTwoFactorResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *EnrollTwoFactorResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Data == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
+	return offset
+}
+
+func (x *EnrollTwoFactorData) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *EnrollTwoFactorData) fastWriteField1(buf []byte) (offset int) {
+	if x.Secret == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetSecret())
+	return offset
+}
+
+func (x *EnrollTwoFactorData) fastWriteField2(buf []byte) (offset int) {
+	if x.OtpauthUri == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOtpauthUri())
+	return offset
+}
+
+func (x *EnrollTwoFactorData) fastWriteField3(buf []byte) (offset int) {
+	if len(x.RecoveryCodes) == 0 {
+		return offset
+	}
+	for i := range x.GetRecoveryCodes() {
+		offset += fastpb.WriteString(buf[offset:], 3, x.GetRecoveryCodes()[i])
+	}
+	return offset
+}
+
+func (x *ConfirmTwoFactorRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmTwoFactorRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ConfirmTwoFactorRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCode())
+	return offset
+}
+
+func (x *ConfirmTwoFactorResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmTwoFactorResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *DisableTwoFactorRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DisableTwoFactorRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *DisableTwoFactorRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCode())
+	return offset
+}
+
+func (x *DisableTwoFactorResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DisableTwoFactorResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ForgotPasswordRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ForgotPasswordRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmail())
+	return offset
+}
+
+func (x *ForgotPasswordRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPhone())
+	return offset
+}
+
+func (x *ForgotPasswordResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ForgotPasswordResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ResetPasswordRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ResetPasswordRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.ResetToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetResetToken())
+	return offset
+}
+
+func (x *ResetPasswordRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.NewPassword == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetNewPassword())
+	return offset
+}
+
+func (x *ResetPasswordResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ResetPasswordResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ChangePasswordRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ChangePasswordRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ChangePasswordRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.OldPassword == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOldPassword())
+	return offset
+}
+
+func (x *ChangePasswordRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.NewPassword == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetNewPassword())
+	return offset
+}
+
+func (x *ChangePasswordResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ChangePasswordResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ResendVerificationRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ResendVerificationRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Channel == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetChannel())
+	return offset
+}
+
+func (x *ResendVerificationRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Destination == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetDestination())
+	return offset
+}
+
+func (x *ResendVerificationResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ResendVerificationResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *VerifyContactRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *VerifyContactRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Channel == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetChannel())
+	return offset
+}
+
+func (x *VerifyContactRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Destination == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetDestination())
+	return offset
+}
+
+func (x *VerifyContactRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCode())
+	return offset
+}
+
+func (x *VerifyContactResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *VerifyContactResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *AssignRoleRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *AssignRoleRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *AssignRoleRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *AssignRoleRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Role == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetRole())
+	return offset
+}
+
+func (x *AssignRoleResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *AssignRoleResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *RevokeRoleRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *RevokeRoleRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *RevokeRoleRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *RevokeRoleRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Role == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetRole())
+	return offset
+}
+
+func (x *RevokeRoleResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RevokeRoleResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListUserRolesRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListUserRolesRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ListUserRolesRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *ListUserRolesResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ListUserRolesResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListUserRolesResponse) fastWriteField2(buf []byte) (offset int) {
+	if len(x.Roles) == 0 {
+		return offset
+	}
+	for i := range x.GetRoles() {
+		offset += fastpb.WriteString(buf[offset:], 2, x.GetRoles()[i])
+	}
+	return offset
+}
+
+func (x *ListUserRolesResponse) fastWriteField3(buf []byte) (offset int) {
+	if len(x.Permissions) == 0 {
+		return offset
+	}
+	for i := range x.GetPermissions() {
+		offset += fastpb.WriteString(buf[offset:], 3, x.GetPermissions()[i])
+	}
+	return offset
+}
+
+func (x *UpdateUserStatusRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UpdateUserStatusRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *UpdateUserStatusRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *UpdateUserStatusRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Status == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetStatus())
+	return offset
+}
+
+func (x *UpdateUserStatusResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *UpdateUserStatusResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *UserBan) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	return offset
+}
+
+func (x *UserBan) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *UserBan) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *UserBan) fastWriteField3(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReason())
+	return offset
+}
+
+func (x *UserBan) fastWriteField4(buf []byte) (offset int) {
+	if x.OperatorId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetOperatorId())
+	return offset
+}
+
+func (x *UserBan) fastWriteField5(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetCreatedAt())
+	return offset
+}
+
+func (x *UserBan) fastWriteField6(buf []byte) (offset int) {
+	if x.ExpiresAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.GetExpiresAt())
+	return offset
+}
+
+func (x *UserBan) fastWriteField7(buf []byte) (offset int) {
+	if x.LiftedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.GetLiftedAt())
+	return offset
+}
+
+func (x *UserBan) fastWriteField8(buf []byte) (offset int) {
+	if x.LiftedBy == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 8, x.GetLiftedBy())
+	return offset
+}
+
+func (x *UserBan) fastWriteField9(buf []byte) (offset int) {
+	if x.LiftReason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetLiftReason())
+	return offset
+}
+
+func (x *BanUserRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *BanUserRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *BanUserRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *BanUserRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReason())
+	return offset
+}
+
+func (x *BanUserRequest) fastWriteField4(buf []byte) (offset int) {
+	if x.DurationSeconds == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetDurationSeconds())
+	return offset
+}
+
+func (x *BanUserResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *BanUserResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *BanUserResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Ban == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetBan())
+	return offset
+}
+
+func (x *UnbanUserRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UnbanUserRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *UnbanUserRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *UnbanUserRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReason())
+	return offset
+}
+
+func (x *UnbanUserResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *UnbanUserResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListUserBansRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListUserBansRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ListUserBansRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *ListUserBansResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListUserBansResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListUserBansResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Bans == nil {
+		return offset
+	}
+	for i := range x.GetBans() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetBans()[i])
+	}
+	return offset
+}
+
+func (x *UnlockLoginRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UnlockLoginRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *UnlockLoginRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetUsername())
+	return offset
+}
+
+func (x *UnlockLoginRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Ip == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetIp())
+	return offset
+}
+
+func (x *UnlockLoginResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *UnlockLoginResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *AuditLog) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	return offset
+}
+
+func (x *AuditLog) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField3(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetUsername())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField4(buf []byte) (offset int) {
+	if x.Event == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetEvent())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField5(buf []byte) (offset int) {
+	if x.Outcome == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetOutcome())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField6(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetReason())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField7(buf []byte) (offset int) {
+	if x.OperatorId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.GetOperatorId())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField8(buf []byte) (offset int) {
+	if x.Ip == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetIp())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField9(buf []byte) (offset int) {
+	if x.UserAgent == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetUserAgent())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField10(buf []byte) (offset int) {
+	if x.TraceId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 10, x.GetTraceId())
+	return offset
+}
+
+func (x *AuditLog) fastWriteField11(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 11, x.GetCreatedAt())
+	return offset
+}
+
+func (x *ListAuditLogsRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *ListAuditLogsRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ListAuditLogsRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *ListAuditLogsRequest) fastWriteField3(buf []byte) (offset int) {
+	if x.Event == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetEvent())
+	return offset
+}
+
+func (x *ListAuditLogsRequest) fastWriteField4(buf []byte) (offset int) {
+	if x.StartTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetStartTime())
+	return offset
+}
+
+func (x *ListAuditLogsRequest) fastWriteField5(buf []byte) (offset int) {
+	if x.EndTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetEndTime())
+	return offset
+}
+
+func (x *ListAuditLogsRequest) fastWriteField6(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 6, x.GetPage())
+	return offset
+}
+
+func (x *ListAuditLogsRequest) fastWriteField7(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 7, x.GetPageSize())
+	return offset
+}
+
+func (x *ListAuditLogsResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ListAuditLogsResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListAuditLogsResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Logs == nil {
+		return offset
+	}
+	for i := range x.GetLogs() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetLogs()[i])
+	}
+	return offset
+}
+
+func (x *ListAuditLogsResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetTotal())
+	return offset
+}
+
+func (x *APIKey) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *APIKey) fastWriteField1(buf []byte) (offset int) {
+	if x.KeyId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetKeyId())
+	return offset
+}
+
+func (x *APIKey) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *APIKey) fastWriteField3(buf []byte) (offset int) {
+	if len(x.Scopes) == 0 {
+		return offset
+	}
+	for i := range x.GetScopes() {
+		offset += fastpb.WriteString(buf[offset:], 3, x.GetScopes()[i])
+	}
+	return offset
+}
+
+func (x *APIKey) fastWriteField4(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetCreatedAt())
+	return offset
+}
+
+func (x *APIKey) fastWriteField5(buf []byte) (offset int) {
+	if x.ExpiresAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetExpiresAt())
+	return offset
+}
+
+func (x *APIKey) fastWriteField6(buf []byte) (offset int) {
+	if x.LastUsedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.GetLastUsedAt())
+	return offset
+}
+
+func (x *APIKey) fastWriteField7(buf []byte) (offset int) {
+	if !x.Revoked {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 7, x.GetRevoked())
+	return offset
+}
+
+func (x *CreateAPIKeyRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *CreateAPIKeyRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *CreateAPIKeyRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *CreateAPIKeyRequest) fastWriteField3(buf []byte) (offset int) {
+	if len(x.Scopes) == 0 {
+		return offset
+	}
+	for i := range x.GetScopes() {
+		offset += fastpb.WriteString(buf[offset:], 3, x.GetScopes()[i])
+	}
+	return offset
+}
+
+func (x *CreateAPIKeyRequest) fastWriteField4(buf []byte) (offset int) {
+	if x.ExpiresInDays == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetExpiresInDays())
+	return offset
+}
+
+func (x *CreateAPIKeyResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *CreateAPIKeyResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *CreateAPIKeyResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Key == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetKey())
+	return offset
+}
+
+func (x *CreateAPIKeyResponse) fastWriteField3(buf []byte) (offset int) {
+	if x.Secret == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetSecret())
+	return offset
+}
+
+func (x *ListAPIKeysRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListAPIKeysRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ListAPIKeysResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListAPIKeysResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListAPIKeysResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Keys == nil {
+		return offset
+	}
+	for i := range x.GetKeys() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetKeys()[i])
+	}
+	return offset
+}
+
+func (x *RevokeAPIKeyRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RevokeAPIKeyRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *RevokeAPIKeyRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.KeyId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetKeyId())
+	return offset
+}
+
+func (x *RevokeAPIKeyResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RevokeAPIKeyResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *VerifyAPISignatureReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *VerifyAPISignatureReq) fastWriteField1(buf []byte) (offset int) {
+	if x.KeyId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetKeyId())
+	return offset
+}
+
+func (x *VerifyAPISignatureReq) fastWriteField2(buf []byte) (offset int) {
+	if x.StringToSign == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetStringToSign())
+	return offset
+}
+
+func (x *VerifyAPISignatureReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Signature == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetSignature())
+	return offset
+}
+
+func (x *VerifyAPISignatureResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *VerifyAPISignatureResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *VerifyAPISignatureResp) fastWriteField2(buf []byte) (offset int) {
+	if !x.Valid {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 2, x.GetValid())
+	return offset
+}
+
+func (x *VerifyAPISignatureResp) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetUserId())
+	return offset
+}
+
+func (x *VerifyAPISignatureResp) fastWriteField4(buf []byte) (offset int) {
+	if len(x.Scopes) == 0 {
+		return offset
+	}
+	for i := range x.GetScopes() {
+		offset += fastpb.WriteString(buf[offset:], 4, x.GetScopes()[i])
+	}
+	return offset
+}
+
+func (x *VerificationStatusReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *VerificationStatusReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *VerificationStatusResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *VerificationStatusResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *VerificationStatusResp) fastWriteField2(buf []byte) (offset int) {
+	if !x.EmailVerified {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 2, x.GetEmailVerified())
+	return offset
+}
+
+func (x *VerificationStatusResp) fastWriteField3(buf []byte) (offset int) {
+	if !x.PhoneVerified {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 3, x.GetPhoneVerified())
+	return offset
+}
+
+func (x *VerificationStatusResp) fastWriteField4(buf []byte) (offset int) {
+	if !x.LoginAllowed {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 4, x.GetLoginAllowed())
+	return offset
+}
+
+func (x *VerificationStatusResp) fastWriteField5(buf []byte) (offset int) {
+	if !x.CheckoutAllowed {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 5, x.GetCheckoutAllowed())
+	return offset
+}
+
+func (x *RefreshTokenRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RefreshTokenRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetRefreshToken())
+	return offset
+}
+
+func (x *RefreshTokenResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RefreshTokenResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *RefreshTokenResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Data == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
+	return offset
+}
+
+func (x *RefreshTokenData) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RefreshTokenData) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *RefreshTokenData) fastWriteField2(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetRefreshToken())
+	return offset
+}
+
+func (x *LogoutRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *LogoutRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *LogoutResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *LogoutResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *Session) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *Session) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Session) fastWriteField2(buf []byte) (offset int) {
+	if x.DeviceName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetDeviceName())
+	return offset
+}
+
+func (x *Session) fastWriteField3(buf []byte) (offset int) {
+	if x.UserAgent == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetUserAgent())
+	return offset
+}
+
+func (x *Session) fastWriteField4(buf []byte) (offset int) {
+	if x.Ip == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetIp())
+	return offset
+}
+
+func (x *Session) fastWriteField5(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetCreatedAt())
+	return offset
+}
+
+func (x *Session) fastWriteField6(buf []byte) (offset int) {
+	if x.LastSeenAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.GetLastSeenAt())
+	return offset
+}
+
+func (x *Session) fastWriteField7(buf []byte) (offset int) {
+	if !x.Current {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 7, x.GetCurrent())
+	return offset
+}
+
+func (x *ListSessionsRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListSessionsRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ListSessionsResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListSessionsResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListSessionsResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Sessions == nil {
+		return offset
+	}
+	for i := range x.GetSessions() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetSessions()[i])
+	}
+	return offset
+}
+
+func (x *RevokeSessionRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RevokeSessionRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *RevokeSessionRequest) fastWriteField2(buf []byte) (offset int) {
+	if x.SessionId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetSessionId())
+	return offset
+}
+
+func (x *RevokeSessionResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RevokeSessionResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *LogoutAllRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *LogoutAllRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *LogoutAllResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *LogoutAllResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ValidateTokenRequest) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ValidateTokenRequest) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ValidateTokenResponse) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ValidateTokenResponse) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ValidateTokenResponse) fastWriteField2(buf []byte) (offset int) {
+	if x.Data == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
+	return offset
+}
+
+func (x *ValidateTokenData) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *ValidateTokenData) fastWriteField1(buf []byte) (offset int) {
+	if !x.Valid {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetValid())
+	return offset
+}
+
+func (x *ValidateTokenData) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *ValidateTokenData) fastWriteField3(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetUsername())
+	return offset
+}
+
+func (x *ValidateTokenData) fastWriteField4(buf []byte) (offset int) {
+	if x.ClientId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetClientId())
+	return offset
+}
+
+func (x *ValidateTokenData) fastWriteField5(buf []byte) (offset int) {
+	if x.Scope == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetScope())
+	return offset
+}
+
+func (x *ValidateTokenData) fastWriteField6(buf []byte) (offset int) {
+	if len(x.Roles) == 0 {
+		return offset
+	}
+	for i := range x.GetRoles() {
+		offset += fastpb.WriteString(buf[offset:], 6, x.GetRoles()[i])
+	}
+	return offset
+}
+
+func (x *ValidateTokenData) fastWriteField7(buf []byte) (offset int) {
+	if len(x.Permissions) == 0 {
+		return offset
+	}
+	for i := range x.GetPermissions() {
+		offset += fastpb.WriteString(buf[offset:], 7, x.GetPermissions()[i])
+	}
+	return offset
+}
+
+func (x *BaseResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *BaseResp) sizeField1() (n int) {
+	if x.Code == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetCode())
+	return n
+}
+
+func (x *BaseResp) sizeField2() (n int) {
+	if x.Message == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetMessage())
+	return n
+}
+
+func (x *DeliverTokenReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DeliverTokenReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *DeliverTokenReq) sizeField2() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetToken())
+	return n
+}
+
+func (x *DeliveryResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DeliveryResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *DeliveryResp) sizeField2() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(2, x.GetSuccess())
+	return n
+}
+
+func (x *VerifyTokenReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *VerifyTokenReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *VerifyTokenReq) sizeField2() (n int) {
+	if !x.WithRoles {
+		return n
+	}
+	n += fastpb.SizeBool(2, x.GetWithRoles())
+	return n
+}
+
+func (x *VerifyResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *VerifyResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *VerifyResp) sizeField2() (n int) {
+	if !x.Valid {
+		return n
+	}
+	n += fastpb.SizeBool(2, x.GetValid())
+	return n
+}
+
+func (x *VerifyResp) sizeField3() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetUserId())
+	return n
+}
+
+func (x *VerifyResp) sizeField4() (n int) {
+	if len(x.Roles) == 0 {
+		return n
+	}
+	for i := range x.GetRoles() {
+		n += fastpb.SizeString(4, x.GetRoles()[i])
+	}
+	return n
+}
+
+func (x *VerifyResp) sizeField5() (n int) {
+	if len(x.Permissions) == 0 {
+		return n
+	}
+	for i := range x.GetPermissions() {
+		n += fastpb.SizeString(5, x.GetPermissions()[i])
+	}
+	return n
+}
+
+func (x *RegisterRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *RegisterRequest) sizeField1() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetUsername())
+	return n
+}
+
+func (x *RegisterRequest) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPassword())
+	return n
+}
+
+func (x *RegisterRequest) sizeField3() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetEmail())
+	return n
+}
+
+func (x *RegisterRequest) sizeField4() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetPhone())
+	return n
+}
+
+func (x *RegisterResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RegisterResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *RegisterResponse) sizeField2() (n int) {
+	if x.Data == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetData())
+	return n
+}
+
+func (x *RegisterData) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RegisterData) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *RegisterData) sizeField2() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetToken())
+	return n
+}
+
+func (x *LoginRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *LoginRequest) sizeField1() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetUsername())
+	return n
+}
+
+func (x *LoginRequest) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPassword())
+	return n
+}
+
+func (x *LoginResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *LoginResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *LoginResponse) sizeField2() (n int) {
+	if x.Data == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetData())
+	return n
+}
+
+func (x *LoginData) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *LoginData) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *LoginData) sizeField2() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetRefreshToken())
+	return n
+}
+
+func (x *LoginData) sizeField3() (n int) {
+	if !x.TwoFactorRequired {
+		return n
+	}
+	n += fastpb.SizeBool(3, x.GetTwoFactorRequired())
+	return n
+}
+
+func (x *LoginData) sizeField4() (n int) {
+	if x.ChallengeToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetChallengeToken())
+	return n
+}
+
+func (x *TwoFactorLoginRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *TwoFactorLoginRequest) sizeField1() (n int) {
+	if x.ChallengeToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetChallengeToken())
+	return n
+}
+
+func (x *TwoFactorLoginRequest) sizeField2() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCode())
+	return n
+}
+
+func (x *EnrollTwoFactorRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *EnrollTwoFactorRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *EnrollTwoFactorResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *EnrollTwoFactorResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *EnrollTwoFactorResponse) sizeField2() (n int) {
+	if x.Data == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetData())
+	return n
+}
+
+func (x *EnrollTwoFactorData) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *EnrollTwoFactorData) sizeField1() (n int) {
+	if x.Secret == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetSecret())
+	return n
+}
+
+func (x *EnrollTwoFactorData) sizeField2() (n int) {
+	if x.OtpauthUri == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOtpauthUri())
+	return n
+}
+
+func (x *EnrollTwoFactorData) sizeField3() (n int) {
+	if len(x.RecoveryCodes) == 0 {
+		return n
+	}
+	for i := range x.GetRecoveryCodes() {
+		n += fastpb.SizeString(3, x.GetRecoveryCodes()[i])
+	}
+	return n
+}
+
+func (x *ConfirmTwoFactorRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ConfirmTwoFactorRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ConfirmTwoFactorRequest) sizeField2() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCode())
+	return n
+}
+
+func (x *ConfirmTwoFactorResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ConfirmTwoFactorResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *DisableTwoFactorRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DisableTwoFactorRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *DisableTwoFactorRequest) sizeField2() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCode())
+	return n
+}
+
+func (x *DisableTwoFactorResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DisableTwoFactorResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ForgotPasswordRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ForgotPasswordRequest) sizeField1() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmail())
+	return n
+}
+
+func (x *ForgotPasswordRequest) sizeField2() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPhone())
+	return n
+}
+
+func (x *ForgotPasswordResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ForgotPasswordResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ResetPasswordRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ResetPasswordRequest) sizeField1() (n int) {
+	if x.ResetToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetResetToken())
+	return n
+}
+
+func (x *ResetPasswordRequest) sizeField2() (n int) {
+	if x.NewPassword == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetNewPassword())
+	return n
+}
+
+func (x *ResetPasswordResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ResetPasswordResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ChangePasswordRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ChangePasswordRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ChangePasswordRequest) sizeField2() (n int) {
+	if x.OldPassword == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOldPassword())
+	return n
+}
+
+func (x *ChangePasswordRequest) sizeField3() (n int) {
+	if x.NewPassword == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetNewPassword())
+	return n
+}
+
+func (x *ChangePasswordResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ChangePasswordResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ResendVerificationRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ResendVerificationRequest) sizeField1() (n int) {
+	if x.Channel == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetChannel())
+	return n
+}
+
+func (x *ResendVerificationRequest) sizeField2() (n int) {
+	if x.Destination == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetDestination())
+	return n
+}
+
+func (x *ResendVerificationResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ResendVerificationResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *VerifyContactRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *VerifyContactRequest) sizeField1() (n int) {
+	if x.Channel == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetChannel())
+	return n
+}
+
+func (x *VerifyContactRequest) sizeField2() (n int) {
+	if x.Destination == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetDestination())
+	return n
+}
+
+func (x *VerifyContactRequest) sizeField3() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetCode())
+	return n
+}
+
+func (x *VerifyContactResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *VerifyContactResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *AssignRoleRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *AssignRoleRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *AssignRoleRequest) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *AssignRoleRequest) sizeField3() (n int) {
+	if x.Role == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetRole())
+	return n
+}
+
+func (x *AssignRoleResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *AssignRoleResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *RevokeRoleRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *RevokeRoleRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *RevokeRoleRequest) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *RevokeRoleRequest) sizeField3() (n int) {
+	if x.Role == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetRole())
+	return n
+}
+
+func (x *RevokeRoleResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RevokeRoleResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ListUserRolesRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListUserRolesRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ListUserRolesRequest) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *ListUserRolesResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ListUserRolesResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ListUserRolesResponse) sizeField2() (n int) {
+	if len(x.Roles) == 0 {
+		return n
+	}
+	for i := range x.GetRoles() {
+		n += fastpb.SizeString(2, x.GetRoles()[i])
+	}
+	return n
+}
+
+func (x *ListUserRolesResponse) sizeField3() (n int) {
+	if len(x.Permissions) == 0 {
+		return n
+	}
+	for i := range x.GetPermissions() {
+		n += fastpb.SizeString(3, x.GetPermissions()[i])
+	}
+	return n
+}
+
+func (x *UpdateUserStatusRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UpdateUserStatusRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *UpdateUserStatusRequest) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *UpdateUserStatusRequest) sizeField3() (n int) {
+	if x.Status == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetStatus())
+	return n
+}
+
+func (x *UpdateUserStatusResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *UpdateUserStatusResponse) sizeField1() (n int) {
 	if x.Base == nil {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
 }
 
-func (x *DeliveryResp) fastWriteField2(buf []byte) (offset int) {
-	if !x.Success {
-		return offset
+func (x *UserBan) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteBool(buf[offset:], 2, x.GetSuccess())
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	return n
 }
 
-func (x *VerifyTokenReq) FastWrite(buf []byte) (offset int) {
+func (x *UserBan) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *UserBan) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *UserBan) sizeField3() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReason())
+	return n
+}
+
+func (x *UserBan) sizeField4() (n int) {
+	if x.OperatorId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetOperatorId())
+	return n
+}
+
+func (x *UserBan) sizeField5() (n int) {
+	if x.CreatedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetCreatedAt())
+	return n
+}
+
+func (x *UserBan) sizeField6() (n int) {
+	if x.ExpiresAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(6, x.GetExpiresAt())
+	return n
+}
+
+func (x *UserBan) sizeField7() (n int) {
+	if x.LiftedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(7, x.GetLiftedAt())
+	return n
+}
+
+func (x *UserBan) sizeField8() (n int) {
+	if x.LiftedBy == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(8, x.GetLiftedBy())
+	return n
+}
+
+func (x *UserBan) sizeField9() (n int) {
+	if x.LiftReason == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetLiftReason())
+	return n
+}
+
+func (x *BanUserRequest) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
 }
 
-func (x *VerifyTokenReq) fastWriteField1(buf []byte) (offset int) {
+func (x *BanUserRequest) sizeField1() (n int) {
 	if x.Token == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *BanUserRequest) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *BanUserRequest) sizeField3() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReason())
+	return n
+}
+
+func (x *BanUserRequest) sizeField4() (n int) {
+	if x.DurationSeconds == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetDurationSeconds())
+	return n
+}
+
+func (x *BanUserResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *BanUserResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *BanUserResponse) sizeField2() (n int) {
+	if x.Ban == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetBan())
+	return n
+}
+
+func (x *UnbanUserRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UnbanUserRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *UnbanUserRequest) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *UnbanUserRequest) sizeField3() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReason())
+	return n
+}
+
+func (x *UnbanUserResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *UnbanUserResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ListUserBansRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListUserBansRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ListUserBansRequest) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *ListUserBansResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListUserBansResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ListUserBansResponse) sizeField2() (n int) {
+	if x.Bans == nil {
+		return n
+	}
+	for i := range x.GetBans() {
+		n += fastpb.SizeMessage(2, x.GetBans()[i])
+	}
+	return n
+}
+
+func (x *UnlockLoginRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UnlockLoginRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *UnlockLoginRequest) sizeField2() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetUsername())
+	return n
+}
+
+func (x *UnlockLoginRequest) sizeField3() (n int) {
+	if x.Ip == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetIp())
+	return n
+}
+
+func (x *UnlockLoginResponse) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
 }
 
-func (x *VerifyResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *UnlockLoginResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	return offset
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
 }
 
-func (x *VerifyResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
+func (x *AuditLog) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	return n
 }
 
-func (x *VerifyResp) fastWriteField2(buf []byte) (offset int) {
-	if !x.Valid {
-		return offset
+func (x *AuditLog) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
 	}
-	offset += fastpb.WriteBool(buf[offset:], 2, x.GetValid())
-	return offset
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
 }
 
-func (x *VerifyResp) fastWriteField3(buf []byte) (offset int) {
+func (x *AuditLog) sizeField2() (n int) {
 	if x.UserId == 0 {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetUserId())
-	return offset
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
 }
 
-func (x *RegisterRequest) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *AuditLog) sizeField3() (n int) {
+	if x.Username == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	return offset
+	n += fastpb.SizeString(3, x.GetUsername())
+	return n
 }
 
-func (x *RegisterRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.Username == "" {
-		return offset
+func (x *AuditLog) sizeField4() (n int) {
+	if x.Event == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
-	return offset
+	n += fastpb.SizeString(4, x.GetEvent())
+	return n
 }
 
-func (x *RegisterRequest) fastWriteField2(buf []byte) (offset int) {
-	if x.Password == "" {
-		return offset
+func (x *AuditLog) sizeField5() (n int) {
+	if x.Outcome == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
-	return offset
+	n += fastpb.SizeString(5, x.GetOutcome())
+	return n
 }
 
-func (x *RegisterRequest) fastWriteField3(buf []byte) (offset int) {
-	if x.Email == "" {
-		return offset
+func (x *AuditLog) sizeField6() (n int) {
+	if x.Reason == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetEmail())
-	return offset
+	n += fastpb.SizeString(6, x.GetReason())
+	return n
 }
 
-func (x *RegisterRequest) fastWriteField4(buf []byte) (offset int) {
-	if x.Phone == "" {
-		return offset
+func (x *AuditLog) sizeField7() (n int) {
+	if x.OperatorId == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetPhone())
-	return offset
+	n += fastpb.SizeInt64(7, x.GetOperatorId())
+	return n
 }
 
-func (x *RegisterResponse) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *AuditLog) sizeField8() (n int) {
+	if x.Ip == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeString(8, x.GetIp())
+	return n
 }
 
-func (x *RegisterResponse) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
+func (x *AuditLog) sizeField9() (n int) {
+	if x.UserAgent == "" {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeString(9, x.GetUserAgent())
+	return n
 }
 
-func (x *RegisterResponse) fastWriteField2(buf []byte) (offset int) {
-	if x.Data == nil {
-		return offset
+func (x *AuditLog) sizeField10() (n int) {
+	if x.TraceId == "" {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
-	return offset
+	n += fastpb.SizeString(10, x.GetTraceId())
+	return n
 }
 
-func (x *RegisterData) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *AuditLog) sizeField11() (n int) {
+	if x.CreatedAt == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeInt64(11, x.GetCreatedAt())
+	return n
 }
 
-func (x *RegisterData) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *ListAuditLogsRequest) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
 }
 
-func (x *RegisterData) fastWriteField2(buf []byte) (offset int) {
+func (x *ListAuditLogsRequest) sizeField1() (n int) {
 	if x.Token == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetToken())
-	return offset
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
 }
 
-func (x *LoginRequest) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ListAuditLogsRequest) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
 }
 
-func (x *LoginRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.Username == "" {
-		return offset
+func (x *ListAuditLogsRequest) sizeField3() (n int) {
+	if x.Event == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
-	return offset
+	n += fastpb.SizeString(3, x.GetEvent())
+	return n
 }
 
-func (x *LoginRequest) fastWriteField2(buf []byte) (offset int) {
-	if x.Password == "" {
-		return offset
+func (x *ListAuditLogsRequest) sizeField4() (n int) {
+	if x.StartTime == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
-	return offset
+	n += fastpb.SizeInt64(4, x.GetStartTime())
+	return n
 }
 
-func (x *LoginResponse) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ListAuditLogsRequest) sizeField5() (n int) {
+	if x.EndTime == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeInt64(5, x.GetEndTime())
+	return n
 }
 
-func (x *LoginResponse) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
+func (x *ListAuditLogsRequest) sizeField6() (n int) {
+	if x.Page == 0 {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeInt32(6, x.GetPage())
+	return n
 }
 
-func (x *LoginResponse) fastWriteField2(buf []byte) (offset int) {
-	if x.Data == nil {
-		return offset
+func (x *ListAuditLogsRequest) sizeField7() (n int) {
+	if x.PageSize == 0 {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
-	return offset
+	n += fastpb.SizeInt32(7, x.GetPageSize())
+	return n
 }
 
-func (x *LoginData) FastWrite(buf []byte) (offset int) {
+func (x *ListAuditLogsResponse) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *LoginData) fastWriteField1(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *ListAuditLogsResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
 }
 
-func (x *LoginData) fastWriteField2(buf []byte) (offset int) {
-	if x.RefreshToken == "" {
-		return offset
+func (x *ListAuditLogsResponse) sizeField2() (n int) {
+	if x.Logs == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetRefreshToken())
-	return offset
+	for i := range x.GetLogs() {
+		n += fastpb.SizeMessage(2, x.GetLogs()[i])
+	}
+	return n
 }
 
-func (x *RefreshTokenRequest) FastWrite(buf []byte) (offset int) {
+func (x *ListAuditLogsResponse) sizeField3() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetTotal())
+	return n
+}
+
+func (x *APIKey) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
 }
 
-func (x *RefreshTokenRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.RefreshToken == "" {
-		return offset
+func (x *APIKey) sizeField1() (n int) {
+	if x.KeyId == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetRefreshToken())
-	return offset
+	n += fastpb.SizeString(1, x.GetKeyId())
+	return n
 }
 
-func (x *RefreshTokenResponse) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *APIKey) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeString(2, x.GetName())
+	return n
 }
 
-func (x *RefreshTokenResponse) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
+func (x *APIKey) sizeField3() (n int) {
+	if len(x.Scopes) == 0 {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	for i := range x.GetScopes() {
+		n += fastpb.SizeString(3, x.GetScopes()[i])
+	}
+	return n
 }
 
-func (x *RefreshTokenResponse) fastWriteField2(buf []byte) (offset int) {
-	if x.Data == nil {
-		return offset
+func (x *APIKey) sizeField4() (n int) {
+	if x.CreatedAt == 0 {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
-	return offset
+	n += fastpb.SizeInt64(4, x.GetCreatedAt())
+	return n
 }
 
-func (x *RefreshTokenData) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *APIKey) sizeField5() (n int) {
+	if x.ExpiresAt == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeInt64(5, x.GetExpiresAt())
+	return n
 }
 
-func (x *RefreshTokenData) fastWriteField1(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *APIKey) sizeField6() (n int) {
+	if x.LastUsedAt == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeInt64(6, x.GetLastUsedAt())
+	return n
 }
 
-func (x *RefreshTokenData) fastWriteField2(buf []byte) (offset int) {
-	if x.RefreshToken == "" {
-		return offset
+func (x *APIKey) sizeField7() (n int) {
+	if !x.Revoked {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetRefreshToken())
-	return offset
+	n += fastpb.SizeBool(7, x.GetRevoked())
+	return n
 }
 
-func (x *LogoutRequest) FastWrite(buf []byte) (offset int) {
+func (x *CreateAPIKeyRequest) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
 }
 
-func (x *LogoutRequest) fastWriteField1(buf []byte) (offset int) {
+func (x *CreateAPIKeyRequest) sizeField1() (n int) {
 	if x.Token == "" {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
 }
 
-func (x *LogoutResponse) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *CreateAPIKeyRequest) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeString(2, x.GetName())
+	return n
 }
 
-func (x *LogoutResponse) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
+func (x *CreateAPIKeyRequest) sizeField3() (n int) {
+	if len(x.Scopes) == 0 {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
-}
-
-func (x *ValidateTokenRequest) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+	for i := range x.GetScopes() {
+		n += fastpb.SizeString(3, x.GetScopes()[i])
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	return n
 }
 
-func (x *ValidateTokenRequest) fastWriteField1(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *CreateAPIKeyRequest) sizeField4() (n int) {
+	if x.ExpiresInDays == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeInt32(4, x.GetExpiresInDays())
+	return n
 }
 
-func (x *ValidateTokenResponse) FastWrite(buf []byte) (offset int) {
+func (x *CreateAPIKeyResponse) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *ValidateTokenResponse) fastWriteField1(buf []byte) (offset int) {
+func (x *CreateAPIKeyResponse) sizeField1() (n int) {
 	if x.Base == nil {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
 }
 
-func (x *ValidateTokenResponse) fastWriteField2(buf []byte) (offset int) {
-	if x.Data == nil {
-		return offset
+func (x *CreateAPIKeyResponse) sizeField2() (n int) {
+	if x.Key == nil {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetData())
-	return offset
+	n += fastpb.SizeMessage(2, x.GetKey())
+	return n
 }
 
-func (x *ValidateTokenData) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *CreateAPIKeyResponse) sizeField3() (n int) {
+	if x.Secret == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeString(3, x.GetSecret())
+	return n
 }
 
-func (x *ValidateTokenData) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *ListAPIKeysRequest) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
-	return offset
+	n += x.sizeField1()
+	return n
 }
 
-func (x *ValidateTokenData) fastWriteField2(buf []byte) (offset int) {
-	if x.Username == "" {
-		return offset
+func (x *ListAPIKeysRequest) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetUsername())
-	return offset
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
 }
 
-func (x *BaseResp) Size() (n int) {
+func (x *ListAPIKeysResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1179,23 +7224,25 @@ func (x *BaseResp) Size() (n int) {
 	return n
 }
 
-func (x *BaseResp) sizeField1() (n int) {
-	if x.Code == 0 {
+func (x *ListAPIKeysResponse) sizeField1() (n int) {
+	if x.Base == nil {
 		return n
 	}
-	n += fastpb.SizeInt32(1, x.GetCode())
+	n += fastpb.SizeMessage(1, x.GetBase())
 	return n
 }
 
-func (x *BaseResp) sizeField2() (n int) {
-	if x.Message == "" {
+func (x *ListAPIKeysResponse) sizeField2() (n int) {
+	if x.Keys == nil {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetMessage())
+	for i := range x.GetKeys() {
+		n += fastpb.SizeMessage(2, x.GetKeys()[i])
+	}
 	return n
 }
 
-func (x *DeliverTokenReq) Size() (n int) {
+func (x *RevokeAPIKeyRequest) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1204,32 +7251,31 @@ func (x *DeliverTokenReq) Size() (n int) {
 	return n
 }
 
-func (x *DeliverTokenReq) sizeField1() (n int) {
-	if x.UserId == 0 {
+func (x *RevokeAPIKeyRequest) sizeField1() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(1, x.GetUserId())
+	n += fastpb.SizeString(1, x.GetToken())
 	return n
 }
 
-func (x *DeliverTokenReq) sizeField2() (n int) {
-	if x.Token == "" {
+func (x *RevokeAPIKeyRequest) sizeField2() (n int) {
+	if x.KeyId == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetToken())
+	n += fastpb.SizeString(2, x.GetKeyId())
 	return n
 }
 
-func (x *DeliveryResp) Size() (n int) {
+func (x *RevokeAPIKeyResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
-	n += x.sizeField2()
 	return n
 }
 
-func (x *DeliveryResp) sizeField1() (n int) {
+func (x *RevokeAPIKeyResponse) sizeField1() (n int) {
 	if x.Base == nil {
 		return n
 	}
@@ -1237,41 +7283,52 @@ func (x *DeliveryResp) sizeField1() (n int) {
 	return n
 }
 
-func (x *DeliveryResp) sizeField2() (n int) {
-	if !x.Success {
+func (x *VerifyAPISignatureReq) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeBool(2, x.GetSuccess())
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
 	return n
 }
 
-func (x *VerifyTokenReq) Size() (n int) {
-	if x == nil {
+func (x *VerifyAPISignatureReq) sizeField1() (n int) {
+	if x.KeyId == "" {
 		return n
 	}
-	n += x.sizeField1()
+	n += fastpb.SizeString(1, x.GetKeyId())
 	return n
 }
 
-func (x *VerifyTokenReq) sizeField1() (n int) {
-	if x.Token == "" {
+func (x *VerifyAPISignatureReq) sizeField2() (n int) {
+	if x.StringToSign == "" {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetToken())
+	n += fastpb.SizeString(2, x.GetStringToSign())
 	return n
 }
 
-func (x *VerifyResp) Size() (n int) {
+func (x *VerifyAPISignatureReq) sizeField3() (n int) {
+	if x.Signature == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetSignature())
+	return n
+}
+
+func (x *VerifyAPISignatureResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
+	n += x.sizeField4()
 	return n
 }
 
-func (x *VerifyResp) sizeField1() (n int) {
+func (x *VerifyAPISignatureResp) sizeField1() (n int) {
 	if x.Base == nil {
 		return n
 	}
@@ -1279,7 +7336,7 @@ func (x *VerifyResp) sizeField1() (n int) {
 	return n
 }
 
-func (x *VerifyResp) sizeField2() (n int) {
+func (x *VerifyAPISignatureResp) sizeField2() (n int) {
 	if !x.Valid {
 		return n
 	}
@@ -1287,7 +7344,7 @@ func (x *VerifyResp) sizeField2() (n int) {
 	return n
 }
 
-func (x *VerifyResp) sizeField3() (n int) {
+func (x *VerifyAPISignatureResp) sizeField3() (n int) {
 	if x.UserId == 0 {
 		return n
 	}
@@ -1295,7 +7352,33 @@ func (x *VerifyResp) sizeField3() (n int) {
 	return n
 }
 
-func (x *RegisterRequest) Size() (n int) {
+func (x *VerifyAPISignatureResp) sizeField4() (n int) {
+	if len(x.Scopes) == 0 {
+		return n
+	}
+	for i := range x.GetScopes() {
+		n += fastpb.SizeString(4, x.GetScopes()[i])
+	}
+	return n
+}
+
+func (x *VerificationStatusReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *VerificationStatusReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *VerificationStatusResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1303,42 +7386,67 @@ func (x *RegisterRequest) Size() (n int) {
 	n += x.sizeField2()
 	n += x.sizeField3()
 	n += x.sizeField4()
+	n += x.sizeField5()
 	return n
 }
 
-func (x *RegisterRequest) sizeField1() (n int) {
-	if x.Username == "" {
+func (x *VerificationStatusResp) sizeField1() (n int) {
+	if x.Base == nil {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetUsername())
+	n += fastpb.SizeMessage(1, x.GetBase())
 	return n
 }
 
-func (x *RegisterRequest) sizeField2() (n int) {
-	if x.Password == "" {
+func (x *VerificationStatusResp) sizeField2() (n int) {
+	if !x.EmailVerified {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetPassword())
+	n += fastpb.SizeBool(2, x.GetEmailVerified())
 	return n
 }
 
-func (x *RegisterRequest) sizeField3() (n int) {
-	if x.Email == "" {
+func (x *VerificationStatusResp) sizeField3() (n int) {
+	if !x.PhoneVerified {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetEmail())
+	n += fastpb.SizeBool(3, x.GetPhoneVerified())
 	return n
 }
 
-func (x *RegisterRequest) sizeField4() (n int) {
-	if x.Phone == "" {
+func (x *VerificationStatusResp) sizeField4() (n int) {
+	if !x.LoginAllowed {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetPhone())
+	n += fastpb.SizeBool(4, x.GetLoginAllowed())
 	return n
 }
 
-func (x *RegisterResponse) Size() (n int) {
+func (x *VerificationStatusResp) sizeField5() (n int) {
+	if !x.CheckoutAllowed {
+		return n
+	}
+	n += fastpb.SizeBool(5, x.GetCheckoutAllowed())
+	return n
+}
+
+func (x *RefreshTokenRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RefreshTokenRequest) sizeField1() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetRefreshToken())
+	return n
+}
+
+func (x *RefreshTokenResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1347,7 +7455,7 @@ func (x *RegisterResponse) Size() (n int) {
 	return n
 }
 
-func (x *RegisterResponse) sizeField1() (n int) {
+func (x *RefreshTokenResponse) sizeField1() (n int) {
 	if x.Base == nil {
 		return n
 	}
@@ -1355,7 +7463,7 @@ func (x *RegisterResponse) sizeField1() (n int) {
 	return n
 }
 
-func (x *RegisterResponse) sizeField2() (n int) {
+func (x *RefreshTokenResponse) sizeField2() (n int) {
 	if x.Data == nil {
 		return n
 	}
@@ -1363,7 +7471,7 @@ func (x *RegisterResponse) sizeField2() (n int) {
 	return n
 }
 
-func (x *RegisterData) Size() (n int) {
+func (x *RefreshTokenData) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1372,98 +7480,125 @@ func (x *RegisterData) Size() (n int) {
 	return n
 }
 
-func (x *RegisterData) sizeField1() (n int) {
-	if x.UserId == 0 {
+func (x *RefreshTokenData) sizeField1() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeInt64(1, x.GetUserId())
+	n += fastpb.SizeString(1, x.GetToken())
 	return n
 }
 
-func (x *RegisterData) sizeField2() (n int) {
+func (x *RefreshTokenData) sizeField2() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetRefreshToken())
+	return n
+}
+
+func (x *LogoutRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *LogoutRequest) sizeField1() (n int) {
 	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetToken())
+	n += fastpb.SizeString(1, x.GetToken())
 	return n
 }
 
-func (x *LoginRequest) Size() (n int) {
+func (x *LogoutResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
-	n += x.sizeField2()
 	return n
 }
 
-func (x *LoginRequest) sizeField1() (n int) {
-	if x.Username == "" {
+func (x *LogoutResponse) sizeField1() (n int) {
+	if x.Base == nil {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetUsername())
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *Session) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
 	return n
 }
 
-func (x *LoginRequest) sizeField2() (n int) {
-	if x.Password == "" {
+func (x *Session) sizeField1() (n int) {
+	if x.Id == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetPassword())
+	n += fastpb.SizeInt64(1, x.GetId())
 	return n
 }
 
-func (x *LoginResponse) Size() (n int) {
-	if x == nil {
+func (x *Session) sizeField2() (n int) {
+	if x.DeviceName == "" {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
+	n += fastpb.SizeString(2, x.GetDeviceName())
 	return n
 }
 
-func (x *LoginResponse) sizeField1() (n int) {
-	if x.Base == nil {
+func (x *Session) sizeField3() (n int) {
+	if x.UserAgent == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(1, x.GetBase())
+	n += fastpb.SizeString(3, x.GetUserAgent())
 	return n
 }
 
-func (x *LoginResponse) sizeField2() (n int) {
-	if x.Data == nil {
+func (x *Session) sizeField4() (n int) {
+	if x.Ip == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(2, x.GetData())
+	n += fastpb.SizeString(4, x.GetIp())
 	return n
 }
 
-func (x *LoginData) Size() (n int) {
-	if x == nil {
+func (x *Session) sizeField5() (n int) {
+	if x.CreatedAt == 0 {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
+	n += fastpb.SizeInt64(5, x.GetCreatedAt())
 	return n
 }
 
-func (x *LoginData) sizeField1() (n int) {
-	if x.Token == "" {
+func (x *Session) sizeField6() (n int) {
+	if x.LastSeenAt == 0 {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetToken())
+	n += fastpb.SizeInt64(6, x.GetLastSeenAt())
 	return n
 }
 
-func (x *LoginData) sizeField2() (n int) {
-	if x.RefreshToken == "" {
+func (x *Session) sizeField7() (n int) {
+	if !x.Current {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetRefreshToken())
+	n += fastpb.SizeBool(7, x.GetCurrent())
 	return n
 }
 
-func (x *RefreshTokenRequest) Size() (n int) {
+func (x *ListSessionsRequest) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1471,15 +7606,15 @@ func (x *RefreshTokenRequest) Size() (n int) {
 	return n
 }
 
-func (x *RefreshTokenRequest) sizeField1() (n int) {
-	if x.RefreshToken == "" {
+func (x *ListSessionsRequest) sizeField1() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetRefreshToken())
+	n += fastpb.SizeString(1, x.GetToken())
 	return n
 }
 
-func (x *RefreshTokenResponse) Size() (n int) {
+func (x *ListSessionsResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1488,7 +7623,7 @@ func (x *RefreshTokenResponse) Size() (n int) {
 	return n
 }
 
-func (x *RefreshTokenResponse) sizeField1() (n int) {
+func (x *ListSessionsResponse) sizeField1() (n int) {
 	if x.Base == nil {
 		return n
 	}
@@ -1496,15 +7631,17 @@ func (x *RefreshTokenResponse) sizeField1() (n int) {
 	return n
 }
 
-func (x *RefreshTokenResponse) sizeField2() (n int) {
-	if x.Data == nil {
+func (x *ListSessionsResponse) sizeField2() (n int) {
+	if x.Sessions == nil {
 		return n
 	}
-	n += fastpb.SizeMessage(2, x.GetData())
+	for i := range x.GetSessions() {
+		n += fastpb.SizeMessage(2, x.GetSessions()[i])
+	}
 	return n
 }
 
-func (x *RefreshTokenData) Size() (n int) {
+func (x *RevokeSessionRequest) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1513,7 +7650,7 @@ func (x *RefreshTokenData) Size() (n int) {
 	return n
 }
 
-func (x *RefreshTokenData) sizeField1() (n int) {
+func (x *RevokeSessionRequest) sizeField1() (n int) {
 	if x.Token == "" {
 		return n
 	}
@@ -1521,15 +7658,15 @@ func (x *RefreshTokenData) sizeField1() (n int) {
 	return n
 }
 
-func (x *RefreshTokenData) sizeField2() (n int) {
-	if x.RefreshToken == "" {
+func (x *RevokeSessionRequest) sizeField2() (n int) {
+	if x.SessionId == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetRefreshToken())
+	n += fastpb.SizeInt64(2, x.GetSessionId())
 	return n
 }
 
-func (x *LogoutRequest) Size() (n int) {
+func (x *RevokeSessionResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1537,7 +7674,23 @@ func (x *LogoutRequest) Size() (n int) {
 	return n
 }
 
-func (x *LogoutRequest) sizeField1() (n int) {
+func (x *RevokeSessionResponse) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *LogoutAllRequest) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *LogoutAllRequest) sizeField1() (n int) {
 	if x.Token == "" {
 		return n
 	}
@@ -1545,7 +7698,7 @@ func (x *LogoutRequest) sizeField1() (n int) {
 	return n
 }
 
-func (x *LogoutResponse) Size() (n int) {
+func (x *LogoutAllResponse) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1553,7 +7706,7 @@ func (x *LogoutResponse) Size() (n int) {
 	return n
 }
 
-func (x *LogoutResponse) sizeField1() (n int) {
+func (x *LogoutAllResponse) sizeField1() (n int) {
 	if x.Base == nil {
 		return n
 	}
@@ -1608,22 +7761,71 @@ func (x *ValidateTokenData) Size() (n int) {
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
 	return n
 }
 
 func (x *ValidateTokenData) sizeField1() (n int) {
-	if x.UserId == 0 {
+	if !x.Valid {
 		return n
 	}
-	n += fastpb.SizeInt64(1, x.GetUserId())
+	n += fastpb.SizeBool(1, x.GetValid())
 	return n
 }
 
 func (x *ValidateTokenData) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *ValidateTokenData) sizeField3() (n int) {
 	if x.Username == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetUsername())
+	n += fastpb.SizeString(3, x.GetUsername())
+	return n
+}
+
+func (x *ValidateTokenData) sizeField4() (n int) {
+	if x.ClientId == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetClientId())
+	return n
+}
+
+func (x *ValidateTokenData) sizeField5() (n int) {
+	if x.Scope == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetScope())
+	return n
+}
+
+func (x *ValidateTokenData) sizeField6() (n int) {
+	if len(x.Roles) == 0 {
+		return n
+	}
+	for i := range x.GetRoles() {
+		n += fastpb.SizeString(6, x.GetRoles()[i])
+	}
+	return n
+}
+
+func (x *ValidateTokenData) sizeField7() (n int) {
+	if len(x.Permissions) == 0 {
+		return n
+	}
+	for i := range x.GetPermissions() {
+		n += fastpb.SizeString(7, x.GetPermissions()[i])
+	}
 	return n
 }
 
@@ -1644,12 +7846,15 @@ var fieldIDToName_DeliveryResp = map[int32]string{
 
 var fieldIDToName_VerifyTokenReq = map[int32]string{
 	1: "Token",
+	2: "WithRoles",
 }
 
 var fieldIDToName_VerifyResp = map[int32]string{
 	1: "Base",
 	2: "Valid",
 	3: "UserId",
+	4: "Roles",
+	5: "Permissions",
 }
 
 var fieldIDToName_RegisterRequest = map[int32]string{
@@ -1682,6 +7887,284 @@ var fieldIDToName_LoginResponse = map[int32]string{
 var fieldIDToName_LoginData = map[int32]string{
 	1: "Token",
 	2: "RefreshToken",
+	3: "TwoFactorRequired",
+	4: "ChallengeToken",
+}
+
+var fieldIDToName_TwoFactorLoginRequest = map[int32]string{
+	1: "ChallengeToken",
+	2: "Code",
+}
+
+var fieldIDToName_EnrollTwoFactorRequest = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_EnrollTwoFactorResponse = map[int32]string{
+	1: "Base",
+	2: "Data",
+}
+
+var fieldIDToName_EnrollTwoFactorData = map[int32]string{
+	1: "Secret",
+	2: "OtpauthUri",
+	3: "RecoveryCodes",
+}
+
+var fieldIDToName_ConfirmTwoFactorRequest = map[int32]string{
+	1: "Token",
+	2: "Code",
+}
+
+var fieldIDToName_ConfirmTwoFactorResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_DisableTwoFactorRequest = map[int32]string{
+	1: "Token",
+	2: "Code",
+}
+
+var fieldIDToName_DisableTwoFactorResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_ForgotPasswordRequest = map[int32]string{
+	1: "Email",
+	2: "Phone",
+}
+
+var fieldIDToName_ForgotPasswordResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_ResetPasswordRequest = map[int32]string{
+	1: "ResetToken",
+	2: "NewPassword",
+}
+
+var fieldIDToName_ResetPasswordResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_ChangePasswordRequest = map[int32]string{
+	1: "Token",
+	2: "OldPassword",
+	3: "NewPassword",
+}
+
+var fieldIDToName_ChangePasswordResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_ResendVerificationRequest = map[int32]string{
+	1: "Channel",
+	2: "Destination",
+}
+
+var fieldIDToName_ResendVerificationResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_VerifyContactRequest = map[int32]string{
+	1: "Channel",
+	2: "Destination",
+	3: "Code",
+}
+
+var fieldIDToName_VerifyContactResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_AssignRoleRequest = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Role",
+}
+
+var fieldIDToName_AssignRoleResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_RevokeRoleRequest = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Role",
+}
+
+var fieldIDToName_RevokeRoleResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_ListUserRolesRequest = map[int32]string{
+	1: "Token",
+	2: "UserId",
+}
+
+var fieldIDToName_ListUserRolesResponse = map[int32]string{
+	1: "Base",
+	2: "Roles",
+	3: "Permissions",
+}
+
+var fieldIDToName_UpdateUserStatusRequest = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Status",
+}
+
+var fieldIDToName_UpdateUserStatusResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_UserBan = map[int32]string{
+	1: "Id",
+	2: "UserId",
+	3: "Reason",
+	4: "OperatorId",
+	5: "CreatedAt",
+	6: "ExpiresAt",
+	7: "LiftedAt",
+	8: "LiftedBy",
+	9: "LiftReason",
+}
+
+var fieldIDToName_BanUserRequest = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Reason",
+	4: "DurationSeconds",
+}
+
+var fieldIDToName_BanUserResponse = map[int32]string{
+	1: "Base",
+	2: "Ban",
+}
+
+var fieldIDToName_UnbanUserRequest = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Reason",
+}
+
+var fieldIDToName_UnbanUserResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_ListUserBansRequest = map[int32]string{
+	1: "Token",
+	2: "UserId",
+}
+
+var fieldIDToName_ListUserBansResponse = map[int32]string{
+	1: "Base",
+	2: "Bans",
+}
+
+var fieldIDToName_UnlockLoginRequest = map[int32]string{
+	1: "Token",
+	2: "Username",
+	3: "Ip",
+}
+
+var fieldIDToName_UnlockLoginResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_AuditLog = map[int32]string{
+	1:  "Id",
+	2:  "UserId",
+	3:  "Username",
+	4:  "Event",
+	5:  "Outcome",
+	6:  "Reason",
+	7:  "OperatorId",
+	8:  "Ip",
+	9:  "UserAgent",
+	10: "TraceId",
+	11: "CreatedAt",
+}
+
+var fieldIDToName_ListAuditLogsRequest = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Event",
+	4: "StartTime",
+	5: "EndTime",
+	6: "Page",
+	7: "PageSize",
+}
+
+var fieldIDToName_ListAuditLogsResponse = map[int32]string{
+	1: "Base",
+	2: "Logs",
+	3: "Total",
+}
+
+var fieldIDToName_APIKey = map[int32]string{
+	1: "KeyId",
+	2: "Name",
+	3: "Scopes",
+	4: "CreatedAt",
+	5: "ExpiresAt",
+	6: "LastUsedAt",
+	7: "Revoked",
+}
+
+var fieldIDToName_CreateAPIKeyRequest = map[int32]string{
+	1: "Token",
+	2: "Name",
+	3: "Scopes",
+	4: "ExpiresInDays",
+}
+
+var fieldIDToName_CreateAPIKeyResponse = map[int32]string{
+	1: "Base",
+	2: "Key",
+	3: "Secret",
+}
+
+var fieldIDToName_ListAPIKeysRequest = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_ListAPIKeysResponse = map[int32]string{
+	1: "Base",
+	2: "Keys",
+}
+
+var fieldIDToName_RevokeAPIKeyRequest = map[int32]string{
+	1: "Token",
+	2: "KeyId",
+}
+
+var fieldIDToName_RevokeAPIKeyResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_VerifyAPISignatureReq = map[int32]string{
+	1: "KeyId",
+	2: "StringToSign",
+	3: "Signature",
+}
+
+var fieldIDToName_VerifyAPISignatureResp = map[int32]string{
+	1: "Base",
+	2: "Valid",
+	3: "UserId",
+	4: "Scopes",
+}
+
+var fieldIDToName_VerificationStatusReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_VerificationStatusResp = map[int32]string{
+	1: "Base",
+	2: "EmailVerified",
+	3: "PhoneVerified",
+	4: "LoginAllowed",
+	5: "CheckoutAllowed",
 }
 
 var fieldIDToName_RefreshTokenRequest = map[int32]string{
@@ -1706,6 +8189,42 @@ var fieldIDToName_LogoutResponse = map[int32]string{
 	1: "Base",
 }
 
+var fieldIDToName_Session = map[int32]string{
+	1: "Id",
+	2: "DeviceName",
+	3: "UserAgent",
+	4: "Ip",
+	5: "CreatedAt",
+	6: "LastSeenAt",
+	7: "Current",
+}
+
+var fieldIDToName_ListSessionsRequest = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_ListSessionsResponse = map[int32]string{
+	1: "Base",
+	2: "Sessions",
+}
+
+var fieldIDToName_RevokeSessionRequest = map[int32]string{
+	1: "Token",
+	2: "SessionId",
+}
+
+var fieldIDToName_RevokeSessionResponse = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_LogoutAllRequest = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_LogoutAllResponse = map[int32]string{
+	1: "Base",
+}
+
 var fieldIDToName_ValidateTokenRequest = map[int32]string{
 	1: "Token",
 }
@@ -1716,8 +8235,13 @@ var fieldIDToName_ValidateTokenResponse = map[int32]string{
 }
 
 var fieldIDToName_ValidateTokenData = map[int32]string{
-	1: "UserId",
-	2: "Username",
+	1: "Valid",
+	2: "UserId",
+	3: "Username",
+	4: "ClientId",
+	5: "Scope",
+	6: "Roles",
+	7: "Permissions",
 }
 
 var _ = api.File_api_proto
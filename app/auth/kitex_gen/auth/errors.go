@@ -8,6 +8,38 @@ var (
 	ErrTokenExpired       = errors.New("token expired")
 	ErrUserNotFound       = errors.New("user not found")
 	ErrUserBanned         = errors.New("user is banned")
+	ErrUserDeleted        = errors.New("account is deleted or pending deletion")
 	ErrTooManyAttempts    = errors.New("too many login attempts")
+	ErrTokenReused        = errors.New("refresh token reused")
+
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication already enabled")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication not enabled")
+	ErrTwoFactorNotEnrolled    = errors.New("two-factor authentication not enrolled")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+
+	ErrIdentityProviderNotFound = errors.New("identity provider not found")
+	ErrInvalidLoginState        = errors.New("invalid or expired login state")
+	ErrIdentityAlreadyLinked    = errors.New("identity already linked to another user")
+
+	ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+	ErrInvalidArgument   = errors.New("invalid argument")
+
+	ErrEmailAlreadyUsed        = errors.New("email already in use")
+	ErrPhoneAlreadyUsed        = errors.New("phone already in use")
+	ErrInvalidVerificationCode = errors.New("invalid or expired verification code")
+	ErrAccountNotVerified      = errors.New("account contact not verified")
+
+	ErrRoleNotFound = errors.New("role not found")
+
+	ErrSessionNotFound = errors.New("session not found")
+
+	ErrAPIKeyNotFound      = errors.New("api key not found")
+	ErrAPIKeyLimitExceeded = errors.New("api key limit exceeded")
+	ErrInvalidAPISignature = errors.New("invalid api key or signature")
+
+	ErrPasskeyNotFound          = errors.New("passkey not found")
+	ErrPasskeyVerification      = errors.New("passkey verification failed")
+	ErrPasskeyAlreadyRegistered = errors.New("passkey already registered")
+	ErrPasskeyLimitExceeded     = errors.New("passkey limit exceeded")
 	// ... 其他错误定义
 )
@@ -1,24 +1,46 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/middlewares/server/recovery"
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/app/server/registry"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/network/standard"
-	"github.com/hertz-contrib/cors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	kserver "github.com/cloudwego/kitex/server"
 
 	"TikTokMall/app/auth/biz/dal/mysql"
 	"TikTokMall/app/auth/biz/dal/redis"
 	"TikTokMall/app/auth/biz/handler"
+	"TikTokMall/app/auth/biz/service"
 	"TikTokMall/app/auth/biz/utils"
 	"TikTokMall/app/auth/conf"
+	"TikTokMall/app/auth/kitex_gen/auth"
+	"TikTokMall/app/auth/kitex_gen/auth/authservice"
+	"TikTokMall/app/auth/pkg/audit"
+	"TikTokMall/app/auth/pkg/encrypt"
 	"TikTokMall/app/auth/pkg/hertz"
+	"TikTokMall/app/auth/pkg/jwt"
+	"TikTokMall/app/auth/pkg/kitex"
 	"TikTokMall/app/auth/pkg/mtls"
+	"TikTokMall/app/auth/pkg/notify"
+	"TikTokMall/app/auth/pkg/oidc"
 	"TikTokMall/app/auth/pkg/tracer"
+	"TikTokMall/app/auth/pkg/webauthn"
+	authmysql "TikTokMall/app/auth/repository/mysql"
+	"TikTokMall/pkg/password"
+	"TikTokMall/pkg/rbac"
+	"TikTokMall/pkg/userdata"
+	"TikTokMall/pkg/websession"
 )
 
 func main() {
@@ -52,6 +74,17 @@ func main() {
 		hlog.Fatalf("init dependencies failed: %v", err)
 	}
 
+	// 启动 RPC 服务
+	rpcServer, err := newRPCServer()
+	if err != nil {
+		hlog.Fatalf("create rpc server failed: %v", err)
+	}
+	go func() {
+		if err := rpcServer.Run(); err != nil {
+			hlog.Fatalf("start rpc server failed: %v", err)
+		}
+	}()
+
 	// 创建 Consul 注册器
 	r, err := hertz.NewConsulRegister("localhost:8500")
 	if err != nil {
@@ -76,6 +109,7 @@ func main() {
 		// 使用TLS和标准网络库创建服务器
 		h = server.New(
 			server.WithHostPorts(":8000"),
+			server.WithExitWaitTime(shutdownWaitTime),
 			server.WithTLS(tlsConfig),
 			server.WithTransport(standard.NewTransporter),
 			server.WithRegistry(r, &registry.Info{
@@ -94,6 +128,7 @@ func main() {
 		// 不使用TLS创建服务器
 		h = server.New(
 			server.WithHostPorts(":8000"),
+			server.WithExitWaitTime(shutdownWaitTime),
 			server.WithRegistry(r, &registry.Info{
 				ServiceName: "auth",
 				Addr:        utils.NewNetAddr("tcp", "localhost:8000"),
@@ -106,36 +141,186 @@ func main() {
 		)
 	}
 
-	// 添加CORS中间件
-	h.Use(cors.New(cors.Config{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		MaxAge:       3600,
+	// 客户端 IP 用于登录和短信限流，只采用可信代理转发的请求头
+	clientIP, err := handler.ClientIPFunc(conf.GetConf().TrustedProxies)
+	if err != nil {
+		hlog.Fatalf("init client ip func failed: %v", err)
+	}
+	h.SetClientIPFunc(clientIP)
+
+	// 添加CORS中间件，只允许配置的前端来源携带 Cookie
+	h.Use(websession.CORS(websession.CORSConfig{
+		AllowOrigins:  conf.GetConf().CORS.AllowOrigins,
+		AllowHeaders:  []string{"X-Device-Name", "X-Trace-Id", "X-Request-Id"},
+		ExposeHeaders: []string{"X-Trace-Id"},
 	}))
 
+	// 浏览器 Cookie 会话：校验 CSRF 令牌并将 Cookie 中的访问令牌转为 Authorization 头
+	h.Use(websession.Middleware())
+	handler.SetSessionCookieConfig(websession.CookieConfig{
+		Domain:   conf.GetConf().Session.CookieDomain,
+		Secure:   conf.GetConf().Session.Secure,
+		SameSite: conf.GetConf().Session.SameSite,
+	})
+
 	// 添加恢复中间件
 	h.Use(recovery.Recovery())
 
+	// 记录客户端信息和追踪 ID，用于登录会话管理和审计日志
+	h.Use(handler.RecordClientInfo())
+
 	// 创建处理器
 	authHandler := handler.NewAuthHandler()
 
+	oauthHandler := handler.NewOAuthHandler(conf.GetConf().OAuth.BaseURL, conf.GetConf().OAuth.LoginURL)
+
+	socialHandler := handler.NewSocialHandler(newSocialProviders(conf.GetConf().OIDC))
+
+	smsLoginHandler := handler.NewSMSLoginHandler(service.SMSLoginPolicy{
+		AutoRegister: conf.GetConf().SMSLogin.AutoRegister,
+		IPSendLimit:  conf.GetConf().SMSLogin.IPSendLimit,
+		IPSendWindow: conf.GetConf().SMSLogin.IPSendWindow,
+	})
+
+	rp, err := newRelyingParty(conf.GetConf().WebAuthn)
+	if err != nil {
+		hlog.Fatalf("init webauthn failed: %v", err)
+	}
+
+	// 管理接口按 AdminRules 检查权限
+	resolve := newPrincipalResolver(service.NewAuthService(authmysql.NewAuthRepository()))
+	require := func(method string) app.HandlerFunc {
+		return rbac.Require(resolve, service.AdminRules[method]...)
+	}
+
 	// 注册路由
+	h.GET("/.well-known/jwks.json", authHandler.JWKS)
+	h.GET("/.well-known/openid-configuration", oauthHandler.Discovery)
+
+	oauth := h.Group("/oauth")
+	{
+		oauth.POST("/clients", oauthHandler.RegisterClient)
+		oauth.GET("/authorize", oauthHandler.Authorize)
+		oauth.POST("/authorize", oauthHandler.Consent)
+		oauth.POST("/token", oauthHandler.Token)
+		oauth.GET("/userinfo", oauthHandler.UserInfo)
+		oauth.GET("/consents", oauthHandler.ListConsents)
+		oauth.DELETE("/consents/:client_id", oauthHandler.RevokeConsent)
+	}
+
 	v1 := h.Group("/v1/auth")
 	{
 		v1.POST("/register", authHandler.Register)
 		v1.POST("/login", authHandler.Login)
 		v1.POST("/refresh", authHandler.RefreshToken)
 		v1.POST("/logout", authHandler.Logout)
+		v1.POST("/logout/all", authHandler.LogoutAll)
+		v1.GET("/sessions", authHandler.ListSessions)
+		v1.POST("/sessions/revoke", authHandler.RevokeSession)
 		v1.POST("/validate", authHandler.ValidateToken)
+		v1.POST("/login/2fa", authHandler.LoginTwoFactor)
+		v1.POST("/login/sms/send", smsLoginHandler.SendCode)
+		v1.POST("/login/sms", smsLoginHandler.Login)
+		v1.POST("/2fa/enroll", authHandler.EnrollTwoFactor)
+		v1.POST("/2fa/confirm", authHandler.ConfirmTwoFactor)
+		v1.POST("/2fa/disable", authHandler.DisableTwoFactor)
+		v1.POST("/password/forgot", authHandler.ForgotPassword)
+		v1.POST("/password/reset", authHandler.ResetPassword)
+		v1.POST("/password/change", authHandler.ChangePassword)
+		v1.POST("/verify", authHandler.VerifyContact)
+		v1.POST("/verify/resend", authHandler.ResendVerification)
+		v1.POST("/apikeys/create", authHandler.CreateAPIKey)
+		v1.GET("/apikeys", authHandler.ListAPIKeys)
+		v1.POST("/apikeys/revoke", authHandler.RevokeAPIKey)
+		v1.GET("/oidc/:provider/login", socialHandler.Login)
+		v1.GET("/oidc/:provider/callback", socialHandler.Callback)
 	}
 
+	if rp != nil {
+		passkeyHandler := handler.NewPasskeyHandler(rp)
+		v1.POST("/passkey/register/begin", passkeyHandler.BeginRegistration)
+		v1.POST("/passkey/register/finish", passkeyHandler.FinishRegistration)
+		v1.POST("/passkey/login/begin", passkeyHandler.BeginLogin)
+		v1.POST("/passkey/login/finish", passkeyHandler.FinishLogin)
+		v1.GET("/passkeys", passkeyHandler.List)
+		v1.POST("/passkeys/delete", passkeyHandler.Delete)
+	}
+
+	admin := v1.Group("/admin")
+	{
+		admin.POST("/roles/assign", require("AssignRole"), authHandler.AssignRole)
+		admin.POST("/roles/revoke", require("RevokeRole"), authHandler.RevokeRole)
+		admin.POST("/roles/list", require("ListUserRoles"), authHandler.ListUserRoles)
+		admin.POST("/users/status", require("UpdateUserStatus"), authHandler.UpdateUserStatus)
+		admin.POST("/users/ban", require("BanUser"), authHandler.BanUser)
+		admin.POST("/users/unban", require("UnbanUser"), authHandler.UnbanUser)
+		admin.POST("/users/bans", require("ListUserBans"), authHandler.ListUserBans)
+		admin.POST("/users/unlock", require("UnlockLogin"), authHandler.UnlockLogin)
+		admin.POST("/audit/list", require("ListAuditLogs"), authHandler.ListAuditLogs)
+	}
+
+	// 定期解封到期的限时封禁
+	banCtx, stopBanExpiry := context.WithCancel(context.Background())
+	go service.RunBanExpiry(banCtx, authmysql.NewAuthRepository(), service.DefaultBanExpiryInterval)
+
+	// 订阅用户服务的个人数据事件：导出认证数据，注销时删除凭据和授权
+	dataCtx, stopUserData := context.WithCancel(context.Background())
+	go func() {
+		err := userdata.Subscribe(dataCtx, redis.RDB, "auth", service.NewUserDataHandler(authmysql.NewUserDataRepository()))
+		if err != nil {
+			hlog.Errorf("subscribe userdata events failed: %v", err)
+		}
+	}()
+
+	// 服务退出前等待后台发送的重置密码通知，并写入队列中剩余的审计事件
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		stopBanExpiry()
+		stopUserData()
+		waitCtx, cancel := context.WithTimeout(ctx, taskShutdownTimeout)
+		defer cancel()
+		if err := service.WaitTasks(waitCtx); err != nil {
+			hlog.Errorf("pending notifications dropped on shutdown: %v", err)
+		}
+		audit.Default.Close()
+	})
+
 	// 启动服务器
 	if err := h.Run(); err != nil {
 		hlog.Fatalf("start server failed: %v", err)
 	}
 }
 
+const (
+	// taskShutdownTimeout 服务退出时等待后台通知发送的最长时间
+	taskShutdownTimeout = 5 * time.Second
+	// shutdownWaitTime 服务退出时等待请求和 OnShutdown 钩子完成的最长时间
+	shutdownWaitTime = taskShutdownTimeout + 5*time.Second
+)
+
+// newRPCServer 创建 Kitex 服务并注册到 Consul
+func newRPCServer() (kserver.Server, error) {
+	addr, err := net.ResolveTCPAddr("tcp", getEnvOrDefault("KITEX_ADDRESS", conf.GetConf().Kitex.Address))
+	if err != nil {
+		return nil, fmt.Errorf("resolve rpc address failed: %v", err)
+	}
+
+	r, err := kitex.NewConsulRegister("localhost:8500")
+	if err != nil {
+		return nil, fmt.Errorf("create kitex consul register failed: %v", err)
+	}
+
+	svc := service.NewAuthService(authmysql.NewAuthRepository())
+	return authservice.NewServer(NewAuthServiceImpl(svc),
+		kserver.WithServiceAddr(addr),
+		kserver.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
+			ServiceName: conf.GetConf().Kitex.Service,
+			Tags:        map[string]string{"protocol": "rpc"},
+		}),
+		kserver.WithRegistry(r),
+		kserver.WithMiddleware(rbac.KitexMiddleware(newPrincipalResolver(svc), service.AdminRules)),
+	), nil
+}
+
 // initDeps 初始化依赖
 func initDeps() error {
 	// 初始化MySQL
@@ -150,6 +335,38 @@ func initDeps() error {
 		return fmt.Errorf("init mysql failed: %v", err)
 	}
 
+	// 初始化JWT签名密钥
+	if err := jwt.Init(newJWTConfig(conf.GetConf().JWT)); err != nil {
+		return fmt.Errorf("init jwt failed: %v", err)
+	}
+
+	// 初始化两步验证密钥加密
+	if err := encrypt.Init(getEnvOrDefault("TWO_FACTOR_ENCRYPTION_KEY", conf.GetConf().TwoFactor.EncryptionKey)); err != nil {
+		return fmt.Errorf("init two-factor encryption failed: %v", err)
+	}
+
+	// 初始化通知发送
+	notify.Init(newNotifier(conf.GetConf().Notify))
+	service.PasswordResetURL = conf.GetConf().Password.ResetURL
+
+	// 初始化密码策略，配置了泄露密码列表时加载到布隆过滤器
+	policy, err := password.New(newPasswordConfig(conf.GetConf().Password.Policy))
+	if err != nil {
+		return fmt.Errorf("init password policy failed: %v", err)
+	}
+	service.PasswordPolicy = policy
+	service.Verification = service.VerificationPolicy{
+		RequireForLogin:    conf.GetConf().Verification.RequireForLogin,
+		RequireForCheckout: conf.GetConf().Verification.RequireForCheckout,
+	}
+
+	// 初始化审计日志，事件由后台协程批量写入数据库
+	audit.Init(audit.NewWriter(service.NewAuditStore(authmysql.NewAuthRepository()), audit.Config{
+		QueueSize:     conf.GetConf().Audit.QueueSize,
+		BatchSize:     conf.GetConf().Audit.BatchSize,
+		FlushInterval: conf.GetConf().Audit.FlushInterval,
+	}))
+
 	// 初始化Redis
 	if err := redis.Init(
 		getEnvOrDefault("REDIS_ADDR", conf.GetConf().Redis.Addr),
@@ -159,9 +376,139 @@ func initDeps() error {
 		return fmt.Errorf("init redis failed: %v", err)
 	}
 
+	// 迁移前明文保存的令牌替换为摘要，已迁移时不做任何修改
+	if n, err := mysql.HashPlaintextTokens(500); err != nil {
+		return fmt.Errorf("hash plaintext tokens failed: %v", err)
+	} else if n > 0 {
+		hlog.Infof("hashed %d plaintext token records", n)
+	}
+	if n, err := redis.HashPlaintextTokenKeys(context.Background()); err != nil {
+		return fmt.Errorf("hash plaintext token keys failed: %v", err)
+	} else if n > 0 {
+		hlog.Infof("hashed %d plaintext token cache keys", n)
+	}
+
 	return nil
 }
 
+// newPrincipalResolver 使用认证服务校验令牌并加载角色和权限，供 rbac 中间件使用
+func newPrincipalResolver(svc service.AuthService) rbac.Resolver {
+	return func(ctx context.Context, token string) (*rbac.Principal, error) {
+		resp, err := svc.ValidateToken(ctx, &auth.ValidateTokenRequest{Token: token})
+		if err != nil {
+			if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrTokenExpired) {
+				return nil, fmt.Errorf("%w: %v", rbac.ErrUnauthenticated, err)
+			}
+			return nil, err
+		}
+		return &rbac.Principal{
+			UserID:      resp.Data.UserId,
+			Roles:       resp.Data.Roles,
+			Permissions: resp.Data.Permissions,
+		}, nil
+	}
+}
+
+// newNotifier 根据配置创建通知发送器，未配置的渠道写入文件或日志
+func newNotifier(c conf.NotifyConfig) notify.Notifier {
+	fallback := notify.NewFile(c.FilePath)
+	mux := notify.Mux{notify.ChannelEmail: fallback, notify.ChannelSMS: fallback}
+	if c.SMTP.Host != "" {
+		mux[notify.ChannelEmail] = notify.NewSMTP(notify.SMTPConfig{
+			Host:     c.SMTP.Host,
+			Port:     c.SMTP.Port,
+			Username: c.SMTP.Username,
+			Password: getEnvOrDefault("SMTP_PASSWORD", c.SMTP.Password),
+			From:     c.SMTP.From,
+		})
+	}
+	if c.SMS.Endpoint != "" {
+		mux[notify.ChannelSMS] = notify.NewSMS(notify.SMSConfig{
+			Endpoint: c.SMS.Endpoint,
+			APIKey:   getEnvOrDefault("SMS_API_KEY", c.SMS.APIKey),
+			Sender:   c.SMS.Sender,
+		}, nil)
+	}
+	return mux
+}
+
+// newPasswordConfig 根据配置创建密码策略，未配置的长度和误判率使用默认值
+func newPasswordConfig(c conf.PasswordPolicyConfig) password.Config {
+	cfg := password.DefaultConfig()
+	if c.MinLength > 0 {
+		cfg.MinLength = c.MinLength
+	}
+	if c.MaxLength > 0 {
+		cfg.MaxLength = c.MaxLength
+	}
+	if c.BreachedFPRate > 0 {
+		cfg.BreachedFPRate = c.BreachedFPRate
+	}
+	cfg.RequireLower = c.RequireLower
+	cfg.RequireUpper = c.RequireUpper
+	cfg.RequireDigit = c.RequireDigit
+	cfg.RequireSymbol = c.RequireSymbol
+	cfg.MinClasses = c.MinClasses
+	cfg.DisallowUsername = c.DisallowUsername
+	cfg.BannedPasswords = c.Banned
+	cfg.BreachedListPath = c.BreachedListPath
+	cfg.HistorySize = c.HistorySize
+	return cfg
+}
+
+// newSocialProviders 根据配置创建外部身份提供方，发现文档在首次登录时拉取
+func newSocialProviders(c conf.OIDCConfig) []*service.SocialProvider {
+	providers := make([]*service.SocialProvider, 0, len(c.Providers))
+	for _, p := range c.Providers {
+		envKey := "OIDC_" + strings.ToUpper(strings.ReplaceAll(p.Name, "-", "_")) + "_CLIENT_SECRET"
+		providers = append(providers, &service.SocialProvider{
+			Provider: oidc.NewProvider(oidc.Config{
+				Name:         p.Name,
+				Issuer:       p.Issuer,
+				ClientID:     p.ClientID,
+				ClientSecret: getEnvOrDefault(envKey, p.ClientSecret),
+				RedirectURL:  p.RedirectURL,
+				Scopes:       p.Scopes,
+			}, nil),
+			LinkByEmail: p.LinkByEmail,
+		})
+	}
+	return providers
+}
+
+// newRelyingParty 根据配置创建 WebAuthn 依赖方，未配置 rp_id 时返回 nil
+func newRelyingParty(c conf.WebAuthnConfig) (*webauthn.RelyingParty, error) {
+	if c.RPID == "" {
+		return nil, nil
+	}
+	return webauthn.New(webauthn.Config{
+		RPID:             c.RPID,
+		RPName:           c.RPName,
+		Origins:          c.Origins,
+		UserVerification: c.UserVerification,
+		Timeout:          c.Timeout,
+	})
+}
+
+// newJWTConfig 将配置文件中的JWT配置转换为签名配置
+func newJWTConfig(c conf.JWTConfig) jwt.Config {
+	cfg := jwt.Config{
+		Issuer:    c.Issuer,
+		Algorithm: c.Algorithm,
+		ActiveKID: c.ActiveKID,
+		// 临时密钥只在开发和测试环境使用，生产环境必须配置共享的签名密钥
+		AllowEphemeral: conf.GetEnv() == "dev" || conf.GetEnv() == "test",
+	}
+	for _, k := range c.Keys {
+		cfg.Keys = append(cfg.Keys, jwt.KeyConfig{
+			KID:            k.KID,
+			Algorithm:      k.Algorithm,
+			PrivateKeyPath: k.PrivateKeyPath,
+		})
+	}
+	return cfg
+}
+
 // getEnvOrDefault 获取环境变量，如果不存在则返回默认值
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
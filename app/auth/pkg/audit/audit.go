@@ -0,0 +1,172 @@
+// Package audit 安全审计日志，事件先进入内存队列，由后台协程批量写入存储，不阻塞请求
+package audit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 事件结果
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Event 审计事件，写入后不再修改
+type Event struct {
+	Type       string
+	Outcome    string
+	Reason     string // 失败原因
+	UserID     int64  // 事件涉及的用户，未知时为 0
+	Username   string
+	OperatorID int64 // 管理操作的执行人
+	IP         string
+	UserAgent  string
+	TraceID    string
+	CreatedAt  time.Time
+}
+
+// Store 审计日志存储，只追加
+type Store interface {
+	SaveEvents(ctx context.Context, events []*Event) error
+}
+
+// Config 异步写入配置
+type Config struct {
+	QueueSize     int           // 队列长度，队列满时丢弃事件
+	BatchSize     int           // 单次写入的最大事件数
+	FlushInterval time.Duration // 未攒满一批时的最长等待时间
+}
+
+// DefaultConfig 默认异步写入配置
+func DefaultConfig() Config {
+	return Config{
+		QueueSize:     10000,
+		BatchSize:     100,
+		FlushInterval: time.Second,
+	}
+}
+
+// Writer 异步审计日志写入器
+type Writer struct {
+	store   Store
+	cfg     Config
+	queue   chan *Event
+	done    chan struct{}
+	mu      sync.RWMutex // 保护 closed，避免关闭后继续写入队列
+	closed  bool
+	dropped atomic.Int64
+}
+
+// NewWriter 创建写入器并启动后台写入协程
+func NewWriter(store Store, cfg Config) *Writer {
+	def := DefaultConfig()
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = def.QueueSize
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = def.BatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = def.FlushInterval
+	}
+	w := &Writer{
+		store: store,
+		cfg:   cfg,
+		queue: make(chan *Event, cfg.QueueSize),
+		done:  make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// Record 将事件放入队列，队列已满时丢弃并返回 false，不阻塞调用方
+func (w *Writer) Record(e *Event) bool {
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return false
+	}
+	select {
+	case w.queue <- e:
+		return true
+	default:
+		if n := w.dropped.Add(1); n == 1 || n%1000 == 0 {
+			hlog.Warnf("audit queue full, %d events dropped", n)
+		}
+		return false
+	}
+}
+
+// Dropped 因队列已满丢弃的事件数
+func (w *Writer) Dropped() int64 {
+	return w.dropped.Load()
+}
+
+// Close 停止接收事件，写入队列中剩余的事件后返回
+func (w *Writer) Close() {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.queue)
+	}
+	w.mu.Unlock()
+	<-w.done
+}
+
+// run 按批写入事件，攒满一批或超过刷新间隔时写入
+func (w *Writer) run() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, w.cfg.BatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := w.store.SaveEvents(context.Background(), batch); err != nil {
+			hlog.Errorf("save %d audit events failed: %v", len(batch), err)
+		}
+		batch = make([]*Event, 0, w.cfg.BatchSize)
+	}
+
+	for {
+		select {
+		case e, ok := <-w.queue:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, e)
+			if len(batch) >= w.cfg.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// Default 服务使用的审计日志写入器，未初始化时不记录
+var Default *Writer
+
+// Init 设置默认审计日志写入器
+func Init(w *Writer) {
+	Default = w
+}
+
+// Record 使用默认写入器记录事件
+func Record(e *Event) {
+	if Default == nil {
+		return
+	}
+	Default.Record(e)
+}
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore 记录每次批量写入的事件
+type memoryStore struct {
+	mu      sync.Mutex
+	batches [][]*Event
+	block   chan struct{} // 非空时写入等待，用于模拟存储变慢
+}
+
+func (s *memoryStore) SaveEvents(ctx context.Context, events []*Event) error {
+	if s.block != nil {
+		<-s.block
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, events)
+	return nil
+}
+
+func (s *memoryStore) events() []*Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var all []*Event
+	for _, b := range s.batches {
+		all = append(all, b...)
+	}
+	return all
+}
+
+func TestWriter_Batch(t *testing.T) {
+	store := &memoryStore{}
+	w := NewWriter(store, Config{QueueSize: 10, BatchSize: 3, FlushInterval: time.Hour})
+
+	for i := 0; i < 7; i++ {
+		require.True(t, w.Record(&Event{Type: "login", UserID: int64(i)}))
+	}
+	// 攒满一批立即写入
+	require.Eventually(t, func() bool { return len(store.events()) == 6 }, time.Second, 10*time.Millisecond)
+
+	// 关闭时写入剩余事件，之后不再接收
+	w.Close()
+	events := store.events()
+	require.Len(t, events, 7)
+	assert.Equal(t, int64(6), events[6].UserID)
+	assert.False(t, events[0].CreatedAt.IsZero())
+	assert.False(t, w.Record(&Event{Type: "login"}))
+}
+
+func TestWriter_FlushInterval(t *testing.T) {
+	store := &memoryStore{}
+	w := NewWriter(store, Config{QueueSize: 10, BatchSize: 100, FlushInterval: 20 * time.Millisecond})
+	defer w.Close()
+
+	w.Record(&Event{Type: "logout"})
+	require.Eventually(t, func() bool { return len(store.events()) == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestWriter_DropWhenFull(t *testing.T) {
+	store := &memoryStore{block: make(chan struct{})}
+	w := NewWriter(store, Config{QueueSize: 2, BatchSize: 1, FlushInterval: time.Hour})
+
+	// 第一个事件被后台协程取出后阻塞在存储上，队列再放入两个
+	require.True(t, w.Record(&Event{Type: "login"}))
+	require.Eventually(t, func() bool { return len(w.queue) == 0 }, time.Second, time.Millisecond)
+	require.True(t, w.Record(&Event{Type: "login"}))
+	require.True(t, w.Record(&Event{Type: "login"}))
+
+	// 队列已满时立即返回，不阻塞调用方
+	assert.False(t, w.Record(&Event{Type: "login"}))
+	assert.Equal(t, int64(1), w.Dropped())
+
+	close(store.block)
+	w.Close()
+	assert.Len(t, store.events(), 3)
+}
+
+func TestRecord_Default(t *testing.T) {
+	// 未初始化时忽略
+	Record(&Event{Type: "login"})
+
+	store := &memoryStore{}
+	Init(NewWriter(store, Config{}))
+	defer Init(nil)
+	Record(&Event{Type: "login"})
+	Default.Close()
+	assert.Len(t, store.events(), 1)
+}
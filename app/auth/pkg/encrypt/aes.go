@@ -0,0 +1,88 @@
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const version = "v1:"
+
+var (
+	ErrNotInitialized = errors.New("encryption key not initialized")
+	ErrInvalidData    = errors.New("invalid encrypted data")
+)
+
+var (
+	mu   sync.RWMutex
+	aead cipher.AEAD
+)
+
+// Init 使用 base64 编码的 32 字节密钥初始化 AES-256-GCM
+func Init(key string) error {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return fmt.Errorf("decode encryption key failed: %w", err)
+	}
+	if len(raw) != 32 {
+		return fmt.Errorf("encryption key must be 32 bytes, got %d", len(raw))
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	aead = gcm
+	return nil
+}
+
+// Encrypt 加密字符串，结果为 v1:base64(nonce|ciphertext)
+func Encrypt(plain string) (string, error) {
+	mu.RLock()
+	gcm := aead
+	mu.RUnlock()
+	if gcm == nil {
+		return "", ErrNotInitialized
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plain), nil)
+	return version + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt 解密 Encrypt 的结果
+func Decrypt(data string) (string, error) {
+	mu.RLock()
+	gcm := aead
+	mu.RUnlock()
+	if gcm == nil {
+		return "", ErrNotInitialized
+	}
+
+	if !strings.HasPrefix(data, version) {
+		return "", ErrInvalidData
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(data, version))
+	if err != nil || len(raw) < gcm.NonceSize() {
+		return "", ErrInvalidData
+	}
+	plain, err := gcm.Open(nil, raw[:gcm.NonceSize()], raw[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", ErrInvalidData
+	}
+	return string(plain), nil
+}
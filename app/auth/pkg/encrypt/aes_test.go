@@ -0,0 +1,29 @@
+package encrypt
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	require.NoError(t, Init(base64.StdEncoding.EncodeToString(make([]byte, 32))))
+
+	cipherText, err := Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	assert.NotContains(t, cipherText, "JBSWY3DPEHPK3PXP")
+
+	plain, err := Decrypt(cipherText)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", plain)
+
+	_, err = Decrypt(cipherText[:len(cipherText)-2])
+	assert.ErrorIs(t, err, ErrInvalidData)
+}
+
+func TestInit_InvalidKey(t *testing.T) {
+	assert.Error(t, Init(""))
+	assert.Error(t, Init(base64.StdEncoding.EncodeToString(make([]byte, 16))))
+}
@@ -0,0 +1,244 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrTokenInvalid = errors.New("invalid jwt token")
+	ErrTokenExpired = errors.New("jwt token expired")
+)
+
+// KeyConfig 单个密钥配置
+type KeyConfig struct {
+	KID            string
+	Algorithm      string
+	PrivateKeyPath string
+}
+
+// Config JWT 配置
+type Config struct {
+	Issuer         string
+	Algorithm      string // 未配置密钥时生成临时密钥所用的算法
+	ActiveKID      string
+	Keys           []KeyConfig
+	AllowEphemeral bool // 是否允许未配置密钥时使用临时密钥，仅用于开发和测试
+}
+
+// ErrNoKeys 未配置签名密钥且不允许使用临时密钥
+var ErrNoKeys = errors.New("no jwt signing keys configured")
+
+// Claims 访问令牌载荷，第三方应用令牌额外携带 client_id 和 scope
+// 不携带角色：角色可随时变更，下游服务通过 VerifyTokenByRPC 的 with_roles 获取实时角色
+type Claims struct {
+	UserID   int64  `json:"uid"`
+	Username string `json:"username"`
+	ClientID string `json:"client_id,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+	gojwt.RegisteredClaims
+}
+
+// IDTokenClaims OIDC ID 令牌载荷
+type IDTokenClaims struct {
+	Nonce             string `json:"nonce,omitempty"`
+	AuthTime          int64  `json:"auth_time,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Email             string `json:"email,omitempty"`
+	PhoneNumber       string `json:"phone_number,omitempty"`
+	gojwt.RegisteredClaims
+}
+
+var (
+	mu      sync.RWMutex
+	keySet  = NewKeySet()
+	issuer  = "tiktokmall-auth"
+	methods = []string{AlgRS256, AlgEdDSA}
+)
+
+// Init 根据配置加载密钥，未配置密钥时生成仅存在于内存中的临时密钥
+// 临时密钥在多实例之间不共享、重启后失效，AllowEphemeral 为 false 时返回 ErrNoKeys
+func Init(cfg Config) error {
+	set := NewKeySet()
+	for _, kc := range cfg.Keys {
+		key, err := LoadKey(kc.KID, kc.Algorithm, kc.PrivateKeyPath)
+		if err != nil {
+			return err
+		}
+		if err := set.Add(key); err != nil {
+			return err
+		}
+	}
+
+	if len(cfg.Keys) == 0 {
+		if !cfg.AllowEphemeral {
+			return ErrNoKeys
+		}
+		alg := cfg.Algorithm
+		if alg == "" {
+			alg = AlgRS256
+		}
+		key, err := GenerateKey("ephemeral-"+strconv.FormatInt(time.Now().Unix(), 10), alg)
+		if err != nil {
+			return err
+		}
+		if err := set.Add(key); err != nil {
+			return err
+		}
+		hlog.Warnf("no jwt keys configured, using ephemeral %s key %s", alg, key.ID)
+	}
+
+	if cfg.ActiveKID != "" {
+		if err := set.SetActive(cfg.ActiveKID); err != nil {
+			return err
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	keySet = set
+	if cfg.Issuer != "" {
+		issuer = cfg.Issuer
+	}
+	return nil
+}
+
+// Keys 返回当前使用的密钥集合
+func Keys() *KeySet {
+	mu.RLock()
+	defer mu.RUnlock()
+	return keySet
+}
+
+// Issuer 返回签发方
+func Issuer() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return issuer
+}
+
+// NewClaims 创建访问令牌载荷
+func NewClaims(userID int64, username string, ttl time.Duration) *Claims {
+	now := time.Now()
+	return &Claims{
+		UserID:   userID,
+		Username: username,
+		RegisteredClaims: gojwt.RegisteredClaims{
+			ID:        newJTI(),
+			Subject:   strconv.FormatInt(userID, 10),
+			IssuedAt:  gojwt.NewNumericDate(now),
+			NotBefore: gojwt.NewNumericDate(now),
+			ExpiresAt: gojwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+}
+
+// Sign 使用 active 密钥签发令牌，header 中携带 kid
+func Sign(claims *Claims) (string, error) {
+	if claims.Issuer == "" {
+		claims.Issuer = Issuer()
+	}
+	return sign(claims)
+}
+
+// SignIDToken 使用 active 密钥签发 ID 令牌
+func SignIDToken(claims *IDTokenClaims) (string, error) {
+	if claims.Issuer == "" {
+		claims.Issuer = Issuer()
+	}
+	return sign(claims)
+}
+
+// Algorithms 返回当前密钥集合支持的签名算法
+func Algorithms() []string {
+	var algs []string
+	seen := make(map[string]bool)
+	for _, k := range Keys().Keys() {
+		if !seen[k.Algorithm] {
+			seen[k.Algorithm] = true
+			algs = append(algs, k.Algorithm)
+		}
+	}
+	return algs
+}
+
+// sign 使用 active 密钥签名
+func sign(claims gojwt.Claims) (string, error) {
+	key, err := Keys().Active()
+	if err != nil {
+		return "", err
+	}
+	method, err := key.signingMethod()
+	if err != nil {
+		return "", err
+	}
+
+	t := gojwt.NewWithClaims(method, claims)
+	t.Header["kid"] = key.ID
+	signed, err := t.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("sign jwt failed: %w", err)
+	}
+	return signed, nil
+}
+
+// Parse 验证签名和有效期并返回载荷
+func Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	if err := parse(tokenString, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// ParseIDToken 验证 ID 令牌签名、有效期和受众
+func ParseIDToken(tokenString, audience string) (*IDTokenClaims, error) {
+	claims := &IDTokenClaims{}
+	if err := parse(tokenString, claims, gojwt.WithAudience(audience)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// parse 根据 header 中的 kid 选择公钥验证令牌
+func parse(tokenString string, claims gojwt.Claims, opts ...gojwt.ParserOption) error {
+	set := Keys()
+	opts = append(opts,
+		gojwt.WithValidMethods(methods),
+		gojwt.WithIssuer(Issuer()),
+		gojwt.WithExpirationRequired(),
+	)
+	_, err := gojwt.ParseWithClaims(tokenString, claims, func(t *gojwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := set.Get(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown jwt kid %q", kid)
+		}
+		if t.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("jwt alg %s does not match key %s", t.Method.Alg(), kid)
+		}
+		return key.Public(), nil
+	}, opts...)
+	if err != nil {
+		if errors.Is(err, gojwt.ErrTokenExpired) {
+			return ErrTokenExpired
+		}
+		return fmt.Errorf("%w: %v", ErrTokenInvalid, err)
+	}
+	return nil
+}
+
+// newJTI 生成令牌唯一标识
+func newJTI() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
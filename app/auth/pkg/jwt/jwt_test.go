@@ -0,0 +1,132 @@
+package jwt
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKey(t *testing.T, key *Key) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key.PrivateKey)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), key.ID+".pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func TestSignAndParse(t *testing.T) {
+	for _, alg := range []string{AlgRS256, AlgEdDSA} {
+		t.Run(alg, func(t *testing.T) {
+			require.NoError(t, Init(Config{Issuer: "test", Algorithm: alg, AllowEphemeral: true}))
+
+			signed, err := Sign(NewClaims(1, "testuser", time.Minute))
+			require.NoError(t, err)
+
+			claims, err := Parse(signed)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), claims.UserID)
+			assert.Equal(t, "testuser", claims.Username)
+			assert.Equal(t, "test", claims.Issuer)
+			assert.NotEmpty(t, claims.ID)
+		})
+	}
+}
+
+func TestInit_RequiresKeys(t *testing.T) {
+	// 未配置密钥且不允许临时密钥时拒绝启动
+	assert.ErrorIs(t, Init(Config{}), ErrNoKeys)
+}
+
+func TestParse_Expired(t *testing.T) {
+	require.NoError(t, Init(Config{AllowEphemeral: true}))
+
+	signed, err := Sign(NewClaims(1, "testuser", -time.Minute))
+	require.NoError(t, err)
+
+	_, err = Parse(signed)
+	assert.ErrorIs(t, err, ErrTokenExpired)
+}
+
+func TestParse_UnknownKey(t *testing.T) {
+	require.NoError(t, Init(Config{AllowEphemeral: true}))
+	signed, err := Sign(NewClaims(1, "testuser", time.Minute))
+	require.NoError(t, err)
+
+	// 重新初始化后旧的临时密钥不再可用
+	require.NoError(t, Init(Config{AllowEphemeral: true}))
+	_, err = Parse(signed)
+	assert.ErrorIs(t, err, ErrTokenInvalid)
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey, err := GenerateKey("2025-01", AlgRS256)
+	require.NoError(t, err)
+	newKey, err := GenerateKey("2025-02", AlgEdDSA)
+	require.NoError(t, err)
+	keys := []KeyConfig{
+		{KID: oldKey.ID, Algorithm: oldKey.Algorithm, PrivateKeyPath: writeKey(t, oldKey)},
+		{KID: newKey.ID, Algorithm: newKey.Algorithm, PrivateKeyPath: writeKey(t, newKey)},
+	}
+
+	// 轮换前使用旧密钥签发
+	require.NoError(t, Init(Config{ActiveKID: oldKey.ID, Keys: keys}))
+	oldToken, err := Sign(NewClaims(1, "testuser", time.Minute))
+	require.NoError(t, err)
+
+	// 轮换后新令牌使用新密钥，旧令牌仍可验证
+	require.NoError(t, Init(Config{ActiveKID: newKey.ID, Keys: keys}))
+	newToken, err := Sign(NewClaims(1, "testuser", time.Minute))
+	require.NoError(t, err)
+
+	_, err = Parse(oldToken)
+	assert.NoError(t, err)
+	claims, err := Parse(newToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), claims.UserID)
+
+	jwks := Keys().JWKS()
+	require.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "RSA", jwks.Keys[0].Kty)
+	assert.Equal(t, "2025-01", jwks.Keys[0].Kid)
+	assert.NotEmpty(t, jwks.Keys[0].N)
+	assert.Equal(t, "OKP", jwks.Keys[1].Kty)
+	assert.Equal(t, "Ed25519", jwks.Keys[1].Crv)
+	assert.NotEmpty(t, jwks.Keys[1].X)
+}
+
+func TestLoadKey_AlgorithmMismatch(t *testing.T) {
+	key, err := GenerateKey("k1", AlgEdDSA)
+	require.NoError(t, err)
+	_, err = LoadKey("k1", AlgRS256, writeKey(t, key))
+	assert.Error(t, err)
+}
+
+func TestSignAndParseIDToken(t *testing.T) {
+	require.NoError(t, Init(Config{Issuer: "https://auth.example.com", AllowEphemeral: true}))
+
+	signed, err := SignIDToken(&IDTokenClaims{
+		Nonce: "n-0S6_WzA2Mj",
+		RegisteredClaims: gojwt.RegisteredClaims{
+			Subject:   "1",
+			Audience:  gojwt.ClaimStrings{"client-1"},
+			ExpiresAt: gojwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	})
+	require.NoError(t, err)
+
+	claims, err := ParseIDToken(signed, "client-1")
+	require.NoError(t, err)
+	assert.Equal(t, "n-0S6_WzA2Mj", claims.Nonce)
+	assert.Equal(t, "https://auth.example.com", claims.Issuer)
+
+	_, err = ParseIDToken(signed, "client-2")
+	assert.ErrorIs(t, err, ErrTokenInvalid)
+	assert.Equal(t, []string{AlgRS256}, Algorithms())
+}
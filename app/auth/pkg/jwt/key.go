@@ -0,0 +1,189 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+
+	rsaKeyBits = 2048
+)
+
+// Key 签名密钥
+type Key struct {
+	ID         string        // kid
+	Algorithm  string        // RS256 或 EdDSA
+	PrivateKey crypto.Signer // 私钥
+	CreatedAt  time.Time
+}
+
+// Public 返回公钥
+func (k *Key) Public() crypto.PublicKey {
+	return k.PrivateKey.Public()
+}
+
+// signingMethod 返回对应的签名算法
+func (k *Key) signingMethod() (gojwt.SigningMethod, error) {
+	switch k.Algorithm {
+	case AlgRS256:
+		return gojwt.SigningMethodRS256, nil
+	case AlgEdDSA:
+		return gojwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported jwt algorithm: %s", k.Algorithm)
+	}
+}
+
+// GenerateKey 生成新的签名密钥
+func GenerateKey(kid, alg string) (*Key, error) {
+	var signer crypto.Signer
+	switch alg {
+	case AlgRS256:
+		pk, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, fmt.Errorf("generate rsa key failed: %w", err)
+		}
+		signer = pk
+	case AlgEdDSA:
+		_, pk, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generate ed25519 key failed: %w", err)
+		}
+		signer = pk
+	default:
+		return nil, fmt.Errorf("unsupported jwt algorithm: %s", alg)
+	}
+	return &Key{ID: kid, Algorithm: alg, PrivateKey: signer, CreatedAt: time.Now()}, nil
+}
+
+// LoadKey 从 PEM 文件加载签名密钥，支持 PKCS8 和 PKCS1
+func LoadKey(kid, alg, path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwt key %s failed: %w", path, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("decode jwt key %s failed: no pem block", path)
+	}
+
+	var parsed interface{}
+	if parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+		if parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return nil, fmt.Errorf("parse jwt key %s failed: %w", path, err)
+		}
+	}
+
+	key := &Key{ID: kid, Algorithm: alg, CreatedAt: time.Now()}
+	switch pk := parsed.(type) {
+	case *rsa.PrivateKey:
+		if alg != AlgRS256 {
+			return nil, fmt.Errorf("jwt key %s is rsa but algorithm is %s", kid, alg)
+		}
+		key.PrivateKey = pk
+	case ed25519.PrivateKey:
+		if alg != AlgEdDSA {
+			return nil, fmt.Errorf("jwt key %s is ed25519 but algorithm is %s", kid, alg)
+		}
+		key.PrivateKey = pk
+	default:
+		return nil, fmt.Errorf("unsupported jwt key type %T", parsed)
+	}
+	return key, nil
+}
+
+// KeySet 密钥集合，active 密钥用于签发，其余密钥仅用于验证已签发的令牌
+type KeySet struct {
+	mu     sync.RWMutex
+	keys   map[string]*Key
+	active string
+}
+
+// NewKeySet 创建密钥集合
+func NewKeySet() *KeySet {
+	return &KeySet{keys: make(map[string]*Key)}
+}
+
+// Add 添加密钥，第一个加入的密钥默认作为 active 密钥
+func (s *KeySet) Add(key *Key) error {
+	if key.ID == "" {
+		return fmt.Errorf("jwt key id is empty")
+	}
+	if _, err := key.signingMethod(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[key.ID] = key
+	if s.active == "" {
+		s.active = key.ID
+	}
+	return nil
+}
+
+// Remove 移除密钥，不能移除 active 密钥
+func (s *KeySet) Remove(kid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if kid == s.active {
+		return fmt.Errorf("cannot remove active jwt key %s", kid)
+	}
+	delete(s.keys, kid)
+	return nil
+}
+
+// SetActive 设置用于签发的密钥
+func (s *KeySet) SetActive(kid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.keys[kid]; !ok {
+		return fmt.Errorf("jwt key %s not found", kid)
+	}
+	s.active = kid
+	return nil
+}
+
+// Active 返回当前签发密钥
+func (s *KeySet) Active() (*Key, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[s.active]
+	if !ok {
+		return nil, fmt.Errorf("no active jwt key")
+	}
+	return key, nil
+}
+
+// Get 根据 kid 获取密钥
+func (s *KeySet) Get(kid string) (*Key, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// Keys 返回全部密钥，按 kid 排序
+func (s *KeySet) Keys() []*Key {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]*Key, 0, len(s.keys))
+	for _, k := range s.keys {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys
+}
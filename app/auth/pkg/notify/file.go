@@ -0,0 +1,55 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// File 开发环境使用的通知发送器，将通知以 JSON 行追加写入文件，路径为空时写入日志
+type File struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFile 创建文件通知发送器
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+// fileRecord 写入文件的通知记录
+type fileRecord struct {
+	Time time.Time `json:"time"`
+	*Message
+}
+
+// Send 记录通知
+func (f *File) Send(ctx context.Context, msg *Message) error {
+	line, err := json.Marshal(fileRecord{Time: time.Now(), Message: msg})
+	if err != nil {
+		return err
+	}
+	if f.path == "" {
+		hlog.CtxInfof(ctx, "notify: %s", line)
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open notify file failed: %w", err)
+	}
+	defer file.Close()
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,62 @@
+// Package notify 向用户发送邮件和短信通知，支持 SMTP、短信网关和开发环境使用的文件输出
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// Channel 通知渠道
+type Channel string
+
+const (
+	ChannelEmail Channel = "email"
+	ChannelSMS   Channel = "sms"
+)
+
+var ErrUnsupportedChannel = errors.New("unsupported notify channel")
+
+// Message 通知内容，短信渠道忽略 Subject
+type Message struct {
+	Channel Channel `json:"channel"`
+	To      string  `json:"to"`
+	Subject string  `json:"subject,omitempty"`
+	Body    string  `json:"body"`
+}
+
+// Notifier 通知发送接口
+type Notifier interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Default 服务使用的通知发送器，未初始化时只记录日志
+var Default Notifier
+
+// Init 设置默认通知发送器
+func Init(n Notifier) {
+	Default = n
+}
+
+// Send 使用默认通知发送器发送通知
+func Send(ctx context.Context, msg *Message) error {
+	if Default == nil {
+		hlog.CtxWarnf(ctx, "notifier not configured, drop %s message to %s", msg.Channel, msg.To)
+		return nil
+	}
+	return Default.Send(ctx, msg)
+}
+
+// Mux 按渠道分发通知
+type Mux map[Channel]Notifier
+
+// Send 将通知交给对应渠道的发送器
+func (m Mux) Send(ctx context.Context, msg *Message) error {
+	n, ok := m[msg.Channel]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, msg.Channel)
+	}
+	return n.Send(ctx, msg)
+}
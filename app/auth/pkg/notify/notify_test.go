@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTP_Send(t *testing.T) {
+	s := NewSMTP(SMTPConfig{Host: "smtp.example.com", Username: "mall", Password: "secret", From: "noreply@example.com"})
+
+	var gotAddr string
+	var gotTo []string
+	var gotMsg []byte
+	s.send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotTo, gotMsg = addr, to, msg
+		assert.NotNil(t, a)
+		assert.Equal(t, "noreply@example.com", from)
+		return nil
+	}
+
+	err := s.Send(context.Background(), &Message{Channel: ChannelEmail, To: "user@example.com", Subject: "重置密码", Body: "line1\nline2"})
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.Equal(t, []string{"user@example.com"}, gotTo)
+	assert.Contains(t, string(gotMsg), "Subject: =?utf-8?q?")
+	assert.Contains(t, string(gotMsg), "\r\n\r\nline1\r\nline2")
+
+	err = s.Send(context.Background(), &Message{Channel: ChannelEmail, To: "a@example.com\r\nBcc: b@example.com"})
+	assert.Error(t, err)
+	err = s.Send(context.Background(), &Message{Channel: ChannelSMS, To: "13800138000"})
+	assert.ErrorIs(t, err, ErrUnsupportedChannel)
+}
+
+func TestSMS_Send(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &got))
+		if got["to"] == "fail" {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	s := NewSMS(SMSConfig{Endpoint: srv.URL, APIKey: "key", Sender: "TikTokMall"}, srv.Client())
+	require.NoError(t, s.Send(context.Background(), &Message{Channel: ChannelSMS, To: "13800138000", Body: "code 123456"}))
+	assert.Equal(t, map[string]string{"to": "13800138000", "sender": "TikTokMall", "content": "code 123456"}, got)
+
+	assert.Error(t, s.Send(context.Background(), &Message{Channel: ChannelSMS, To: "fail"}))
+}
+
+func TestFile_Send(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log", "notify.log")
+	f := NewFile(path)
+	mux := Mux{ChannelEmail: f, ChannelSMS: f}
+	ctx := context.Background()
+
+	require.NoError(t, mux.Send(ctx, &Message{Channel: ChannelEmail, To: "user@example.com", Subject: "s", Body: "b"}))
+	require.NoError(t, mux.Send(ctx, &Message{Channel: ChannelSMS, To: "13800138000", Body: "b"}))
+	assert.ErrorIs(t, Mux{}.Send(ctx, &Message{Channel: ChannelSMS}), ErrUnsupportedChannel)
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.Len(t, lines, 2)
+	assert.True(t, strings.Contains(lines[0], `"to":"user@example.com"`), lines[0])
+	assert.True(t, strings.Contains(lines[1], `"channel":"sms"`), lines[1])
+}
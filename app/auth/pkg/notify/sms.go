@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SMSConfig 短信网关配置
+type SMSConfig struct {
+	Endpoint string // 网关地址，接收 JSON 格式的 {"to","sender","content"}
+	APIKey   string // 以 Bearer 方式放在 Authorization 头中
+	Sender   string // 短信签名
+}
+
+// SMS 通过 HTTP 短信网关发送短信
+type SMS struct {
+	cfg    SMSConfig
+	client *http.Client
+}
+
+// NewSMS 创建短信发送器，client 为空时使用默认 HTTP 客户端
+func NewSMS(cfg SMSConfig, client *http.Client) *SMS {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &SMS{cfg: cfg, client: client}
+}
+
+// Send 发送短信
+func (s *SMS) Send(ctx context.Context, msg *Message) error {
+	if msg.Channel != ChannelSMS {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, msg.Channel)
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"to":      msg.To,
+		"sender":  s.cfg.Sender,
+		"content": msg.Body,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.Endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.cfg.APIKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send sms to %s failed: %w", msg.To, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("send sms to %s failed: status %d: %s", msg.To, resp.StatusCode, body)
+	}
+	return nil
+}
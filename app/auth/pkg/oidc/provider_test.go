@@ -0,0 +1,139 @@
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/pkg/oidc"
+	"TikTokMall/app/auth/pkg/oidc/oidctest"
+)
+
+const redirectURL = "https://mall.example.com/oidc/callback"
+
+func newProvider(idp *oidctest.Server) *oidc.Provider {
+	return oidc.NewProvider(oidc.Config{
+		Name:         "fake",
+		Issuer:       idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RedirectURL:  redirectURL,
+	}, idp.Client())
+}
+
+// authorize 模拟浏览器访问授权地址，返回回调中的授权码
+func authorize(t *testing.T, idp *oidctest.Server, p *oidc.Provider, state, nonce, verifier string) string {
+	authURL, err := p.AuthCodeURL(context.Background(), state, nonce, verifier)
+	require.NoError(t, err)
+
+	client := idp.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, state, location.Query().Get("state"))
+	return location.Query().Get("code")
+}
+
+func TestProvider_ExchangeAndVerify(t *testing.T) {
+	idp := oidctest.NewServer("mall", "secret")
+	defer idp.Close()
+	p := newProvider(idp)
+	ctx := context.Background()
+
+	code := authorize(t, idp, p, "state-1", "nonce-1", "verifier-verifier-verifier-verifier-1234")
+	token, err := p.Exchange(ctx, code, "verifier-verifier-verifier-verifier-1234")
+	require.NoError(t, err)
+
+	claims, err := p.VerifyIDToken(ctx, token.IDToken, "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, "fake-user-1", claims.Subject)
+	assert.Equal(t, "fake@example.com", claims.Email)
+	assert.True(t, claims.EmailVerified)
+
+	_, err = p.VerifyIDToken(ctx, token.IDToken, "other-nonce")
+	assert.ErrorIs(t, err, oidc.ErrNonceMismatch)
+}
+
+func TestProvider_ExchangeWrongVerifier(t *testing.T) {
+	idp := oidctest.NewServer("mall", "secret")
+	defer idp.Close()
+	p := newProvider(idp)
+
+	code := authorize(t, idp, p, "state", "nonce", "verifier-verifier-verifier-verifier-1234")
+	_, err := p.Exchange(context.Background(), code, "wrong-verifier-wrong-verifier-wrong-123")
+	assert.Error(t, err)
+}
+
+func TestProvider_VerifyIDTokenRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(gojwt.MapClaims)
+	}{
+		{"wrong_audience", func(c gojwt.MapClaims) { c["aud"] = "another-client" }},
+		{"wrong_issuer", func(c gojwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+		{"expired", func(c gojwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{"missing_sub", func(c gojwt.MapClaims) { delete(c, "sub") }},
+		{"azp_mismatch", func(c gojwt.MapClaims) { c["aud"] = []string{"mall", "other"}; c["azp"] = "other" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := oidctest.NewServer("mall", "secret")
+			defer idp.Close()
+			idp.Mutate = tt.mutate
+			p := newProvider(idp)
+			ctx := context.Background()
+
+			code := authorize(t, idp, p, "state", "nonce", "verifier-verifier-verifier-verifier-1234")
+			token, err := p.Exchange(ctx, code, "verifier-verifier-verifier-verifier-1234")
+			require.NoError(t, err)
+
+			_, err = p.VerifyIDToken(ctx, token.IDToken, "nonce")
+			assert.ErrorIs(t, err, oidc.ErrIDTokenInvalid)
+		})
+	}
+}
+
+func TestProvider_KeyRotation(t *testing.T) {
+	idp := oidctest.NewServer("mall", "secret")
+	defer idp.Close()
+	p := newProvider(idp)
+	ctx := context.Background()
+
+	code := authorize(t, idp, p, "state", "nonce", "verifier-verifier-verifier-verifier-1234")
+	token, err := p.Exchange(ctx, code, "verifier-verifier-verifier-verifier-1234")
+	require.NoError(t, err)
+	_, err = p.VerifyIDToken(ctx, token.IDToken, "nonce")
+	require.NoError(t, err)
+
+	// 轮换后公钥缓存未过期，新 kid 在刷新间隔内不会重新拉取
+	idp.RotateKey()
+	code = authorize(t, idp, p, "state", "nonce", "verifier-verifier-verifier-verifier-1234")
+	token, err = p.Exchange(ctx, code, "verifier-verifier-verifier-verifier-1234")
+	require.NoError(t, err)
+	_, err = p.VerifyIDToken(ctx, token.IDToken, "nonce")
+	assert.ErrorIs(t, err, oidc.ErrIDTokenInvalid)
+
+	// 新的客户端首次遇到新 kid 时拉取 JWKS
+	_, err = newProvider(idp).VerifyIDToken(ctx, token.IDToken, "nonce")
+	assert.NoError(t, err)
+}
+
+func TestProvider_IssuerMismatch(t *testing.T) {
+	idp := oidctest.NewServer("mall", "secret")
+	defer idp.Close()
+
+	p := oidc.NewProvider(oidc.Config{Name: "fake", Issuer: idp.Issuer() + "/other", ClientID: "mall"}, idp.Client())
+	_, err := p.AuthCodeURL(context.Background(), "state", "nonce", "verifier")
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// maxCBORDepth 嵌套层数上限，认证器数据最多嵌套三层
+const maxCBORDepth = 8
+
+var errCBOR = errors.New("malformed cbor")
+
+// decodeCBOR 解码一个 CBOR 数据项，返回剩余未解码的数据
+// 只支持 WebAuthn 用到的类型：整数、字节串、文本串、数组、映射和简单值，不支持不定长编码和浮点数
+func decodeCBOR(data []byte) (interface{}, []byte, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (interface{}, []byte, error) {
+	if depth > maxCBORDepth {
+		return nil, nil, fmt.Errorf("%w: nesting too deep", errCBOR)
+	}
+	major, arg, rest, err := decodeCBORHead(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case 0: // 无符号整数
+		if arg > 1<<63-1 {
+			return nil, nil, fmt.Errorf("%w: integer overflow", errCBOR)
+		}
+		return int64(arg), rest, nil
+	case 1: // 负整数
+		if arg > 1<<63-1 {
+			return nil, nil, fmt.Errorf("%w: integer overflow", errCBOR)
+		}
+		return -1 - int64(arg), rest, nil
+	case 2, 3: // 字节串、文本串
+		if arg > uint64(len(rest)) {
+			return nil, nil, fmt.Errorf("%w: truncated string", errCBOR)
+		}
+		b := rest[:arg]
+		if major == 3 {
+			return string(b), rest[arg:], nil
+		}
+		return append([]byte(nil), b...), rest[arg:], nil
+	case 4: // 数组
+		if arg > uint64(len(rest)) {
+			return nil, nil, fmt.Errorf("%w: truncated array", errCBOR)
+		}
+		items := make([]interface{}, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			var item interface{}
+			if item, rest, err = decodeCBORItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, rest, nil
+	case 5: // 映射，键只能是整数或文本串
+		if arg > uint64(len(rest)) {
+			return nil, nil, fmt.Errorf("%w: truncated map", errCBOR)
+		}
+		m := make(map[interface{}]interface{}, arg)
+		for i := uint64(0); i < arg; i++ {
+			var key, value interface{}
+			if key, rest, err = decodeCBORItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, fmt.Errorf("%w: unsupported map key", errCBOR)
+			}
+			if _, ok := m[key]; ok {
+				return nil, nil, fmt.Errorf("%w: duplicate map key", errCBOR)
+			}
+			if value, rest, err = decodeCBORItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			m[key] = value
+		}
+		return m, rest, nil
+	case 6: // 标签，忽略标签本身
+		return decodeCBORItem(rest, depth+1)
+	default: // 简单值
+		switch arg {
+		case 20:
+			return false, rest, nil
+		case 21:
+			return true, rest, nil
+		case 22, 23:
+			return nil, rest, nil
+		}
+		return nil, nil, fmt.Errorf("%w: unsupported simple value %d", errCBOR, arg)
+	}
+}
+
+// decodeCBORHead 解码数据项的类型和参数
+func decodeCBORHead(data []byte) (major byte, arg uint64, rest []byte, err error) {
+	if len(data) == 0 {
+		return 0, 0, nil, fmt.Errorf("%w: unexpected end of data", errCBOR)
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	data = data[1:]
+	switch {
+	case info < 24:
+		return major, uint64(info), data, nil
+	case info <= 27:
+		n := 1 << (info - 24)
+		if len(data) < n {
+			return 0, 0, nil, fmt.Errorf("%w: unexpected end of data", errCBOR)
+		}
+		switch n {
+		case 1:
+			arg = uint64(data[0])
+		case 2:
+			arg = uint64(binary.BigEndian.Uint16(data))
+		case 4:
+			arg = uint64(binary.BigEndian.Uint32(data))
+		default:
+			arg = binary.BigEndian.Uint64(data)
+		}
+		return major, arg, data[n:], nil
+	default:
+		return 0, 0, nil, fmt.Errorf("%w: indefinite length not supported", errCBOR)
+	}
+}
@@ -0,0 +1,44 @@
+package webauthn
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCBOR(t *testing.T) {
+	// {1: 2, "fmt": "none", -1: h'0102', 3: [true, -7]}
+	data := []byte{0xa4, 0x01, 0x02, 0x63, 'f', 'm', 't', 0x64, 'n', 'o', 'n', 'e', 0x20, 0x42, 0x01, 0x02, 0x03, 0x82, 0xf5, 0x26, 0xff}
+	v, rest, err := decodeCBOR(data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xff}, rest)
+	assert.Equal(t, map[interface{}]interface{}{
+		int64(1):  int64(2),
+		"fmt":     "none",
+		int64(-1): []byte{0x01, 0x02},
+		int64(3):  []interface{}{true, int64(-7)},
+	}, v)
+}
+
+func TestDecodeCBOR_Malformed(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":               {},
+		"truncated_length":    {0x19, 0x01},
+		"truncated_bytes":     {0x45, 0x01, 0x02},
+		"truncated_map":       {0xa2, 0x01, 0x02},
+		"huge_array":          {0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		"indefinite_length":   {0x5f, 0x41, 0x00, 0xff},
+		"duplicate_key":       {0xa2, 0x01, 0x02, 0x01, 0x03},
+		"unsupported_map_key": {0xa1, 0x41, 0x00, 0x01},
+		"float":               {0xf9, 0x3c, 0x00},
+		"too_deep":            bytes.Repeat([]byte{0x81}, maxCBORDepth+2),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := decodeCBOR(data)
+			assert.ErrorIs(t, err, errCBOR)
+		})
+	}
+}
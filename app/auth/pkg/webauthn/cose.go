@@ -0,0 +1,126 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+)
+
+// COSE 算法标识
+const (
+	AlgES256 int64 = -7
+	AlgEdDSA int64 = -8
+	AlgRS256 int64 = -257
+)
+
+// SupportedAlgorithms 注册时声明支持的公钥算法，按优先级排列
+var SupportedAlgorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+// COSE 密钥参数
+const (
+	coseKeyType  int64 = 1
+	coseKeyAlg   int64 = 3
+	coseKeyCurve int64 = -1
+	coseKeyX     int64 = -2
+	coseKeyY     int64 = -3
+	coseKeyN     int64 = -1
+	coseKeyE     int64 = -2
+
+	coseKeyTypeOKP int64 = 1
+	coseKeyTypeEC2 int64 = 2
+	coseKeyTypeRSA int64 = 3
+
+	coseCurveP256    int64 = 1
+	coseCurveEd25519 int64 = 6
+
+	minRSAKeyBits = 2048
+)
+
+// publicKey 解析后的凭据公钥
+type publicKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+// parsePublicKey 解析 COSE 编码的凭据公钥，只接受 SupportedAlgorithms 中的算法
+func parsePublicKey(data []byte) (*publicKey, error) {
+	v, rest, err := decodeCBOR(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: trailing data after public key", ErrVerification)
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: public key is not a map", ErrVerification)
+	}
+	kty, _ := m[coseKeyType].(int64)
+	alg, _ := m[coseKeyAlg].(int64)
+
+	switch {
+	case kty == coseKeyTypeEC2 && alg == AlgES256:
+		if crv, _ := m[coseKeyCurve].(int64); crv != coseCurveP256 {
+			return nil, fmt.Errorf("%w: unsupported curve", ErrVerification)
+		}
+		x, _ := m[coseKeyX].([]byte)
+		y, _ := m[coseKeyY].([]byte)
+		if len(x) != 32 || len(y) != 32 {
+			return nil, fmt.Errorf("%w: invalid ec2 public key", ErrVerification)
+		}
+		// 通过 ecdh 校验点在曲线上
+		point := append(append([]byte{0x04}, x...), y...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, fmt.Errorf("%w: invalid ec2 public key", ErrVerification)
+		}
+		return &publicKey{alg: alg, key: &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}}, nil
+	case kty == coseKeyTypeOKP && alg == AlgEdDSA:
+		if crv, _ := m[coseKeyCurve].(int64); crv != coseCurveEd25519 {
+			return nil, fmt.Errorf("%w: unsupported curve", ErrVerification)
+		}
+		x, _ := m[coseKeyX].([]byte)
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w: invalid okp public key", ErrVerification)
+		}
+		return &publicKey{alg: alg, key: ed25519.PublicKey(x)}, nil
+	case kty == coseKeyTypeRSA && alg == AlgRS256:
+		n, _ := m[coseKeyN].([]byte)
+		e, _ := m[coseKeyE].([]byte)
+		if len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("%w: invalid rsa public key", ErrVerification)
+		}
+		key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if key.N.BitLen() < minRSAKeyBits || key.E < 3 {
+			return nil, fmt.Errorf("%w: invalid rsa public key", ErrVerification)
+		}
+		return &publicKey{alg: alg, key: key}, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported key type %d with algorithm %d", ErrVerification, kty, alg)
+	}
+}
+
+// verify 校验签名，ES256 签名为 ASN.1 DER 编码
+func (k *publicKey) verify(message, signature []byte) bool {
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, message, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	default:
+		return false
+	}
+}
@@ -0,0 +1,476 @@
+// Package webauthn 实现 WebAuthn 依赖方的注册和认证仪式校验，凭据和挑战的保存由调用方负责
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrVerification = errors.New("webauthn verification failed")
+	// ErrSignCount 签名计数器没有增长，认证器可能被克隆
+	ErrSignCount = errors.New("authenticator sign count did not increase")
+)
+
+// 用户验证要求
+const (
+	UserVerificationRequired    = "required"
+	UserVerificationPreferred   = "preferred"
+	UserVerificationDiscouraged = "discouraged"
+)
+
+const (
+	DefaultTimeout = 5 * time.Minute
+
+	challengeLength       = 32
+	maxCredentialIDLength = 1023
+	credentialType        = "public-key"
+	ceremonyCreate        = "webauthn.create"
+	ceremonyGet           = "webauthn.get"
+)
+
+// 认证器数据标志位
+const (
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagBackupEligible         = 0x08
+	flagAttestedCredentialData = 0x40
+	flagExtensionData          = 0x80
+)
+
+// Config 依赖方配置
+type Config struct {
+	RPID             string        // 依赖方 ID，为站点的可注册域名
+	RPName           string        // 展示给用户的名称，为空时使用 RPID
+	Origins          []string      // 允许发起仪式的页面来源，如 https://mall.example.com
+	UserVerification string        // required、preferred 或 discouraged，默认 preferred
+	Timeout          time.Duration // 仪式超时时间，也是挑战的有效期
+}
+
+// RelyingParty WebAuthn 依赖方
+type RelyingParty struct {
+	cfg      Config
+	rpIDHash [32]byte
+}
+
+// New 校验配置并创建依赖方
+func New(cfg Config) (*RelyingParty, error) {
+	if cfg.RPID == "" {
+		return nil, errors.New("webauthn rp_id is required")
+	}
+	if len(cfg.Origins) == 0 {
+		return nil, errors.New("webauthn origins are required")
+	}
+	if cfg.RPName == "" {
+		cfg.RPName = cfg.RPID
+	}
+	switch cfg.UserVerification {
+	case "":
+		cfg.UserVerification = UserVerificationPreferred
+	case UserVerificationRequired, UserVerificationPreferred, UserVerificationDiscouraged:
+	default:
+		return nil, fmt.Errorf("unknown webauthn user_verification %q", cfg.UserVerification)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	return &RelyingParty{cfg: cfg, rpIDHash: sha256.Sum256([]byte(cfg.RPID))}, nil
+}
+
+// Timeout 仪式超时时间
+func (rp *RelyingParty) Timeout() time.Duration {
+	return rp.cfg.Timeout
+}
+
+// RelyingPartyEntity 依赖方信息
+type RelyingPartyEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserEntity 用户信息，ID 为 base64url 编码的用户句柄
+type UserEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// CredentialParameter 支持的公钥算法
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+// CredentialDescriptor 凭据描述，ID 为 base64url 编码
+type CredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+// AuthenticatorSelection 认证器要求
+type AuthenticatorSelection struct {
+	ResidentKey        string `json:"residentKey"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification"`
+}
+
+// CreationOptions navigator.credentials.create() 的 publicKey 参数
+type CreationOptions struct {
+	Challenge              string                 `json:"challenge"`
+	RP                     RelyingPartyEntity     `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials,omitempty"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// RequestOptions navigator.credentials.get() 的 publicKey 参数，AllowCredentials 为空时使用可发现凭据
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	Timeout          int64                  `json:"timeout"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials,omitempty"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// RegistrationResponse navigator.credentials.create() 返回的凭据，二进制字段为 base64url 编码
+type RegistrationResponse struct {
+	ID       string              `json:"id"`
+	RawID    string              `json:"rawId"`
+	Type     string              `json:"type"`
+	Response AttestationResponse `json:"response"`
+}
+
+// AttestationResponse 认证器的注册响应
+type AttestationResponse struct {
+	ClientDataJSON    string   `json:"clientDataJSON"`
+	AttestationObject string   `json:"attestationObject"`
+	Transports        []string `json:"transports,omitempty"`
+}
+
+// AssertionResponse navigator.credentials.get() 返回的凭据，二进制字段为 base64url 编码
+type AssertionResponse struct {
+	ID       string                 `json:"id"`
+	RawID    string                 `json:"rawId"`
+	Type     string                 `json:"type"`
+	Response AuthenticatorAssertion `json:"response"`
+}
+
+// AuthenticatorAssertion 认证器的签名响应，UserHandle 仅可发现凭据返回
+type AuthenticatorAssertion struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"userHandle,omitempty"`
+}
+
+// User 注册凭据的用户，Handle 为不含个人信息的随机句柄
+type User struct {
+	Handle      []byte
+	Name        string
+	DisplayName string
+}
+
+// Credential 注册成功的凭据，PublicKey 为 COSE 编码
+type Credential struct {
+	ID             []byte
+	PublicKey      []byte
+	SignCount      uint32
+	AAGUID         []byte
+	Transports     []string
+	UserVerified   bool
+	BackupEligible bool // 可同步的通行密钥
+}
+
+// Assertion 认证成功的结果
+type Assertion struct {
+	SignCount    uint32
+	UserVerified bool
+}
+
+// clientData 浏览器生成的客户端数据
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// authenticatorData 解析后的认证器数据
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+// EncodeID 将二进制数据编码为 base64url
+func EncodeID(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeID 解码 base64url 数据，兼容带填充的编码
+func DecodeID(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// BeginRegistration 生成注册参数和挑战，exclude 为用户已注册的凭据，防止同一认证器重复注册
+func (rp *RelyingParty) BeginRegistration(user User, exclude []CredentialDescriptor) (*CreationOptions, []byte, error) {
+	challenge, err := newChallenge()
+	if err != nil {
+		return nil, nil, err
+	}
+	params := make([]CredentialParameter, 0, len(SupportedAlgorithms))
+	for _, alg := range SupportedAlgorithms {
+		params = append(params, CredentialParameter{Type: credentialType, Alg: alg})
+	}
+	return &CreationOptions{
+		Challenge: EncodeID(challenge),
+		RP:        RelyingPartyEntity{ID: rp.cfg.RPID, Name: rp.cfg.RPName},
+		User: UserEntity{
+			ID:          EncodeID(user.Handle),
+			Name:        user.Name,
+			DisplayName: user.DisplayName,
+		},
+		PubKeyCredParams:   params,
+		Timeout:            rp.cfg.Timeout.Milliseconds(),
+		ExcludeCredentials: exclude,
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:      "preferred",
+			UserVerification: rp.cfg.UserVerification,
+		},
+		Attestation: "none",
+	}, challenge, nil
+}
+
+// FinishRegistration 校验注册响应，返回需要保存的凭据
+// 依赖方不要求认证器证明（attestation: none），证明声明不做校验
+func (rp *RelyingParty) FinishRegistration(challenge []byte, resp *RegistrationResponse) (*Credential, error) {
+	if resp == nil || resp.Type != credentialType {
+		return nil, fmt.Errorf("%w: invalid credential type", ErrVerification)
+	}
+	if err := rp.verifyClientData(resp.Response.ClientDataJSON, ceremonyCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	raw, err := DecodeID(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid attestation object encoding", ErrVerification)
+	}
+	v, rest, err := decodeCBOR(raw)
+	if err != nil || len(rest) != 0 {
+		return nil, fmt.Errorf("%w: invalid attestation object", ErrVerification)
+	}
+	attestation, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid attestation object", ErrVerification)
+	}
+	if _, ok := attestation["fmt"].(string); !ok {
+		return nil, fmt.Errorf("%w: missing attestation format", ErrVerification)
+	}
+	rawAuthData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing authenticator data", ErrVerification)
+	}
+
+	ad, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyAuthenticatorData(ad); err != nil {
+		return nil, err
+	}
+	if ad.flags&flagAttestedCredentialData == 0 {
+		return nil, fmt.Errorf("%w: missing attested credential data", ErrVerification)
+	}
+	if rawID, err := DecodeID(resp.RawID); err != nil || !bytes.Equal(rawID, ad.credentialID) {
+		return nil, fmt.Errorf("%w: credential id mismatch", ErrVerification)
+	}
+	if _, err := parsePublicKey(ad.publicKey); err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:             ad.credentialID,
+		PublicKey:      ad.publicKey,
+		SignCount:      ad.signCount,
+		AAGUID:         ad.aaguid,
+		Transports:     resp.Response.Transports,
+		UserVerified:   ad.flags&flagUserVerified != 0,
+		BackupEligible: ad.flags&flagBackupEligible != 0,
+	}, nil
+}
+
+// BeginLogin 生成认证参数和挑战，allow 为空时由认证器选择可发现凭据
+func (rp *RelyingParty) BeginLogin(allow []CredentialDescriptor) (*RequestOptions, []byte, error) {
+	challenge, err := newChallenge()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &RequestOptions{
+		Challenge:        EncodeID(challenge),
+		Timeout:          rp.cfg.Timeout.Milliseconds(),
+		RPID:             rp.cfg.RPID,
+		AllowCredentials: allow,
+		UserVerification: rp.cfg.UserVerification,
+	}, challenge, nil
+}
+
+// FinishLogin 使用已保存的凭据校验认证响应，签名计数器未增长时返回 ErrSignCount
+func (rp *RelyingParty) FinishLogin(challenge []byte, credential *Credential, resp *AssertionResponse) (*Assertion, error) {
+	if resp == nil || resp.Type != credentialType {
+		return nil, fmt.Errorf("%w: invalid credential type", ErrVerification)
+	}
+	if rawID, err := DecodeID(resp.RawID); err != nil || !bytes.Equal(rawID, credential.ID) {
+		return nil, fmt.Errorf("%w: credential id mismatch", ErrVerification)
+	}
+	if err := rp.verifyClientData(resp.Response.ClientDataJSON, ceremonyGet, challenge); err != nil {
+		return nil, err
+	}
+
+	rawAuthData, err := DecodeID(resp.Response.AuthenticatorData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid authenticator data encoding", ErrVerification)
+	}
+	ad, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyAuthenticatorData(ad); err != nil {
+		return nil, err
+	}
+
+	key, err := parsePublicKey(credential.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := DecodeID(resp.Response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid signature encoding", ErrVerification)
+	}
+	clientDataJSON, _ := DecodeID(resp.Response.ClientDataJSON)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	if !key.verify(append(rawAuthData, clientDataHash[:]...), signature) {
+		return nil, fmt.Errorf("%w: invalid signature", ErrVerification)
+	}
+
+	// 不支持计数器的认证器始终返回 0
+	if (ad.signCount != 0 || credential.SignCount != 0) && ad.signCount <= credential.SignCount {
+		return nil, ErrSignCount
+	}
+	return &Assertion{
+		SignCount:    ad.signCount,
+		UserVerified: ad.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// verifyClientData 校验仪式类型、挑战和页面来源
+func (rp *RelyingParty) verifyClientData(encoded, ceremony string, challenge []byte) error {
+	raw, err := DecodeID(encoded)
+	if err != nil {
+		return fmt.Errorf("%w: invalid client data encoding", ErrVerification)
+	}
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return fmt.Errorf("%w: invalid client data", ErrVerification)
+	}
+	if cd.Type != ceremony {
+		return fmt.Errorf("%w: unexpected ceremony type %q", ErrVerification, cd.Type)
+	}
+	got, err := DecodeID(cd.Challenge)
+	if err != nil || len(challenge) == 0 || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return fmt.Errorf("%w: challenge mismatch", ErrVerification)
+	}
+	if cd.CrossOrigin || !containsString(rp.cfg.Origins, cd.Origin) {
+		return fmt.Errorf("%w: origin %q not allowed", ErrVerification, cd.Origin)
+	}
+	return nil
+}
+
+// verifyAuthenticatorData 校验依赖方 ID 摘要和用户在场、用户验证标志
+func (rp *RelyingParty) verifyAuthenticatorData(ad *authenticatorData) error {
+	if subtle.ConstantTimeCompare(ad.rpIDHash, rp.rpIDHash[:]) != 1 {
+		return fmt.Errorf("%w: rp id mismatch", ErrVerification)
+	}
+	if ad.flags&flagUserPresent == 0 {
+		return fmt.Errorf("%w: user not present", ErrVerification)
+	}
+	if rp.cfg.UserVerification == UserVerificationRequired && ad.flags&flagUserVerified == 0 {
+		return fmt.Errorf("%w: user not verified", ErrVerification)
+	}
+	return nil
+}
+
+// parseAuthenticatorData 解析认证器数据：依赖方 ID 摘要、标志、计数器，以及可选的凭据数据和扩展
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, fmt.Errorf("%w: authenticator data too short", ErrVerification)
+	}
+	ad := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	rest := data[37:]
+
+	if ad.flags&flagAttestedCredentialData != 0 {
+		if len(rest) < 18 {
+			return nil, fmt.Errorf("%w: attested credential data too short", ErrVerification)
+		}
+		ad.aaguid = rest[:16]
+		n := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if n == 0 || n > maxCredentialIDLength || len(rest) < n {
+			return nil, fmt.Errorf("%w: invalid credential id length", ErrVerification)
+		}
+		ad.credentialID, rest = rest[:n], rest[n:]
+
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid credential public key", ErrVerification)
+		}
+		ad.publicKey, rest = rest[:len(rest)-len(after)], after
+	}
+	if ad.flags&flagExtensionData != 0 {
+		var err error
+		if _, rest, err = decodeCBOR(rest); err != nil {
+			return nil, fmt.Errorf("%w: invalid extension data", ErrVerification)
+		}
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: trailing authenticator data", ErrVerification)
+	}
+	return ad, nil
+}
+
+// newChallenge 生成随机挑战
+func newChallenge() ([]byte, error) {
+	challenge := make([]byte, challengeLength)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, fmt.Errorf("generate challenge failed: %w", err)
+	}
+	return challenge, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
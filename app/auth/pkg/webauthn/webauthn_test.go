@@ -0,0 +1,190 @@
+package webauthn_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/auth/pkg/webauthn"
+	"TikTokMall/app/auth/pkg/webauthn/webauthntest"
+)
+
+const testOrigin = "https://mall.example.com"
+
+func newTestRP(t *testing.T, userVerification string) *webauthn.RelyingParty {
+	rp, err := webauthn.New(webauthn.Config{
+		RPID:             "mall.example.com",
+		RPName:           "TikTokMall",
+		Origins:          []string{testOrigin},
+		UserVerification: userVerification,
+	})
+	require.NoError(t, err)
+	return rp
+}
+
+// register 完成一次注册仪式
+func register(t *testing.T, rp *webauthn.RelyingParty, a *webauthntest.Authenticator) *webauthn.Credential {
+	options, challenge, err := rp.BeginRegistration(webauthn.User{Handle: []byte("user-handle"), Name: "alice"}, nil)
+	require.NoError(t, err)
+	resp, err := a.Create(options)
+	require.NoError(t, err)
+	cred, err := rp.FinishRegistration(challenge, resp)
+	require.NoError(t, err)
+	return cred
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	_, err := webauthn.New(webauthn.Config{Origins: []string{testOrigin}})
+	assert.Error(t, err)
+	_, err = webauthn.New(webauthn.Config{RPID: "mall.example.com"})
+	assert.Error(t, err)
+	_, err = webauthn.New(webauthn.Config{RPID: "mall.example.com", Origins: []string{testOrigin}, UserVerification: "always"})
+	assert.Error(t, err)
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	for name, alg := range map[string]int64{
+		"ES256": webauthn.AlgES256,
+		"EdDSA": webauthn.AlgEdDSA,
+		"RS256": webauthn.AlgRS256,
+	} {
+		t.Run(name, func(t *testing.T) {
+			rp := newTestRP(t, "")
+			a := webauthntest.NewAuthenticator(testOrigin)
+			a.Algorithm = alg
+
+			cred := register(t, rp, a)
+			assert.NotEmpty(t, cred.ID)
+			assert.NotEmpty(t, cred.PublicKey)
+			assert.Equal(t, []string{"internal"}, cred.Transports)
+			assert.True(t, cred.UserVerified)
+
+			options, challenge, err := rp.BeginLogin([]webauthn.CredentialDescriptor{{Type: "public-key", ID: webauthn.EncodeID(cred.ID)}})
+			require.NoError(t, err)
+			resp, err := a.Get(options)
+			require.NoError(t, err)
+			assertion, err := rp.FinishLogin(challenge, cred, resp)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(1), assertion.SignCount)
+			assert.True(t, assertion.UserVerified)
+		})
+	}
+}
+
+func TestFinishRegistration_Rejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		uv     string
+		modify func(a *webauthntest.Authenticator, options *webauthn.CreationOptions, challenge []byte) []byte
+	}{
+		{
+			name: "wrong_challenge",
+			modify: func(a *webauthntest.Authenticator, options *webauthn.CreationOptions, challenge []byte) []byte {
+				return []byte("another-challenge-another-challenge")
+			},
+		},
+		{
+			name: "wrong_origin",
+			modify: func(a *webauthntest.Authenticator, options *webauthn.CreationOptions, challenge []byte) []byte {
+				a.Origin = "https://phishing.example.com"
+				return challenge
+			},
+		},
+		{
+			name: "wrong_rp_id",
+			modify: func(a *webauthntest.Authenticator, options *webauthn.CreationOptions, challenge []byte) []byte {
+				options.RP.ID = "phishing.example.com"
+				return challenge
+			},
+		},
+		{
+			name: "user_not_verified",
+			uv:   webauthn.UserVerificationRequired,
+			modify: func(a *webauthntest.Authenticator, options *webauthn.CreationOptions, challenge []byte) []byte {
+				a.UserVerified = false
+				return challenge
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp := newTestRP(t, tt.uv)
+			a := webauthntest.NewAuthenticator(testOrigin)
+			options, challenge, err := rp.BeginRegistration(webauthn.User{Handle: []byte("user-handle"), Name: "alice"}, nil)
+			require.NoError(t, err)
+			challenge = tt.modify(a, options, challenge)
+
+			resp, err := a.Create(options)
+			require.NoError(t, err)
+			_, err = rp.FinishRegistration(challenge, resp)
+			assert.ErrorIs(t, err, webauthn.ErrVerification)
+		})
+	}
+}
+
+func TestFinishLogin_Rejects(t *testing.T) {
+	rp := newTestRP(t, "")
+	a := webauthntest.NewAuthenticator(testOrigin)
+	cred := register(t, rp, a)
+
+	login := func() (*webauthn.AssertionResponse, []byte) {
+		options, challenge, err := rp.BeginLogin(nil)
+		require.NoError(t, err)
+		resp, err := a.Get(options)
+		require.NoError(t, err)
+		return resp, challenge
+	}
+
+	t.Run("wrong_challenge", func(t *testing.T) {
+		resp, _ := login()
+		_, err := rp.FinishLogin([]byte("another-challenge-another-challenge"), cred, resp)
+		assert.ErrorIs(t, err, webauthn.ErrVerification)
+	})
+
+	t.Run("tampered_signature", func(t *testing.T) {
+		resp, challenge := login()
+		sig, err := webauthn.DecodeID(resp.Response.Signature)
+		require.NoError(t, err)
+		sig[len(sig)-1] ^= 0xff
+		resp.Response.Signature = webauthn.EncodeID(sig)
+		_, err = rp.FinishLogin(challenge, cred, resp)
+		assert.ErrorIs(t, err, webauthn.ErrVerification)
+	})
+
+	t.Run("other_credential", func(t *testing.T) {
+		other := register(t, rp, webauthntest.NewAuthenticator(testOrigin))
+		resp, challenge := login()
+		_, err := rp.FinishLogin(challenge, other, resp)
+		assert.ErrorIs(t, err, webauthn.ErrVerification)
+	})
+
+	t.Run("sign_count_not_increased", func(t *testing.T) {
+		resp, challenge := login()
+		assertion, err := rp.FinishLogin(challenge, cred, resp)
+		require.NoError(t, err)
+
+		// 克隆的认证器已把服务端计数器推进到当前认证器之前
+		stored := *cred
+		stored.SignCount = assertion.SignCount + 1
+		resp, challenge = login()
+		_, err = rp.FinishLogin(challenge, &stored, resp)
+		assert.ErrorIs(t, err, webauthn.ErrSignCount)
+	})
+
+	t.Run("counter_not_supported", func(t *testing.T) {
+		synced := webauthntest.NewAuthenticator(testOrigin)
+		synced.NoCounter = true
+		syncedCred := register(t, rp, synced)
+		for i := 0; i < 2; i++ {
+			options, challenge, err := rp.BeginLogin(nil)
+			require.NoError(t, err)
+			resp, err := synced.Get(options)
+			require.NoError(t, err)
+			assertion, err := rp.FinishLogin(challenge, syncedCred, resp)
+			require.NoError(t, err)
+			assert.Zero(t, assertion.SignCount)
+		}
+	})
+}
@@ -0,0 +1,228 @@
+// Package webauthntest 提供测试用的软件认证器
+package webauthntest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"TikTokMall/app/auth/pkg/webauthn"
+)
+
+// 认证器数据标志位
+const (
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+)
+
+// Authenticator 在内存中保存凭据的软件认证器，所有凭据都是可发现凭据
+type Authenticator struct {
+	Origin       string // 客户端数据中的页面来源
+	Algorithm    int64  // 新凭据使用的算法，默认 ES256
+	UserVerified bool   // 是否声明已完成用户验证
+	NoCounter    bool   // 签名计数器始终为 0，模拟同步的通行密钥
+
+	credentials []*credential
+}
+
+// credential 认证器保存的凭据
+type credential struct {
+	id         []byte
+	rpID       string
+	userHandle []byte
+	alg        int64
+	key        interface{}
+	signCount  uint32
+}
+
+// NewAuthenticator 创建使用 ES256 并完成用户验证的软件认证器
+func NewAuthenticator(origin string) *Authenticator {
+	return &Authenticator{Origin: origin, Algorithm: webauthn.AlgES256, UserVerified: true}
+}
+
+// Create 模拟 navigator.credentials.create()，生成新凭据
+func (a *Authenticator) Create(options *webauthn.CreationOptions) (*webauthn.RegistrationResponse, error) {
+	for _, exclude := range options.ExcludeCredentials {
+		if a.find(options.RP.ID, exclude.ID) != nil {
+			return nil, errors.New("credential already registered")
+		}
+	}
+	userHandle, err := webauthn.DecodeID(options.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cred := &credential{id: make([]byte, 16), rpID: options.RP.ID, userHandle: userHandle, alg: a.Algorithm}
+	if _, err := rand.Read(cred.id); err != nil {
+		return nil, err
+	}
+	publicKey, err := cred.generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	authData := a.authenticatorData(cred.rpID, flagAttestedCredentialData, 0)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(cred.id)))
+	authData = append(authData, cred.id...)
+	authData = append(authData, publicKey...)
+
+	attestationObject := encodeCBOR(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": authData,
+	})
+	clientDataJSON, err := a.clientData("webauthn.create", options.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	a.credentials = append(a.credentials, cred)
+	id := webauthn.EncodeID(cred.id)
+	return &webauthn.RegistrationResponse{
+		ID:    id,
+		RawID: id,
+		Type:  "public-key",
+		Response: webauthn.AttestationResponse{
+			ClientDataJSON:    webauthn.EncodeID(clientDataJSON),
+			AttestationObject: webauthn.EncodeID(attestationObject),
+			Transports:        []string{"internal"},
+		},
+	}, nil
+}
+
+// Get 模拟 navigator.credentials.get()，使用允许列表中的第一个凭据签名，列表为空时使用该依赖方最新注册的凭据
+func (a *Authenticator) Get(options *webauthn.RequestOptions) (*webauthn.AssertionResponse, error) {
+	var cred *credential
+	for _, allow := range options.AllowCredentials {
+		if cred = a.find(options.RPID, allow.ID); cred != nil {
+			break
+		}
+	}
+	if cred == nil && len(options.AllowCredentials) == 0 {
+		for i := len(a.credentials) - 1; i >= 0; i-- {
+			if a.credentials[i].rpID == options.RPID {
+				cred = a.credentials[i]
+				break
+			}
+		}
+	}
+	if cred == nil {
+		return nil, errors.New("no matching credential")
+	}
+
+	if !a.NoCounter {
+		cred.signCount++
+	}
+	authData := a.authenticatorData(cred.rpID, 0, cred.signCount)
+	clientDataJSON, err := a.clientData("webauthn.get", options.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signature, err := cred.sign(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	if err != nil {
+		return nil, err
+	}
+
+	id := webauthn.EncodeID(cred.id)
+	return &webauthn.AssertionResponse{
+		ID:    id,
+		RawID: id,
+		Type:  "public-key",
+		Response: webauthn.AuthenticatorAssertion{
+			ClientDataJSON:    webauthn.EncodeID(clientDataJSON),
+			AuthenticatorData: webauthn.EncodeID(authData),
+			Signature:         webauthn.EncodeID(signature),
+			UserHandle:        webauthn.EncodeID(cred.userHandle),
+		},
+	}, nil
+}
+
+func (a *Authenticator) find(rpID, id string) *credential {
+	for _, c := range a.credentials {
+		if c.rpID == rpID && webauthn.EncodeID(c.id) == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (a *Authenticator) authenticatorData(rpID string, flags byte, signCount uint32) []byte {
+	flags |= flagUserPresent
+	if a.UserVerified {
+		flags |= flagUserVerified
+	}
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, signCount)
+}
+
+func (a *Authenticator) clientData(ceremony, challenge string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":        ceremony,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+// generateKey 生成凭据密钥，返回 COSE 编码的公钥
+func (c *credential) generateKey() ([]byte, error) {
+	switch c.alg {
+	case webauthn.AlgES256:
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		c.key = key
+		point, err := key.PublicKey.ECDH()
+		if err != nil {
+			return nil, err
+		}
+		raw := point.Bytes() // 0x04 || X || Y
+		return encodeCBOR(map[int64]interface{}{1: int64(2), 3: c.alg, -1: int64(1), -2: raw[1:33], -3: raw[33:]}), nil
+	case webauthn.AlgEdDSA:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		c.key = priv
+		return encodeCBOR(map[int64]interface{}{1: int64(1), 3: c.alg, -1: int64(6), -2: []byte(pub)}), nil
+	case webauthn.AlgRS256:
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		c.key = key
+		e := big.NewInt(int64(key.E)).Bytes()
+		return encodeCBOR(map[int64]interface{}{1: int64(3), 3: c.alg, -1: key.N.Bytes(), -2: e}), nil
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %d", c.alg)
+	}
+}
+
+func (c *credential) sign(message []byte) ([]byte, error) {
+	switch key := c.key.(type) {
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256(message)
+		return ecdsa.SignASN1(rand.Reader, key, digest[:])
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, message), nil
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256(message)
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	default:
+		return nil, errors.New("unsupported key")
+	}
+}
@@ -1,6 +1,8 @@
 package mysql
 
 import (
+	"time"
+
 	"TikTokMall/app/auth/biz/dal/mysql"
 	"TikTokMall/app/auth/biz/service"
 )
@@ -37,15 +39,287 @@ func (r *AuthRepository) CreateToken(token *mysql.Token) error {
 	return mysql.DB.Create(token).Error
 }
 
-func (r *AuthRepository) GetTokenByToken(token string) (*mysql.Token, error) {
+func (r *AuthRepository) GetTokenByToken(digest string) (*mysql.Token, error) {
 	var t mysql.Token
-	err := mysql.DB.Where("token = ?", token).First(&t).Error
+	err := mysql.DB.Where("token = ?", digest).First(&t).Error
 	if err != nil {
 		return nil, err
 	}
 	return &t, nil
 }
 
-func (r *AuthRepository) DeleteToken(token string) error {
-	return mysql.DB.Where("token = ?", token).Delete(&mysql.Token{}).Error
+func (r *AuthRepository) GetTokenByRefreshToken(digest string) (*mysql.Token, error) {
+	t, err := mysql.GetTokenByRefreshToken(digest)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return t, nil
+}
+
+func (r *AuthRepository) MarkRefreshTokenUsed(id int64, familyID string) (bool, error) {
+	return mysql.MarkRefreshTokenUsed(id, familyID)
+}
+
+func (r *AuthRepository) RevokeTokenFamily(familyID string) ([]*mysql.Token, error) {
+	return mysql.RevokeTokenFamily(familyID)
+}
+
+func (r *AuthRepository) DeleteToken(digest string) error {
+	return mysql.DB.Where("token = ?", digest).Delete(&mysql.Token{}).Error
+}
+
+func (r *AuthRepository) UpdateUserTwoFactor(userID int64, enabled bool, secret, recoveryCodes string) error {
+	return mysql.UpdateUserTwoFactor(userID, enabled, secret, recoveryCodes)
+}
+
+func (r *AuthRepository) ReplaceRecoveryCodes(userID int64, current, remaining string) (bool, error) {
+	return mysql.ReplaceRecoveryCodes(userID, current, remaining)
+}
+
+func (r *AuthRepository) CreateOAuthClient(client *mysql.OAuthClient) error {
+	return mysql.CreateOAuthClient(client)
+}
+
+func (r *AuthRepository) GetOAuthClient(clientID string) (*mysql.OAuthClient, error) {
+	c, err := mysql.GetOAuthClient(clientID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return c, nil
+}
+
+func (r *AuthRepository) GetOAuthConsent(userID int64, clientID string) (*mysql.OAuthConsent, error) {
+	return mysql.GetOAuthConsent(userID, clientID)
+}
+
+func (r *AuthRepository) SaveOAuthConsent(consent *mysql.OAuthConsent) error {
+	return mysql.SaveOAuthConsent(consent)
+}
+
+func (r *AuthRepository) ListOAuthConsents(userID int64) ([]*mysql.OAuthConsent, error) {
+	return mysql.ListOAuthConsents(userID)
+}
+
+func (r *AuthRepository) DeleteOAuthConsent(userID int64, clientID string) error {
+	return mysql.DeleteOAuthConsent(userID, clientID)
+}
+
+func (r *AuthRepository) RevokeClientTokens(userID int64, clientID string) ([]*mysql.Token, error) {
+	return mysql.RevokeClientTokens(userID, clientID)
+}
+
+func (r *AuthRepository) GetUserByEmail(email string) (*mysql.User, error) {
+	u, err := mysql.GetUserByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return u, nil
+}
+
+func (r *AuthRepository) CheckUserExists(username string) (bool, error) {
+	return mysql.CheckUserExists(username)
+}
+
+func (r *AuthRepository) GetUserIdentity(provider, subject string) (*mysql.UserIdentity, error) {
+	identity, err := mysql.GetUserIdentity(provider, subject)
+	if err != nil {
+		return nil, err
+	}
+	if identity == nil {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return identity, nil
+}
+
+func (r *AuthRepository) CreateUserIdentity(identity *mysql.UserIdentity) error {
+	return mysql.CreateUserIdentity(identity)
+}
+
+func (r *AuthRepository) GetUserByPhone(phone string) (*mysql.User, error) {
+	u, err := mysql.GetUserByPhone(phone)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return u, nil
+}
+
+func (r *AuthRepository) UpdateUserPassword(userID int64, password string) error {
+	return mysql.UpdateUserPassword(userID, password)
+}
+
+func (r *AuthRepository) DeleteTokenByUserID(userID int64) ([]*mysql.Token, error) {
+	return mysql.DeleteTokenByUserID(userID)
+}
+
+func (r *AuthRepository) CheckEmailExists(email string) (bool, error) {
+	return mysql.CheckEmailExists(email)
+}
+
+func (r *AuthRepository) CheckPhoneExists(phone string) (bool, error) {
+	return mysql.CheckPhoneExists(phone)
+}
+
+func (r *AuthRepository) MarkEmailVerified(userID int64, email string) (bool, error) {
+	return mysql.MarkEmailVerified(userID, email)
+}
+
+func (r *AuthRepository) MarkPhoneVerified(userID int64, phone string) (bool, error) {
+	return mysql.MarkPhoneVerified(userID, phone)
+}
+
+func (r *AuthRepository) GetRoleByName(name string) (*mysql.Role, error) {
+	role, err := mysql.GetRoleByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return role, nil
+}
+
+func (r *AuthRepository) GetUserRoles(userID int64) ([]string, error) {
+	return mysql.GetUserRoles(userID)
+}
+
+func (r *AuthRepository) GetRolePermissions(roles []string) ([]string, error) {
+	return mysql.GetRolePermissions(roles)
+}
+
+func (r *AuthRepository) AssignUserRole(userID, roleID, grantedBy int64) error {
+	return mysql.AssignUserRole(userID, roleID, grantedBy)
+}
+
+func (r *AuthRepository) RevokeUserRole(userID, roleID int64) error {
+	return mysql.RevokeUserRole(userID, roleID)
+}
+
+func (r *AuthRepository) UpdateUserStatus(userID int64, status int8) error {
+	return mysql.UpdateUserStatus(userID, status)
+}
+
+func (r *AuthRepository) GetTokenByID(id int64) (*mysql.Token, error) {
+	t, err := mysql.GetTokenByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return t, nil
+}
+
+func (r *AuthRepository) ListUserSessions(userID int64) ([]*mysql.Token, error) {
+	return mysql.ListUserSessions(userID)
+}
+
+func (r *AuthRepository) TouchToken(digest string, seenAt time.Time) error {
+	return mysql.TouchToken(digest, seenAt)
+}
+
+func (r *AuthRepository) GetPasswordHistory(userID int64, limit int) ([]string, error) {
+	return mysql.GetPasswordHistory(userID, limit)
+}
+
+func (r *AuthRepository) AddPasswordHistory(userID int64, password string, keep int) error {
+	return mysql.AddPasswordHistory(userID, password, keep)
+}
+
+func (r *AuthRepository) CreateAuditLogs(logs []*mysql.AuditLog) error {
+	return mysql.CreateAuditLogs(logs)
+}
+
+func (r *AuthRepository) ListAuditLogs(filter mysql.AuditLogFilter) ([]*mysql.AuditLog, int64, error) {
+	return mysql.ListAuditLogs(filter)
+}
+
+func (r *AuthRepository) CreateAPIKey(key *mysql.APIKey) error {
+	return mysql.CreateAPIKey(key)
+}
+
+func (r *AuthRepository) GetAPIKey(keyID string) (*mysql.APIKey, error) {
+	return mysql.GetAPIKey(keyID)
+}
+
+func (r *AuthRepository) ListAPIKeys(userID int64) ([]*mysql.APIKey, error) {
+	return mysql.ListAPIKeys(userID)
+}
+
+func (r *AuthRepository) CountActiveAPIKeys(userID int64, now time.Time) (int64, error) {
+	return mysql.CountActiveAPIKeys(userID, now)
+}
+
+func (r *AuthRepository) RevokeAPIKey(userID int64, keyID string) (bool, error) {
+	return mysql.RevokeAPIKey(userID, keyID)
+}
+
+func (r *AuthRepository) TouchAPIKey(keyID string, usedAt time.Time) error {
+	return mysql.TouchAPIKey(keyID, usedAt)
+}
+
+func (r *AuthRepository) CreateWebAuthnCredential(cred *mysql.WebAuthnCredential) error {
+	return mysql.CreateWebAuthnCredential(cred)
+}
+
+func (r *AuthRepository) GetWebAuthnCredential(credentialID []byte) (*mysql.WebAuthnCredential, error) {
+	return mysql.GetWebAuthnCredential(credentialID)
+}
+
+func (r *AuthRepository) ListWebAuthnCredentials(userID int64) ([]*mysql.WebAuthnCredential, error) {
+	return mysql.ListWebAuthnCredentials(userID)
+}
+
+func (r *AuthRepository) UpdateWebAuthnCredentialUsage(id int64, signCount uint32, usedAt time.Time) error {
+	return mysql.UpdateWebAuthnCredentialUsage(id, signCount, usedAt)
+}
+
+func (r *AuthRepository) DeleteWebAuthnCredential(userID, id int64) (bool, error) {
+	return mysql.DeleteWebAuthnCredential(userID, id)
+}
+
+func (r *AuthRepository) CreateUserBan(ban *mysql.UserBan) error {
+	return mysql.CreateUserBan(ban)
+}
+
+func (r *AuthRepository) LiftUserBans(userID, liftedBy int64, reason string, at time.Time) error {
+	return mysql.LiftUserBans(userID, liftedBy, reason, at)
+}
+
+func (r *AuthRepository) ListExpiredUserBans(now time.Time, limit int) ([]*mysql.UserBan, error) {
+	return mysql.ListExpiredUserBans(now, limit)
+}
+
+func (r *AuthRepository) LiftExpiredUserBan(id int64, at time.Time) (bool, error) {
+	return mysql.LiftExpiredUserBan(id, at)
+}
+
+func (r *AuthRepository) ListUserBans(userID int64) ([]*mysql.UserBan, error) {
+	return mysql.ListUserBans(userID)
+}
+
+// UserDataRepository 个人数据导出和注销使用的存储
+type UserDataRepository struct{}
+
+func NewUserDataRepository() service.UserDataRepository {
+	return &UserDataRepository{}
+}
+
+func (r *UserDataRepository) GetUserData(userID int64) (*mysql.UserData, error) {
+	return mysql.GetUserData(userID)
+}
+
+func (r *UserDataRepository) EraseUserData(userID int64) ([]*mysql.Token, error) {
+	return mysql.EraseUserData(userID)
 }
@@ -22,6 +22,11 @@ func EmptyCart(ctx context.Context, userID uint32) error {
     return DB.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.CartItem{}).Error
 }
 
+// PurgeUserCart 彻底删除用户的购物车商品，包括已软删除的记录
+func PurgeUserCart(ctx context.Context, userID uint32) error {
+    return DB.WithContext(ctx).Unscoped().Where("user_id = ?", userID).Delete(&model.CartItem{}).Error
+}
+
 // CreateCartItem 创建购物车商品
 func CreateCartItem(ctx context.Context, item *model.CartItem) error {
     return DB.WithContext(ctx).Create(item).Error
@@ -74,3 +74,8 @@ func (r *MockCartRepository) EmptyCart(ctx context.Context, userID uint32) error
 	r.items[userID] = []*model.CartItem{}
 	return nil
 }
+
+func (r *MockCartRepository) PurgeItems(ctx context.Context, userID uint32) error {
+	delete(r.items, userID)
+	return nil
+}
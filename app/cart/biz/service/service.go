@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"TikTokMall/app/cart/biz/dal/mysql"
+	"TikTokMall/app/cart/biz/dal/redis"
 	"TikTokMall/app/cart/biz/model"
 )
 
@@ -39,3 +40,10 @@ func (r *defaultCartRepository) UpdateItemQuantity(ctx context.Context, userID u
 func (r *defaultCartRepository) EmptyCart(ctx context.Context, userID uint32) error {
 	return mysql.DB.Where("user_id = ?", userID).Delete(&model.CartItem{}).Error
 }
+
+func (r *defaultCartRepository) PurgeItems(ctx context.Context, userID uint32) error {
+	if err := mysql.PurgeUserCart(ctx, userID); err != nil {
+		return err
+	}
+	return redis.InvalidateCartCache(ctx, userID)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"TikTokMall/app/cart/biz/model"
+)
+
+// UserDataRepository 个人数据导出和注销使用的购物车存储
+type UserDataRepository interface {
+	GetItems(ctx context.Context, userID uint32) ([]*model.CartItem, error)
+	PurgeItems(ctx context.Context, userID uint32) error // 彻底删除，包括已软删除的记录和缓存
+}
+
+// UserDataHandler 处理用户服务发布的个人数据事件，实现 userdata.Handler
+type UserDataHandler struct {
+	repo UserDataRepository
+}
+
+// NewUserDataHandler 创建个人数据事件处理器
+func NewUserDataHandler() *UserDataHandler {
+	return NewUserDataHandlerWithRepo(&defaultCartRepository{})
+}
+
+// NewUserDataHandlerWithRepo 使用指定存储创建个人数据事件处理器
+func NewUserDataHandlerWithRepo(repo UserDataRepository) *UserDataHandler {
+	return &UserDataHandler{repo: repo}
+}
+
+// cartItemExport 导出的购物车商品
+type cartItemExport struct {
+	ProductID uint32    `json:"product_id"`
+	Quantity  uint32    `json:"quantity"`
+	Selected  bool      `json:"selected"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+// Export 导出用户购物车中的商品
+func (h *UserDataHandler) Export(ctx context.Context, userID int64) (interface{}, error) {
+	items, err := h.repo.GetItems(ctx, uint32(userID))
+	if err != nil {
+		return nil, fmt.Errorf("获取购物车失败: %w", err)
+	}
+	result := struct {
+		Items []cartItemExport `json:"items"`
+	}{Items: []cartItemExport{}}
+	for _, item := range items {
+		result.Items = append(result.Items, cartItemExport{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Selected:  item.Selected,
+			AddedAt:   item.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+// Erase 删除用户的购物车
+func (h *UserDataHandler) Erase(ctx context.Context, userID int64) error {
+	if err := h.repo.PurgeItems(ctx, uint32(userID)); err != nil {
+		return fmt.Errorf("删除购物车失败: %w", err)
+	}
+	return nil
+}
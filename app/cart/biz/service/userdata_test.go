@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/cart/biz/model"
+)
+
+func TestUserDataHandler_ExportAndErase(t *testing.T) {
+	ctx := context.Background()
+	repo := &MockCartRepository{items: map[uint32][]*model.CartItem{
+		1: {{UserID: 1, ProductID: 100, Quantity: 2, Selected: true}},
+		2: {{UserID: 2, ProductID: 200, Quantity: 1}},
+	}}
+	h := NewUserDataHandlerWithRepo(repo)
+
+	data, err := h.Export(ctx, 1)
+	require.NoError(t, err)
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+	var decoded struct {
+		Items []struct {
+			ProductID uint32 `json:"product_id"`
+			Quantity  uint32 `json:"quantity"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	require.Len(t, decoded.Items, 1)
+	assert.Equal(t, uint32(100), decoded.Items[0].ProductID)
+	assert.Equal(t, uint32(2), decoded.Items[0].Quantity)
+
+	require.NoError(t, h.Erase(ctx, 1))
+	items, err := repo.GetItems(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	items, err = repo.GetItems(ctx, 2)
+	require.NoError(t, err)
+	assert.Len(t, items, 1, "其他用户的购物车不受影响")
+
+	// 重复投递的注销事件不会出错
+	require.NoError(t, h.Erase(ctx, 1))
+}
@@ -18,6 +18,7 @@ var (
 
 type Config struct {
 	Env        string           `mapstructure:"env"`
+	Kitex      KitexConfig      `mapstructure:"kitex"`
 	Service    ServiceConfig    `mapstructure:"service"`
 	MySQL      MySQLConfig      `mapstructure:"mysql"`
 	Redis      RedisConfig      `mapstructure:"redis"`
@@ -26,6 +27,14 @@ type Config struct {
 	Jaeger     JaegerConfig     `mapstructure:"jaeger"`
 	Prometheus PrometheusConfig `mapstructure:"prometheus"`
 	TLS        TLSConfig        `mapstructure:"tls"`
+	AuthRPC    RPCClientConfig  `mapstructure:"auth_rpc"`
+	CORS       CORSConfig       `mapstructure:"cors"`
+}
+
+// KitexConfig 购物车 RPC 服务配置，结算服务通过它读取用户的购物车，RPC 端口只对内网开放
+type KitexConfig struct {
+	Service string `mapstructure:"service"`
+	Address string `mapstructure:"address"`
 }
 
 type ServiceConfig struct {
@@ -87,6 +96,17 @@ type TLSConfig struct {
 	ClientKeyPath  string `mapstructure:"client_key_path"`
 }
 
+// RPCClientConfig 下游 Kitex 服务配置
+type RPCClientConfig struct {
+	Service string `mapstructure:"service"` // 服务注册名，与下游服务的 kitex.service 一致
+	Address string `mapstructure:"address"` // 服务的 RPC 地址，如 127.0.0.1:8888
+}
+
+// CORSConfig 跨域配置，只有 allow_origins 中的来源可以携带 Cookie 访问，为空时拒绝跨域请求
+type CORSConfig struct {
+	AllowOrigins []string `mapstructure:"allow_origins"`
+}
+
 // GetConfig 获取配置实例
 func GetConfig() *Config {
 	once.Do(initConf)
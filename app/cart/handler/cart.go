@@ -3,8 +3,11 @@ package handler
 import (
 	"context"
 
+	"github.com/cloudwego/kitex/pkg/kerrors"
+
 	"TikTokMall/app/cart/biz/service"
 	"TikTokMall/app/cart/kitex_gen/cart"
+	"TikTokMall/pkg/authn"
 )
 
 // CartServiceImpl 实现 cart.thrift 中定义的服务接口
@@ -21,15 +24,34 @@ func NewCartServiceImpl() *CartServiceImpl {
 
 // AddItem 实现 CartServiceImpl 接口
 func (s *CartServiceImpl) AddItem(ctx context.Context, req *cart.AddItemReq) (resp *cart.AddItemResp, err error) {
+	if err := authorizeUser(ctx, &req.UserId); err != nil {
+		return nil, err
+	}
 	return s.svc.AddItem(ctx, req)
 }
 
 // GetCart 实现 CartServiceImpl 接口
 func (s *CartServiceImpl) GetCart(ctx context.Context, req *cart.GetCartReq) (resp *cart.GetCartResp, err error) {
+	if err := authorizeUser(ctx, &req.UserId); err != nil {
+		return nil, err
+	}
 	return s.svc.GetCart(ctx, req)
 }
 
 // EmptyCart 实现 CartServiceImpl 接口
 func (s *CartServiceImpl) EmptyCart(ctx context.Context, req *cart.EmptyCartReq) (resp *cart.EmptyCartResp, err error) {
+	if err := authorizeUser(ctx, &req.UserId); err != nil {
+		return nil, err
+	}
 	return s.svc.EmptyCart(ctx, req)
 }
+
+// authorizeUser 校验请求中的 user_id 与访问令牌一致，未传时使用令牌中的用户
+func authorizeUser(ctx context.Context, userID *uint32) error {
+	id, err := authn.UserID(ctx, int64(*userID))
+	if err != nil {
+		return kerrors.ErrACL.WithCause(err)
+	}
+	*userID = uint32(id)
+	return nil
+}
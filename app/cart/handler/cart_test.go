@@ -14,6 +14,7 @@ import (
 	"TikTokMall/app/cart/biz/model"
 	"TikTokMall/app/cart/biz/service"
 	"TikTokMall/app/cart/kitex_gen/cart"
+	"TikTokMall/pkg/authn"
 )
 
 func init() {
@@ -54,13 +55,18 @@ func cleanTestData() {
 	mysql.DB.Where("user_id = ?", 88888).Delete(&model.CartItem{})
 }
 
+// testUserContext 模拟 RPC 中间件校验访问令牌后写入的调用方
+func testUserContext() context.Context {
+	return authn.WithIdentity(context.Background(), &authn.Identity{UserID: 88888})
+}
+
 func TestNewCartServiceImpl(t *testing.T) {
 	handler := NewCartServiceImpl()
 	assert.NotNil(t, handler)
 }
 
 func TestCartServiceImpl_AddItem(t *testing.T) {
-	ctx := context.Background()
+	ctx := testUserContext()
 	handler := NewCartServiceImpl()
 
 	// 测试添加有效商品
@@ -89,8 +95,27 @@ func TestCartServiceImpl_AddItem(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestCartServiceImpl_RequiresOwnCart(t *testing.T) {
+	handler := NewCartServiceImpl()
+
+	// 未认证
+	_, err := handler.GetCart(context.Background(), &cart.GetCartReq{UserId: 88888})
+	assert.ErrorIs(t, err, authn.ErrUnauthenticated)
+
+	// 不能访问其他用户的购物车
+	_, err = handler.GetCart(testUserContext(), &cart.GetCartReq{UserId: 88889})
+	assert.ErrorIs(t, err, authn.ErrUserMismatch)
+	_, err = handler.EmptyCart(testUserContext(), &cart.EmptyCartReq{UserId: 88889})
+	assert.ErrorIs(t, err, authn.ErrUserMismatch)
+
+	// 未传 user_id 时使用令牌中的用户
+	resp, err := handler.GetCart(testUserContext(), &cart.GetCartReq{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(88888), resp.Cart.UserId)
+}
+
 func TestCartServiceImpl_GetCart(t *testing.T) {
-	ctx := context.Background()
+	ctx := testUserContext()
 	handler := NewCartServiceImpl()
 
 	// 先添加一些商品到测试用户购物车
@@ -121,7 +146,7 @@ func TestCartServiceImpl_GetCart(t *testing.T) {
 }
 
 func TestCartServiceImpl_EmptyCart(t *testing.T) {
-	ctx := context.Background()
+	ctx := testUserContext()
 	handler := NewCartServiceImpl()
 
 	// 确保测试环境干净
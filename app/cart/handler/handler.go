@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -9,6 +10,7 @@ import (
 
 	"TikTokMall/app/cart/biz/service"
 	"TikTokMall/app/cart/kitex_gen/cart"
+	"TikTokMall/pkg/authn"
 )
 
 // CartHandler 购物车HTTP处理器
@@ -41,10 +43,14 @@ func (h *CartHandler) AddItem(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
+	userID, ok := currentUser(ctx, c, req.UserID)
+	if !ok {
+		return
+	}
 
 	// 创建符合接口的请求对象
 	addItemReq := &cart.AddItemReq{
-		UserId: uint32(req.UserID),
+		UserId: uint32(userID),
 		Item: &cart.CartItem{
 			ProductId: uint32(req.ProductID),
 			Quantity:  req.Quantity,
@@ -69,13 +75,21 @@ func (h *CartHandler) AddItem(ctx context.Context, c *app.RequestContext) {
 
 // GetCart 获取购物车
 func (h *CartHandler) GetCart(ctx context.Context, c *app.RequestContext) {
-	userIDStr := c.Query("user_id")
-	userID, err := strconv.ParseInt(userIDStr, 10, 64)
-	if err != nil {
-		c.JSON(consts.StatusBadRequest, map[string]interface{}{
-			"code":    400,
-			"message": "用户ID参数错误",
-		})
+	// user_id 可选，未传时查询当前用户的购物车
+	var requested int64
+	if userIDStr := c.Query("user_id"); userIDStr != "" {
+		var err error
+		requested, err = strconv.ParseInt(userIDStr, 10, 64)
+		if err != nil {
+			c.JSON(consts.StatusBadRequest, map[string]interface{}{
+				"code":    400,
+				"message": "用户ID参数错误",
+			})
+			return
+		}
+	}
+	userID, ok := currentUser(ctx, c, requested)
+	if !ok {
 		return
 	}
 
@@ -113,10 +127,14 @@ func (h *CartHandler) EmptyCart(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
+	userID, ok := currentUser(ctx, c, req.UserID)
+	if !ok {
+		return
+	}
 
 	// 创建符合接口的请求对象
 	emptyCartReq := &cart.EmptyCartReq{
-		UserId: uint32(req.UserID),
+		UserId: uint32(userID),
 	}
 
 	resp, err := h.cartService.EmptyCart(ctx, emptyCartReq)
@@ -150,10 +168,14 @@ func (h *CartHandler) UpdateItem(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
+	userID, ok := currentUser(ctx, c, req.UserID)
+	if !ok {
+		return
+	}
 
 	// 由于proto中没有定义UpdateItem，我们使用AddItem来实现更新功能
 	addItemReq := &cart.AddItemReq{
-		UserId: uint32(req.UserID),
+		UserId: uint32(userID),
 		Item: &cart.CartItem{
 			ProductId: uint32(req.ProductID),
 			Quantity:  req.Quantity,
@@ -190,11 +212,15 @@ func (h *CartHandler) RemoveItem(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
+	userID, ok := currentUser(ctx, c, req.UserID)
+	if !ok {
+		return
+	}
 
 	// 由于proto中没有定义RemoveItem，我们使用AddItem来实现移除功能
 	// 设置数量为0表示移除
 	addItemReq := &cart.AddItemReq{
-		UserId: uint32(req.UserID),
+		UserId: uint32(userID),
 		Item: &cart.CartItem{
 			ProductId: uint32(req.ProductID),
 			Quantity:  0, // 数量为0表示移除
@@ -216,3 +242,20 @@ func (h *CartHandler) RemoveItem(ctx context.Context, c *app.RequestContext) {
 		"data":    resp,
 	})
 }
+
+// currentUser 校验请求中的用户ID与访问令牌一致，未传时使用令牌中的用户；失败时写入响应并返回 false
+func currentUser(ctx context.Context, c *app.RequestContext, requested int64) (int64, bool) {
+	userID, err := authn.UserID(ctx, requested)
+	if err != nil {
+		status := consts.StatusUnauthorized
+		if errors.Is(err, authn.ErrUserMismatch) {
+			status = consts.StatusForbidden
+		}
+		c.JSON(status, map[string]interface{}{
+			"code":    status,
+			"message": err.Error(),
+		})
+		return 0, false
+	}
+	return userID, true
+}
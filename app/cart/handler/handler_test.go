@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/cart/biz/service"
+	"TikTokMall/pkg/authn"
+)
+
+// newTestCartHandler 使用模拟仓库创建购物车处理器
+func newTestCartHandler() *CartHandler {
+	return &CartHandler{
+		cartService: service.NewCartServiceWithRepo(service.NewMockCartRepository()),
+	}
+}
+
+func TestCartHandler_AddItemUser(t *testing.T) {
+	h := newTestCartHandler()
+
+	tests := []struct {
+		name       string
+		identity   *authn.Identity
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{name: "unauthenticated", body: map[string]interface{}{"user_id": 88888, "product_id": 1001, "quantity": 1}, wantStatus: consts.StatusUnauthorized},
+		{name: "user_mismatch", identity: &authn.Identity{UserID: 1}, body: map[string]interface{}{"user_id": 88888, "product_id": 1001, "quantity": 1}, wantStatus: consts.StatusForbidden},
+		{name: "same_user", identity: &authn.Identity{UserID: 88888}, body: map[string]interface{}{"user_id": 88888, "product_id": 1001, "quantity": 1}, wantStatus: consts.StatusOK},
+		{name: "user_from_token", identity: &authn.Identity{UserID: 88888}, body: map[string]interface{}{"product_id": 1001, "quantity": 1}, wantStatus: consts.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.identity != nil {
+				ctx = authn.WithIdentity(ctx, tt.identity)
+			}
+			reqBody, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			c := app.NewContext(16)
+			c.Request.Header.SetMethod("POST")
+			c.Request.Header.SetContentTypeBytes([]byte("application/json"))
+			c.Request.SetBody(reqBody)
+			c.Request.Header.SetContentLength(len(reqBody))
+
+			h.AddItem(ctx, c)
+
+			assert.Equal(t, tt.wantStatus, c.Response.StatusCode())
+		})
+	}
+}
+
+func TestCartHandler_GetCartUser(t *testing.T) {
+	h := newTestCartHandler()
+	ctx := authn.WithIdentity(context.Background(), &authn.Identity{UserID: 88888})
+
+	// 未传 user_id 时查询当前用户的购物车
+	c := app.NewContext(16)
+	c.Request.SetRequestURI("/api/cart/get")
+	h.GetCart(ctx, c)
+	require.Equal(t, consts.StatusOK, c.Response.StatusCode())
+	var got struct {
+		Data struct {
+			Cart struct {
+				UserID uint32 `json:"user_id"`
+			} `json:"cart"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &got))
+	assert.Equal(t, uint32(88888), got.Data.Cart.UserID)
+
+	c = app.NewContext(16)
+	c.Request.SetRequestURI("/api/cart/get?user_id=1")
+	h.GetCart(ctx, c)
+	assert.Equal(t, consts.StatusForbidden, c.Response.StatusCode())
+}
@@ -0,0 +1,5702 @@
+// Code generated by Kitex v0.12.1. DO NOT EDIT.
+
+package authservice
+
+import (
+	auth "TikTokMall/app/cart/kitex_gen/auth"
+	"context"
+	"errors"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"DeliverTokenByRPC": kitex.NewMethodInfo(
+		deliverTokenByRPCHandler,
+		newDeliverTokenByRPCArgs,
+		newDeliverTokenByRPCResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"VerifyTokenByRPC": kitex.NewMethodInfo(
+		verifyTokenByRPCHandler,
+		newVerifyTokenByRPCArgs,
+		newVerifyTokenByRPCResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Register": kitex.NewMethodInfo(
+		registerHandler,
+		newRegisterArgs,
+		newRegisterResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Login": kitex.NewMethodInfo(
+		loginHandler,
+		newLoginArgs,
+		newLoginResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"LoginTwoFactor": kitex.NewMethodInfo(
+		loginTwoFactorHandler,
+		newLoginTwoFactorArgs,
+		newLoginTwoFactorResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"EnrollTwoFactor": kitex.NewMethodInfo(
+		enrollTwoFactorHandler,
+		newEnrollTwoFactorArgs,
+		newEnrollTwoFactorResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ConfirmTwoFactor": kitex.NewMethodInfo(
+		confirmTwoFactorHandler,
+		newConfirmTwoFactorArgs,
+		newConfirmTwoFactorResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DisableTwoFactor": kitex.NewMethodInfo(
+		disableTwoFactorHandler,
+		newDisableTwoFactorArgs,
+		newDisableTwoFactorResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ForgotPassword": kitex.NewMethodInfo(
+		forgotPasswordHandler,
+		newForgotPasswordArgs,
+		newForgotPasswordResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ResetPassword": kitex.NewMethodInfo(
+		resetPasswordHandler,
+		newResetPasswordArgs,
+		newResetPasswordResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ChangePassword": kitex.NewMethodInfo(
+		changePasswordHandler,
+		newChangePasswordArgs,
+		newChangePasswordResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ResendVerification": kitex.NewMethodInfo(
+		resendVerificationHandler,
+		newResendVerificationArgs,
+		newResendVerificationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"VerifyContact": kitex.NewMethodInfo(
+		verifyContactHandler,
+		newVerifyContactArgs,
+		newVerifyContactResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetVerificationStatus": kitex.NewMethodInfo(
+		getVerificationStatusHandler,
+		newGetVerificationStatusArgs,
+		newGetVerificationStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AssignRole": kitex.NewMethodInfo(
+		assignRoleHandler,
+		newAssignRoleArgs,
+		newAssignRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RevokeRole": kitex.NewMethodInfo(
+		revokeRoleHandler,
+		newRevokeRoleArgs,
+		newRevokeRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListUserRoles": kitex.NewMethodInfo(
+		listUserRolesHandler,
+		newListUserRolesArgs,
+		newListUserRolesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateUserStatus": kitex.NewMethodInfo(
+		updateUserStatusHandler,
+		newUpdateUserStatusArgs,
+		newUpdateUserStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"BanUser": kitex.NewMethodInfo(
+		banUserHandler,
+		newBanUserArgs,
+		newBanUserResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UnbanUser": kitex.NewMethodInfo(
+		unbanUserHandler,
+		newUnbanUserArgs,
+		newUnbanUserResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListUserBans": kitex.NewMethodInfo(
+		listUserBansHandler,
+		newListUserBansArgs,
+		newListUserBansResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UnlockLogin": kitex.NewMethodInfo(
+		unlockLoginHandler,
+		newUnlockLoginArgs,
+		newUnlockLoginResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAuditLogs": kitex.NewMethodInfo(
+		listAuditLogsHandler,
+		newListAuditLogsArgs,
+		newListAuditLogsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateAPIKey": kitex.NewMethodInfo(
+		createAPIKeyHandler,
+		newCreateAPIKeyArgs,
+		newCreateAPIKeyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAPIKeys": kitex.NewMethodInfo(
+		listAPIKeysHandler,
+		newListAPIKeysArgs,
+		newListAPIKeysResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RevokeAPIKey": kitex.NewMethodInfo(
+		revokeAPIKeyHandler,
+		newRevokeAPIKeyArgs,
+		newRevokeAPIKeyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"VerifyAPISignature": kitex.NewMethodInfo(
+		verifyAPISignatureHandler,
+		newVerifyAPISignatureArgs,
+		newVerifyAPISignatureResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RefreshToken": kitex.NewMethodInfo(
+		refreshTokenHandler,
+		newRefreshTokenArgs,
+		newRefreshTokenResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Logout": kitex.NewMethodInfo(
+		logoutHandler,
+		newLogoutArgs,
+		newLogoutResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListSessions": kitex.NewMethodInfo(
+		listSessionsHandler,
+		newListSessionsArgs,
+		newListSessionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RevokeSession": kitex.NewMethodInfo(
+		revokeSessionHandler,
+		newRevokeSessionArgs,
+		newRevokeSessionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"LogoutAll": kitex.NewMethodInfo(
+		logoutAllHandler,
+		newLogoutAllArgs,
+		newLogoutAllResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ValidateToken": kitex.NewMethodInfo(
+		validateTokenHandler,
+		newValidateTokenArgs,
+		newValidateTokenResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+}
+
+var (
+	authServiceServiceInfo                = NewServiceInfo()
+	authServiceServiceInfoForClient       = NewServiceInfoForClient()
+	authServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return authServiceServiceInfo
+}
+
+// for stream client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return authServiceServiceInfoForStreamClient
+}
+
+// for client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return authServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "AuthService"
+	handlerType := (*auth.AuthService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "auth",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.12.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func deliverTokenByRPCHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.DeliverTokenReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).DeliverTokenByRPC(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeliverTokenByRPCArgs:
+		success, err := handler.(auth.AuthService).DeliverTokenByRPC(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeliverTokenByRPCResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeliverTokenByRPCArgs() interface{} {
+	return &DeliverTokenByRPCArgs{}
+}
+
+func newDeliverTokenByRPCResult() interface{} {
+	return &DeliverTokenByRPCResult{}
+}
+
+type DeliverTokenByRPCArgs struct {
+	Req *auth.DeliverTokenReq
+}
+
+func (p *DeliverTokenByRPCArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.DeliverTokenReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeliverTokenByRPCArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeliverTokenByRPCArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeliverTokenByRPCArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeliverTokenByRPCArgs) Unmarshal(in []byte) error {
+	msg := new(auth.DeliverTokenReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeliverTokenByRPCArgs_Req_DEFAULT *auth.DeliverTokenReq
+
+func (p *DeliverTokenByRPCArgs) GetReq() *auth.DeliverTokenReq {
+	if !p.IsSetReq() {
+		return DeliverTokenByRPCArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeliverTokenByRPCArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeliverTokenByRPCArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeliverTokenByRPCResult struct {
+	Success *auth.DeliveryResp
+}
+
+var DeliverTokenByRPCResult_Success_DEFAULT *auth.DeliveryResp
+
+func (p *DeliverTokenByRPCResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.DeliveryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeliverTokenByRPCResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeliverTokenByRPCResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeliverTokenByRPCResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeliverTokenByRPCResult) Unmarshal(in []byte) error {
+	msg := new(auth.DeliveryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeliverTokenByRPCResult) GetSuccess() *auth.DeliveryResp {
+	if !p.IsSetSuccess() {
+		return DeliverTokenByRPCResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeliverTokenByRPCResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.DeliveryResp)
+}
+
+func (p *DeliverTokenByRPCResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeliverTokenByRPCResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyTokenByRPCHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.VerifyTokenReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).VerifyTokenByRPC(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *VerifyTokenByRPCArgs:
+		success, err := handler.(auth.AuthService).VerifyTokenByRPC(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyTokenByRPCResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newVerifyTokenByRPCArgs() interface{} {
+	return &VerifyTokenByRPCArgs{}
+}
+
+func newVerifyTokenByRPCResult() interface{} {
+	return &VerifyTokenByRPCResult{}
+}
+
+type VerifyTokenByRPCArgs struct {
+	Req *auth.VerifyTokenReq
+}
+
+func (p *VerifyTokenByRPCArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.VerifyTokenReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VerifyTokenByRPCArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VerifyTokenByRPCArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VerifyTokenByRPCArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyTokenByRPCArgs) Unmarshal(in []byte) error {
+	msg := new(auth.VerifyTokenReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyTokenByRPCArgs_Req_DEFAULT *auth.VerifyTokenReq
+
+func (p *VerifyTokenByRPCArgs) GetReq() *auth.VerifyTokenReq {
+	if !p.IsSetReq() {
+		return VerifyTokenByRPCArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyTokenByRPCArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyTokenByRPCArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyTokenByRPCResult struct {
+	Success *auth.VerifyResp
+}
+
+var VerifyTokenByRPCResult_Success_DEFAULT *auth.VerifyResp
+
+func (p *VerifyTokenByRPCResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.VerifyResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VerifyTokenByRPCResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VerifyTokenByRPCResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VerifyTokenByRPCResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyTokenByRPCResult) Unmarshal(in []byte) error {
+	msg := new(auth.VerifyResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyTokenByRPCResult) GetSuccess() *auth.VerifyResp {
+	if !p.IsSetSuccess() {
+		return VerifyTokenByRPCResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyTokenByRPCResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.VerifyResp)
+}
+
+func (p *VerifyTokenByRPCResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyTokenByRPCResult) GetResult() interface{} {
+	return p.Success
+}
+
+func registerHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.RegisterRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).Register(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RegisterArgs:
+		success, err := handler.(auth.AuthService).Register(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RegisterResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRegisterArgs() interface{} {
+	return &RegisterArgs{}
+}
+
+func newRegisterResult() interface{} {
+	return &RegisterResult{}
+}
+
+type RegisterArgs struct {
+	Req *auth.RegisterRequest
+}
+
+func (p *RegisterArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.RegisterRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RegisterArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RegisterArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RegisterArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RegisterArgs) Unmarshal(in []byte) error {
+	msg := new(auth.RegisterRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RegisterArgs_Req_DEFAULT *auth.RegisterRequest
+
+func (p *RegisterArgs) GetReq() *auth.RegisterRequest {
+	if !p.IsSetReq() {
+		return RegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RegisterArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RegisterResult struct {
+	Success *auth.RegisterResponse
+}
+
+var RegisterResult_Success_DEFAULT *auth.RegisterResponse
+
+func (p *RegisterResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.RegisterResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RegisterResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RegisterResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RegisterResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RegisterResult) Unmarshal(in []byte) error {
+	msg := new(auth.RegisterResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RegisterResult) GetSuccess() *auth.RegisterResponse {
+	if !p.IsSetSuccess() {
+		return RegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RegisterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.RegisterResponse)
+}
+
+func (p *RegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RegisterResult) GetResult() interface{} {
+	return p.Success
+}
+
+func loginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.LoginRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).Login(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LoginArgs:
+		success, err := handler.(auth.AuthService).Login(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LoginResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLoginArgs() interface{} {
+	return &LoginArgs{}
+}
+
+func newLoginResult() interface{} {
+	return &LoginResult{}
+}
+
+type LoginArgs struct {
+	Req *auth.LoginRequest
+}
+
+func (p *LoginArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.LoginRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LoginArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LoginArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LoginArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LoginArgs) Unmarshal(in []byte) error {
+	msg := new(auth.LoginRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LoginArgs_Req_DEFAULT *auth.LoginRequest
+
+func (p *LoginArgs) GetReq() *auth.LoginRequest {
+	if !p.IsSetReq() {
+		return LoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LoginArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LoginResult struct {
+	Success *auth.LoginResponse
+}
+
+var LoginResult_Success_DEFAULT *auth.LoginResponse
+
+func (p *LoginResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.LoginResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LoginResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LoginResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LoginResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LoginResult) Unmarshal(in []byte) error {
+	msg := new(auth.LoginResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LoginResult) GetSuccess() *auth.LoginResponse {
+	if !p.IsSetSuccess() {
+		return LoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.LoginResponse)
+}
+
+func (p *LoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LoginResult) GetResult() interface{} {
+	return p.Success
+}
+
+func loginTwoFactorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.TwoFactorLoginRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).LoginTwoFactor(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LoginTwoFactorArgs:
+		success, err := handler.(auth.AuthService).LoginTwoFactor(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LoginTwoFactorResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLoginTwoFactorArgs() interface{} {
+	return &LoginTwoFactorArgs{}
+}
+
+func newLoginTwoFactorResult() interface{} {
+	return &LoginTwoFactorResult{}
+}
+
+type LoginTwoFactorArgs struct {
+	Req *auth.TwoFactorLoginRequest
+}
+
+func (p *LoginTwoFactorArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.TwoFactorLoginRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LoginTwoFactorArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LoginTwoFactorArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LoginTwoFactorArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LoginTwoFactorArgs) Unmarshal(in []byte) error {
+	msg := new(auth.TwoFactorLoginRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LoginTwoFactorArgs_Req_DEFAULT *auth.TwoFactorLoginRequest
+
+func (p *LoginTwoFactorArgs) GetReq() *auth.TwoFactorLoginRequest {
+	if !p.IsSetReq() {
+		return LoginTwoFactorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LoginTwoFactorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LoginTwoFactorArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LoginTwoFactorResult struct {
+	Success *auth.LoginResponse
+}
+
+var LoginTwoFactorResult_Success_DEFAULT *auth.LoginResponse
+
+func (p *LoginTwoFactorResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.LoginResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LoginTwoFactorResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LoginTwoFactorResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LoginTwoFactorResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LoginTwoFactorResult) Unmarshal(in []byte) error {
+	msg := new(auth.LoginResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LoginTwoFactorResult) GetSuccess() *auth.LoginResponse {
+	if !p.IsSetSuccess() {
+		return LoginTwoFactorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LoginTwoFactorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.LoginResponse)
+}
+
+func (p *LoginTwoFactorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LoginTwoFactorResult) GetResult() interface{} {
+	return p.Success
+}
+
+func enrollTwoFactorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.EnrollTwoFactorRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).EnrollTwoFactor(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *EnrollTwoFactorArgs:
+		success, err := handler.(auth.AuthService).EnrollTwoFactor(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EnrollTwoFactorResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newEnrollTwoFactorArgs() interface{} {
+	return &EnrollTwoFactorArgs{}
+}
+
+func newEnrollTwoFactorResult() interface{} {
+	return &EnrollTwoFactorResult{}
+}
+
+type EnrollTwoFactorArgs struct {
+	Req *auth.EnrollTwoFactorRequest
+}
+
+func (p *EnrollTwoFactorArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.EnrollTwoFactorRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EnrollTwoFactorArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EnrollTwoFactorArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EnrollTwoFactorArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EnrollTwoFactorArgs) Unmarshal(in []byte) error {
+	msg := new(auth.EnrollTwoFactorRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EnrollTwoFactorArgs_Req_DEFAULT *auth.EnrollTwoFactorRequest
+
+func (p *EnrollTwoFactorArgs) GetReq() *auth.EnrollTwoFactorRequest {
+	if !p.IsSetReq() {
+		return EnrollTwoFactorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EnrollTwoFactorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EnrollTwoFactorArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EnrollTwoFactorResult struct {
+	Success *auth.EnrollTwoFactorResponse
+}
+
+var EnrollTwoFactorResult_Success_DEFAULT *auth.EnrollTwoFactorResponse
+
+func (p *EnrollTwoFactorResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.EnrollTwoFactorResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EnrollTwoFactorResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EnrollTwoFactorResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EnrollTwoFactorResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EnrollTwoFactorResult) Unmarshal(in []byte) error {
+	msg := new(auth.EnrollTwoFactorResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EnrollTwoFactorResult) GetSuccess() *auth.EnrollTwoFactorResponse {
+	if !p.IsSetSuccess() {
+		return EnrollTwoFactorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EnrollTwoFactorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.EnrollTwoFactorResponse)
+}
+
+func (p *EnrollTwoFactorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EnrollTwoFactorResult) GetResult() interface{} {
+	return p.Success
+}
+
+func confirmTwoFactorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ConfirmTwoFactorRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ConfirmTwoFactor(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ConfirmTwoFactorArgs:
+		success, err := handler.(auth.AuthService).ConfirmTwoFactor(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ConfirmTwoFactorResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newConfirmTwoFactorArgs() interface{} {
+	return &ConfirmTwoFactorArgs{}
+}
+
+func newConfirmTwoFactorResult() interface{} {
+	return &ConfirmTwoFactorResult{}
+}
+
+type ConfirmTwoFactorArgs struct {
+	Req *auth.ConfirmTwoFactorRequest
+}
+
+func (p *ConfirmTwoFactorArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ConfirmTwoFactorRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmTwoFactorArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ConfirmTwoFactorArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ConfirmTwoFactorArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ConfirmTwoFactorArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ConfirmTwoFactorRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ConfirmTwoFactorArgs_Req_DEFAULT *auth.ConfirmTwoFactorRequest
+
+func (p *ConfirmTwoFactorArgs) GetReq() *auth.ConfirmTwoFactorRequest {
+	if !p.IsSetReq() {
+		return ConfirmTwoFactorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ConfirmTwoFactorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ConfirmTwoFactorArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ConfirmTwoFactorResult struct {
+	Success *auth.ConfirmTwoFactorResponse
+}
+
+var ConfirmTwoFactorResult_Success_DEFAULT *auth.ConfirmTwoFactorResponse
+
+func (p *ConfirmTwoFactorResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ConfirmTwoFactorResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmTwoFactorResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ConfirmTwoFactorResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ConfirmTwoFactorResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ConfirmTwoFactorResult) Unmarshal(in []byte) error {
+	msg := new(auth.ConfirmTwoFactorResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ConfirmTwoFactorResult) GetSuccess() *auth.ConfirmTwoFactorResponse {
+	if !p.IsSetSuccess() {
+		return ConfirmTwoFactorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ConfirmTwoFactorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ConfirmTwoFactorResponse)
+}
+
+func (p *ConfirmTwoFactorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ConfirmTwoFactorResult) GetResult() interface{} {
+	return p.Success
+}
+
+func disableTwoFactorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.DisableTwoFactorRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).DisableTwoFactor(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DisableTwoFactorArgs:
+		success, err := handler.(auth.AuthService).DisableTwoFactor(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DisableTwoFactorResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDisableTwoFactorArgs() interface{} {
+	return &DisableTwoFactorArgs{}
+}
+
+func newDisableTwoFactorResult() interface{} {
+	return &DisableTwoFactorResult{}
+}
+
+type DisableTwoFactorArgs struct {
+	Req *auth.DisableTwoFactorRequest
+}
+
+func (p *DisableTwoFactorArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.DisableTwoFactorRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DisableTwoFactorArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DisableTwoFactorArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DisableTwoFactorArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DisableTwoFactorArgs) Unmarshal(in []byte) error {
+	msg := new(auth.DisableTwoFactorRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DisableTwoFactorArgs_Req_DEFAULT *auth.DisableTwoFactorRequest
+
+func (p *DisableTwoFactorArgs) GetReq() *auth.DisableTwoFactorRequest {
+	if !p.IsSetReq() {
+		return DisableTwoFactorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DisableTwoFactorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DisableTwoFactorArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DisableTwoFactorResult struct {
+	Success *auth.DisableTwoFactorResponse
+}
+
+var DisableTwoFactorResult_Success_DEFAULT *auth.DisableTwoFactorResponse
+
+func (p *DisableTwoFactorResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.DisableTwoFactorResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DisableTwoFactorResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DisableTwoFactorResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DisableTwoFactorResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DisableTwoFactorResult) Unmarshal(in []byte) error {
+	msg := new(auth.DisableTwoFactorResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DisableTwoFactorResult) GetSuccess() *auth.DisableTwoFactorResponse {
+	if !p.IsSetSuccess() {
+		return DisableTwoFactorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DisableTwoFactorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.DisableTwoFactorResponse)
+}
+
+func (p *DisableTwoFactorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DisableTwoFactorResult) GetResult() interface{} {
+	return p.Success
+}
+
+func forgotPasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ForgotPasswordRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ForgotPassword(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ForgotPasswordArgs:
+		success, err := handler.(auth.AuthService).ForgotPassword(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ForgotPasswordResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newForgotPasswordArgs() interface{} {
+	return &ForgotPasswordArgs{}
+}
+
+func newForgotPasswordResult() interface{} {
+	return &ForgotPasswordResult{}
+}
+
+type ForgotPasswordArgs struct {
+	Req *auth.ForgotPasswordRequest
+}
+
+func (p *ForgotPasswordArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ForgotPasswordRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ForgotPasswordArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ForgotPasswordArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ForgotPasswordArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ForgotPasswordArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ForgotPasswordRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ForgotPasswordArgs_Req_DEFAULT *auth.ForgotPasswordRequest
+
+func (p *ForgotPasswordArgs) GetReq() *auth.ForgotPasswordRequest {
+	if !p.IsSetReq() {
+		return ForgotPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ForgotPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ForgotPasswordArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ForgotPasswordResult struct {
+	Success *auth.ForgotPasswordResponse
+}
+
+var ForgotPasswordResult_Success_DEFAULT *auth.ForgotPasswordResponse
+
+func (p *ForgotPasswordResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ForgotPasswordResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ForgotPasswordResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ForgotPasswordResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ForgotPasswordResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ForgotPasswordResult) Unmarshal(in []byte) error {
+	msg := new(auth.ForgotPasswordResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ForgotPasswordResult) GetSuccess() *auth.ForgotPasswordResponse {
+	if !p.IsSetSuccess() {
+		return ForgotPasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ForgotPasswordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ForgotPasswordResponse)
+}
+
+func (p *ForgotPasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ForgotPasswordResult) GetResult() interface{} {
+	return p.Success
+}
+
+func resetPasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ResetPasswordRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ResetPassword(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ResetPasswordArgs:
+		success, err := handler.(auth.AuthService).ResetPassword(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ResetPasswordResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newResetPasswordArgs() interface{} {
+	return &ResetPasswordArgs{}
+}
+
+func newResetPasswordResult() interface{} {
+	return &ResetPasswordResult{}
+}
+
+type ResetPasswordArgs struct {
+	Req *auth.ResetPasswordRequest
+}
+
+func (p *ResetPasswordArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ResetPasswordRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ResetPasswordArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ResetPasswordArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ResetPasswordArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ResetPasswordArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ResetPasswordRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ResetPasswordArgs_Req_DEFAULT *auth.ResetPasswordRequest
+
+func (p *ResetPasswordArgs) GetReq() *auth.ResetPasswordRequest {
+	if !p.IsSetReq() {
+		return ResetPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ResetPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResetPasswordArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ResetPasswordResult struct {
+	Success *auth.ResetPasswordResponse
+}
+
+var ResetPasswordResult_Success_DEFAULT *auth.ResetPasswordResponse
+
+func (p *ResetPasswordResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ResetPasswordResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ResetPasswordResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ResetPasswordResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ResetPasswordResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ResetPasswordResult) Unmarshal(in []byte) error {
+	msg := new(auth.ResetPasswordResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ResetPasswordResult) GetSuccess() *auth.ResetPasswordResponse {
+	if !p.IsSetSuccess() {
+		return ResetPasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ResetPasswordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ResetPasswordResponse)
+}
+
+func (p *ResetPasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResetPasswordResult) GetResult() interface{} {
+	return p.Success
+}
+
+func changePasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ChangePasswordRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ChangePassword(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ChangePasswordArgs:
+		success, err := handler.(auth.AuthService).ChangePassword(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ChangePasswordResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newChangePasswordArgs() interface{} {
+	return &ChangePasswordArgs{}
+}
+
+func newChangePasswordResult() interface{} {
+	return &ChangePasswordResult{}
+}
+
+type ChangePasswordArgs struct {
+	Req *auth.ChangePasswordRequest
+}
+
+func (p *ChangePasswordArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ChangePasswordRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ChangePasswordArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ChangePasswordArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ChangePasswordArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ChangePasswordArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ChangePasswordRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ChangePasswordArgs_Req_DEFAULT *auth.ChangePasswordRequest
+
+func (p *ChangePasswordArgs) GetReq() *auth.ChangePasswordRequest {
+	if !p.IsSetReq() {
+		return ChangePasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ChangePasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ChangePasswordArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ChangePasswordResult struct {
+	Success *auth.ChangePasswordResponse
+}
+
+var ChangePasswordResult_Success_DEFAULT *auth.ChangePasswordResponse
+
+func (p *ChangePasswordResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ChangePasswordResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ChangePasswordResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ChangePasswordResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ChangePasswordResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ChangePasswordResult) Unmarshal(in []byte) error {
+	msg := new(auth.ChangePasswordResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ChangePasswordResult) GetSuccess() *auth.ChangePasswordResponse {
+	if !p.IsSetSuccess() {
+		return ChangePasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ChangePasswordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ChangePasswordResponse)
+}
+
+func (p *ChangePasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ChangePasswordResult) GetResult() interface{} {
+	return p.Success
+}
+
+func resendVerificationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ResendVerificationRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ResendVerification(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ResendVerificationArgs:
+		success, err := handler.(auth.AuthService).ResendVerification(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ResendVerificationResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newResendVerificationArgs() interface{} {
+	return &ResendVerificationArgs{}
+}
+
+func newResendVerificationResult() interface{} {
+	return &ResendVerificationResult{}
+}
+
+type ResendVerificationArgs struct {
+	Req *auth.ResendVerificationRequest
+}
+
+func (p *ResendVerificationArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ResendVerificationRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ResendVerificationArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ResendVerificationArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ResendVerificationArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ResendVerificationArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ResendVerificationRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ResendVerificationArgs_Req_DEFAULT *auth.ResendVerificationRequest
+
+func (p *ResendVerificationArgs) GetReq() *auth.ResendVerificationRequest {
+	if !p.IsSetReq() {
+		return ResendVerificationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ResendVerificationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResendVerificationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ResendVerificationResult struct {
+	Success *auth.ResendVerificationResponse
+}
+
+var ResendVerificationResult_Success_DEFAULT *auth.ResendVerificationResponse
+
+func (p *ResendVerificationResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ResendVerificationResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ResendVerificationResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ResendVerificationResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ResendVerificationResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ResendVerificationResult) Unmarshal(in []byte) error {
+	msg := new(auth.ResendVerificationResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ResendVerificationResult) GetSuccess() *auth.ResendVerificationResponse {
+	if !p.IsSetSuccess() {
+		return ResendVerificationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ResendVerificationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ResendVerificationResponse)
+}
+
+func (p *ResendVerificationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResendVerificationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyContactHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.VerifyContactRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).VerifyContact(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *VerifyContactArgs:
+		success, err := handler.(auth.AuthService).VerifyContact(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyContactResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newVerifyContactArgs() interface{} {
+	return &VerifyContactArgs{}
+}
+
+func newVerifyContactResult() interface{} {
+	return &VerifyContactResult{}
+}
+
+type VerifyContactArgs struct {
+	Req *auth.VerifyContactRequest
+}
+
+func (p *VerifyContactArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.VerifyContactRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VerifyContactArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VerifyContactArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VerifyContactArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyContactArgs) Unmarshal(in []byte) error {
+	msg := new(auth.VerifyContactRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyContactArgs_Req_DEFAULT *auth.VerifyContactRequest
+
+func (p *VerifyContactArgs) GetReq() *auth.VerifyContactRequest {
+	if !p.IsSetReq() {
+		return VerifyContactArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyContactArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyContactArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyContactResult struct {
+	Success *auth.VerifyContactResponse
+}
+
+var VerifyContactResult_Success_DEFAULT *auth.VerifyContactResponse
+
+func (p *VerifyContactResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.VerifyContactResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VerifyContactResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VerifyContactResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VerifyContactResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyContactResult) Unmarshal(in []byte) error {
+	msg := new(auth.VerifyContactResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyContactResult) GetSuccess() *auth.VerifyContactResponse {
+	if !p.IsSetSuccess() {
+		return VerifyContactResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyContactResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.VerifyContactResponse)
+}
+
+func (p *VerifyContactResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyContactResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getVerificationStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.VerificationStatusReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).GetVerificationStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetVerificationStatusArgs:
+		success, err := handler.(auth.AuthService).GetVerificationStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetVerificationStatusResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetVerificationStatusArgs() interface{} {
+	return &GetVerificationStatusArgs{}
+}
+
+func newGetVerificationStatusResult() interface{} {
+	return &GetVerificationStatusResult{}
+}
+
+type GetVerificationStatusArgs struct {
+	Req *auth.VerificationStatusReq
+}
+
+func (p *GetVerificationStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.VerificationStatusReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetVerificationStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetVerificationStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetVerificationStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetVerificationStatusArgs) Unmarshal(in []byte) error {
+	msg := new(auth.VerificationStatusReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetVerificationStatusArgs_Req_DEFAULT *auth.VerificationStatusReq
+
+func (p *GetVerificationStatusArgs) GetReq() *auth.VerificationStatusReq {
+	if !p.IsSetReq() {
+		return GetVerificationStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetVerificationStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetVerificationStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetVerificationStatusResult struct {
+	Success *auth.VerificationStatusResp
+}
+
+var GetVerificationStatusResult_Success_DEFAULT *auth.VerificationStatusResp
+
+func (p *GetVerificationStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.VerificationStatusResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetVerificationStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetVerificationStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetVerificationStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetVerificationStatusResult) Unmarshal(in []byte) error {
+	msg := new(auth.VerificationStatusResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetVerificationStatusResult) GetSuccess() *auth.VerificationStatusResp {
+	if !p.IsSetSuccess() {
+		return GetVerificationStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetVerificationStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.VerificationStatusResp)
+}
+
+func (p *GetVerificationStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetVerificationStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func assignRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.AssignRoleRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).AssignRole(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AssignRoleArgs:
+		success, err := handler.(auth.AuthService).AssignRole(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AssignRoleResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAssignRoleArgs() interface{} {
+	return &AssignRoleArgs{}
+}
+
+func newAssignRoleResult() interface{} {
+	return &AssignRoleResult{}
+}
+
+type AssignRoleArgs struct {
+	Req *auth.AssignRoleRequest
+}
+
+func (p *AssignRoleArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.AssignRoleRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *AssignRoleArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *AssignRoleArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *AssignRoleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AssignRoleArgs) Unmarshal(in []byte) error {
+	msg := new(auth.AssignRoleRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AssignRoleArgs_Req_DEFAULT *auth.AssignRoleRequest
+
+func (p *AssignRoleArgs) GetReq() *auth.AssignRoleRequest {
+	if !p.IsSetReq() {
+		return AssignRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AssignRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AssignRoleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AssignRoleResult struct {
+	Success *auth.AssignRoleResponse
+}
+
+var AssignRoleResult_Success_DEFAULT *auth.AssignRoleResponse
+
+func (p *AssignRoleResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.AssignRoleResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *AssignRoleResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *AssignRoleResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *AssignRoleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AssignRoleResult) Unmarshal(in []byte) error {
+	msg := new(auth.AssignRoleResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AssignRoleResult) GetSuccess() *auth.AssignRoleResponse {
+	if !p.IsSetSuccess() {
+		return AssignRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AssignRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.AssignRoleResponse)
+}
+
+func (p *AssignRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AssignRoleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.RevokeRoleRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).RevokeRole(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RevokeRoleArgs:
+		success, err := handler.(auth.AuthService).RevokeRole(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeRoleResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRevokeRoleArgs() interface{} {
+	return &RevokeRoleArgs{}
+}
+
+func newRevokeRoleResult() interface{} {
+	return &RevokeRoleResult{}
+}
+
+type RevokeRoleArgs struct {
+	Req *auth.RevokeRoleRequest
+}
+
+func (p *RevokeRoleArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.RevokeRoleRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RevokeRoleArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RevokeRoleArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RevokeRoleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeRoleArgs) Unmarshal(in []byte) error {
+	msg := new(auth.RevokeRoleRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeRoleArgs_Req_DEFAULT *auth.RevokeRoleRequest
+
+func (p *RevokeRoleArgs) GetReq() *auth.RevokeRoleRequest {
+	if !p.IsSetReq() {
+		return RevokeRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeRoleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeRoleResult struct {
+	Success *auth.RevokeRoleResponse
+}
+
+var RevokeRoleResult_Success_DEFAULT *auth.RevokeRoleResponse
+
+func (p *RevokeRoleResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.RevokeRoleResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RevokeRoleResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RevokeRoleResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RevokeRoleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeRoleResult) Unmarshal(in []byte) error {
+	msg := new(auth.RevokeRoleResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeRoleResult) GetSuccess() *auth.RevokeRoleResponse {
+	if !p.IsSetSuccess() {
+		return RevokeRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.RevokeRoleResponse)
+}
+
+func (p *RevokeRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeRoleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listUserRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ListUserRolesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ListUserRoles(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListUserRolesArgs:
+		success, err := handler.(auth.AuthService).ListUserRoles(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListUserRolesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListUserRolesArgs() interface{} {
+	return &ListUserRolesArgs{}
+}
+
+func newListUserRolesResult() interface{} {
+	return &ListUserRolesResult{}
+}
+
+type ListUserRolesArgs struct {
+	Req *auth.ListUserRolesRequest
+}
+
+func (p *ListUserRolesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ListUserRolesRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListUserRolesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListUserRolesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListUserRolesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListUserRolesArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ListUserRolesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListUserRolesArgs_Req_DEFAULT *auth.ListUserRolesRequest
+
+func (p *ListUserRolesArgs) GetReq() *auth.ListUserRolesRequest {
+	if !p.IsSetReq() {
+		return ListUserRolesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListUserRolesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListUserRolesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListUserRolesResult struct {
+	Success *auth.ListUserRolesResponse
+}
+
+var ListUserRolesResult_Success_DEFAULT *auth.ListUserRolesResponse
+
+func (p *ListUserRolesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ListUserRolesResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListUserRolesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListUserRolesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListUserRolesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListUserRolesResult) Unmarshal(in []byte) error {
+	msg := new(auth.ListUserRolesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListUserRolesResult) GetSuccess() *auth.ListUserRolesResponse {
+	if !p.IsSetSuccess() {
+		return ListUserRolesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListUserRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ListUserRolesResponse)
+}
+
+func (p *ListUserRolesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListUserRolesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateUserStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.UpdateUserStatusRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).UpdateUserStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateUserStatusArgs:
+		success, err := handler.(auth.AuthService).UpdateUserStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateUserStatusResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateUserStatusArgs() interface{} {
+	return &UpdateUserStatusArgs{}
+}
+
+func newUpdateUserStatusResult() interface{} {
+	return &UpdateUserStatusResult{}
+}
+
+type UpdateUserStatusArgs struct {
+	Req *auth.UpdateUserStatusRequest
+}
+
+func (p *UpdateUserStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.UpdateUserStatusRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateUserStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateUserStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateUserStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateUserStatusArgs) Unmarshal(in []byte) error {
+	msg := new(auth.UpdateUserStatusRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateUserStatusArgs_Req_DEFAULT *auth.UpdateUserStatusRequest
+
+func (p *UpdateUserStatusArgs) GetReq() *auth.UpdateUserStatusRequest {
+	if !p.IsSetReq() {
+		return UpdateUserStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateUserStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateUserStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateUserStatusResult struct {
+	Success *auth.UpdateUserStatusResponse
+}
+
+var UpdateUserStatusResult_Success_DEFAULT *auth.UpdateUserStatusResponse
+
+func (p *UpdateUserStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.UpdateUserStatusResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateUserStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateUserStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateUserStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateUserStatusResult) Unmarshal(in []byte) error {
+	msg := new(auth.UpdateUserStatusResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateUserStatusResult) GetSuccess() *auth.UpdateUserStatusResponse {
+	if !p.IsSetSuccess() {
+		return UpdateUserStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateUserStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.UpdateUserStatusResponse)
+}
+
+func (p *UpdateUserStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateUserStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func banUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.BanUserRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).BanUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *BanUserArgs:
+		success, err := handler.(auth.AuthService).BanUser(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*BanUserResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newBanUserArgs() interface{} {
+	return &BanUserArgs{}
+}
+
+func newBanUserResult() interface{} {
+	return &BanUserResult{}
+}
+
+type BanUserArgs struct {
+	Req *auth.BanUserRequest
+}
+
+func (p *BanUserArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.BanUserRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *BanUserArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *BanUserArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *BanUserArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *BanUserArgs) Unmarshal(in []byte) error {
+	msg := new(auth.BanUserRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var BanUserArgs_Req_DEFAULT *auth.BanUserRequest
+
+func (p *BanUserArgs) GetReq() *auth.BanUserRequest {
+	if !p.IsSetReq() {
+		return BanUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BanUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *BanUserArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type BanUserResult struct {
+	Success *auth.BanUserResponse
+}
+
+var BanUserResult_Success_DEFAULT *auth.BanUserResponse
+
+func (p *BanUserResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.BanUserResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *BanUserResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *BanUserResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *BanUserResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *BanUserResult) Unmarshal(in []byte) error {
+	msg := new(auth.BanUserResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BanUserResult) GetSuccess() *auth.BanUserResponse {
+	if !p.IsSetSuccess() {
+		return BanUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BanUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.BanUserResponse)
+}
+
+func (p *BanUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BanUserResult) GetResult() interface{} {
+	return p.Success
+}
+
+func unbanUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.UnbanUserRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).UnbanUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UnbanUserArgs:
+		success, err := handler.(auth.AuthService).UnbanUser(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UnbanUserResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUnbanUserArgs() interface{} {
+	return &UnbanUserArgs{}
+}
+
+func newUnbanUserResult() interface{} {
+	return &UnbanUserResult{}
+}
+
+type UnbanUserArgs struct {
+	Req *auth.UnbanUserRequest
+}
+
+func (p *UnbanUserArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.UnbanUserRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UnbanUserArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UnbanUserArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UnbanUserArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UnbanUserArgs) Unmarshal(in []byte) error {
+	msg := new(auth.UnbanUserRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UnbanUserArgs_Req_DEFAULT *auth.UnbanUserRequest
+
+func (p *UnbanUserArgs) GetReq() *auth.UnbanUserRequest {
+	if !p.IsSetReq() {
+		return UnbanUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UnbanUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UnbanUserArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UnbanUserResult struct {
+	Success *auth.UnbanUserResponse
+}
+
+var UnbanUserResult_Success_DEFAULT *auth.UnbanUserResponse
+
+func (p *UnbanUserResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.UnbanUserResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UnbanUserResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UnbanUserResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UnbanUserResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UnbanUserResult) Unmarshal(in []byte) error {
+	msg := new(auth.UnbanUserResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UnbanUserResult) GetSuccess() *auth.UnbanUserResponse {
+	if !p.IsSetSuccess() {
+		return UnbanUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UnbanUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.UnbanUserResponse)
+}
+
+func (p *UnbanUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UnbanUserResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listUserBansHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ListUserBansRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ListUserBans(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListUserBansArgs:
+		success, err := handler.(auth.AuthService).ListUserBans(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListUserBansResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListUserBansArgs() interface{} {
+	return &ListUserBansArgs{}
+}
+
+func newListUserBansResult() interface{} {
+	return &ListUserBansResult{}
+}
+
+type ListUserBansArgs struct {
+	Req *auth.ListUserBansRequest
+}
+
+func (p *ListUserBansArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ListUserBansRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListUserBansArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListUserBansArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListUserBansArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListUserBansArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ListUserBansRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListUserBansArgs_Req_DEFAULT *auth.ListUserBansRequest
+
+func (p *ListUserBansArgs) GetReq() *auth.ListUserBansRequest {
+	if !p.IsSetReq() {
+		return ListUserBansArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListUserBansArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListUserBansArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListUserBansResult struct {
+	Success *auth.ListUserBansResponse
+}
+
+var ListUserBansResult_Success_DEFAULT *auth.ListUserBansResponse
+
+func (p *ListUserBansResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ListUserBansResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListUserBansResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListUserBansResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListUserBansResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListUserBansResult) Unmarshal(in []byte) error {
+	msg := new(auth.ListUserBansResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListUserBansResult) GetSuccess() *auth.ListUserBansResponse {
+	if !p.IsSetSuccess() {
+		return ListUserBansResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListUserBansResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ListUserBansResponse)
+}
+
+func (p *ListUserBansResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListUserBansResult) GetResult() interface{} {
+	return p.Success
+}
+
+func unlockLoginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.UnlockLoginRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).UnlockLogin(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UnlockLoginArgs:
+		success, err := handler.(auth.AuthService).UnlockLogin(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UnlockLoginResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUnlockLoginArgs() interface{} {
+	return &UnlockLoginArgs{}
+}
+
+func newUnlockLoginResult() interface{} {
+	return &UnlockLoginResult{}
+}
+
+type UnlockLoginArgs struct {
+	Req *auth.UnlockLoginRequest
+}
+
+func (p *UnlockLoginArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.UnlockLoginRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UnlockLoginArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UnlockLoginArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UnlockLoginArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UnlockLoginArgs) Unmarshal(in []byte) error {
+	msg := new(auth.UnlockLoginRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UnlockLoginArgs_Req_DEFAULT *auth.UnlockLoginRequest
+
+func (p *UnlockLoginArgs) GetReq() *auth.UnlockLoginRequest {
+	if !p.IsSetReq() {
+		return UnlockLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UnlockLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UnlockLoginArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UnlockLoginResult struct {
+	Success *auth.UnlockLoginResponse
+}
+
+var UnlockLoginResult_Success_DEFAULT *auth.UnlockLoginResponse
+
+func (p *UnlockLoginResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.UnlockLoginResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UnlockLoginResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UnlockLoginResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UnlockLoginResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UnlockLoginResult) Unmarshal(in []byte) error {
+	msg := new(auth.UnlockLoginResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UnlockLoginResult) GetSuccess() *auth.UnlockLoginResponse {
+	if !p.IsSetSuccess() {
+		return UnlockLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UnlockLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.UnlockLoginResponse)
+}
+
+func (p *UnlockLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UnlockLoginResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listAuditLogsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ListAuditLogsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ListAuditLogs(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListAuditLogsArgs:
+		success, err := handler.(auth.AuthService).ListAuditLogs(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListAuditLogsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListAuditLogsArgs() interface{} {
+	return &ListAuditLogsArgs{}
+}
+
+func newListAuditLogsResult() interface{} {
+	return &ListAuditLogsResult{}
+}
+
+type ListAuditLogsArgs struct {
+	Req *auth.ListAuditLogsRequest
+}
+
+func (p *ListAuditLogsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ListAuditLogsRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListAuditLogsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListAuditLogsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListAuditLogsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListAuditLogsArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ListAuditLogsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListAuditLogsArgs_Req_DEFAULT *auth.ListAuditLogsRequest
+
+func (p *ListAuditLogsArgs) GetReq() *auth.ListAuditLogsRequest {
+	if !p.IsSetReq() {
+		return ListAuditLogsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListAuditLogsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListAuditLogsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListAuditLogsResult struct {
+	Success *auth.ListAuditLogsResponse
+}
+
+var ListAuditLogsResult_Success_DEFAULT *auth.ListAuditLogsResponse
+
+func (p *ListAuditLogsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ListAuditLogsResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListAuditLogsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListAuditLogsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListAuditLogsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListAuditLogsResult) Unmarshal(in []byte) error {
+	msg := new(auth.ListAuditLogsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListAuditLogsResult) GetSuccess() *auth.ListAuditLogsResponse {
+	if !p.IsSetSuccess() {
+		return ListAuditLogsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListAuditLogsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ListAuditLogsResponse)
+}
+
+func (p *ListAuditLogsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListAuditLogsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createAPIKeyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.CreateAPIKeyRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).CreateAPIKey(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateAPIKeyArgs:
+		success, err := handler.(auth.AuthService).CreateAPIKey(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateAPIKeyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateAPIKeyArgs() interface{} {
+	return &CreateAPIKeyArgs{}
+}
+
+func newCreateAPIKeyResult() interface{} {
+	return &CreateAPIKeyResult{}
+}
+
+type CreateAPIKeyArgs struct {
+	Req *auth.CreateAPIKeyRequest
+}
+
+func (p *CreateAPIKeyArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.CreateAPIKeyRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateAPIKeyArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateAPIKeyArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateAPIKeyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateAPIKeyArgs) Unmarshal(in []byte) error {
+	msg := new(auth.CreateAPIKeyRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateAPIKeyArgs_Req_DEFAULT *auth.CreateAPIKeyRequest
+
+func (p *CreateAPIKeyArgs) GetReq() *auth.CreateAPIKeyRequest {
+	if !p.IsSetReq() {
+		return CreateAPIKeyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateAPIKeyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateAPIKeyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateAPIKeyResult struct {
+	Success *auth.CreateAPIKeyResponse
+}
+
+var CreateAPIKeyResult_Success_DEFAULT *auth.CreateAPIKeyResponse
+
+func (p *CreateAPIKeyResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.CreateAPIKeyResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateAPIKeyResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateAPIKeyResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateAPIKeyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateAPIKeyResult) Unmarshal(in []byte) error {
+	msg := new(auth.CreateAPIKeyResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateAPIKeyResult) GetSuccess() *auth.CreateAPIKeyResponse {
+	if !p.IsSetSuccess() {
+		return CreateAPIKeyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateAPIKeyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.CreateAPIKeyResponse)
+}
+
+func (p *CreateAPIKeyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateAPIKeyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listAPIKeysHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ListAPIKeysRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ListAPIKeys(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListAPIKeysArgs:
+		success, err := handler.(auth.AuthService).ListAPIKeys(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListAPIKeysResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListAPIKeysArgs() interface{} {
+	return &ListAPIKeysArgs{}
+}
+
+func newListAPIKeysResult() interface{} {
+	return &ListAPIKeysResult{}
+}
+
+type ListAPIKeysArgs struct {
+	Req *auth.ListAPIKeysRequest
+}
+
+func (p *ListAPIKeysArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ListAPIKeysRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListAPIKeysArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListAPIKeysArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListAPIKeysArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListAPIKeysArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ListAPIKeysRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListAPIKeysArgs_Req_DEFAULT *auth.ListAPIKeysRequest
+
+func (p *ListAPIKeysArgs) GetReq() *auth.ListAPIKeysRequest {
+	if !p.IsSetReq() {
+		return ListAPIKeysArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListAPIKeysArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListAPIKeysArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListAPIKeysResult struct {
+	Success *auth.ListAPIKeysResponse
+}
+
+var ListAPIKeysResult_Success_DEFAULT *auth.ListAPIKeysResponse
+
+func (p *ListAPIKeysResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ListAPIKeysResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListAPIKeysResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListAPIKeysResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListAPIKeysResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListAPIKeysResult) Unmarshal(in []byte) error {
+	msg := new(auth.ListAPIKeysResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListAPIKeysResult) GetSuccess() *auth.ListAPIKeysResponse {
+	if !p.IsSetSuccess() {
+		return ListAPIKeysResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListAPIKeysResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ListAPIKeysResponse)
+}
+
+func (p *ListAPIKeysResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListAPIKeysResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeAPIKeyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.RevokeAPIKeyRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).RevokeAPIKey(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RevokeAPIKeyArgs:
+		success, err := handler.(auth.AuthService).RevokeAPIKey(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeAPIKeyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRevokeAPIKeyArgs() interface{} {
+	return &RevokeAPIKeyArgs{}
+}
+
+func newRevokeAPIKeyResult() interface{} {
+	return &RevokeAPIKeyResult{}
+}
+
+type RevokeAPIKeyArgs struct {
+	Req *auth.RevokeAPIKeyRequest
+}
+
+func (p *RevokeAPIKeyArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.RevokeAPIKeyRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RevokeAPIKeyArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RevokeAPIKeyArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RevokeAPIKeyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeAPIKeyArgs) Unmarshal(in []byte) error {
+	msg := new(auth.RevokeAPIKeyRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeAPIKeyArgs_Req_DEFAULT *auth.RevokeAPIKeyRequest
+
+func (p *RevokeAPIKeyArgs) GetReq() *auth.RevokeAPIKeyRequest {
+	if !p.IsSetReq() {
+		return RevokeAPIKeyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeAPIKeyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeAPIKeyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeAPIKeyResult struct {
+	Success *auth.RevokeAPIKeyResponse
+}
+
+var RevokeAPIKeyResult_Success_DEFAULT *auth.RevokeAPIKeyResponse
+
+func (p *RevokeAPIKeyResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.RevokeAPIKeyResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RevokeAPIKeyResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RevokeAPIKeyResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RevokeAPIKeyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeAPIKeyResult) Unmarshal(in []byte) error {
+	msg := new(auth.RevokeAPIKeyResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeAPIKeyResult) GetSuccess() *auth.RevokeAPIKeyResponse {
+	if !p.IsSetSuccess() {
+		return RevokeAPIKeyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeAPIKeyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.RevokeAPIKeyResponse)
+}
+
+func (p *RevokeAPIKeyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeAPIKeyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyAPISignatureHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.VerifyAPISignatureReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).VerifyAPISignature(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *VerifyAPISignatureArgs:
+		success, err := handler.(auth.AuthService).VerifyAPISignature(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyAPISignatureResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newVerifyAPISignatureArgs() interface{} {
+	return &VerifyAPISignatureArgs{}
+}
+
+func newVerifyAPISignatureResult() interface{} {
+	return &VerifyAPISignatureResult{}
+}
+
+type VerifyAPISignatureArgs struct {
+	Req *auth.VerifyAPISignatureReq
+}
+
+func (p *VerifyAPISignatureArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.VerifyAPISignatureReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VerifyAPISignatureArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VerifyAPISignatureArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VerifyAPISignatureArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyAPISignatureArgs) Unmarshal(in []byte) error {
+	msg := new(auth.VerifyAPISignatureReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyAPISignatureArgs_Req_DEFAULT *auth.VerifyAPISignatureReq
+
+func (p *VerifyAPISignatureArgs) GetReq() *auth.VerifyAPISignatureReq {
+	if !p.IsSetReq() {
+		return VerifyAPISignatureArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyAPISignatureArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyAPISignatureArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyAPISignatureResult struct {
+	Success *auth.VerifyAPISignatureResp
+}
+
+var VerifyAPISignatureResult_Success_DEFAULT *auth.VerifyAPISignatureResp
+
+func (p *VerifyAPISignatureResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.VerifyAPISignatureResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VerifyAPISignatureResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VerifyAPISignatureResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VerifyAPISignatureResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyAPISignatureResult) Unmarshal(in []byte) error {
+	msg := new(auth.VerifyAPISignatureResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyAPISignatureResult) GetSuccess() *auth.VerifyAPISignatureResp {
+	if !p.IsSetSuccess() {
+		return VerifyAPISignatureResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyAPISignatureResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.VerifyAPISignatureResp)
+}
+
+func (p *VerifyAPISignatureResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyAPISignatureResult) GetResult() interface{} {
+	return p.Success
+}
+
+func refreshTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.RefreshTokenRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).RefreshToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RefreshTokenArgs:
+		success, err := handler.(auth.AuthService).RefreshToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RefreshTokenResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRefreshTokenArgs() interface{} {
+	return &RefreshTokenArgs{}
+}
+
+func newRefreshTokenResult() interface{} {
+	return &RefreshTokenResult{}
+}
+
+type RefreshTokenArgs struct {
+	Req *auth.RefreshTokenRequest
+}
+
+func (p *RefreshTokenArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.RefreshTokenRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RefreshTokenArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RefreshTokenArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RefreshTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RefreshTokenArgs) Unmarshal(in []byte) error {
+	msg := new(auth.RefreshTokenRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RefreshTokenArgs_Req_DEFAULT *auth.RefreshTokenRequest
+
+func (p *RefreshTokenArgs) GetReq() *auth.RefreshTokenRequest {
+	if !p.IsSetReq() {
+		return RefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RefreshTokenArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RefreshTokenResult struct {
+	Success *auth.RefreshTokenResponse
+}
+
+var RefreshTokenResult_Success_DEFAULT *auth.RefreshTokenResponse
+
+func (p *RefreshTokenResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.RefreshTokenResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RefreshTokenResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RefreshTokenResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RefreshTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RefreshTokenResult) Unmarshal(in []byte) error {
+	msg := new(auth.RefreshTokenResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RefreshTokenResult) GetSuccess() *auth.RefreshTokenResponse {
+	if !p.IsSetSuccess() {
+		return RefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RefreshTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.RefreshTokenResponse)
+}
+
+func (p *RefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RefreshTokenResult) GetResult() interface{} {
+	return p.Success
+}
+
+func logoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.LogoutRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).Logout(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LogoutArgs:
+		success, err := handler.(auth.AuthService).Logout(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LogoutResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLogoutArgs() interface{} {
+	return &LogoutArgs{}
+}
+
+func newLogoutResult() interface{} {
+	return &LogoutResult{}
+}
+
+type LogoutArgs struct {
+	Req *auth.LogoutRequest
+}
+
+func (p *LogoutArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.LogoutRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LogoutArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LogoutArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LogoutArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LogoutArgs) Unmarshal(in []byte) error {
+	msg := new(auth.LogoutRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LogoutArgs_Req_DEFAULT *auth.LogoutRequest
+
+func (p *LogoutArgs) GetReq() *auth.LogoutRequest {
+	if !p.IsSetReq() {
+		return LogoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LogoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LogoutArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LogoutResult struct {
+	Success *auth.LogoutResponse
+}
+
+var LogoutResult_Success_DEFAULT *auth.LogoutResponse
+
+func (p *LogoutResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.LogoutResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LogoutResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LogoutResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LogoutResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LogoutResult) Unmarshal(in []byte) error {
+	msg := new(auth.LogoutResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LogoutResult) GetSuccess() *auth.LogoutResponse {
+	if !p.IsSetSuccess() {
+		return LogoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LogoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.LogoutResponse)
+}
+
+func (p *LogoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LogoutResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ListSessionsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ListSessions(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListSessionsArgs:
+		success, err := handler.(auth.AuthService).ListSessions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListSessionsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListSessionsArgs() interface{} {
+	return &ListSessionsArgs{}
+}
+
+func newListSessionsResult() interface{} {
+	return &ListSessionsResult{}
+}
+
+type ListSessionsArgs struct {
+	Req *auth.ListSessionsRequest
+}
+
+func (p *ListSessionsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ListSessionsRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListSessionsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListSessionsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListSessionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListSessionsArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ListSessionsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListSessionsArgs_Req_DEFAULT *auth.ListSessionsRequest
+
+func (p *ListSessionsArgs) GetReq() *auth.ListSessionsRequest {
+	if !p.IsSetReq() {
+		return ListSessionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListSessionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListSessionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListSessionsResult struct {
+	Success *auth.ListSessionsResponse
+}
+
+var ListSessionsResult_Success_DEFAULT *auth.ListSessionsResponse
+
+func (p *ListSessionsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ListSessionsResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListSessionsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListSessionsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListSessionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListSessionsResult) Unmarshal(in []byte) error {
+	msg := new(auth.ListSessionsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListSessionsResult) GetSuccess() *auth.ListSessionsResponse {
+	if !p.IsSetSuccess() {
+		return ListSessionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListSessionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ListSessionsResponse)
+}
+
+func (p *ListSessionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListSessionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.RevokeSessionRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).RevokeSession(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RevokeSessionArgs:
+		success, err := handler.(auth.AuthService).RevokeSession(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeSessionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRevokeSessionArgs() interface{} {
+	return &RevokeSessionArgs{}
+}
+
+func newRevokeSessionResult() interface{} {
+	return &RevokeSessionResult{}
+}
+
+type RevokeSessionArgs struct {
+	Req *auth.RevokeSessionRequest
+}
+
+func (p *RevokeSessionArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.RevokeSessionRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RevokeSessionArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RevokeSessionArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RevokeSessionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeSessionArgs) Unmarshal(in []byte) error {
+	msg := new(auth.RevokeSessionRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeSessionArgs_Req_DEFAULT *auth.RevokeSessionRequest
+
+func (p *RevokeSessionArgs) GetReq() *auth.RevokeSessionRequest {
+	if !p.IsSetReq() {
+		return RevokeSessionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeSessionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeSessionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeSessionResult struct {
+	Success *auth.RevokeSessionResponse
+}
+
+var RevokeSessionResult_Success_DEFAULT *auth.RevokeSessionResponse
+
+func (p *RevokeSessionResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.RevokeSessionResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RevokeSessionResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RevokeSessionResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RevokeSessionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeSessionResult) Unmarshal(in []byte) error {
+	msg := new(auth.RevokeSessionResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeSessionResult) GetSuccess() *auth.RevokeSessionResponse {
+	if !p.IsSetSuccess() {
+		return RevokeSessionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeSessionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.RevokeSessionResponse)
+}
+
+func (p *RevokeSessionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeSessionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func logoutAllHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.LogoutAllRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).LogoutAll(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LogoutAllArgs:
+		success, err := handler.(auth.AuthService).LogoutAll(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LogoutAllResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLogoutAllArgs() interface{} {
+	return &LogoutAllArgs{}
+}
+
+func newLogoutAllResult() interface{} {
+	return &LogoutAllResult{}
+}
+
+type LogoutAllArgs struct {
+	Req *auth.LogoutAllRequest
+}
+
+func (p *LogoutAllArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.LogoutAllRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LogoutAllArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LogoutAllArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LogoutAllArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LogoutAllArgs) Unmarshal(in []byte) error {
+	msg := new(auth.LogoutAllRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LogoutAllArgs_Req_DEFAULT *auth.LogoutAllRequest
+
+func (p *LogoutAllArgs) GetReq() *auth.LogoutAllRequest {
+	if !p.IsSetReq() {
+		return LogoutAllArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LogoutAllArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LogoutAllArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LogoutAllResult struct {
+	Success *auth.LogoutAllResponse
+}
+
+var LogoutAllResult_Success_DEFAULT *auth.LogoutAllResponse
+
+func (p *LogoutAllResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.LogoutAllResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LogoutAllResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LogoutAllResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LogoutAllResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LogoutAllResult) Unmarshal(in []byte) error {
+	msg := new(auth.LogoutAllResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LogoutAllResult) GetSuccess() *auth.LogoutAllResponse {
+	if !p.IsSetSuccess() {
+		return LogoutAllResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LogoutAllResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.LogoutAllResponse)
+}
+
+func (p *LogoutAllResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LogoutAllResult) GetResult() interface{} {
+	return p.Success
+}
+
+func validateTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auth.ValidateTokenRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auth.AuthService).ValidateToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ValidateTokenArgs:
+		success, err := handler.(auth.AuthService).ValidateToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ValidateTokenResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newValidateTokenArgs() interface{} {
+	return &ValidateTokenArgs{}
+}
+
+func newValidateTokenResult() interface{} {
+	return &ValidateTokenResult{}
+}
+
+type ValidateTokenArgs struct {
+	Req *auth.ValidateTokenRequest
+}
+
+func (p *ValidateTokenArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(auth.ValidateTokenRequest)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ValidateTokenArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ValidateTokenArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ValidateTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ValidateTokenArgs) Unmarshal(in []byte) error {
+	msg := new(auth.ValidateTokenRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ValidateTokenArgs_Req_DEFAULT *auth.ValidateTokenRequest
+
+func (p *ValidateTokenArgs) GetReq() *auth.ValidateTokenRequest {
+	if !p.IsSetReq() {
+		return ValidateTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ValidateTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ValidateTokenArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ValidateTokenResult struct {
+	Success *auth.ValidateTokenResponse
+}
+
+var ValidateTokenResult_Success_DEFAULT *auth.ValidateTokenResponse
+
+func (p *ValidateTokenResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(auth.ValidateTokenResponse)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ValidateTokenResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ValidateTokenResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ValidateTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ValidateTokenResult) Unmarshal(in []byte) error {
+	msg := new(auth.ValidateTokenResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ValidateTokenResult) GetSuccess() *auth.ValidateTokenResponse {
+	if !p.IsSetSuccess() {
+		return ValidateTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ValidateTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auth.ValidateTokenResponse)
+}
+
+func (p *ValidateTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ValidateTokenResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) DeliverTokenByRPC(ctx context.Context, Req *auth.DeliverTokenReq) (r *auth.DeliveryResp, err error) {
+	var _args DeliverTokenByRPCArgs
+	_args.Req = Req
+	var _result DeliverTokenByRPCResult
+	if err = p.c.Call(ctx, "DeliverTokenByRPC", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyTokenByRPC(ctx context.Context, Req *auth.VerifyTokenReq) (r *auth.VerifyResp, err error) {
+	var _args VerifyTokenByRPCArgs
+	_args.Req = Req
+	var _result VerifyTokenByRPCResult
+	if err = p.c.Call(ctx, "VerifyTokenByRPC", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Register(ctx context.Context, Req *auth.RegisterRequest) (r *auth.RegisterResponse, err error) {
+	var _args RegisterArgs
+	_args.Req = Req
+	var _result RegisterResult
+	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Login(ctx context.Context, Req *auth.LoginRequest) (r *auth.LoginResponse, err error) {
+	var _args LoginArgs
+	_args.Req = Req
+	var _result LoginResult
+	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) LoginTwoFactor(ctx context.Context, Req *auth.TwoFactorLoginRequest) (r *auth.LoginResponse, err error) {
+	var _args LoginTwoFactorArgs
+	_args.Req = Req
+	var _result LoginTwoFactorResult
+	if err = p.c.Call(ctx, "LoginTwoFactor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EnrollTwoFactor(ctx context.Context, Req *auth.EnrollTwoFactorRequest) (r *auth.EnrollTwoFactorResponse, err error) {
+	var _args EnrollTwoFactorArgs
+	_args.Req = Req
+	var _result EnrollTwoFactorResult
+	if err = p.c.Call(ctx, "EnrollTwoFactor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmTwoFactor(ctx context.Context, Req *auth.ConfirmTwoFactorRequest) (r *auth.ConfirmTwoFactorResponse, err error) {
+	var _args ConfirmTwoFactorArgs
+	_args.Req = Req
+	var _result ConfirmTwoFactorResult
+	if err = p.c.Call(ctx, "ConfirmTwoFactor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableTwoFactor(ctx context.Context, Req *auth.DisableTwoFactorRequest) (r *auth.DisableTwoFactorResponse, err error) {
+	var _args DisableTwoFactorArgs
+	_args.Req = Req
+	var _result DisableTwoFactorResult
+	if err = p.c.Call(ctx, "DisableTwoFactor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ForgotPassword(ctx context.Context, Req *auth.ForgotPasswordRequest) (r *auth.ForgotPasswordResponse, err error) {
+	var _args ForgotPasswordArgs
+	_args.Req = Req
+	var _result ForgotPasswordResult
+	if err = p.c.Call(ctx, "ForgotPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResetPassword(ctx context.Context, Req *auth.ResetPasswordRequest) (r *auth.ResetPasswordResponse, err error) {
+	var _args ResetPasswordArgs
+	_args.Req = Req
+	var _result ResetPasswordResult
+	if err = p.c.Call(ctx, "ResetPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ChangePassword(ctx context.Context, Req *auth.ChangePasswordRequest) (r *auth.ChangePasswordResponse, err error) {
+	var _args ChangePasswordArgs
+	_args.Req = Req
+	var _result ChangePasswordResult
+	if err = p.c.Call(ctx, "ChangePassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResendVerification(ctx context.Context, Req *auth.ResendVerificationRequest) (r *auth.ResendVerificationResponse, err error) {
+	var _args ResendVerificationArgs
+	_args.Req = Req
+	var _result ResendVerificationResult
+	if err = p.c.Call(ctx, "ResendVerification", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyContact(ctx context.Context, Req *auth.VerifyContactRequest) (r *auth.VerifyContactResponse, err error) {
+	var _args VerifyContactArgs
+	_args.Req = Req
+	var _result VerifyContactResult
+	if err = p.c.Call(ctx, "VerifyContact", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetVerificationStatus(ctx context.Context, Req *auth.VerificationStatusReq) (r *auth.VerificationStatusResp, err error) {
+	var _args GetVerificationStatusArgs
+	_args.Req = Req
+	var _result GetVerificationStatusResult
+	if err = p.c.Call(ctx, "GetVerificationStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AssignRole(ctx context.Context, Req *auth.AssignRoleRequest) (r *auth.AssignRoleResponse, err error) {
+	var _args AssignRoleArgs
+	_args.Req = Req
+	var _result AssignRoleResult
+	if err = p.c.Call(ctx, "AssignRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeRole(ctx context.Context, Req *auth.RevokeRoleRequest) (r *auth.RevokeRoleResponse, err error) {
+	var _args RevokeRoleArgs
+	_args.Req = Req
+	var _result RevokeRoleResult
+	if err = p.c.Call(ctx, "RevokeRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListUserRoles(ctx context.Context, Req *auth.ListUserRolesRequest) (r *auth.ListUserRolesResponse, err error) {
+	var _args ListUserRolesArgs
+	_args.Req = Req
+	var _result ListUserRolesResult
+	if err = p.c.Call(ctx, "ListUserRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateUserStatus(ctx context.Context, Req *auth.UpdateUserStatusRequest) (r *auth.UpdateUserStatusResponse, err error) {
+	var _args UpdateUserStatusArgs
+	_args.Req = Req
+	var _result UpdateUserStatusResult
+	if err = p.c.Call(ctx, "UpdateUserStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BanUser(ctx context.Context, Req *auth.BanUserRequest) (r *auth.BanUserResponse, err error) {
+	var _args BanUserArgs
+	_args.Req = Req
+	var _result BanUserResult
+	if err = p.c.Call(ctx, "BanUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnbanUser(ctx context.Context, Req *auth.UnbanUserRequest) (r *auth.UnbanUserResponse, err error) {
+	var _args UnbanUserArgs
+	_args.Req = Req
+	var _result UnbanUserResult
+	if err = p.c.Call(ctx, "UnbanUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListUserBans(ctx context.Context, Req *auth.ListUserBansRequest) (r *auth.ListUserBansResponse, err error) {
+	var _args ListUserBansArgs
+	_args.Req = Req
+	var _result ListUserBansResult
+	if err = p.c.Call(ctx, "ListUserBans", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnlockLogin(ctx context.Context, Req *auth.UnlockLoginRequest) (r *auth.UnlockLoginResponse, err error) {
+	var _args UnlockLoginArgs
+	_args.Req = Req
+	var _result UnlockLoginResult
+	if err = p.c.Call(ctx, "UnlockLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAuditLogs(ctx context.Context, Req *auth.ListAuditLogsRequest) (r *auth.ListAuditLogsResponse, err error) {
+	var _args ListAuditLogsArgs
+	_args.Req = Req
+	var _result ListAuditLogsResult
+	if err = p.c.Call(ctx, "ListAuditLogs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAPIKey(ctx context.Context, Req *auth.CreateAPIKeyRequest) (r *auth.CreateAPIKeyResponse, err error) {
+	var _args CreateAPIKeyArgs
+	_args.Req = Req
+	var _result CreateAPIKeyResult
+	if err = p.c.Call(ctx, "CreateAPIKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAPIKeys(ctx context.Context, Req *auth.ListAPIKeysRequest) (r *auth.ListAPIKeysResponse, err error) {
+	var _args ListAPIKeysArgs
+	_args.Req = Req
+	var _result ListAPIKeysResult
+	if err = p.c.Call(ctx, "ListAPIKeys", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeAPIKey(ctx context.Context, Req *auth.RevokeAPIKeyRequest) (r *auth.RevokeAPIKeyResponse, err error) {
+	var _args RevokeAPIKeyArgs
+	_args.Req = Req
+	var _result RevokeAPIKeyResult
+	if err = p.c.Call(ctx, "RevokeAPIKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyAPISignature(ctx context.Context, Req *auth.VerifyAPISignatureReq) (r *auth.VerifyAPISignatureResp, err error) {
+	var _args VerifyAPISignatureArgs
+	_args.Req = Req
+	var _result VerifyAPISignatureResult
+	if err = p.c.Call(ctx, "VerifyAPISignature", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RefreshToken(ctx context.Context, Req *auth.RefreshTokenRequest) (r *auth.RefreshTokenResponse, err error) {
+	var _args RefreshTokenArgs
+	_args.Req = Req
+	var _result RefreshTokenResult
+	if err = p.c.Call(ctx, "RefreshToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Logout(ctx context.Context, Req *auth.LogoutRequest) (r *auth.LogoutResponse, err error) {
+	var _args LogoutArgs
+	_args.Req = Req
+	var _result LogoutResult
+	if err = p.c.Call(ctx, "Logout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListSessions(ctx context.Context, Req *auth.ListSessionsRequest) (r *auth.ListSessionsResponse, err error) {
+	var _args ListSessionsArgs
+	_args.Req = Req
+	var _result ListSessionsResult
+	if err = p.c.Call(ctx, "ListSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeSession(ctx context.Context, Req *auth.RevokeSessionRequest) (r *auth.RevokeSessionResponse, err error) {
+	var _args RevokeSessionArgs
+	_args.Req = Req
+	var _result RevokeSessionResult
+	if err = p.c.Call(ctx, "RevokeSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) LogoutAll(ctx context.Context, Req *auth.LogoutAllRequest) (r *auth.LogoutAllResponse, err error) {
+	var _args LogoutAllArgs
+	_args.Req = Req
+	var _result LogoutAllResult
+	if err = p.c.Call(ctx, "LogoutAll", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ValidateToken(ctx context.Context, Req *auth.ValidateTokenRequest) (r *auth.ValidateTokenResponse, err error) {
+	var _args ValidateTokenArgs
+	_args.Req = Req
+	var _result ValidateTokenResult
+	if err = p.c.Call(ctx, "ValidateToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
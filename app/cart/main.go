@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -10,13 +12,25 @@ import (
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	kserver "github.com/cloudwego/kitex/server"
+	goredis "github.com/redis/go-redis/v9"
 
 	"TikTokMall/app/cart/biz/dal/mysql"
 	"TikTokMall/app/cart/biz/dal/redis"
+	"TikTokMall/app/cart/biz/service"
 	"TikTokMall/app/cart/conf"
 	"TikTokMall/app/cart/handler"
+	"TikTokMall/app/cart/kitex_gen/auth"
+	"TikTokMall/app/cart/kitex_gen/auth/authservice"
+	"TikTokMall/app/cart/kitex_gen/cart/cartservice"
+	"TikTokMall/app/cart/pkg/client"
 	"TikTokMall/app/cart/pkg/mtls"
 	"TikTokMall/app/cart/pkg/tracer"
+	"TikTokMall/pkg/authn"
+	"TikTokMall/pkg/userdata"
+	"TikTokMall/pkg/websession"
 )
 
 func main() {
@@ -67,6 +81,12 @@ func main() {
 		}
 	}
 
+	// 添加CORS中间件，只允许配置的前端来源携带 Cookie
+	h.Use(websession.CORS(websession.CORSConfig{AllowOrigins: config.CORS.AllowOrigins}))
+
+	// 浏览器 Cookie 会话：校验 CSRF 令牌并将 Cookie 中的访问令牌转为 Authorization 头
+	h.Use(websession.Middleware())
+
 	// 注册路由
 	h.GET("/ping", func(ctx context.Context, c *app.RequestContext) {
 		c.JSON(consts.StatusOK, map[string]interface{}{
@@ -74,9 +94,16 @@ func main() {
 		})
 	})
 
-	// 注册购物车API路由
+	// 注册购物车API路由，要求携带访问令牌，令牌通过 auth 服务校验
+	authClient, err := authservice.NewClient(config.AuthRPC.Service, client.NewClientOptions(config.AuthRPC)...)
+	if err != nil {
+		hlog.Fatalf("创建 auth 客户端失败: %v", err)
+	}
 	cartHandler := handler.NewCartHandler()
-	cartGroup := h.Group("/api/cart")
+	verifier := authn.NewRPCVerifier(func(ctx context.Context, token string, withRoles bool) (*auth.VerifyResp, error) {
+		return authClient.VerifyTokenByRPC(ctx, &auth.VerifyTokenReq{Token: token, WithRoles: withRoles})
+	})
+	cartGroup := h.Group("/api/cart", authn.Authenticate(verifier))
 	{
 		cartGroup.POST("/add", cartHandler.AddItem)
 		cartGroup.GET("/get", cartHandler.GetCart)
@@ -85,6 +112,29 @@ func main() {
 		cartGroup.POST("/remove", cartHandler.RemoveItem)
 	}
 
+	// 订阅用户服务的个人数据事件：导出购物车，注销时删除购物车
+	dataCtx, stopUserData := context.WithCancel(context.Background())
+	if rdb, ok := redis.RDB.(*goredis.Client); ok {
+		go func() {
+			if err := userdata.Subscribe(dataCtx, rdb, "cart", service.NewUserDataHandler()); err != nil {
+				hlog.Errorf("订阅个人数据事件失败: %v", err)
+			}
+		}()
+	} else {
+		hlog.Warn("Redis 未连接，不处理个人数据事件")
+	}
+
+	// 启动 RPC 服务，结算服务通过它读取用户的购物车
+	rpcServer, err := newRPCServer(config.Kitex, verifier)
+	if err != nil {
+		hlog.Fatalf("创建 RPC 服务失败: %v", err)
+	}
+	go func() {
+		if err := rpcServer.Run(); err != nil {
+			hlog.Fatalf("RPC 服务启动失败: %v", err)
+		}
+	}()
+
 	// 异步启动服务
 	go func() {
 		hlog.Infof("Cart HTTP服务启动于 %s", addrStr)
@@ -99,5 +149,21 @@ func main() {
 	<-quit
 
 	hlog.Info("正在关闭服务...")
+	stopUserData()
+	rpcServer.Stop()
 	h.Shutdown(context.Background())
 }
+
+// newRPCServer 创建购物车 RPC 服务，调用方通过 TTHeader 的 metainfo 传递用户的访问令牌
+func newRPCServer(c conf.KitexConfig, verifier authn.Verifier) (kserver.Server, error) {
+	addr, err := net.ResolveTCPAddr("tcp", c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("resolve rpc address failed: %w", err)
+	}
+	return cartservice.NewServer(handler.NewCartServiceImpl(),
+		kserver.WithServiceAddr(addr),
+		kserver.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: c.Service}),
+		kserver.WithMetaHandler(transmeta.ServerTTHeaderHandler),
+		kserver.WithMiddleware(authn.KitexMiddleware(verifier)),
+	), nil
+}
@@ -2,11 +2,13 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 
 	"TikTokMall/app/checkout/kitex_gen/checkout"
+	"TikTokMall/pkg/authn"
 )
 
 type CheckoutHTTPHandler struct {
@@ -28,6 +30,18 @@ func (h *CheckoutHTTPHandler) CreateOrder(c context.Context, ctx *app.RequestCon
 		})
 		return
 	}
+	userID, err := authn.UserID(c, int64(req.UserId))
+	if err != nil {
+		status := consts.StatusUnauthorized
+		if errors.Is(err, authn.ErrUserMismatch) {
+			status = consts.StatusForbidden
+		}
+		ctx.JSON(status, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	req.UserId = uint32(userID)
 
 	resp, err := h.svc.Checkout(c, &req)
 	if err != nil {
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"TikTokMall/app/checkout/kitex_gen/checkout"
+	"TikTokMall/pkg/authn"
 )
 
 func TestCheckoutHTTPHandler_Checkout(t *testing.T) {
@@ -100,3 +102,40 @@ func TestCheckoutHTTPHandler_InvalidRequest(t *testing.T) {
 	assert.False(t, gotResp["success"].(bool))
 	assert.NotEmpty(t, gotResp["error"])
 }
+
+func TestCheckoutHTTPHandler_CreateOrderUser(t *testing.T) {
+	handler := NewCheckoutHTTPHandler()
+
+	tests := []struct {
+		name       string
+		identity   *authn.Identity
+		userID     uint32
+		wantStatus int
+	}{
+		{name: "unauthenticated", userID: 12345, wantStatus: consts.StatusUnauthorized},
+		{name: "user_mismatch", identity: &authn.Identity{UserID: 1}, userID: 12345, wantStatus: consts.StatusForbidden},
+		{name: "same_user", identity: &authn.Identity{UserID: 12345}, userID: 12345, wantStatus: consts.StatusOK},
+		{name: "user_from_token", identity: &authn.Identity{UserID: 12345}, wantStatus: consts.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.identity != nil {
+				ctx = authn.WithIdentity(ctx, tt.identity)
+			}
+			reqBody, err := json.Marshal(&checkout.CheckoutReq{UserId: tt.userID, Email: "test@example.com"})
+			require.NoError(t, err)
+
+			hertzCtx := app.NewContext(16)
+			hertzCtx.Request.Header.SetMethod("POST")
+			hertzCtx.Request.Header.SetContentTypeBytes([]byte("application/json"))
+			hertzCtx.Request.SetBody(reqBody)
+			hertzCtx.Request.Header.SetContentLength(len(reqBody))
+
+			handler.CreateOrder(ctx, hertzCtx)
+
+			assert.Equal(t, tt.wantStatus, hertzCtx.Response.StatusCode())
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/checkout/kitex_gen/checkout"
+	"TikTokMall/app/checkout/kitex_gen/payment"
+)
+
+// fakeUserClient 模拟用户服务的地址簿和积分
+type fakeUserClient struct {
+	addrs    map[int64]*checkout.Address // 只属于用户 1
+	points   int64                       // 用户 1 的积分余额
+	redeemed map[string]int64            // 按结算订单号记录抵扣的积分
+}
+
+func (f *fakeUserClient) GetAddress(ctx context.Context, userID uint32, addressID int64) (*checkout.Address, error) {
+	addr, ok := f.addrs[addressID]
+	if !ok || userID != 1 {
+		return nil, ErrAddressNotFound
+	}
+	return addr, nil
+}
+
+func (f *fakeUserClient) RedeemPoints(ctx context.Context, userID uint32, orderNo string, points int64, maxDiscount float64) (int64, float64, error) {
+	if userID != 1 || points > f.points {
+		return 0, 0, ErrPointsUnavailable
+	}
+	if limit := int64(maxDiscount * 100); points > limit {
+		points = limit
+	}
+	f.points -= points
+	if f.redeemed == nil {
+		f.redeemed = map[string]int64{}
+	}
+	f.redeemed[orderNo] = points
+	return points, float64(points) / 100, nil
+}
+
+func (f *fakeUserClient) CancelRedemption(ctx context.Context, userID uint32, orderNo string) error {
+	f.points += f.redeemed[orderNo]
+	delete(f.redeemed, orderNo)
+	return nil
+}
+
+func TestCheckout_ResolveAddress(t *testing.T) {
+	saved := &checkout.Address{StreetAddress: "科技园路 1 号", City: "深圳", Country: "中国"}
+	s := &checkoutServiceImpl{userClient: &fakeUserClient{addrs: map[int64]*checkout.Address{7: saved}}}
+	ctx := context.Background()
+
+	// 使用地址簿中的地址
+	addr, err := s.resolveAddress(ctx, &checkout.CheckoutReq{UserId: 1, AddressId: 7})
+	require.NoError(t, err)
+	assert.Equal(t, saved, addr)
+
+	// 其他用户的地址不可用
+	_, err = s.resolveAddress(ctx, &checkout.CheckoutReq{UserId: 2, AddressId: 7})
+	assert.ErrorIs(t, err, ErrAddressNotFound)
+
+	// 直接填写的地址原样使用
+	inline := &checkout.Address{StreetAddress: "南山大道 100 号"}
+	addr, err = s.resolveAddress(ctx, &checkout.CheckoutReq{UserId: 1, Address: inline})
+	require.NoError(t, err)
+	assert.Equal(t, inline, addr)
+}
+
+func TestCheckout_ValidateAddressChoice(t *testing.T) {
+	s := &checkoutServiceImpl{}
+	newReq := func() *checkout.CheckoutReq {
+		return &checkout.CheckoutReq{
+			UserId:     1,
+			Firstname:  "San",
+			Lastname:   "Zhang",
+			Email:      "test@example.com",
+			CreditCard: &payment.CreditCardInfo{},
+		}
+	}
+
+	// 地址和地址 ID 都未指定
+	assert.ErrorIs(t, s.validateRequest(newReq()), ErrAddressInvalid)
+
+	// 同时指定
+	req := newReq()
+	req.Address = &checkout.Address{City: "深圳"}
+	req.AddressId = 7
+	assert.ErrorIs(t, s.validateRequest(req), ErrAddressInvalid)
+
+	req = newReq()
+	req.AddressId = 7
+	assert.NoError(t, s.validateRequest(req))
+
+	req = newReq()
+	req.Address = &checkout.Address{City: "深圳"}
+	assert.NoError(t, s.validateRequest(req))
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"TikTokMall/app/checkout/conf"
+	"TikTokMall/app/checkout/kitex_gen/auth"
+	"TikTokMall/app/checkout/kitex_gen/auth/authservice"
+	"TikTokMall/app/checkout/pkg/client"
+)
+
+// AuthClientAdapter 适配真实的认证客户端
+type AuthClientAdapter struct {
+	client authservice.Client
+}
+
+// NewAuthClientAdapter 创建适配器
+func NewAuthClientAdapter() (AuthClient, error) {
+	cli, err := authservice.NewClient(conf.GetConfig().AuthRPC.Service, client.NewClientOptions(conf.GetConfig().AuthRPC)...)
+	if err != nil {
+		return nil, fmt.Errorf("创建认证客户端失败: %w", err)
+	}
+	return &AuthClientAdapter{client: cli}, nil
+}
+
+// CheckoutAllowed 查询用户的验证状态
+func (a *AuthClientAdapter) CheckoutAllowed(ctx context.Context, userID uint32) (bool, error) {
+	resp, err := a.client.GetVerificationStatus(ctx, &auth.VerificationStatusReq{UserId: int64(userID)})
+	if err != nil {
+		return false, err
+	}
+	return resp.CheckoutAllowed, nil
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+)
+
+// AuthClient 认证客户端接口
+type AuthClient interface {
+	// CheckoutAllowed 按认证服务的验证策略判断用户是否允许下单
+	CheckoutAllowed(ctx context.Context, userID uint32) (bool, error)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
+
+	"TikTokMall/app/checkout/conf"
+	"TikTokMall/app/checkout/kitex_gen/cart"
+	"TikTokMall/app/checkout/kitex_gen/cart/cartservice"
+	kclient "TikTokMall/app/checkout/pkg/client"
+)
+
+// CartClientAdapter 适配真实的购物车服务客户端
+type CartClientAdapter struct {
+	client cartservice.Client
+}
+
+// NewCartClientAdapter 创建适配器
+func NewCartClientAdapter() (CartClient, error) {
+	svc := conf.GetConfig().CartRPC
+	// 使用 TTHeader 传输 metainfo，购物车服务按用户的访问令牌校验调用方
+	opts := append(kclient.NewClientOptions(svc),
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+	)
+	cli, err := cartservice.NewClient(svc.Service, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("创建购物车服务客户端失败: %w", err)
+	}
+	return &CartClientAdapter{client: cli}, nil
+}
+
+// GetCart 通过购物车服务查询购物车中的商品
+func (a *CartClientAdapter) GetCart(ctx context.Context, userID uint32) ([]*cart.CartItem, error) {
+	resp, err := a.client.GetCart(ctx, &cart.GetCartReq{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetCart().GetItems(), nil
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"TikTokMall/app/checkout/kitex_gen/cart"
+)
+
+// CartClient 购物车服务客户端接口
+type CartClient interface {
+	// GetCart 查询用户购物车中的商品，需要 context 中携带用户的访问令牌
+	GetCart(ctx context.Context, userID uint32) ([]*cart.CartItem, error)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
 
 	"TikTokMall/app/checkout/kitex_gen/payment/paymentservice"
 )
@@ -16,11 +19,15 @@ type PaymentClientAdapter struct {
 
 // NewPaymentClientAdapter 创建适配器
 func NewPaymentClientAdapter() (PaymentClient, error) {
-	client, err := paymentservice.NewClient("payment")
+	// 使用 TTHeader 传输 metainfo，将用户的访问令牌传递给支付服务
+	cli, err := paymentservice.NewClient("payment",
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("创建支付客户端失败: %w", err)
 	}
-	return &PaymentClientAdapter{client: client}, nil
+	return &PaymentClientAdapter{client: cli}, nil
 }
 
 // ProcessPayment 处理支付
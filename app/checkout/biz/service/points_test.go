@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"TikTokMall/app/checkout/biz/dal/mysql"
+	"TikTokMall/app/checkout/kitex_gen/cart"
+	"TikTokMall/app/checkout/kitex_gen/checkout"
+	"TikTokMall/app/checkout/kitex_gen/payment"
+)
+
+// fakeCartClient 按用户返回购物车中的商品
+type fakeCartClient map[uint32][]*cart.CartItem
+
+func (f fakeCartClient) GetCart(ctx context.Context, userID uint32) ([]*cart.CartItem, error) {
+	return f[userID], nil
+}
+
+// fakeProductClient 按商品 ID 返回当前价格
+type fakeProductClient map[uint32]float64
+
+func (f fakeProductClient) GetPrice(ctx context.Context, productID uint32) (float64, error) {
+	price, ok := f[productID]
+	if !ok {
+		return 0, ErrProductNotFound
+	}
+	return price, nil
+}
+
+// recordingPaymentClient 记录支付金额
+type recordingPaymentClient struct {
+	amount float64
+}
+
+func (c *recordingPaymentClient) ProcessPayment(ctx context.Context, orderID string, amount float64, userID uint32) (string, bool, string, error) {
+	c.amount = amount
+	return "tx-1", true, "", nil
+}
+
+// useDryRunDB 使用只生成 SQL 不执行的数据库，保存订单时不需要 MySQL
+func useDryRunDB(t *testing.T) {
+	db, err := gorm.Open(gormmysql.New(gormmysql.Config{
+		DSN:                       "test:test@tcp(127.0.0.1:3306)/test",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	require.NoError(t, err)
+	old := mysql.DB
+	mysql.DB = db
+	t.Cleanup(func() { mysql.DB = old })
+}
+
+func TestCheckout_RedeemPoints(t *testing.T) {
+	users := &fakeUserClient{points: 500}
+	s := &checkoutServiceImpl{userClient: users}
+	ctx := context.Background()
+
+	// 不抵扣时不调用用户服务
+	order := &mysql.Order{OrderNo: "ORD-1", UserID: 1, TotalAmount: 10}
+	points, discount, err := s.redeemPoints(ctx, &checkout.CheckoutReq{UserId: 1}, order)
+	require.NoError(t, err)
+	assert.Zero(t, points)
+	assert.Zero(t, discount)
+	assert.Equal(t, 10.0, order.TotalAmount)
+
+	// 抵扣金额从订单金额中扣除
+	points, discount, err = s.redeemPoints(ctx, &checkout.CheckoutReq{UserId: 1, RedeemPoints: 300}, order)
+	require.NoError(t, err)
+	assert.Equal(t, int64(300), points)
+	assert.Equal(t, 3.0, discount)
+	assert.Equal(t, 7.0, order.TotalAmount)
+	assert.Equal(t, int64(200), users.points)
+
+	// 支付失败后退回
+	s.cancelRedemption(ctx, order, points)
+	assert.Equal(t, int64(500), users.points)
+
+	// 抵扣金额不超过订单金额
+	order = &mysql.Order{OrderNo: "ORD-2", UserID: 1, TotalAmount: 1.5}
+	points, _, err = s.redeemPoints(ctx, &checkout.CheckoutReq{UserId: 1, RedeemPoints: 500}, order)
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), points)
+	assert.Equal(t, 0.0, order.TotalAmount)
+
+	// 积分不足
+	order = &mysql.Order{OrderNo: "ORD-3", UserID: 1, TotalAmount: 100}
+	_, _, err = s.redeemPoints(ctx, &checkout.CheckoutReq{UserId: 1, RedeemPoints: 1000}, order)
+	assert.ErrorIs(t, err, ErrPointsUnavailable)
+	assert.Equal(t, 100.0, order.TotalAmount)
+}
+
+// 积分按购物车和商品价格计算出的订单金额抵扣
+func TestCheckout_RunRedeemsAgainstCartTotal(t *testing.T) {
+	useDryRunDB(t)
+	users := &fakeUserClient{points: 5000}
+	payments := &recordingPaymentClient{}
+	s := &checkoutServiceImpl{
+		paymentClient: payments,
+		userClient:    users,
+		cartClient: fakeCartClient{1: {
+			{ProductId: 1, Quantity: 2},
+			{ProductId: 2, Quantity: 1},
+		}},
+		productClient: fakeProductClient{1: 10.5, 2: 4.25},
+	}
+	ctx := context.Background()
+	req := &checkout.CheckoutReq{
+		UserId:       1,
+		Firstname:    "John",
+		Lastname:     "Doe",
+		Email:        "john@example.com",
+		Address:      &checkout.Address{StreetAddress: "123 Main St", City: "City"},
+		CreditCard:   &payment.CreditCardInfo{},
+		RedeemPoints: 1000,
+	}
+
+	// 订单金额 25.25，抵扣 10 元后支付 15.25
+	resp, err := s.Run(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), resp.PointsRedeemed)
+	assert.Equal(t, 10.0, resp.Discount)
+	assert.Equal(t, 15.25, payments.amount)
+
+	// 抵扣金额不超过订单金额
+	req.RedeemPoints = 4000
+	resp, err = s.Run(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2525), resp.PointsRedeemed)
+	assert.Equal(t, 0.0, payments.amount)
+
+	// 购物车为空时不能结算
+	req.UserId = 2
+	_, err = s.Run(ctx, req)
+	assert.ErrorIs(t, err, ErrCartEmpty)
+
+	// 商品不存在
+	s.productClient = fakeProductClient{1: 10.5}
+	req.UserId = 1
+	_, err = s.Run(ctx, req)
+	assert.ErrorIs(t, err, ErrProductNotFound)
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"TikTokMall/app/checkout/conf"
+	"TikTokMall/app/checkout/kitex_gen/product"
+	"TikTokMall/app/checkout/kitex_gen/product/productcatalogservice"
+	"TikTokMall/app/checkout/pkg/client"
+)
+
+// ProductClientAdapter 适配真实的商品服务客户端
+type ProductClientAdapter struct {
+	client productcatalogservice.Client
+}
+
+// NewProductClientAdapter 创建适配器
+func NewProductClientAdapter() (ProductClient, error) {
+	svc := conf.GetConfig().ProductRPC
+	cli, err := productcatalogservice.NewClient(svc.Service, client.NewClientOptions(svc)...)
+	if err != nil {
+		return nil, fmt.Errorf("创建商品服务客户端失败: %w", err)
+	}
+	return &ProductClientAdapter{client: cli}, nil
+}
+
+// GetPrice 通过商品服务查询商品当前价格
+func (a *ProductClientAdapter) GetPrice(ctx context.Context, productID uint32) (float64, error) {
+	resp, err := a.client.GetProduct(ctx, &product.GetProductReq{Id: productID})
+	if err != nil {
+		// 商品服务以错误信息返回商品不存在，没有单独的错误码
+		if strings.Contains(err.Error(), "does not exist") {
+			return 0, fmt.Errorf("%w: %d", ErrProductNotFound, productID)
+		}
+		return 0, err
+	}
+	if resp.Product == nil {
+		return 0, fmt.Errorf("%w: %d", ErrProductNotFound, productID)
+	}
+	return float64(resp.Product.Price), nil
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+)
+
+// ProductClient 商品服务客户端接口
+type ProductClient interface {
+	// GetPrice 查询商品当前价格，商品不存在时返回 ErrProductNotFound
+	GetPrice(ctx context.Context, productID uint32) (float64, error)
+}
@@ -6,9 +6,14 @@ import (
 
 // 错误定义
 var (
-	ErrInvalidInput      = fmt.Errorf("无效的输入参数")
-	ErrPaymentFailed     = fmt.Errorf("支付处理失败")
-	ErrAddressInvalid    = fmt.Errorf("地址信息无效")
-	ErrCreditCardInvalid = fmt.Errorf("信用卡信息无效")
-	ErrOrderCreateFailed = fmt.Errorf("订单创建失败")
+	ErrInvalidInput       = fmt.Errorf("无效的输入参数")
+	ErrPaymentFailed      = fmt.Errorf("支付处理失败")
+	ErrAddressInvalid     = fmt.Errorf("地址信息无效")
+	ErrAddressNotFound    = fmt.Errorf("收货地址不存在")
+	ErrCreditCardInvalid  = fmt.Errorf("信用卡信息无效")
+	ErrOrderCreateFailed  = fmt.Errorf("订单创建失败")
+	ErrAccountNotVerified = fmt.Errorf("账号未完成邮箱和手机验证")
+	ErrPointsUnavailable  = fmt.Errorf("积分不足或无法抵扣")
+	ErrCartEmpty          = fmt.Errorf("购物车为空")
+	ErrProductNotFound    = fmt.Errorf("商品不存在")
 )
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"math"
 	"os"
 	"time"
 
@@ -19,6 +21,10 @@ import (
 
 type checkoutServiceImpl struct {
 	paymentClient PaymentClient
+	authClient    AuthClient
+	userClient    UserClient
+	cartClient    CartClient
+	productClient ProductClient
 }
 
 func NewCheckoutService() CheckoutService {
@@ -28,8 +34,34 @@ func NewCheckoutService() CheckoutService {
 		klog.Fatalf("创建支付客户端失败: %v", err)
 	}
 
+	// 创建认证客户端适配器
+	authClient, err := NewAuthClientAdapter()
+	if err != nil {
+		klog.Fatalf("创建认证客户端失败: %v", err)
+	}
+
+	// 创建用户服务客户端适配器，用于解析地址簿中的地址
+	userClient, err := NewUserClientAdapter()
+	if err != nil {
+		klog.Fatalf("创建用户服务客户端失败: %v", err)
+	}
+
+	// 创建购物车和商品服务客户端适配器，用于计算订单金额
+	cartClient, err := NewCartClientAdapter()
+	if err != nil {
+		klog.Fatalf("创建购物车服务客户端失败: %v", err)
+	}
+	productClient, err := NewProductClientAdapter()
+	if err != nil {
+		klog.Fatalf("创建商品服务客户端失败: %v", err)
+	}
+
 	return &checkoutServiceImpl{
 		paymentClient: paymentClient,
+		authClient:    authClient,
+		userClient:    userClient,
+		cartClient:    cartClient,
+		productClient: productClient,
 	}
 }
 
@@ -58,15 +90,42 @@ func (s *checkoutServiceImpl) Run(ctx context.Context, req *checkout.CheckoutReq
 		return nil, fmt.Errorf("参数验证失败: %w", err)
 	}
 
+	// 按验证策略检查账号是否允许下单
+	if err := s.checkAccountVerified(ctx, req.UserId); err != nil {
+		metrics.CheckoutTotal.WithLabelValues("failed").Inc()
+		span.SetTag("error", true)
+		span.LogKV("error.message", err.Error())
+		return nil, err
+	}
+
+	// 使用地址簿中的地址时通过用户服务解析
+	address, err := s.resolveAddress(ctx, req)
+	if err != nil {
+		metrics.CheckoutTotal.WithLabelValues("failed").Inc()
+		span.SetTag("error", true)
+		span.LogKV("error.message", err.Error())
+		return nil, err
+	}
+
+	// 按购物车和商品当前价格计算订单金额
+	total, err := s.orderTotal(ctx, req.UserId)
+	if err != nil {
+		metrics.CheckoutTotal.WithLabelValues("failed").Inc()
+		span.SetTag("error", true)
+		span.LogKV("error.message", err.Error())
+		return nil, err
+	}
+
 	// 2. 创建订单
 	order := &mysql.Order{
-		OrderNo:   generateOrderNo(req.UserId),
-		UserID:    int64(req.UserId),
-		Status:    mysql.OrderStatusPending,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		OrderNo:     generateOrderNo(req.UserId),
+		UserID:      int64(req.UserId),
+		TotalAmount: total,
+		Status:      mysql.OrderStatusPending,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 		ShippingAddress: sql.NullString{
-			String: formatAddress(req.Address),
+			String: formatAddress(address),
 			Valid:  true,
 		},
 		UserInfo: sql.NullString{
@@ -75,10 +134,20 @@ func (s *checkoutServiceImpl) Run(ctx context.Context, req *checkout.CheckoutReq
 		},
 	}
 
+	// 抵扣积分，支付失败时退回
+	pointsRedeemed, discount, err := s.redeemPoints(ctx, req, order)
+	if err != nil {
+		metrics.CheckoutTotal.WithLabelValues("failed").Inc()
+		span.SetTag("error", true)
+		span.LogKV("error.message", err.Error())
+		return nil, err
+	}
+
 	// 3. 处理支付
 	transactionID, err := s.processPayment(ctx, order, req.CreditCard)
 	if err != nil {
 		klog.Errorf("支付处理失败: %v", err)
+		s.cancelRedemption(ctx, order, pointsRedeemed)
 		return nil, fmt.Errorf("支付处理失败: %w", err)
 	}
 
@@ -97,8 +166,10 @@ func (s *checkoutServiceImpl) Run(ctx context.Context, req *checkout.CheckoutReq
 
 	// 5. 返回结果
 	resp := &checkout.CheckoutResp{
-		OrderId:       order.OrderNo,
-		TransactionId: transactionID,
+		OrderId:        order.OrderNo,
+		TransactionId:  transactionID,
+		PointsRedeemed: pointsRedeemed,
+		Discount:       discount,
 	}
 
 	// 成功计数
@@ -112,18 +183,114 @@ func (s *checkoutServiceImpl) validateRequest(req *checkout.CheckoutReq) error {
 	if req.UserId == 0 {
 		return ErrInvalidInput
 	}
-	if req.Address == nil {
+	// address 和 address_id 必须且只能指定一个
+	if (req.Address == nil) == (req.AddressId == 0) {
 		return ErrAddressInvalid
 	}
 	if req.CreditCard == nil {
 		return ErrCreditCardInvalid
 	}
+	if req.RedeemPoints < 0 {
+		return ErrInvalidInput
+	}
 	if req.Firstname == "" || req.Lastname == "" || req.Email == "" {
 		return ErrInvalidInput
 	}
 	return nil
 }
 
+func (s *checkoutServiceImpl) checkAccountVerified(ctx context.Context, userID uint32) error {
+	// 在测试模式下跳过
+	if os.Getenv("TESTING") == "1" || s.authClient == nil {
+		return nil
+	}
+	allowed, err := s.authClient.CheckoutAllowed(ctx, userID)
+	if err != nil {
+		klog.Errorf("查询账号验证状态失败: %v", err)
+		return fmt.Errorf("查询账号验证状态失败: %w", err)
+	}
+	if !allowed {
+		return ErrAccountNotVerified
+	}
+	return nil
+}
+
+func (s *checkoutServiceImpl) resolveAddress(ctx context.Context, req *checkout.CheckoutReq) (*checkout.Address, error) {
+	if req.AddressId == 0 {
+		return req.Address, nil
+	}
+	if s.userClient == nil {
+		return nil, fmt.Errorf("查询收货地址失败: 用户服务不可用")
+	}
+	addr, err := s.userClient.GetAddress(ctx, req.UserId, req.AddressId)
+	if err != nil {
+		if errors.Is(err, ErrAddressNotFound) {
+			return nil, err
+		}
+		klog.Errorf("查询收货地址失败: %v", err)
+		return nil, fmt.Errorf("查询收货地址失败: %w", err)
+	}
+	return addr, nil
+}
+
+// orderTotal 按购物车中的商品数量和商品服务的当前价格计算订单金额
+func (s *checkoutServiceImpl) orderTotal(ctx context.Context, userID uint32) (float64, error) {
+	if s.cartClient == nil || s.productClient == nil {
+		return 0, fmt.Errorf("计算订单金额失败: 购物车或商品服务不可用")
+	}
+	items, err := s.cartClient.GetCart(ctx, userID)
+	if err != nil {
+		klog.Errorf("查询购物车失败: %v", err)
+		return 0, fmt.Errorf("查询购物车失败: %w", err)
+	}
+	if len(items) == 0 {
+		return 0, ErrCartEmpty
+	}
+	var total float64
+	for _, item := range items {
+		price, err := s.productClient.GetPrice(ctx, item.ProductId)
+		if err != nil {
+			if errors.Is(err, ErrProductNotFound) {
+				return 0, err
+			}
+			klog.Errorf("查询商品价格失败: %v", err)
+			return 0, fmt.Errorf("查询商品价格失败: %w", err)
+		}
+		total += price * float64(item.Quantity)
+	}
+	return math.Round(total*100) / 100, nil
+}
+
+// redeemPoints 按请求抵扣积分并从订单金额中扣除，抵扣金额不超过订单金额
+func (s *checkoutServiceImpl) redeemPoints(ctx context.Context, req *checkout.CheckoutReq, order *mysql.Order) (int64, float64, error) {
+	if req.RedeemPoints == 0 {
+		return 0, 0, nil
+	}
+	if s.userClient == nil {
+		return 0, 0, fmt.Errorf("抵扣积分失败: 用户服务不可用")
+	}
+	points, discount, err := s.userClient.RedeemPoints(ctx, req.UserId, order.OrderNo, req.RedeemPoints, order.TotalAmount)
+	if err != nil {
+		if errors.Is(err, ErrPointsUnavailable) {
+			return 0, 0, err
+		}
+		klog.Errorf("抵扣积分失败: %v", err)
+		return 0, 0, fmt.Errorf("抵扣积分失败: %w", err)
+	}
+	order.TotalAmount = math.Round((order.TotalAmount-discount)*100) / 100
+	return points, discount, nil
+}
+
+// cancelRedemption 结算失败时退回抵扣的积分，失败只记录日志，用户服务按订单号去重可以人工重试
+func (s *checkoutServiceImpl) cancelRedemption(ctx context.Context, order *mysql.Order, points int64) {
+	if points == 0 {
+		return
+	}
+	if err := s.userClient.CancelRedemption(ctx, uint32(order.UserID), order.OrderNo); err != nil {
+		klog.Errorf("退回抵扣积分失败: order_no=%s, points=%d, err=%v", order.OrderNo, points, err)
+	}
+}
+
 func (s *checkoutServiceImpl) processPayment(ctx context.Context, order *mysql.Order, creditCard *payment.CreditCardInfo) (string, error) {
 	// 在测试模式下直接返回模拟数据
 	if os.Getenv("TESTING") == "1" {
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
+
+	"TikTokMall/app/checkout/conf"
+	"TikTokMall/app/checkout/kitex_gen/checkout"
+	"TikTokMall/app/checkout/kitex_gen/user"
+	"TikTokMall/app/checkout/kitex_gen/user/userservice"
+	kclient "TikTokMall/app/checkout/pkg/client"
+	"TikTokMall/pkg/authn"
+)
+
+// UserClientAdapter 适配真实的用户服务客户端
+type UserClientAdapter struct {
+	client userservice.Client
+}
+
+// NewUserClientAdapter 创建适配器
+func NewUserClientAdapter() (UserClient, error) {
+	secret, err := authn.ServiceSecret(conf.GetConfig().ServiceAuth.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("创建用户服务客户端失败: %w", err)
+	}
+	svc := conf.GetConfig().UserRPC
+	// 用户服务的地址和积分接口要求结算服务的服务凭证，通过 TTHeader 的 metainfo 传递
+	opts := append(kclient.NewClientOptions(svc),
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+		client.WithMiddleware(authn.ServiceClientMiddleware("checkout", secret)),
+	)
+	cli, err := userservice.NewClient(svc.Service, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("创建用户服务客户端失败: %w", err)
+	}
+	return &UserClientAdapter{client: cli}, nil
+}
+
+// GetAddress 通过用户服务查询收货地址
+func (a *UserClientAdapter) GetAddress(ctx context.Context, userID uint32, addressID int64) (*checkout.Address, error) {
+	resp, err := a.client.GetAddress(ctx, &user.GetAddressReq{UserId: int64(userID), AddressId: addressID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Base != nil && resp.Base.Code == 404 {
+		return nil, ErrAddressNotFound
+	}
+	if resp.Base != nil && resp.Base.Code != 200 {
+		return nil, fmt.Errorf("查询收货地址失败: %s", resp.Base.Message)
+	}
+	if resp.Address == nil {
+		return nil, ErrAddressNotFound
+	}
+	return &checkout.Address{
+		StreetAddress: resp.Address.StreetAddress,
+		City:          resp.Address.City,
+		State:         resp.Address.State,
+		Country:       resp.Address.Country,
+		ZipCode:       resp.Address.ZipCode,
+	}, nil
+}
+
+// RedeemPoints 通过用户服务抵扣积分
+func (a *UserClientAdapter) RedeemPoints(ctx context.Context, userID uint32, orderNo string, points int64, maxDiscount float64) (int64, float64, error) {
+	resp, err := a.client.RedeemPoints(ctx, &user.RedeemPointsReq{
+		UserId:      int64(userID),
+		OrderId:     orderNo,
+		Points:      points,
+		MaxDiscount: maxDiscount,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if resp.Base != nil && resp.Base.Code == 409 {
+		return 0, 0, fmt.Errorf("%w: %s", ErrPointsUnavailable, resp.Base.Message)
+	}
+	if resp.Base != nil && resp.Base.Code != 200 {
+		return 0, 0, fmt.Errorf("抵扣积分失败: %s", resp.Base.Message)
+	}
+	return resp.Points, resp.Discount, nil
+}
+
+// CancelRedemption 通过用户服务退回抵扣的积分
+func (a *UserClientAdapter) CancelRedemption(ctx context.Context, userID uint32, orderNo string) error {
+	resp, err := a.client.CancelRedemption(ctx, &user.CancelRedemptionReq{UserId: int64(userID), OrderId: orderNo})
+	if err != nil {
+		return err
+	}
+	if resp.Base != nil && resp.Base.Code != 200 {
+		return fmt.Errorf("退回积分失败: %s", resp.Base.Message)
+	}
+	return nil
+}
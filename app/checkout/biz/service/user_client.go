@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"TikTokMall/app/checkout/kitex_gen/checkout"
+)
+
+// UserClient 用户服务客户端接口
+type UserClient interface {
+	// GetAddress 查询用户地址簿中的地址，地址不存在或不属于该用户时返回 ErrAddressNotFound
+	GetAddress(ctx context.Context, userID uint32, addressID int64) (*checkout.Address, error)
+	// RedeemPoints 以结算订单号抵扣积分，抵扣金额不超过 maxDiscount，返回实际抵扣的积分和金额
+	// 积分不足或该订单的抵扣已取消时返回 ErrPointsUnavailable
+	RedeemPoints(ctx context.Context, userID uint32, orderNo string, points int64, maxDiscount float64) (int64, float64, error)
+	// CancelRedemption 退回结算订单抵扣的积分，可以重复调用
+	CancelRedemption(ctx context.Context, userID uint32, orderNo string) error
+}
@@ -13,13 +13,19 @@ var (
 )
 
 type Config struct {
-	Service    ServiceConfig    `mapstructure:"service"`
-	MySQL      MySQLConfig      `mapstructure:"mysql"`
-	Registry   RegistryConfig   `mapstructure:"registry"`
-	Log        LogConfig        `mapstructure:"log"`
-	Jaeger     JaegerConfig     `mapstructure:"jaeger"`
-	Prometheus PrometheusConfig `mapstructure:"prometheus"`
-	TLS        TLSConfig        `mapstructure:"tls"`
+	Service     ServiceConfig     `mapstructure:"service"`
+	MySQL       MySQLConfig       `mapstructure:"mysql"`
+	Registry    RegistryConfig    `mapstructure:"registry"`
+	Log         LogConfig         `mapstructure:"log"`
+	Jaeger      JaegerConfig      `mapstructure:"jaeger"`
+	Prometheus  PrometheusConfig  `mapstructure:"prometheus"`
+	TLS         TLSConfig         `mapstructure:"tls"`
+	CORS        CORSConfig        `mapstructure:"cors"`
+	AuthRPC     RPCClientConfig   `mapstructure:"auth_rpc"`
+	UserRPC     RPCClientConfig   `mapstructure:"user_rpc"`
+	CartRPC     RPCClientConfig   `mapstructure:"cart_rpc"`
+	ProductRPC  RPCClientConfig   `mapstructure:"product_rpc"`
+	ServiceAuth ServiceAuthConfig `mapstructure:"service_auth"`
 }
 
 type ServiceConfig struct {
@@ -71,6 +77,22 @@ type TLSConfig struct {
 	ClientKeyPath  string `mapstructure:"client_key_path"`
 }
 
+// RPCClientConfig 下游 Kitex 服务配置
+type RPCClientConfig struct {
+	Service string `mapstructure:"service"` // 服务注册名，与下游服务的 kitex.service 一致
+	Address string `mapstructure:"address"` // 服务的 RPC 地址，如 127.0.0.1:8888
+}
+
+// ServiceAuthConfig 服务间调用凭证配置，所有服务使用相同的共享密钥
+type ServiceAuthConfig struct {
+	Secret string `mapstructure:"secret"` // 可通过 SERVICE_AUTH_SECRET 覆盖
+}
+
+// CORSConfig 跨域配置，只有 allow_origins 中的来源可以携带 Cookie 访问，为空时拒绝跨域请求
+type CORSConfig struct {
+	AllowOrigins []string `mapstructure:"allow_origins"`
+}
+
 func Init() {
 	once.Do(func() {
 		viper.SetConfigName("conf")
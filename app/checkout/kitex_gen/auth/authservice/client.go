@@ -0,0 +1,241 @@
+// Code generated by Kitex v0.12.1. DO NOT EDIT.
+
+package authservice
+
+import (
+	auth "TikTokMall/app/checkout/kitex_gen/auth"
+	"context"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	DeliverTokenByRPC(ctx context.Context, Req *auth.DeliverTokenReq, callOptions ...callopt.Option) (r *auth.DeliveryResp, err error)
+	VerifyTokenByRPC(ctx context.Context, Req *auth.VerifyTokenReq, callOptions ...callopt.Option) (r *auth.VerifyResp, err error)
+	Register(ctx context.Context, Req *auth.RegisterRequest, callOptions ...callopt.Option) (r *auth.RegisterResponse, err error)
+	Login(ctx context.Context, Req *auth.LoginRequest, callOptions ...callopt.Option) (r *auth.LoginResponse, err error)
+	LoginTwoFactor(ctx context.Context, Req *auth.TwoFactorLoginRequest, callOptions ...callopt.Option) (r *auth.LoginResponse, err error)
+	EnrollTwoFactor(ctx context.Context, Req *auth.EnrollTwoFactorRequest, callOptions ...callopt.Option) (r *auth.EnrollTwoFactorResponse, err error)
+	ConfirmTwoFactor(ctx context.Context, Req *auth.ConfirmTwoFactorRequest, callOptions ...callopt.Option) (r *auth.ConfirmTwoFactorResponse, err error)
+	DisableTwoFactor(ctx context.Context, Req *auth.DisableTwoFactorRequest, callOptions ...callopt.Option) (r *auth.DisableTwoFactorResponse, err error)
+	ForgotPassword(ctx context.Context, Req *auth.ForgotPasswordRequest, callOptions ...callopt.Option) (r *auth.ForgotPasswordResponse, err error)
+	ResetPassword(ctx context.Context, Req *auth.ResetPasswordRequest, callOptions ...callopt.Option) (r *auth.ResetPasswordResponse, err error)
+	ChangePassword(ctx context.Context, Req *auth.ChangePasswordRequest, callOptions ...callopt.Option) (r *auth.ChangePasswordResponse, err error)
+	ResendVerification(ctx context.Context, Req *auth.ResendVerificationRequest, callOptions ...callopt.Option) (r *auth.ResendVerificationResponse, err error)
+	VerifyContact(ctx context.Context, Req *auth.VerifyContactRequest, callOptions ...callopt.Option) (r *auth.VerifyContactResponse, err error)
+	GetVerificationStatus(ctx context.Context, Req *auth.VerificationStatusReq, callOptions ...callopt.Option) (r *auth.VerificationStatusResp, err error)
+	AssignRole(ctx context.Context, Req *auth.AssignRoleRequest, callOptions ...callopt.Option) (r *auth.AssignRoleResponse, err error)
+	RevokeRole(ctx context.Context, Req *auth.RevokeRoleRequest, callOptions ...callopt.Option) (r *auth.RevokeRoleResponse, err error)
+	ListUserRoles(ctx context.Context, Req *auth.ListUserRolesRequest, callOptions ...callopt.Option) (r *auth.ListUserRolesResponse, err error)
+	UpdateUserStatus(ctx context.Context, Req *auth.UpdateUserStatusRequest, callOptions ...callopt.Option) (r *auth.UpdateUserStatusResponse, err error)
+	BanUser(ctx context.Context, Req *auth.BanUserRequest, callOptions ...callopt.Option) (r *auth.BanUserResponse, err error)
+	UnbanUser(ctx context.Context, Req *auth.UnbanUserRequest, callOptions ...callopt.Option) (r *auth.UnbanUserResponse, err error)
+	ListUserBans(ctx context.Context, Req *auth.ListUserBansRequest, callOptions ...callopt.Option) (r *auth.ListUserBansResponse, err error)
+	UnlockLogin(ctx context.Context, Req *auth.UnlockLoginRequest, callOptions ...callopt.Option) (r *auth.UnlockLoginResponse, err error)
+	ListAuditLogs(ctx context.Context, Req *auth.ListAuditLogsRequest, callOptions ...callopt.Option) (r *auth.ListAuditLogsResponse, err error)
+	CreateAPIKey(ctx context.Context, Req *auth.CreateAPIKeyRequest, callOptions ...callopt.Option) (r *auth.CreateAPIKeyResponse, err error)
+	ListAPIKeys(ctx context.Context, Req *auth.ListAPIKeysRequest, callOptions ...callopt.Option) (r *auth.ListAPIKeysResponse, err error)
+	RevokeAPIKey(ctx context.Context, Req *auth.RevokeAPIKeyRequest, callOptions ...callopt.Option) (r *auth.RevokeAPIKeyResponse, err error)
+	VerifyAPISignature(ctx context.Context, Req *auth.VerifyAPISignatureReq, callOptions ...callopt.Option) (r *auth.VerifyAPISignatureResp, err error)
+	RefreshToken(ctx context.Context, Req *auth.RefreshTokenRequest, callOptions ...callopt.Option) (r *auth.RefreshTokenResponse, err error)
+	Logout(ctx context.Context, Req *auth.LogoutRequest, callOptions ...callopt.Option) (r *auth.LogoutResponse, err error)
+	ListSessions(ctx context.Context, Req *auth.ListSessionsRequest, callOptions ...callopt.Option) (r *auth.ListSessionsResponse, err error)
+	RevokeSession(ctx context.Context, Req *auth.RevokeSessionRequest, callOptions ...callopt.Option) (r *auth.RevokeSessionResponse, err error)
+	LogoutAll(ctx context.Context, Req *auth.LogoutAllRequest, callOptions ...callopt.Option) (r *auth.LogoutAllResponse, err error)
+	ValidateToken(ctx context.Context, Req *auth.ValidateTokenRequest, callOptions ...callopt.Option) (r *auth.ValidateTokenResponse, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kAuthServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kAuthServiceClient struct {
+	*kClient
+}
+
+func (p *kAuthServiceClient) DeliverTokenByRPC(ctx context.Context, Req *auth.DeliverTokenReq, callOptions ...callopt.Option) (r *auth.DeliveryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeliverTokenByRPC(ctx, Req)
+}
+
+func (p *kAuthServiceClient) VerifyTokenByRPC(ctx context.Context, Req *auth.VerifyTokenReq, callOptions ...callopt.Option) (r *auth.VerifyResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyTokenByRPC(ctx, Req)
+}
+
+func (p *kAuthServiceClient) Register(ctx context.Context, Req *auth.RegisterRequest, callOptions ...callopt.Option) (r *auth.RegisterResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Register(ctx, Req)
+}
+
+func (p *kAuthServiceClient) Login(ctx context.Context, Req *auth.LoginRequest, callOptions ...callopt.Option) (r *auth.LoginResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Login(ctx, Req)
+}
+
+func (p *kAuthServiceClient) LoginTwoFactor(ctx context.Context, Req *auth.TwoFactorLoginRequest, callOptions ...callopt.Option) (r *auth.LoginResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.LoginTwoFactor(ctx, Req)
+}
+
+func (p *kAuthServiceClient) EnrollTwoFactor(ctx context.Context, Req *auth.EnrollTwoFactorRequest, callOptions ...callopt.Option) (r *auth.EnrollTwoFactorResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EnrollTwoFactor(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ConfirmTwoFactor(ctx context.Context, Req *auth.ConfirmTwoFactorRequest, callOptions ...callopt.Option) (r *auth.ConfirmTwoFactorResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmTwoFactor(ctx, Req)
+}
+
+func (p *kAuthServiceClient) DisableTwoFactor(ctx context.Context, Req *auth.DisableTwoFactorRequest, callOptions ...callopt.Option) (r *auth.DisableTwoFactorResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableTwoFactor(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ForgotPassword(ctx context.Context, Req *auth.ForgotPasswordRequest, callOptions ...callopt.Option) (r *auth.ForgotPasswordResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ForgotPassword(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ResetPassword(ctx context.Context, Req *auth.ResetPasswordRequest, callOptions ...callopt.Option) (r *auth.ResetPasswordResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResetPassword(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ChangePassword(ctx context.Context, Req *auth.ChangePasswordRequest, callOptions ...callopt.Option) (r *auth.ChangePasswordResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ChangePassword(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ResendVerification(ctx context.Context, Req *auth.ResendVerificationRequest, callOptions ...callopt.Option) (r *auth.ResendVerificationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResendVerification(ctx, Req)
+}
+
+func (p *kAuthServiceClient) VerifyContact(ctx context.Context, Req *auth.VerifyContactRequest, callOptions ...callopt.Option) (r *auth.VerifyContactResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyContact(ctx, Req)
+}
+
+func (p *kAuthServiceClient) GetVerificationStatus(ctx context.Context, Req *auth.VerificationStatusReq, callOptions ...callopt.Option) (r *auth.VerificationStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetVerificationStatus(ctx, Req)
+}
+
+func (p *kAuthServiceClient) AssignRole(ctx context.Context, Req *auth.AssignRoleRequest, callOptions ...callopt.Option) (r *auth.AssignRoleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AssignRole(ctx, Req)
+}
+
+func (p *kAuthServiceClient) RevokeRole(ctx context.Context, Req *auth.RevokeRoleRequest, callOptions ...callopt.Option) (r *auth.RevokeRoleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeRole(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ListUserRoles(ctx context.Context, Req *auth.ListUserRolesRequest, callOptions ...callopt.Option) (r *auth.ListUserRolesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListUserRoles(ctx, Req)
+}
+
+func (p *kAuthServiceClient) UpdateUserStatus(ctx context.Context, Req *auth.UpdateUserStatusRequest, callOptions ...callopt.Option) (r *auth.UpdateUserStatusResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateUserStatus(ctx, Req)
+}
+
+func (p *kAuthServiceClient) BanUser(ctx context.Context, Req *auth.BanUserRequest, callOptions ...callopt.Option) (r *auth.BanUserResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BanUser(ctx, Req)
+}
+
+func (p *kAuthServiceClient) UnbanUser(ctx context.Context, Req *auth.UnbanUserRequest, callOptions ...callopt.Option) (r *auth.UnbanUserResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnbanUser(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ListUserBans(ctx context.Context, Req *auth.ListUserBansRequest, callOptions ...callopt.Option) (r *auth.ListUserBansResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListUserBans(ctx, Req)
+}
+
+func (p *kAuthServiceClient) UnlockLogin(ctx context.Context, Req *auth.UnlockLoginRequest, callOptions ...callopt.Option) (r *auth.UnlockLoginResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnlockLogin(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ListAuditLogs(ctx context.Context, Req *auth.ListAuditLogsRequest, callOptions ...callopt.Option) (r *auth.ListAuditLogsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAuditLogs(ctx, Req)
+}
+
+func (p *kAuthServiceClient) CreateAPIKey(ctx context.Context, Req *auth.CreateAPIKeyRequest, callOptions ...callopt.Option) (r *auth.CreateAPIKeyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAPIKey(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ListAPIKeys(ctx context.Context, Req *auth.ListAPIKeysRequest, callOptions ...callopt.Option) (r *auth.ListAPIKeysResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAPIKeys(ctx, Req)
+}
+
+func (p *kAuthServiceClient) RevokeAPIKey(ctx context.Context, Req *auth.RevokeAPIKeyRequest, callOptions ...callopt.Option) (r *auth.RevokeAPIKeyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeAPIKey(ctx, Req)
+}
+
+func (p *kAuthServiceClient) VerifyAPISignature(ctx context.Context, Req *auth.VerifyAPISignatureReq, callOptions ...callopt.Option) (r *auth.VerifyAPISignatureResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyAPISignature(ctx, Req)
+}
+
+func (p *kAuthServiceClient) RefreshToken(ctx context.Context, Req *auth.RefreshTokenRequest, callOptions ...callopt.Option) (r *auth.RefreshTokenResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefreshToken(ctx, Req)
+}
+
+func (p *kAuthServiceClient) Logout(ctx context.Context, Req *auth.LogoutRequest, callOptions ...callopt.Option) (r *auth.LogoutResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Logout(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ListSessions(ctx context.Context, Req *auth.ListSessionsRequest, callOptions ...callopt.Option) (r *auth.ListSessionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSessions(ctx, Req)
+}
+
+func (p *kAuthServiceClient) RevokeSession(ctx context.Context, Req *auth.RevokeSessionRequest, callOptions ...callopt.Option) (r *auth.RevokeSessionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeSession(ctx, Req)
+}
+
+func (p *kAuthServiceClient) LogoutAll(ctx context.Context, Req *auth.LogoutAllRequest, callOptions ...callopt.Option) (r *auth.LogoutAllResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.LogoutAll(ctx, Req)
+}
+
+func (p *kAuthServiceClient) ValidateToken(ctx context.Context, Req *auth.ValidateTokenRequest, callOptions ...callopt.Option) (r *auth.ValidateTokenResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ValidateToken(ctx, Req)
+}
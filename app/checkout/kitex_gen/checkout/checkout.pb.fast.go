@@ -111,6 +111,16 @@ func (x *CheckoutReq) FastRead(buf []byte, _type int8, number int32) (offset int
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -164,6 +174,16 @@ func (x *CheckoutReq) fastReadField6(buf []byte, _type int8) (offset int, err er
 	return offset, nil
 }
 
+func (x *CheckoutReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.RedeemPoints, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
 func (x *CheckoutResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -176,6 +196,16 @@ func (x *CheckoutResp) FastRead(buf []byte, _type int8, number int32) (offset in
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -199,6 +229,16 @@ func (x *CheckoutResp) fastReadField2(buf []byte, _type int8) (offset int, err e
 	return offset, err
 }
 
+func (x *CheckoutResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PointsRedeemed, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
 func (x *Address) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
@@ -261,6 +301,8 @@ func (x *CheckoutReq) FastWrite(buf []byte) (offset int) {
 	offset += x.fastWriteField4(buf[offset:])
 	offset += x.fastWriteField5(buf[offset:])
 	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
 	return offset
 }
 
@@ -312,12 +354,30 @@ func (x *CheckoutReq) fastWriteField6(buf []byte) (offset int) {
 	return offset
 }
 
+func (x *CheckoutReq) fastWriteField7(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.GetAddressId())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField8(buf []byte) (offset int) {
+	if x.RedeemPoints == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 8, x.GetRedeemPoints())
+	return offset
+}
+
 func (x *CheckoutResp) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
 	offset += x.fastWriteField1(buf[offset:])
 	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
 	return offset
 }
 
@@ -337,6 +397,22 @@ func (x *CheckoutResp) fastWriteField2(buf []byte) (offset int) {
 	return offset
 }
 
+func (x *CheckoutResp) fastWriteField3(buf []byte) (offset int) {
+	if x.PointsRedeemed == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetPointsRedeemed())
+	return offset
+}
+
+func (x *CheckoutResp) fastWriteField4(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 4, x.GetDiscount())
+	return offset
+}
+
 func (x *Address) Size() (n int) {
 	if x == nil {
 		return n
@@ -399,6 +475,8 @@ func (x *CheckoutReq) Size() (n int) {
 	n += x.sizeField4()
 	n += x.sizeField5()
 	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
 	return n
 }
 
@@ -450,12 +528,30 @@ func (x *CheckoutReq) sizeField6() (n int) {
 	return n
 }
 
+func (x *CheckoutReq) sizeField7() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(7, x.GetAddressId())
+	return n
+}
+
+func (x *CheckoutReq) sizeField8() (n int) {
+	if x.RedeemPoints == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(8, x.GetRedeemPoints())
+	return n
+}
+
 func (x *CheckoutResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
 	return n
 }
 
@@ -475,6 +571,22 @@ func (x *CheckoutResp) sizeField2() (n int) {
 	return n
 }
 
+func (x *CheckoutResp) sizeField3() (n int) {
+	if x.PointsRedeemed == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetPointsRedeemed())
+	return n
+}
+
+func (x *CheckoutResp) sizeField4() (n int) {
+	if x.Discount == 0 {
+		return n
+	}
+	n += fastpb.SizeDouble(4, x.GetDiscount())
+	return n
+}
+
 var fieldIDToName_Address = map[int32]string{
 	1: "StreetAddress",
 	2: "City",
@@ -490,11 +602,15 @@ var fieldIDToName_CheckoutReq = map[int32]string{
 	4: "Email",
 	5: "Address",
 	6: "CreditCard",
+	7: "AddressId",
+	8: "RedeemPoints",
 }
 
 var fieldIDToName_CheckoutResp = map[int32]string{
 	1: "OrderId",
 	2: "TransactionId",
+	3: "PointsRedeemed",
+	4: "Discount",
 }
 
 var _ = payment.File_payment_proto
@@ -0,0 +1,199 @@
+// Code generated by Kitex v0.12.1. DO NOT EDIT.
+
+package userservice
+
+import (
+	user "TikTokMall/app/checkout/kitex_gen/user"
+	"context"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	Register(ctx context.Context, Req *user.RegisterReq, callOptions ...callopt.Option) (r *user.RegisterResp, err error)
+	Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	Logout(ctx context.Context, Req *user.LogoutReq, callOptions ...callopt.Option) (r *user.LogoutResp, err error)
+	Delete(ctx context.Context, Req *user.DeleteReq, callOptions ...callopt.Option) (r *user.DeleteResp, err error)
+	CancelDelete(ctx context.Context, Req *user.CancelDeleteReq, callOptions ...callopt.Option) (r *user.CancelDeleteResp, err error)
+	Update(ctx context.Context, Req *user.UpdateReq, callOptions ...callopt.Option) (r *user.UpdateResp, err error)
+	Info(ctx context.Context, Req *user.InfoReq, callOptions ...callopt.Option) (r *user.InfoResp, err error)
+	ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error)
+	GetAddress(ctx context.Context, Req *user.GetAddressReq, callOptions ...callopt.Option) (r *user.GetAddressResp, err error)
+	CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error)
+	UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error)
+	DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error)
+	SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error)
+	RequestExport(ctx context.Context, Req *user.RequestExportReq, callOptions ...callopt.Option) (r *user.RequestExportResp, err error)
+	GetExport(ctx context.Context, Req *user.GetExportReq, callOptions ...callopt.Option) (r *user.GetExportResp, err error)
+	UploadAvatar(ctx context.Context, Req *user.UploadAvatarReq, callOptions ...callopt.Option) (r *user.UploadAvatarResp, err error)
+	DeleteAvatar(ctx context.Context, Req *user.DeleteAvatarReq, callOptions ...callopt.Option) (r *user.DeleteAvatarResp, err error)
+	GetPoints(ctx context.Context, Req *user.GetPointsReq, callOptions ...callopt.Option) (r *user.GetPointsResp, err error)
+	ListPointsHistory(ctx context.Context, Req *user.ListPointsHistoryReq, callOptions ...callopt.Option) (r *user.ListPointsHistoryResp, err error)
+	RedeemPoints(ctx context.Context, Req *user.RedeemPointsReq, callOptions ...callopt.Option) (r *user.RedeemPointsResp, err error)
+	CancelRedemption(ctx context.Context, Req *user.CancelRedemptionReq, callOptions ...callopt.Option) (r *user.CancelRedemptionResp, err error)
+	ListWishlists(ctx context.Context, Req *user.ListWishlistsReq, callOptions ...callopt.Option) (r *user.ListWishlistsResp, err error)
+	AddWishlistItem(ctx context.Context, Req *user.AddWishlistItemReq, callOptions ...callopt.Option) (r *user.AddWishlistItemResp, err error)
+	RemoveWishlistItem(ctx context.Context, Req *user.RemoveWishlistItemReq, callOptions ...callopt.Option) (r *user.RemoveWishlistItemResp, err error)
+	DeleteWishlist(ctx context.Context, Req *user.DeleteWishlistReq, callOptions ...callopt.Option) (r *user.DeleteWishlistResp, err error)
+	SubscribeWishlistItem(ctx context.Context, Req *user.SubscribeWishlistItemReq, callOptions ...callopt.Option) (r *user.SubscribeWishlistItemResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kUserServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kUserServiceClient struct {
+	*kClient
+}
+
+func (p *kUserServiceClient) Register(ctx context.Context, Req *user.RegisterReq, callOptions ...callopt.Option) (r *user.RegisterResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Register(ctx, Req)
+}
+
+func (p *kUserServiceClient) Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Login(ctx, Req)
+}
+
+func (p *kUserServiceClient) Logout(ctx context.Context, Req *user.LogoutReq, callOptions ...callopt.Option) (r *user.LogoutResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Logout(ctx, Req)
+}
+
+func (p *kUserServiceClient) Delete(ctx context.Context, Req *user.DeleteReq, callOptions ...callopt.Option) (r *user.DeleteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Delete(ctx, Req)
+}
+
+func (p *kUserServiceClient) CancelDelete(ctx context.Context, Req *user.CancelDeleteReq, callOptions ...callopt.Option) (r *user.CancelDeleteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelDelete(ctx, Req)
+}
+
+func (p *kUserServiceClient) Update(ctx context.Context, Req *user.UpdateReq, callOptions ...callopt.Option) (r *user.UpdateResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Update(ctx, Req)
+}
+
+func (p *kUserServiceClient) Info(ctx context.Context, Req *user.InfoReq, callOptions ...callopt.Option) (r *user.InfoResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Info(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAddresses(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetAddress(ctx context.Context, Req *user.GetAddressReq, callOptions ...callopt.Option) (r *user.GetAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetDefaultAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) RequestExport(ctx context.Context, Req *user.RequestExportReq, callOptions ...callopt.Option) (r *user.RequestExportResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestExport(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetExport(ctx context.Context, Req *user.GetExportReq, callOptions ...callopt.Option) (r *user.GetExportResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetExport(ctx, Req)
+}
+
+func (p *kUserServiceClient) UploadAvatar(ctx context.Context, Req *user.UploadAvatarReq, callOptions ...callopt.Option) (r *user.UploadAvatarResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadAvatar(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteAvatar(ctx context.Context, Req *user.DeleteAvatarReq, callOptions ...callopt.Option) (r *user.DeleteAvatarResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAvatar(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetPoints(ctx context.Context, Req *user.GetPointsReq, callOptions ...callopt.Option) (r *user.GetPointsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPoints(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListPointsHistory(ctx context.Context, Req *user.ListPointsHistoryReq, callOptions ...callopt.Option) (r *user.ListPointsHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPointsHistory(ctx, Req)
+}
+
+func (p *kUserServiceClient) RedeemPoints(ctx context.Context, Req *user.RedeemPointsReq, callOptions ...callopt.Option) (r *user.RedeemPointsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RedeemPoints(ctx, Req)
+}
+
+func (p *kUserServiceClient) CancelRedemption(ctx context.Context, Req *user.CancelRedemptionReq, callOptions ...callopt.Option) (r *user.CancelRedemptionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelRedemption(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListWishlists(ctx context.Context, Req *user.ListWishlistsReq, callOptions ...callopt.Option) (r *user.ListWishlistsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListWishlists(ctx, Req)
+}
+
+func (p *kUserServiceClient) AddWishlistItem(ctx context.Context, Req *user.AddWishlistItemReq, callOptions ...callopt.Option) (r *user.AddWishlistItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddWishlistItem(ctx, Req)
+}
+
+func (p *kUserServiceClient) RemoveWishlistItem(ctx context.Context, Req *user.RemoveWishlistItemReq, callOptions ...callopt.Option) (r *user.RemoveWishlistItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveWishlistItem(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteWishlist(ctx context.Context, Req *user.DeleteWishlistReq, callOptions ...callopt.Option) (r *user.DeleteWishlistResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteWishlist(ctx, Req)
+}
+
+func (p *kUserServiceClient) SubscribeWishlistItem(ctx context.Context, Req *user.SubscribeWishlistItemReq, callOptions ...callopt.Option) (r *user.SubscribeWishlistItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubscribeWishlistItem(ctx, Req)
+}
@@ -0,0 +1,4512 @@
+// Code generated by Kitex v0.12.1. DO NOT EDIT.
+
+package userservice
+
+import (
+	user "TikTokMall/app/checkout/kitex_gen/user"
+	"context"
+	"errors"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"Register": kitex.NewMethodInfo(
+		registerHandler,
+		newRegisterArgs,
+		newRegisterResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Login": kitex.NewMethodInfo(
+		loginHandler,
+		newLoginArgs,
+		newLoginResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Logout": kitex.NewMethodInfo(
+		logoutHandler,
+		newLogoutArgs,
+		newLogoutResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Delete": kitex.NewMethodInfo(
+		deleteHandler,
+		newDeleteArgs,
+		newDeleteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CancelDelete": kitex.NewMethodInfo(
+		cancelDeleteHandler,
+		newCancelDeleteArgs,
+		newCancelDeleteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Update": kitex.NewMethodInfo(
+		updateHandler,
+		newUpdateArgs,
+		newUpdateResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Info": kitex.NewMethodInfo(
+		infoHandler,
+		newInfoArgs,
+		newInfoResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAddresses": kitex.NewMethodInfo(
+		listAddressesHandler,
+		newListAddressesArgs,
+		newListAddressesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetAddress": kitex.NewMethodInfo(
+		getAddressHandler,
+		newGetAddressArgs,
+		newGetAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateAddress": kitex.NewMethodInfo(
+		createAddressHandler,
+		newCreateAddressArgs,
+		newCreateAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateAddress": kitex.NewMethodInfo(
+		updateAddressHandler,
+		newUpdateAddressArgs,
+		newUpdateAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteAddress": kitex.NewMethodInfo(
+		deleteAddressHandler,
+		newDeleteAddressArgs,
+		newDeleteAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SetDefaultAddress": kitex.NewMethodInfo(
+		setDefaultAddressHandler,
+		newSetDefaultAddressArgs,
+		newSetDefaultAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RequestExport": kitex.NewMethodInfo(
+		requestExportHandler,
+		newRequestExportArgs,
+		newRequestExportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetExport": kitex.NewMethodInfo(
+		getExportHandler,
+		newGetExportArgs,
+		newGetExportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UploadAvatar": kitex.NewMethodInfo(
+		uploadAvatarHandler,
+		newUploadAvatarArgs,
+		newUploadAvatarResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteAvatar": kitex.NewMethodInfo(
+		deleteAvatarHandler,
+		newDeleteAvatarArgs,
+		newDeleteAvatarResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetPoints": kitex.NewMethodInfo(
+		getPointsHandler,
+		newGetPointsArgs,
+		newGetPointsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListPointsHistory": kitex.NewMethodInfo(
+		listPointsHistoryHandler,
+		newListPointsHistoryArgs,
+		newListPointsHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RedeemPoints": kitex.NewMethodInfo(
+		redeemPointsHandler,
+		newRedeemPointsArgs,
+		newRedeemPointsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CancelRedemption": kitex.NewMethodInfo(
+		cancelRedemptionHandler,
+		newCancelRedemptionArgs,
+		newCancelRedemptionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListWishlists": kitex.NewMethodInfo(
+		listWishlistsHandler,
+		newListWishlistsArgs,
+		newListWishlistsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AddWishlistItem": kitex.NewMethodInfo(
+		addWishlistItemHandler,
+		newAddWishlistItemArgs,
+		newAddWishlistItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RemoveWishlistItem": kitex.NewMethodInfo(
+		removeWishlistItemHandler,
+		newRemoveWishlistItemArgs,
+		newRemoveWishlistItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteWishlist": kitex.NewMethodInfo(
+		deleteWishlistHandler,
+		newDeleteWishlistArgs,
+		newDeleteWishlistResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SubscribeWishlistItem": kitex.NewMethodInfo(
+		subscribeWishlistItemHandler,
+		newSubscribeWishlistItemArgs,
+		newSubscribeWishlistItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+}
+
+var (
+	userServiceServiceInfo                = NewServiceInfo()
+	userServiceServiceInfoForClient       = NewServiceInfoForClient()
+	userServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return userServiceServiceInfo
+}
+
+// for stream client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return userServiceServiceInfoForStreamClient
+}
+
+// for client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return userServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "UserService"
+	handlerType := (*user.UserService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "user",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.12.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func registerHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RegisterReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Register(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RegisterArgs:
+		success, err := handler.(user.UserService).Register(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RegisterResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRegisterArgs() interface{} {
+	return &RegisterArgs{}
+}
+
+func newRegisterResult() interface{} {
+	return &RegisterResult{}
+}
+
+type RegisterArgs struct {
+	Req *user.RegisterReq
+}
+
+func (p *RegisterArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RegisterReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RegisterArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RegisterArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RegisterArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RegisterArgs) Unmarshal(in []byte) error {
+	msg := new(user.RegisterReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RegisterArgs_Req_DEFAULT *user.RegisterReq
+
+func (p *RegisterArgs) GetReq() *user.RegisterReq {
+	if !p.IsSetReq() {
+		return RegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RegisterArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RegisterResult struct {
+	Success *user.RegisterResp
+}
+
+var RegisterResult_Success_DEFAULT *user.RegisterResp
+
+func (p *RegisterResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RegisterResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RegisterResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RegisterResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RegisterResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RegisterResult) Unmarshal(in []byte) error {
+	msg := new(user.RegisterResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RegisterResult) GetSuccess() *user.RegisterResp {
+	if !p.IsSetSuccess() {
+		return RegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RegisterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RegisterResp)
+}
+
+func (p *RegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RegisterResult) GetResult() interface{} {
+	return p.Success
+}
+
+func loginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.LoginReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Login(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LoginArgs:
+		success, err := handler.(user.UserService).Login(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LoginResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLoginArgs() interface{} {
+	return &LoginArgs{}
+}
+
+func newLoginResult() interface{} {
+	return &LoginResult{}
+}
+
+type LoginArgs struct {
+	Req *user.LoginReq
+}
+
+func (p *LoginArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.LoginReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LoginArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LoginArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LoginArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LoginArgs) Unmarshal(in []byte) error {
+	msg := new(user.LoginReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LoginArgs_Req_DEFAULT *user.LoginReq
+
+func (p *LoginArgs) GetReq() *user.LoginReq {
+	if !p.IsSetReq() {
+		return LoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LoginArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LoginResult struct {
+	Success *user.LoginResp
+}
+
+var LoginResult_Success_DEFAULT *user.LoginResp
+
+func (p *LoginResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.LoginResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LoginResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LoginResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LoginResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LoginResult) Unmarshal(in []byte) error {
+	msg := new(user.LoginResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LoginResult) GetSuccess() *user.LoginResp {
+	if !p.IsSetSuccess() {
+		return LoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.LoginResp)
+}
+
+func (p *LoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LoginResult) GetResult() interface{} {
+	return p.Success
+}
+
+func logoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.LogoutReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Logout(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LogoutArgs:
+		success, err := handler.(user.UserService).Logout(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LogoutResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLogoutArgs() interface{} {
+	return &LogoutArgs{}
+}
+
+func newLogoutResult() interface{} {
+	return &LogoutResult{}
+}
+
+type LogoutArgs struct {
+	Req *user.LogoutReq
+}
+
+func (p *LogoutArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.LogoutReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LogoutArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LogoutArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LogoutArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LogoutArgs) Unmarshal(in []byte) error {
+	msg := new(user.LogoutReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LogoutArgs_Req_DEFAULT *user.LogoutReq
+
+func (p *LogoutArgs) GetReq() *user.LogoutReq {
+	if !p.IsSetReq() {
+		return LogoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LogoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LogoutArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LogoutResult struct {
+	Success *user.LogoutResp
+}
+
+var LogoutResult_Success_DEFAULT *user.LogoutResp
+
+func (p *LogoutResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.LogoutResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LogoutResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LogoutResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LogoutResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LogoutResult) Unmarshal(in []byte) error {
+	msg := new(user.LogoutResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LogoutResult) GetSuccess() *user.LogoutResp {
+	if !p.IsSetSuccess() {
+		return LogoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LogoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.LogoutResp)
+}
+
+func (p *LogoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LogoutResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DeleteReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Delete(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteArgs:
+		success, err := handler.(user.UserService).Delete(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteArgs() interface{} {
+	return &DeleteArgs{}
+}
+
+func newDeleteResult() interface{} {
+	return &DeleteResult{}
+}
+
+type DeleteArgs struct {
+	Req *user.DeleteReq
+}
+
+func (p *DeleteArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DeleteReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteArgs) Unmarshal(in []byte) error {
+	msg := new(user.DeleteReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteArgs_Req_DEFAULT *user.DeleteReq
+
+func (p *DeleteArgs) GetReq() *user.DeleteReq {
+	if !p.IsSetReq() {
+		return DeleteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteResult struct {
+	Success *user.DeleteResp
+}
+
+var DeleteResult_Success_DEFAULT *user.DeleteResp
+
+func (p *DeleteResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DeleteResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteResult) Unmarshal(in []byte) error {
+	msg := new(user.DeleteResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteResult) GetSuccess() *user.DeleteResp {
+	if !p.IsSetSuccess() {
+		return DeleteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DeleteResp)
+}
+
+func (p *DeleteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelDeleteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.CancelDeleteReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).CancelDelete(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CancelDeleteArgs:
+		success, err := handler.(user.UserService).CancelDelete(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelDeleteResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCancelDeleteArgs() interface{} {
+	return &CancelDeleteArgs{}
+}
+
+func newCancelDeleteResult() interface{} {
+	return &CancelDeleteResult{}
+}
+
+type CancelDeleteArgs struct {
+	Req *user.CancelDeleteReq
+}
+
+func (p *CancelDeleteArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.CancelDeleteReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CancelDeleteArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CancelDeleteArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CancelDeleteArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelDeleteArgs) Unmarshal(in []byte) error {
+	msg := new(user.CancelDeleteReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelDeleteArgs_Req_DEFAULT *user.CancelDeleteReq
+
+func (p *CancelDeleteArgs) GetReq() *user.CancelDeleteReq {
+	if !p.IsSetReq() {
+		return CancelDeleteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelDeleteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelDeleteArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelDeleteResult struct {
+	Success *user.CancelDeleteResp
+}
+
+var CancelDeleteResult_Success_DEFAULT *user.CancelDeleteResp
+
+func (p *CancelDeleteResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.CancelDeleteResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CancelDeleteResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CancelDeleteResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CancelDeleteResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelDeleteResult) Unmarshal(in []byte) error {
+	msg := new(user.CancelDeleteResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelDeleteResult) GetSuccess() *user.CancelDeleteResp {
+	if !p.IsSetSuccess() {
+		return CancelDeleteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelDeleteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.CancelDeleteResp)
+}
+
+func (p *CancelDeleteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelDeleteResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Update(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateArgs:
+		success, err := handler.(user.UserService).Update(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateArgs() interface{} {
+	return &UpdateArgs{}
+}
+
+func newUpdateResult() interface{} {
+	return &UpdateResult{}
+}
+
+type UpdateArgs struct {
+	Req *user.UpdateReq
+}
+
+func (p *UpdateArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateArgs_Req_DEFAULT *user.UpdateReq
+
+func (p *UpdateArgs) GetReq() *user.UpdateReq {
+	if !p.IsSetReq() {
+		return UpdateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateResult struct {
+	Success *user.UpdateResp
+}
+
+var UpdateResult_Success_DEFAULT *user.UpdateResp
+
+func (p *UpdateResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateResult) GetSuccess() *user.UpdateResp {
+	if !p.IsSetSuccess() {
+		return UpdateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateResp)
+}
+
+func (p *UpdateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateResult) GetResult() interface{} {
+	return p.Success
+}
+
+func infoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.InfoReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Info(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *InfoArgs:
+		success, err := handler.(user.UserService).Info(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*InfoResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newInfoArgs() interface{} {
+	return &InfoArgs{}
+}
+
+func newInfoResult() interface{} {
+	return &InfoResult{}
+}
+
+type InfoArgs struct {
+	Req *user.InfoReq
+}
+
+func (p *InfoArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.InfoReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *InfoArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *InfoArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *InfoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *InfoArgs) Unmarshal(in []byte) error {
+	msg := new(user.InfoReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var InfoArgs_Req_DEFAULT *user.InfoReq
+
+func (p *InfoArgs) GetReq() *user.InfoReq {
+	if !p.IsSetReq() {
+		return InfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *InfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InfoArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type InfoResult struct {
+	Success *user.InfoResp
+}
+
+var InfoResult_Success_DEFAULT *user.InfoResp
+
+func (p *InfoResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.InfoResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *InfoResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *InfoResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *InfoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *InfoResult) Unmarshal(in []byte) error {
+	msg := new(user.InfoResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *InfoResult) GetSuccess() *user.InfoResp {
+	if !p.IsSetSuccess() {
+		return InfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *InfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.InfoResp)
+}
+
+func (p *InfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InfoResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listAddressesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListAddressesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListAddresses(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListAddressesArgs:
+		success, err := handler.(user.UserService).ListAddresses(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListAddressesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListAddressesArgs() interface{} {
+	return &ListAddressesArgs{}
+}
+
+func newListAddressesResult() interface{} {
+	return &ListAddressesResult{}
+}
+
+type ListAddressesArgs struct {
+	Req *user.ListAddressesReq
+}
+
+func (p *ListAddressesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListAddressesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListAddressesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListAddressesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListAddressesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListAddressesArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListAddressesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListAddressesArgs_Req_DEFAULT *user.ListAddressesReq
+
+func (p *ListAddressesArgs) GetReq() *user.ListAddressesReq {
+	if !p.IsSetReq() {
+		return ListAddressesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListAddressesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListAddressesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListAddressesResult struct {
+	Success *user.ListAddressesResp
+}
+
+var ListAddressesResult_Success_DEFAULT *user.ListAddressesResp
+
+func (p *ListAddressesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListAddressesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListAddressesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListAddressesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListAddressesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListAddressesResult) Unmarshal(in []byte) error {
+	msg := new(user.ListAddressesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListAddressesResult) GetSuccess() *user.ListAddressesResp {
+	if !p.IsSetSuccess() {
+		return ListAddressesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListAddressesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListAddressesResp)
+}
+
+func (p *ListAddressesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListAddressesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetAddressArgs:
+		success, err := handler.(user.UserService).GetAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetAddressArgs() interface{} {
+	return &GetAddressArgs{}
+}
+
+func newGetAddressResult() interface{} {
+	return &GetAddressResult{}
+}
+
+type GetAddressArgs struct {
+	Req *user.GetAddressReq
+}
+
+func (p *GetAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetAddressArgs_Req_DEFAULT *user.GetAddressReq
+
+func (p *GetAddressArgs) GetReq() *user.GetAddressReq {
+	if !p.IsSetReq() {
+		return GetAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetAddressResult struct {
+	Success *user.GetAddressResp
+}
+
+var GetAddressResult_Success_DEFAULT *user.GetAddressResp
+
+func (p *GetAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.GetAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetAddressResult) GetSuccess() *user.GetAddressResp {
+	if !p.IsSetSuccess() {
+		return GetAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetAddressResp)
+}
+
+func (p *GetAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.CreateAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).CreateAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateAddressArgs:
+		success, err := handler.(user.UserService).CreateAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateAddressArgs() interface{} {
+	return &CreateAddressArgs{}
+}
+
+func newCreateAddressResult() interface{} {
+	return &CreateAddressResult{}
+}
+
+type CreateAddressArgs struct {
+	Req *user.CreateAddressReq
+}
+
+func (p *CreateAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.CreateAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.CreateAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateAddressArgs_Req_DEFAULT *user.CreateAddressReq
+
+func (p *CreateAddressArgs) GetReq() *user.CreateAddressReq {
+	if !p.IsSetReq() {
+		return CreateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateAddressResult struct {
+	Success *user.CreateAddressResp
+}
+
+var CreateAddressResult_Success_DEFAULT *user.CreateAddressResp
+
+func (p *CreateAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.CreateAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.CreateAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateAddressResult) GetSuccess() *user.CreateAddressResp {
+	if !p.IsSetSuccess() {
+		return CreateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.CreateAddressResp)
+}
+
+func (p *CreateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateAddressArgs:
+		success, err := handler.(user.UserService).UpdateAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateAddressArgs() interface{} {
+	return &UpdateAddressArgs{}
+}
+
+func newUpdateAddressResult() interface{} {
+	return &UpdateAddressResult{}
+}
+
+type UpdateAddressArgs struct {
+	Req *user.UpdateAddressReq
+}
+
+func (p *UpdateAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateAddressArgs_Req_DEFAULT *user.UpdateAddressReq
+
+func (p *UpdateAddressArgs) GetReq() *user.UpdateAddressReq {
+	if !p.IsSetReq() {
+		return UpdateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateAddressResult struct {
+	Success *user.UpdateAddressResp
+}
+
+var UpdateAddressResult_Success_DEFAULT *user.UpdateAddressResp
+
+func (p *UpdateAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateAddressResult) GetSuccess() *user.UpdateAddressResp {
+	if !p.IsSetSuccess() {
+		return UpdateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateAddressResp)
+}
+
+func (p *UpdateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DeleteAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DeleteAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteAddressArgs:
+		success, err := handler.(user.UserService).DeleteAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteAddressArgs() interface{} {
+	return &DeleteAddressArgs{}
+}
+
+func newDeleteAddressResult() interface{} {
+	return &DeleteAddressResult{}
+}
+
+type DeleteAddressArgs struct {
+	Req *user.DeleteAddressReq
+}
+
+func (p *DeleteAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DeleteAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteAddressArgs_Req_DEFAULT *user.DeleteAddressReq
+
+func (p *DeleteAddressArgs) GetReq() *user.DeleteAddressReq {
+	if !p.IsSetReq() {
+		return DeleteAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteAddressResult struct {
+	Success *user.DeleteAddressResp
+}
+
+var DeleteAddressResult_Success_DEFAULT *user.DeleteAddressResp
+
+func (p *DeleteAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DeleteAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteAddressResult) GetSuccess() *user.DeleteAddressResp {
+	if !p.IsSetSuccess() {
+		return DeleteAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DeleteAddressResp)
+}
+
+func (p *DeleteAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func setDefaultAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SetDefaultAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SetDefaultAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SetDefaultAddressArgs:
+		success, err := handler.(user.UserService).SetDefaultAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SetDefaultAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSetDefaultAddressArgs() interface{} {
+	return &SetDefaultAddressArgs{}
+}
+
+func newSetDefaultAddressResult() interface{} {
+	return &SetDefaultAddressResult{}
+}
+
+type SetDefaultAddressArgs struct {
+	Req *user.SetDefaultAddressReq
+}
+
+func (p *SetDefaultAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.SetDefaultAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SetDefaultAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SetDefaultAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SetDefaultAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SetDefaultAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.SetDefaultAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SetDefaultAddressArgs_Req_DEFAULT *user.SetDefaultAddressReq
+
+func (p *SetDefaultAddressArgs) GetReq() *user.SetDefaultAddressReq {
+	if !p.IsSetReq() {
+		return SetDefaultAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SetDefaultAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SetDefaultAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SetDefaultAddressResult struct {
+	Success *user.SetDefaultAddressResp
+}
+
+var SetDefaultAddressResult_Success_DEFAULT *user.SetDefaultAddressResp
+
+func (p *SetDefaultAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.SetDefaultAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SetDefaultAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SetDefaultAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SetDefaultAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SetDefaultAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.SetDefaultAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SetDefaultAddressResult) GetSuccess() *user.SetDefaultAddressResp {
+	if !p.IsSetSuccess() {
+		return SetDefaultAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SetDefaultAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.SetDefaultAddressResp)
+}
+
+func (p *SetDefaultAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SetDefaultAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func requestExportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RequestExportReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RequestExport(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RequestExportArgs:
+		success, err := handler.(user.UserService).RequestExport(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RequestExportResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRequestExportArgs() interface{} {
+	return &RequestExportArgs{}
+}
+
+func newRequestExportResult() interface{} {
+	return &RequestExportResult{}
+}
+
+type RequestExportArgs struct {
+	Req *user.RequestExportReq
+}
+
+func (p *RequestExportArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RequestExportReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RequestExportArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RequestExportArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RequestExportArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RequestExportArgs) Unmarshal(in []byte) error {
+	msg := new(user.RequestExportReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RequestExportArgs_Req_DEFAULT *user.RequestExportReq
+
+func (p *RequestExportArgs) GetReq() *user.RequestExportReq {
+	if !p.IsSetReq() {
+		return RequestExportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RequestExportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RequestExportArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RequestExportResult struct {
+	Success *user.RequestExportResp
+}
+
+var RequestExportResult_Success_DEFAULT *user.RequestExportResp
+
+func (p *RequestExportResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RequestExportResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RequestExportResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RequestExportResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RequestExportResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RequestExportResult) Unmarshal(in []byte) error {
+	msg := new(user.RequestExportResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RequestExportResult) GetSuccess() *user.RequestExportResp {
+	if !p.IsSetSuccess() {
+		return RequestExportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RequestExportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RequestExportResp)
+}
+
+func (p *RequestExportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RequestExportResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getExportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetExportReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetExport(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetExportArgs:
+		success, err := handler.(user.UserService).GetExport(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetExportResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetExportArgs() interface{} {
+	return &GetExportArgs{}
+}
+
+func newGetExportResult() interface{} {
+	return &GetExportResult{}
+}
+
+type GetExportArgs struct {
+	Req *user.GetExportReq
+}
+
+func (p *GetExportArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetExportReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetExportArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetExportArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetExportArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetExportArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetExportReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetExportArgs_Req_DEFAULT *user.GetExportReq
+
+func (p *GetExportArgs) GetReq() *user.GetExportReq {
+	if !p.IsSetReq() {
+		return GetExportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetExportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetExportArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetExportResult struct {
+	Success *user.GetExportResp
+}
+
+var GetExportResult_Success_DEFAULT *user.GetExportResp
+
+func (p *GetExportResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetExportResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetExportResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetExportResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetExportResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetExportResult) Unmarshal(in []byte) error {
+	msg := new(user.GetExportResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetExportResult) GetSuccess() *user.GetExportResp {
+	if !p.IsSetSuccess() {
+		return GetExportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetExportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetExportResp)
+}
+
+func (p *GetExportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetExportResult) GetResult() interface{} {
+	return p.Success
+}
+
+func uploadAvatarHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UploadAvatarReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UploadAvatar(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UploadAvatarArgs:
+		success, err := handler.(user.UserService).UploadAvatar(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UploadAvatarResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUploadAvatarArgs() interface{} {
+	return &UploadAvatarArgs{}
+}
+
+func newUploadAvatarResult() interface{} {
+	return &UploadAvatarResult{}
+}
+
+type UploadAvatarArgs struct {
+	Req *user.UploadAvatarReq
+}
+
+func (p *UploadAvatarArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UploadAvatarReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UploadAvatarArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UploadAvatarArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UploadAvatarArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UploadAvatarArgs) Unmarshal(in []byte) error {
+	msg := new(user.UploadAvatarReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UploadAvatarArgs_Req_DEFAULT *user.UploadAvatarReq
+
+func (p *UploadAvatarArgs) GetReq() *user.UploadAvatarReq {
+	if !p.IsSetReq() {
+		return UploadAvatarArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UploadAvatarArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UploadAvatarArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UploadAvatarResult struct {
+	Success *user.UploadAvatarResp
+}
+
+var UploadAvatarResult_Success_DEFAULT *user.UploadAvatarResp
+
+func (p *UploadAvatarResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UploadAvatarResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UploadAvatarResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UploadAvatarResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UploadAvatarResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UploadAvatarResult) Unmarshal(in []byte) error {
+	msg := new(user.UploadAvatarResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UploadAvatarResult) GetSuccess() *user.UploadAvatarResp {
+	if !p.IsSetSuccess() {
+		return UploadAvatarResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UploadAvatarResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UploadAvatarResp)
+}
+
+func (p *UploadAvatarResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UploadAvatarResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteAvatarHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DeleteAvatarReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DeleteAvatar(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteAvatarArgs:
+		success, err := handler.(user.UserService).DeleteAvatar(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteAvatarResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteAvatarArgs() interface{} {
+	return &DeleteAvatarArgs{}
+}
+
+func newDeleteAvatarResult() interface{} {
+	return &DeleteAvatarResult{}
+}
+
+type DeleteAvatarArgs struct {
+	Req *user.DeleteAvatarReq
+}
+
+func (p *DeleteAvatarArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DeleteAvatarReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAvatarArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteAvatarArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteAvatarArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteAvatarArgs) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAvatarReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteAvatarArgs_Req_DEFAULT *user.DeleteAvatarReq
+
+func (p *DeleteAvatarArgs) GetReq() *user.DeleteAvatarReq {
+	if !p.IsSetReq() {
+		return DeleteAvatarArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteAvatarArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteAvatarArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteAvatarResult struct {
+	Success *user.DeleteAvatarResp
+}
+
+var DeleteAvatarResult_Success_DEFAULT *user.DeleteAvatarResp
+
+func (p *DeleteAvatarResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DeleteAvatarResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAvatarResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteAvatarResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteAvatarResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteAvatarResult) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAvatarResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteAvatarResult) GetSuccess() *user.DeleteAvatarResp {
+	if !p.IsSetSuccess() {
+		return DeleteAvatarResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteAvatarResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DeleteAvatarResp)
+}
+
+func (p *DeleteAvatarResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteAvatarResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getPointsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetPointsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetPoints(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetPointsArgs:
+		success, err := handler.(user.UserService).GetPoints(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetPointsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetPointsArgs() interface{} {
+	return &GetPointsArgs{}
+}
+
+func newGetPointsResult() interface{} {
+	return &GetPointsResult{}
+}
+
+type GetPointsArgs struct {
+	Req *user.GetPointsReq
+}
+
+func (p *GetPointsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetPointsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetPointsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetPointsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetPointsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetPointsArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetPointsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetPointsArgs_Req_DEFAULT *user.GetPointsReq
+
+func (p *GetPointsArgs) GetReq() *user.GetPointsReq {
+	if !p.IsSetReq() {
+		return GetPointsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetPointsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetPointsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetPointsResult struct {
+	Success *user.GetPointsResp
+}
+
+var GetPointsResult_Success_DEFAULT *user.GetPointsResp
+
+func (p *GetPointsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetPointsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetPointsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetPointsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetPointsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetPointsResult) Unmarshal(in []byte) error {
+	msg := new(user.GetPointsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetPointsResult) GetSuccess() *user.GetPointsResp {
+	if !p.IsSetSuccess() {
+		return GetPointsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetPointsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetPointsResp)
+}
+
+func (p *GetPointsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetPointsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listPointsHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListPointsHistoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListPointsHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListPointsHistoryArgs:
+		success, err := handler.(user.UserService).ListPointsHistory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListPointsHistoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListPointsHistoryArgs() interface{} {
+	return &ListPointsHistoryArgs{}
+}
+
+func newListPointsHistoryResult() interface{} {
+	return &ListPointsHistoryResult{}
+}
+
+type ListPointsHistoryArgs struct {
+	Req *user.ListPointsHistoryReq
+}
+
+func (p *ListPointsHistoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListPointsHistoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListPointsHistoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListPointsHistoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListPointsHistoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListPointsHistoryArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListPointsHistoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListPointsHistoryArgs_Req_DEFAULT *user.ListPointsHistoryReq
+
+func (p *ListPointsHistoryArgs) GetReq() *user.ListPointsHistoryReq {
+	if !p.IsSetReq() {
+		return ListPointsHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListPointsHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListPointsHistoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListPointsHistoryResult struct {
+	Success *user.ListPointsHistoryResp
+}
+
+var ListPointsHistoryResult_Success_DEFAULT *user.ListPointsHistoryResp
+
+func (p *ListPointsHistoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListPointsHistoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListPointsHistoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListPointsHistoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListPointsHistoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListPointsHistoryResult) Unmarshal(in []byte) error {
+	msg := new(user.ListPointsHistoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListPointsHistoryResult) GetSuccess() *user.ListPointsHistoryResp {
+	if !p.IsSetSuccess() {
+		return ListPointsHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListPointsHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListPointsHistoryResp)
+}
+
+func (p *ListPointsHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListPointsHistoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func redeemPointsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RedeemPointsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RedeemPoints(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RedeemPointsArgs:
+		success, err := handler.(user.UserService).RedeemPoints(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RedeemPointsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRedeemPointsArgs() interface{} {
+	return &RedeemPointsArgs{}
+}
+
+func newRedeemPointsResult() interface{} {
+	return &RedeemPointsResult{}
+}
+
+type RedeemPointsArgs struct {
+	Req *user.RedeemPointsReq
+}
+
+func (p *RedeemPointsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RedeemPointsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RedeemPointsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RedeemPointsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RedeemPointsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RedeemPointsArgs) Unmarshal(in []byte) error {
+	msg := new(user.RedeemPointsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RedeemPointsArgs_Req_DEFAULT *user.RedeemPointsReq
+
+func (p *RedeemPointsArgs) GetReq() *user.RedeemPointsReq {
+	if !p.IsSetReq() {
+		return RedeemPointsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RedeemPointsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RedeemPointsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RedeemPointsResult struct {
+	Success *user.RedeemPointsResp
+}
+
+var RedeemPointsResult_Success_DEFAULT *user.RedeemPointsResp
+
+func (p *RedeemPointsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RedeemPointsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RedeemPointsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RedeemPointsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RedeemPointsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RedeemPointsResult) Unmarshal(in []byte) error {
+	msg := new(user.RedeemPointsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RedeemPointsResult) GetSuccess() *user.RedeemPointsResp {
+	if !p.IsSetSuccess() {
+		return RedeemPointsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RedeemPointsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RedeemPointsResp)
+}
+
+func (p *RedeemPointsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RedeemPointsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelRedemptionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.CancelRedemptionReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).CancelRedemption(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CancelRedemptionArgs:
+		success, err := handler.(user.UserService).CancelRedemption(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelRedemptionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCancelRedemptionArgs() interface{} {
+	return &CancelRedemptionArgs{}
+}
+
+func newCancelRedemptionResult() interface{} {
+	return &CancelRedemptionResult{}
+}
+
+type CancelRedemptionArgs struct {
+	Req *user.CancelRedemptionReq
+}
+
+func (p *CancelRedemptionArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.CancelRedemptionReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CancelRedemptionArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CancelRedemptionArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CancelRedemptionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelRedemptionArgs) Unmarshal(in []byte) error {
+	msg := new(user.CancelRedemptionReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelRedemptionArgs_Req_DEFAULT *user.CancelRedemptionReq
+
+func (p *CancelRedemptionArgs) GetReq() *user.CancelRedemptionReq {
+	if !p.IsSetReq() {
+		return CancelRedemptionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelRedemptionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelRedemptionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelRedemptionResult struct {
+	Success *user.CancelRedemptionResp
+}
+
+var CancelRedemptionResult_Success_DEFAULT *user.CancelRedemptionResp
+
+func (p *CancelRedemptionResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.CancelRedemptionResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CancelRedemptionResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CancelRedemptionResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CancelRedemptionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelRedemptionResult) Unmarshal(in []byte) error {
+	msg := new(user.CancelRedemptionResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelRedemptionResult) GetSuccess() *user.CancelRedemptionResp {
+	if !p.IsSetSuccess() {
+		return CancelRedemptionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelRedemptionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.CancelRedemptionResp)
+}
+
+func (p *CancelRedemptionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelRedemptionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listWishlistsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListWishlistsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListWishlists(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListWishlistsArgs:
+		success, err := handler.(user.UserService).ListWishlists(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListWishlistsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListWishlistsArgs() interface{} {
+	return &ListWishlistsArgs{}
+}
+
+func newListWishlistsResult() interface{} {
+	return &ListWishlistsResult{}
+}
+
+type ListWishlistsArgs struct {
+	Req *user.ListWishlistsReq
+}
+
+func (p *ListWishlistsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListWishlistsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListWishlistsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListWishlistsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListWishlistsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListWishlistsArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListWishlistsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListWishlistsArgs_Req_DEFAULT *user.ListWishlistsReq
+
+func (p *ListWishlistsArgs) GetReq() *user.ListWishlistsReq {
+	if !p.IsSetReq() {
+		return ListWishlistsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListWishlistsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListWishlistsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListWishlistsResult struct {
+	Success *user.ListWishlistsResp
+}
+
+var ListWishlistsResult_Success_DEFAULT *user.ListWishlistsResp
+
+func (p *ListWishlistsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListWishlistsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListWishlistsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListWishlistsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListWishlistsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListWishlistsResult) Unmarshal(in []byte) error {
+	msg := new(user.ListWishlistsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListWishlistsResult) GetSuccess() *user.ListWishlistsResp {
+	if !p.IsSetSuccess() {
+		return ListWishlistsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListWishlistsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListWishlistsResp)
+}
+
+func (p *ListWishlistsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListWishlistsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func addWishlistItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.AddWishlistItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).AddWishlistItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AddWishlistItemArgs:
+		success, err := handler.(user.UserService).AddWishlistItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AddWishlistItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAddWishlistItemArgs() interface{} {
+	return &AddWishlistItemArgs{}
+}
+
+func newAddWishlistItemResult() interface{} {
+	return &AddWishlistItemResult{}
+}
+
+type AddWishlistItemArgs struct {
+	Req *user.AddWishlistItemReq
+}
+
+func (p *AddWishlistItemArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.AddWishlistItemReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *AddWishlistItemArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *AddWishlistItemArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *AddWishlistItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AddWishlistItemArgs) Unmarshal(in []byte) error {
+	msg := new(user.AddWishlistItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AddWishlistItemArgs_Req_DEFAULT *user.AddWishlistItemReq
+
+func (p *AddWishlistItemArgs) GetReq() *user.AddWishlistItemReq {
+	if !p.IsSetReq() {
+		return AddWishlistItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AddWishlistItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AddWishlistItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AddWishlistItemResult struct {
+	Success *user.AddWishlistItemResp
+}
+
+var AddWishlistItemResult_Success_DEFAULT *user.AddWishlistItemResp
+
+func (p *AddWishlistItemResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.AddWishlistItemResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *AddWishlistItemResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *AddWishlistItemResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *AddWishlistItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AddWishlistItemResult) Unmarshal(in []byte) error {
+	msg := new(user.AddWishlistItemResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AddWishlistItemResult) GetSuccess() *user.AddWishlistItemResp {
+	if !p.IsSetSuccess() {
+		return AddWishlistItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AddWishlistItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.AddWishlistItemResp)
+}
+
+func (p *AddWishlistItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AddWishlistItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func removeWishlistItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RemoveWishlistItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RemoveWishlistItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RemoveWishlistItemArgs:
+		success, err := handler.(user.UserService).RemoveWishlistItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RemoveWishlistItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRemoveWishlistItemArgs() interface{} {
+	return &RemoveWishlistItemArgs{}
+}
+
+func newRemoveWishlistItemResult() interface{} {
+	return &RemoveWishlistItemResult{}
+}
+
+type RemoveWishlistItemArgs struct {
+	Req *user.RemoveWishlistItemReq
+}
+
+func (p *RemoveWishlistItemArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RemoveWishlistItemReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RemoveWishlistItemArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RemoveWishlistItemArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RemoveWishlistItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RemoveWishlistItemArgs) Unmarshal(in []byte) error {
+	msg := new(user.RemoveWishlistItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RemoveWishlistItemArgs_Req_DEFAULT *user.RemoveWishlistItemReq
+
+func (p *RemoveWishlistItemArgs) GetReq() *user.RemoveWishlistItemReq {
+	if !p.IsSetReq() {
+		return RemoveWishlistItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RemoveWishlistItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RemoveWishlistItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RemoveWishlistItemResult struct {
+	Success *user.RemoveWishlistItemResp
+}
+
+var RemoveWishlistItemResult_Success_DEFAULT *user.RemoveWishlistItemResp
+
+func (p *RemoveWishlistItemResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RemoveWishlistItemResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RemoveWishlistItemResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RemoveWishlistItemResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RemoveWishlistItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RemoveWishlistItemResult) Unmarshal(in []byte) error {
+	msg := new(user.RemoveWishlistItemResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RemoveWishlistItemResult) GetSuccess() *user.RemoveWishlistItemResp {
+	if !p.IsSetSuccess() {
+		return RemoveWishlistItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RemoveWishlistItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RemoveWishlistItemResp)
+}
+
+func (p *RemoveWishlistItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RemoveWishlistItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteWishlistHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DeleteWishlistReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DeleteWishlist(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteWishlistArgs:
+		success, err := handler.(user.UserService).DeleteWishlist(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteWishlistResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteWishlistArgs() interface{} {
+	return &DeleteWishlistArgs{}
+}
+
+func newDeleteWishlistResult() interface{} {
+	return &DeleteWishlistResult{}
+}
+
+type DeleteWishlistArgs struct {
+	Req *user.DeleteWishlistReq
+}
+
+func (p *DeleteWishlistArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DeleteWishlistReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteWishlistArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteWishlistArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteWishlistArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteWishlistArgs) Unmarshal(in []byte) error {
+	msg := new(user.DeleteWishlistReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteWishlistArgs_Req_DEFAULT *user.DeleteWishlistReq
+
+func (p *DeleteWishlistArgs) GetReq() *user.DeleteWishlistReq {
+	if !p.IsSetReq() {
+		return DeleteWishlistArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteWishlistArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteWishlistArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteWishlistResult struct {
+	Success *user.DeleteWishlistResp
+}
+
+var DeleteWishlistResult_Success_DEFAULT *user.DeleteWishlistResp
+
+func (p *DeleteWishlistResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DeleteWishlistResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteWishlistResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteWishlistResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteWishlistResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteWishlistResult) Unmarshal(in []byte) error {
+	msg := new(user.DeleteWishlistResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteWishlistResult) GetSuccess() *user.DeleteWishlistResp {
+	if !p.IsSetSuccess() {
+		return DeleteWishlistResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteWishlistResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DeleteWishlistResp)
+}
+
+func (p *DeleteWishlistResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteWishlistResult) GetResult() interface{} {
+	return p.Success
+}
+
+func subscribeWishlistItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SubscribeWishlistItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SubscribeWishlistItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SubscribeWishlistItemArgs:
+		success, err := handler.(user.UserService).SubscribeWishlistItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SubscribeWishlistItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSubscribeWishlistItemArgs() interface{} {
+	return &SubscribeWishlistItemArgs{}
+}
+
+func newSubscribeWishlistItemResult() interface{} {
+	return &SubscribeWishlistItemResult{}
+}
+
+type SubscribeWishlistItemArgs struct {
+	Req *user.SubscribeWishlistItemReq
+}
+
+func (p *SubscribeWishlistItemArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.SubscribeWishlistItemReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SubscribeWishlistItemArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SubscribeWishlistItemArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SubscribeWishlistItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SubscribeWishlistItemArgs) Unmarshal(in []byte) error {
+	msg := new(user.SubscribeWishlistItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SubscribeWishlistItemArgs_Req_DEFAULT *user.SubscribeWishlistItemReq
+
+func (p *SubscribeWishlistItemArgs) GetReq() *user.SubscribeWishlistItemReq {
+	if !p.IsSetReq() {
+		return SubscribeWishlistItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SubscribeWishlistItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SubscribeWishlistItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SubscribeWishlistItemResult struct {
+	Success *user.SubscribeWishlistItemResp
+}
+
+var SubscribeWishlistItemResult_Success_DEFAULT *user.SubscribeWishlistItemResp
+
+func (p *SubscribeWishlistItemResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.SubscribeWishlistItemResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SubscribeWishlistItemResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SubscribeWishlistItemResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SubscribeWishlistItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SubscribeWishlistItemResult) Unmarshal(in []byte) error {
+	msg := new(user.SubscribeWishlistItemResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SubscribeWishlistItemResult) GetSuccess() *user.SubscribeWishlistItemResp {
+	if !p.IsSetSuccess() {
+		return SubscribeWishlistItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SubscribeWishlistItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.SubscribeWishlistItemResp)
+}
+
+func (p *SubscribeWishlistItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SubscribeWishlistItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) Register(ctx context.Context, Req *user.RegisterReq) (r *user.RegisterResp, err error) {
+	var _args RegisterArgs
+	_args.Req = Req
+	var _result RegisterResult
+	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Login(ctx context.Context, Req *user.LoginReq) (r *user.LoginResp, err error) {
+	var _args LoginArgs
+	_args.Req = Req
+	var _result LoginResult
+	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Logout(ctx context.Context, Req *user.LogoutReq) (r *user.LogoutResp, err error) {
+	var _args LogoutArgs
+	_args.Req = Req
+	var _result LogoutResult
+	if err = p.c.Call(ctx, "Logout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Delete(ctx context.Context, Req *user.DeleteReq) (r *user.DeleteResp, err error) {
+	var _args DeleteArgs
+	_args.Req = Req
+	var _result DeleteResult
+	if err = p.c.Call(ctx, "Delete", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelDelete(ctx context.Context, Req *user.CancelDeleteReq) (r *user.CancelDeleteResp, err error) {
+	var _args CancelDeleteArgs
+	_args.Req = Req
+	var _result CancelDeleteResult
+	if err = p.c.Call(ctx, "CancelDelete", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Update(ctx context.Context, Req *user.UpdateReq) (r *user.UpdateResp, err error) {
+	var _args UpdateArgs
+	_args.Req = Req
+	var _result UpdateResult
+	if err = p.c.Call(ctx, "Update", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Info(ctx context.Context, Req *user.InfoReq) (r *user.InfoResp, err error) {
+	var _args InfoArgs
+	_args.Req = Req
+	var _result InfoResult
+	if err = p.c.Call(ctx, "Info", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAddresses(ctx context.Context, Req *user.ListAddressesReq) (r *user.ListAddressesResp, err error) {
+	var _args ListAddressesArgs
+	_args.Req = Req
+	var _result ListAddressesResult
+	if err = p.c.Call(ctx, "ListAddresses", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAddress(ctx context.Context, Req *user.GetAddressReq) (r *user.GetAddressResp, err error) {
+	var _args GetAddressArgs
+	_args.Req = Req
+	var _result GetAddressResult
+	if err = p.c.Call(ctx, "GetAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAddress(ctx context.Context, Req *user.CreateAddressReq) (r *user.CreateAddressResp, err error) {
+	var _args CreateAddressArgs
+	_args.Req = Req
+	var _result CreateAddressResult
+	if err = p.c.Call(ctx, "CreateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq) (r *user.UpdateAddressResp, err error) {
+	var _args UpdateAddressArgs
+	_args.Req = Req
+	var _result UpdateAddressResult
+	if err = p.c.Call(ctx, "UpdateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq) (r *user.DeleteAddressResp, err error) {
+	var _args DeleteAddressArgs
+	_args.Req = Req
+	var _result DeleteAddressResult
+	if err = p.c.Call(ctx, "DeleteAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq) (r *user.SetDefaultAddressResp, err error) {
+	var _args SetDefaultAddressArgs
+	_args.Req = Req
+	var _result SetDefaultAddressResult
+	if err = p.c.Call(ctx, "SetDefaultAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestExport(ctx context.Context, Req *user.RequestExportReq) (r *user.RequestExportResp, err error) {
+	var _args RequestExportArgs
+	_args.Req = Req
+	var _result RequestExportResult
+	if err = p.c.Call(ctx, "RequestExport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetExport(ctx context.Context, Req *user.GetExportReq) (r *user.GetExportResp, err error) {
+	var _args GetExportArgs
+	_args.Req = Req
+	var _result GetExportResult
+	if err = p.c.Call(ctx, "GetExport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadAvatar(ctx context.Context, Req *user.UploadAvatarReq) (r *user.UploadAvatarResp, err error) {
+	var _args UploadAvatarArgs
+	_args.Req = Req
+	var _result UploadAvatarResult
+	if err = p.c.Call(ctx, "UploadAvatar", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAvatar(ctx context.Context, Req *user.DeleteAvatarReq) (r *user.DeleteAvatarResp, err error) {
+	var _args DeleteAvatarArgs
+	_args.Req = Req
+	var _result DeleteAvatarResult
+	if err = p.c.Call(ctx, "DeleteAvatar", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPoints(ctx context.Context, Req *user.GetPointsReq) (r *user.GetPointsResp, err error) {
+	var _args GetPointsArgs
+	_args.Req = Req
+	var _result GetPointsResult
+	if err = p.c.Call(ctx, "GetPoints", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPointsHistory(ctx context.Context, Req *user.ListPointsHistoryReq) (r *user.ListPointsHistoryResp, err error) {
+	var _args ListPointsHistoryArgs
+	_args.Req = Req
+	var _result ListPointsHistoryResult
+	if err = p.c.Call(ctx, "ListPointsHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RedeemPoints(ctx context.Context, Req *user.RedeemPointsReq) (r *user.RedeemPointsResp, err error) {
+	var _args RedeemPointsArgs
+	_args.Req = Req
+	var _result RedeemPointsResult
+	if err = p.c.Call(ctx, "RedeemPoints", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelRedemption(ctx context.Context, Req *user.CancelRedemptionReq) (r *user.CancelRedemptionResp, err error) {
+	var _args CancelRedemptionArgs
+	_args.Req = Req
+	var _result CancelRedemptionResult
+	if err = p.c.Call(ctx, "CancelRedemption", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListWishlists(ctx context.Context, Req *user.ListWishlistsReq) (r *user.ListWishlistsResp, err error) {
+	var _args ListWishlistsArgs
+	_args.Req = Req
+	var _result ListWishlistsResult
+	if err = p.c.Call(ctx, "ListWishlists", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddWishlistItem(ctx context.Context, Req *user.AddWishlistItemReq) (r *user.AddWishlistItemResp, err error) {
+	var _args AddWishlistItemArgs
+	_args.Req = Req
+	var _result AddWishlistItemResult
+	if err = p.c.Call(ctx, "AddWishlistItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveWishlistItem(ctx context.Context, Req *user.RemoveWishlistItemReq) (r *user.RemoveWishlistItemResp, err error) {
+	var _args RemoveWishlistItemArgs
+	_args.Req = Req
+	var _result RemoveWishlistItemResult
+	if err = p.c.Call(ctx, "RemoveWishlistItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteWishlist(ctx context.Context, Req *user.DeleteWishlistReq) (r *user.DeleteWishlistResp, err error) {
+	var _args DeleteWishlistArgs
+	_args.Req = Req
+	var _result DeleteWishlistResult
+	if err = p.c.Call(ctx, "DeleteWishlist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubscribeWishlistItem(ctx context.Context, Req *user.SubscribeWishlistItemReq) (r *user.SubscribeWishlistItemResp, err error) {
+	var _args SubscribeWishlistItemArgs
+	_args.Req = Req
+	var _result SubscribeWishlistItemResult
+	if err = p.c.Call(ctx, "SubscribeWishlistItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -8,16 +9,20 @@ import (
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/app/server/registry"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
-	"github.com/hertz-contrib/cors"
 
 	"TikTokMall/app/checkout/biz/dal/mysql"
 	"TikTokMall/app/checkout/biz/dal/redis"
 	"TikTokMall/app/checkout/biz/handler"
 	"TikTokMall/app/checkout/biz/utils"
 	"TikTokMall/app/checkout/conf"
+	"TikTokMall/app/checkout/kitex_gen/auth"
+	"TikTokMall/app/checkout/kitex_gen/auth/authservice"
+	"TikTokMall/app/checkout/pkg/client"
 	"TikTokMall/app/checkout/pkg/hertz"
 	"TikTokMall/app/checkout/pkg/mtls"
 	"TikTokMall/app/checkout/pkg/tracer"
+	"TikTokMall/pkg/authn"
+	"TikTokMall/pkg/websession"
 )
 
 func main() {
@@ -74,13 +79,11 @@ func main() {
 		}
 	}
 
-	// 添加CORS中间件
-	h.Use(cors.New(cors.Config{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		MaxAge:       3600,
-	}))
+	// 添加CORS中间件，只允许配置的前端来源携带 Cookie
+	h.Use(websession.CORS(websession.CORSConfig{AllowOrigins: config.CORS.AllowOrigins}))
+
+	// 浏览器 Cookie 会话：校验 CSRF 令牌并将 Cookie 中的访问令牌转为 Authorization 头
+	h.Use(websession.Middleware())
 
 	// 添加恢复中间件
 	h.Use(recovery.Recovery())
@@ -88,8 +91,16 @@ func main() {
 	// 创建处理器
 	checkoutHandler := handler.NewCheckoutHTTPHandler()
 
-	// 注册路由
-	v1 := h.Group("/v1/checkout")
+	authClient, err := authservice.NewClient(config.AuthRPC.Service, client.NewClientOptions(config.AuthRPC)...)
+	if err != nil {
+		hlog.Fatalf("create auth client failed: %v", err)
+	}
+
+	// 注册路由，要求携带访问令牌
+	verifier := authn.NewRPCVerifier(func(ctx context.Context, token string, withRoles bool) (*auth.VerifyResp, error) {
+		return authClient.VerifyTokenByRPC(ctx, &auth.VerifyTokenReq{Token: token, WithRoles: withRoles})
+	})
+	v1 := h.Group("/v1/checkout", authn.Authenticate(verifier))
 	{
 		v1.POST("/create", checkoutHandler.CreateOrder)
 		v1.POST("/pay", checkoutHandler.ProcessPayment)
@@ -6,6 +6,7 @@ const (
 	OrderStatusPaid     = 2 // 已支付
 	OrderStatusCanceled = 3 // 已取消
 	OrderStatusComplete = 4 // 已完成
+	OrderStatusRefunded = 5 // 已退款
 )
 
 // 支付方式常量
@@ -43,3 +43,27 @@ func ListOrdersByUserID(ctx context.Context, userID uint32) ([]*Order, error) {
 func UpdateOrderStatus(ctx context.Context, orderID int64, status int8) error {
 	return DB.WithContext(ctx).Model(&Order{}).Where("id = ?", orderID).Update("status", status).Error
 }
+
+// TransitionOrderStatus 订单处于 from 中的状态时更新为 to，返回是否更新
+func TransitionOrderStatus(ctx context.Context, orderID int64, from []int8, to int8) (bool, error) {
+	result := DB.WithContext(ctx).Model(&Order{}).
+		Where("id = ? AND status IN ?", orderID, from).
+		Update("status", to)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListOrderItemsByOrderIDs 获取多个订单的订单项
+func ListOrderItemsByOrderIDs(ctx context.Context, orderIDs []int64) ([]*OrderItem, error) {
+	var items []*OrderItem
+	if len(orderIDs) == 0 {
+		return items, nil
+	}
+	err := DB.WithContext(ctx).Where("order_id IN ?", orderIDs).Find(&items).Error
+	return items, err
+}
+
+// AnonymizeOrdersByUserID 清除用户订单中的邮箱和收货地址，订单金额和商品作为交易记录保留
+func AnonymizeOrdersByUserID(ctx context.Context, userID uint32) error {
+	return DB.WithContext(ctx).Model(&Order{}).Where("user_id = ?", userID).
+		Updates(map[string]interface{}{"email": "", "shipping_address": nil}).Error
+}
@@ -2,6 +2,9 @@ package mysql
 
 import (
 	"context"
+	"errors"
+
+	"gorm.io/gorm"
 )
 
 type OrderMySQLRepository struct{}
@@ -21,3 +24,24 @@ func (r *OrderMySQLRepository) ListOrdersByUserID(ctx context.Context, userID ui
 func (r *OrderMySQLRepository) UpdateOrderStatus(ctx context.Context, orderID int64, status int8) error {
 	return UpdateOrderStatus(ctx, orderID, status)
 }
+
+// GetOrderByID 查询订单，不存在时返回 nil
+func (r *OrderMySQLRepository) GetOrderByID(ctx context.Context, orderID int64) (*Order, error) {
+	order, err := GetOrderByID(ctx, orderID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return order, err
+}
+
+func (r *OrderMySQLRepository) TransitionOrderStatus(ctx context.Context, orderID int64, from []int8, to int8) (bool, error) {
+	return TransitionOrderStatus(ctx, orderID, from, to)
+}
+
+func (r *OrderMySQLRepository) ListOrderItemsByOrderIDs(ctx context.Context, orderIDs []int64) ([]*OrderItem, error) {
+	return ListOrderItemsByOrderIDs(ctx, orderIDs)
+}
+
+func (r *OrderMySQLRepository) AnonymizeOrdersByUserID(ctx context.Context, userID uint32) error {
+	return AnonymizeOrdersByUserID(ctx, userID)
+}
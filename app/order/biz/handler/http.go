@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -10,6 +11,7 @@ import (
 	"TikTokMall/app/order/biz/dal/mysql"
 	"TikTokMall/app/order/biz/service"
 	"TikTokMall/app/order/kitex_gen/order"
+	"TikTokMall/pkg/authn"
 )
 
 type OrderHTTPHandler struct {
@@ -32,6 +34,9 @@ func (h *OrderHTTPHandler) PlaceOrder(c context.Context, ctx *app.RequestContext
 		})
 		return
 	}
+	if !authorizeUser(c, ctx, &req.UserId) {
+		return
+	}
 
 	resp, err := h.svc.PlaceOrder(c, &req)
 	if err != nil {
@@ -48,26 +53,21 @@ func (h *OrderHTTPHandler) PlaceOrder(c context.Context, ctx *app.RequestContext
 func (h *OrderHTTPHandler) ListOrder(c context.Context, ctx *app.RequestContext) {
 	var req order.ListOrderReq
 
-	// 从查询参数中获取 user_id
-	userIDStr := ctx.Query("user_id")
-	if userIDStr == "" {
-		ctx.JSON(consts.StatusBadRequest, map[string]interface{}{
-			"error": "invalid user_id",
-		})
-		return
+	// 查询参数中的 user_id 可选，未传时查询当前用户的订单
+	if userIDStr := ctx.Query("user_id"); userIDStr != "" {
+		userID, err := strconv.ParseUint(userIDStr, 10, 32)
+		if err != nil {
+			ctx.JSON(consts.StatusBadRequest, map[string]interface{}{
+				"error": "invalid user_id format",
+			})
+			return
+		}
+		req.UserId = uint32(userID)
 	}
-
-	// 转换为 uint32
-	userID, err := strconv.ParseUint(userIDStr, 10, 32)
-	if err != nil {
-		ctx.JSON(consts.StatusBadRequest, map[string]interface{}{
-			"error": "invalid user_id format",
-		})
+	if !authorizeUser(c, ctx, &req.UserId) {
 		return
 	}
 
-	req.UserId = uint32(userID)
-
 	resp, err := h.svc.ListOrder(c, &req)
 	if err != nil {
 		ctx.JSON(consts.StatusInternalServerError, map[string]interface{}{
@@ -79,19 +79,22 @@ func (h *OrderHTTPHandler) ListOrder(c context.Context, ctx *app.RequestContext)
 	ctx.JSON(consts.StatusOK, resp)
 }
 
-// MarkOrderPaid handles HTTP request for marking order as paid
-func (h *OrderHTTPHandler) MarkOrderPaid(c context.Context, ctx *app.RequestContext) {
-	var req order.MarkOrderPaidReq
+// MarkOrderCompleted handles HTTP request for confirming receipt of an order
+func (h *OrderHTTPHandler) MarkOrderCompleted(c context.Context, ctx *app.RequestContext) {
+	var req order.MarkOrderCompletedReq
 	if err := ctx.BindAndValidate(&req); err != nil {
 		ctx.JSON(consts.StatusBadRequest, map[string]interface{}{
 			"error": err.Error(),
 		})
 		return
 	}
+	if !authorizeUser(c, ctx, &req.UserId) {
+		return
+	}
 
-	resp, err := h.svc.MarkOrderPaid(c, &req)
+	resp, err := h.svc.MarkOrderCompleted(c, &req)
 	if err != nil {
-		ctx.JSON(consts.StatusInternalServerError, map[string]interface{}{
+		ctx.JSON(statusErrorCode(err), map[string]interface{}{
 			"error": err.Error(),
 		})
 		return
@@ -99,3 +102,32 @@ func (h *OrderHTTPHandler) MarkOrderPaid(c context.Context, ctx *app.RequestCont
 
 	ctx.JSON(consts.StatusOK, resp)
 }
+
+// statusErrorCode 订单状态变化失败对应的状态码
+func statusErrorCode(err error) int {
+	switch {
+	case errors.Is(err, service.ErrOrderNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrInvalidStatusTransition):
+		return consts.StatusConflict
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// authorizeUser 校验请求中的 user_id 与访问令牌一致，未传时使用令牌中的用户；失败时写入响应并返回 false
+func authorizeUser(c context.Context, ctx *app.RequestContext, userID *uint32) bool {
+	id, err := authn.UserID(c, int64(*userID))
+	if err != nil {
+		status := consts.StatusUnauthorized
+		if errors.Is(err, authn.ErrUserMismatch) {
+			status = consts.StatusForbidden
+		}
+		ctx.JSON(status, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return false
+	}
+	*userID = uint32(id)
+	return true
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/order/kitex_gen/order"
+	"TikTokMall/pkg/apisign"
+)
+
+// OpenPlaceOrder 处理开放平台下单请求，订单属于 API 密钥所属的用户
+func (h *OrderHTTPHandler) OpenPlaceOrder(c context.Context, ctx *app.RequestContext) {
+	caller, ok := apisign.FromContext(c)
+	if !ok {
+		ctx.JSON(consts.StatusUnauthorized, map[string]interface{}{
+			"error": "api signature required",
+		})
+		return
+	}
+
+	var req order.PlaceOrderReq
+	if err := ctx.BindAndValidate(&req); err != nil {
+		ctx.JSON(consts.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if req.UserId != 0 && int64(req.UserId) != caller.UserID {
+		ctx.JSON(consts.StatusForbidden, map[string]interface{}{
+			"error": "user_id does not match api key",
+		})
+		return
+	}
+	req.UserId = uint32(caller.UserID)
+
+	resp, err := h.svc.PlaceOrder(c, &req)
+	if err != nil {
+		ctx.JSON(consts.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(consts.StatusOK, resp)
+}
+
+// OpenListOrder 处理开放平台订单查询请求，只返回 API 密钥所属用户的订单
+func (h *OrderHTTPHandler) OpenListOrder(c context.Context, ctx *app.RequestContext) {
+	caller, ok := apisign.FromContext(c)
+	if !ok {
+		ctx.JSON(consts.StatusUnauthorized, map[string]interface{}{
+			"error": "api signature required",
+		})
+		return
+	}
+
+	resp, err := h.svc.ListOrder(c, &order.ListOrderReq{UserId: uint32(caller.UserID)})
+	if err != nil {
+		ctx.JSON(consts.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"TikTokMall/app/order/biz/service/mock"
+	"TikTokMall/app/order/kitex_gen/order"
+	"TikTokMall/pkg/apisign"
+)
+
+func TestOrderHTTPHandler_OpenPlaceOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock.NewMockOrderService(ctrl)
+	handler := &OrderHTTPHandler{svc: mockService}
+	caller := &apisign.Caller{KeyID: "ak_test", UserID: 7, Scopes: []string{"order:write"}}
+
+	tests := []struct {
+		name       string
+		caller     *apisign.Caller
+		req        *order.PlaceOrderReq
+		mockSetup  func()
+		wantStatus int
+	}{
+		{
+			name:       "no_signature",
+			req:        &order.PlaceOrderReq{UserCurrency: "CNY"},
+			mockSetup:  func() {},
+			wantStatus: consts.StatusUnauthorized,
+		},
+		{
+			name:       "user_mismatch",
+			caller:     caller,
+			req:        &order.PlaceOrderReq{UserId: 8, UserCurrency: "CNY"},
+			mockSetup:  func() {},
+			wantStatus: consts.StatusForbidden,
+		},
+		{
+			name:   "success",
+			caller: caller,
+			req:    &order.PlaceOrderReq{UserCurrency: "CNY"},
+			mockSetup: func() {
+				mockService.EXPECT().PlaceOrder(gomock.Any(), &order.PlaceOrderReq{
+					UserId:       7,
+					UserCurrency: "CNY",
+				}).Return(&order.PlaceOrderResp{
+					Order: &order.OrderResult{OrderId: "test-order-id"},
+				}, nil)
+			},
+			wantStatus: consts.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			ctx := context.Background()
+			if tt.caller != nil {
+				ctx = apisign.WithCaller(ctx, tt.caller)
+			}
+			hertzCtx := app.NewContext(16)
+			hertzCtx.Request.Header.SetMethod("POST")
+			hertzCtx.Request.Header.Set("Content-Type", "application/json")
+			reqBody, _ := json.Marshal(tt.req)
+			hertzCtx.Request.SetBody(reqBody)
+			hertzCtx.Request.Header.SetContentLength(len(reqBody))
+
+			handler.OpenPlaceOrder(ctx, hertzCtx)
+
+			assert.Equal(t, tt.wantStatus, hertzCtx.Response.StatusCode())
+		})
+	}
+}
+
+func TestOrderHTTPHandler_OpenListOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock.NewMockOrderService(ctrl)
+	handler := &OrderHTTPHandler{svc: mockService}
+
+	// 忽略查询参数中的 user_id，只查询密钥所属用户的订单
+	mockService.EXPECT().ListOrder(gomock.Any(), &order.ListOrderReq{UserId: 7}).Return(
+		&order.ListOrderResp{Orders: []*order.Order{{OrderId: "test-order-1", UserId: 7}}},
+		nil,
+	)
+
+	ctx := apisign.WithCaller(context.Background(), &apisign.Caller{KeyID: "ak_test", UserID: 7})
+	hertzCtx := app.NewContext(16)
+	hertzCtx.Request.Header.SetMethod("GET")
+	hertzCtx.Request.SetRequestURI("/open/v1/order/list?user_id=1")
+
+	handler.OpenListOrder(ctx, hertzCtx)
+
+	assert.Equal(t, consts.StatusOK, hertzCtx.Response.StatusCode())
+	var got order.ListOrderResp
+	assert.NoError(t, json.Unmarshal(hertzCtx.Response.Body(), &got))
+	assert.Len(t, got.Orders, 1)
+	assert.Equal(t, "test-order-1", got.Orders[0].OrderId)
+
+	hertzCtx = app.NewContext(16)
+	handler.OpenListOrder(context.Background(), hertzCtx)
+	assert.Equal(t, consts.StatusUnauthorized, hertzCtx.Response.StatusCode())
+}
@@ -50,6 +50,21 @@ func (mr *MockOrderServiceMockRecorder) ListOrder(ctx, req interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrder", reflect.TypeOf((*MockOrderService)(nil).ListOrder), ctx, req)
 }
 
+// MarkOrderCompleted mocks base method.
+func (m *MockOrderService) MarkOrderCompleted(ctx context.Context, req *order.MarkOrderCompletedReq) (*order.MarkOrderCompletedResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOrderCompleted", ctx, req)
+	ret0, _ := ret[0].(*order.MarkOrderCompletedResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOrderCompleted indicates an expected call of MarkOrderCompleted.
+func (mr *MockOrderServiceMockRecorder) MarkOrderCompleted(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOrderCompleted", reflect.TypeOf((*MockOrderService)(nil).MarkOrderCompleted), ctx, req)
+}
+
 // MarkOrderPaid mocks base method.
 func (m *MockOrderService) MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq) (*order.MarkOrderPaidResp, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +80,21 @@ func (mr *MockOrderServiceMockRecorder) MarkOrderPaid(ctx, req interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOrderPaid", reflect.TypeOf((*MockOrderService)(nil).MarkOrderPaid), ctx, req)
 }
 
+// MarkOrderRefunded mocks base method.
+func (m *MockOrderService) MarkOrderRefunded(ctx context.Context, req *order.MarkOrderRefundedReq) (*order.MarkOrderRefundedResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOrderRefunded", ctx, req)
+	ret0, _ := ret[0].(*order.MarkOrderRefundedResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOrderRefunded indicates an expected call of MarkOrderRefunded.
+func (mr *MockOrderServiceMockRecorder) MarkOrderRefunded(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOrderRefunded", reflect.TypeOf((*MockOrderService)(nil).MarkOrderRefunded), ctx, req)
+}
+
 // PlaceOrder mocks base method.
 func (m *MockOrderService) PlaceOrder(ctx context.Context, req *order.PlaceOrderReq) (*order.PlaceOrderResp, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,21 +12,63 @@ import (
 	"TikTokMall/app/order/biz/dal/mysql"
 	"TikTokMall/app/order/biz/dal/redis"
 	"TikTokMall/app/order/kitex_gen/order"
+	"TikTokMall/pkg/orderevents"
 )
 
+var (
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrInvalidStatusTransition = errors.New("order status does not allow this operation")
+)
+
+// statusTransitions 目标状态及允许变化到该状态的原状态
+var statusTransitions = map[int8][]int8{
+	mysql.OrderStatusPaid:     {mysql.OrderStatusPending},
+	mysql.OrderStatusComplete: {mysql.OrderStatusPaid},
+	mysql.OrderStatusRefunded: {mysql.OrderStatusPaid, mysql.OrderStatusComplete},
+}
+
+// eventStatuses 订单状态对应的事件状态
+var eventStatuses = map[int8]string{
+	mysql.OrderStatusPaid:     orderevents.StatusPaid,
+	mysql.OrderStatusComplete: orderevents.StatusCompleted,
+	mysql.OrderStatusRefunded: orderevents.StatusRefunded,
+}
+
 type orderService struct {
 	orderRepo OrderRepository
+	events    OrderEvents
 }
 
 type OrderRepository interface {
 	CreateOrder(ctx context.Context, order *mysql.Order, items []*mysql.OrderItem) error
 	ListOrdersByUserID(ctx context.Context, userID uint32) ([]*mysql.Order, error)
-	UpdateOrderStatus(ctx context.Context, orderID int64, status int8) error
+	// GetOrderByID 查询订单，不存在时返回 nil
+	GetOrderByID(ctx context.Context, orderID int64) (*mysql.Order, error)
+	// TransitionOrderStatus 订单处于 from 中的状态时更新为 to，返回是否更新
+	TransitionOrderStatus(ctx context.Context, orderID int64, from []int8, to int8) (bool, error)
+}
+
+// OrderEvents 订单状态变化事件的发布
+type OrderEvents interface {
+	PublishStatusChanged(ctx context.Context, ev *orderevents.StatusChanged) error
+}
+
+// redisOrderEvents 通过 Redis Stream 发布订单事件
+type redisOrderEvents struct{}
+
+func (redisOrderEvents) PublishStatusChanged(ctx context.Context, ev *orderevents.StatusChanged) error {
+	return orderevents.Publish(ctx, redis.RDB, ev)
 }
 
 func NewOrderService(repo OrderRepository) OrderService {
+	return NewOrderServiceWithEvents(repo, redisOrderEvents{})
+}
+
+// NewOrderServiceWithEvents 使用指定的事件发布方式创建订单服务
+func NewOrderServiceWithEvents(repo OrderRepository, events OrderEvents) OrderService {
 	return &orderService{
 		orderRepo: repo,
+		events:    events,
 	}
 }
 
@@ -114,24 +157,73 @@ func (s *orderService) ListOrder(ctx context.Context, req *order.ListOrderReq) (
 }
 
 func (s *orderService) MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq) (*order.MarkOrderPaidResp, error) {
+	if err := s.changeStatus(ctx, req.UserId, req.OrderId, mysql.OrderStatusPaid); err != nil {
+		return nil, err
+	}
+	return &order.MarkOrderPaidResp{}, nil
+}
+
+func (s *orderService) MarkOrderCompleted(ctx context.Context, req *order.MarkOrderCompletedReq) (*order.MarkOrderCompletedResp, error) {
+	if err := s.changeStatus(ctx, req.UserId, req.OrderId, mysql.OrderStatusComplete); err != nil {
+		return nil, err
+	}
+	return &order.MarkOrderCompletedResp{}, nil
+}
+
+func (s *orderService) MarkOrderRefunded(ctx context.Context, req *order.MarkOrderRefundedReq) (*order.MarkOrderRefundedResp, error) {
+	if err := s.changeStatus(ctx, req.UserId, req.OrderId, mysql.OrderStatusRefunded); err != nil {
+		return nil, err
+	}
+	return &order.MarkOrderRefundedResp{}, nil
+}
+
+// changeStatus 更新订单状态并发布状态变化事件
+// 订单已处于目标状态时重新发布事件，调用方在发布失败后重试即可保证事件送达，订阅方按订单去重
+func (s *orderService) changeStatus(ctx context.Context, userID uint32, rawOrderID string, to int8) error {
 	// 获取订单ID
-	orderID, err := strconv.ParseInt(req.OrderId, 10, 64)
+	orderID, err := strconv.ParseInt(rawOrderID, 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("invalid order id: %w", err)
+		return fmt.Errorf("invalid order id: %w", err)
 	}
 
-	// 更新订单状态
-	if err := s.orderRepo.UpdateOrderStatus(ctx, orderID, mysql.OrderStatusPaid); err != nil {
-		return nil, fmt.Errorf("update order status failed: %w", err)
+	o, err := s.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("get order failed: %w", err)
+	}
+	if o == nil || (userID != 0 && o.UserID != userID) {
+		return ErrOrderNotFound
 	}
 
-	// 使缓存失效
-	if err := redis.InvalidateOrderCache(ctx, orderID); err != nil {
-		// 记录错误但不影响主流程
-		fmt.Printf("invalidate order cache failed: %v\n", err)
+	if o.Status != to {
+		// 更新订单状态，并发修改时以先完成的为准
+		ok, err := s.orderRepo.TransitionOrderStatus(ctx, orderID, statusTransitions[to], to)
+		if err != nil {
+			return fmt.Errorf("update order status failed: %w", err)
+		}
+		if !ok {
+			return ErrInvalidStatusTransition
+		}
+
+		// 使缓存失效
+		if err := redis.InvalidateOrderCache(ctx, orderID); err != nil {
+			// 记录错误但不影响主流程
+			fmt.Printf("invalidate order cache failed: %v\n", err)
+		}
 	}
 
-	return &order.MarkOrderPaidResp{}, nil
+	err = s.events.PublishStatusChanged(ctx, &orderevents.StatusChanged{
+		OrderID:   o.ID,
+		OrderNo:   o.OrderNo,
+		UserID:    int64(o.UserID),
+		Status:    eventStatuses[to],
+		Amount:    o.TotalAmount,
+		Currency:  o.UserCurrency,
+		ChangedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("publish order event failed: %w", err)
+	}
+	return nil
 }
 
 // 生成订单号
@@ -4,13 +4,16 @@ import (
 	"context"
 	"testing"
 
+	goredis "github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"TikTokMall/app/order/biz/dal/mysql"
 	"TikTokMall/app/order/biz/dal/redis"
 	"TikTokMall/app/order/kitex_gen/cart"
 	"TikTokMall/app/order/kitex_gen/order"
+	"TikTokMall/pkg/orderevents"
 )
 
 type mockOrderRepo struct {
@@ -27,9 +30,54 @@ func (m *mockOrderRepo) ListOrdersByUserID(ctx context.Context, userID uint32) (
 	return args.Get(0).([]*mysql.Order), args.Error(1)
 }
 
-func (m *mockOrderRepo) UpdateOrderStatus(ctx context.Context, orderID int64, status int8) error {
-	args := m.Called(ctx, orderID, status)
-	return args.Error(0)
+func (m *mockOrderRepo) GetOrderByID(ctx context.Context, orderID int64) (*mysql.Order, error) {
+	args := m.Called(ctx, orderID)
+	o, _ := args.Get(0).(*mysql.Order)
+	return o, args.Error(1)
+}
+
+func (m *mockOrderRepo) TransitionOrderStatus(ctx context.Context, orderID int64, from []int8, to int8) (bool, error) {
+	args := m.Called(ctx, orderID, from, to)
+	return args.Bool(0), args.Error(1)
+}
+
+// memOrderEvents 记录发布的订单事件
+type memOrderEvents struct {
+	published []*orderevents.StatusChanged
+}
+
+func (m *memOrderEvents) PublishStatusChanged(ctx context.Context, ev *orderevents.StatusChanged) error {
+	m.published = append(m.published, ev)
+	return nil
+}
+
+// memStatusRepo 内存订单存储，只支持状态变化
+type memStatusRepo struct {
+	mockOrderRepo
+	orders map[int64]*mysql.Order
+}
+
+func (m *memStatusRepo) GetOrderByID(ctx context.Context, orderID int64) (*mysql.Order, error) {
+	o, ok := m.orders[orderID]
+	if !ok {
+		return nil, nil
+	}
+	cp := *o
+	return &cp, nil
+}
+
+func (m *memStatusRepo) TransitionOrderStatus(ctx context.Context, orderID int64, from []int8, to int8) (bool, error) {
+	o, ok := m.orders[orderID]
+	if !ok {
+		return false, nil
+	}
+	for _, status := range from {
+		if o.Status == status {
+			o.Status = to
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 func TestOrderService_PlaceOrder(t *testing.T) {
@@ -94,3 +142,66 @@ func TestOrderService_PlaceOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderService_ChangeStatus(t *testing.T) {
+	// 缓存失效失败不影响状态变化，使用不可用的 Redis 地址即可
+	saved := redis.RDB
+	redis.RDB = goredis.NewClient(&goredis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	t.Cleanup(func() {
+		redis.RDB.Close()
+		redis.RDB = saved
+	})
+
+	ctx := context.Background()
+	repo := &memStatusRepo{orders: map[int64]*mysql.Order{
+		1: {ID: 1, OrderNo: "ORD-1", UserID: 7, UserCurrency: "CNY", TotalAmount: 120, Status: mysql.OrderStatusPending},
+	}}
+	events := &memOrderEvents{}
+	svc := NewOrderServiceWithEvents(repo, events)
+
+	// 待支付的订单不能直接完成或退款
+	_, err := svc.MarkOrderCompleted(ctx, &order.MarkOrderCompletedReq{UserId: 7, OrderId: "1"})
+	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+	_, err = svc.MarkOrderRefunded(ctx, &order.MarkOrderRefundedReq{UserId: 7, OrderId: "1"})
+	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	// 其他用户的订单
+	_, err = svc.MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: 8, OrderId: "1"})
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+	_, err = svc.MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: 7, OrderId: "2"})
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+	assert.Empty(t, events.published)
+
+	_, err = svc.MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: 7, OrderId: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, int8(mysql.OrderStatusPaid), repo.orders[1].Status)
+	require.Len(t, events.published, 1)
+	assert.Equal(t, &orderevents.StatusChanged{
+		OrderID:   1,
+		OrderNo:   "ORD-1",
+		UserID:    7,
+		Status:    orderevents.StatusPaid,
+		Amount:    120,
+		Currency:  "CNY",
+		ChangedAt: events.published[0].ChangedAt,
+	}, events.published[0])
+
+	// 重试时重新发布事件
+	_, err = svc.MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: 7, OrderId: "1"})
+	require.NoError(t, err)
+	require.Len(t, events.published, 2)
+	assert.Equal(t, orderevents.StatusPaid, events.published[1].Status)
+
+	_, err = svc.MarkOrderCompleted(ctx, &order.MarkOrderCompletedReq{UserId: 7, OrderId: "1"})
+	require.NoError(t, err)
+	_, err = svc.MarkOrderRefunded(ctx, &order.MarkOrderRefundedReq{OrderId: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, int8(mysql.OrderStatusRefunded), repo.orders[1].Status)
+	require.Len(t, events.published, 4)
+	assert.Equal(t, orderevents.StatusCompleted, events.published[2].Status)
+	assert.Equal(t, orderevents.StatusRefunded, events.published[3].Status)
+
+	// 已退款的订单不能再支付
+	_, err = svc.MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: 7, OrderId: "1"})
+	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+}
@@ -11,4 +11,6 @@ type OrderService interface {
 	PlaceOrder(ctx context.Context, req *order.PlaceOrderReq) (*order.PlaceOrderResp, error)
 	ListOrder(ctx context.Context, req *order.ListOrderReq) (*order.ListOrderResp, error)
 	MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq) (*order.MarkOrderPaidResp, error)
+	MarkOrderCompleted(ctx context.Context, req *order.MarkOrderCompletedReq) (*order.MarkOrderCompletedResp, error)
+	MarkOrderRefunded(ctx context.Context, req *order.MarkOrderRefundedReq) (*order.MarkOrderRefundedResp, error)
 }
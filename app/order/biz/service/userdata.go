@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"TikTokMall/app/order/biz/dal/mysql"
+)
+
+// UserDataRepository 个人数据导出和注销使用的订单存储
+type UserDataRepository interface {
+	ListOrdersByUserID(ctx context.Context, userID uint32) ([]*mysql.Order, error)
+	ListOrderItemsByOrderIDs(ctx context.Context, orderIDs []int64) ([]*mysql.OrderItem, error)
+	AnonymizeOrdersByUserID(ctx context.Context, userID uint32) error
+}
+
+// UserDataHandler 处理用户服务发布的个人数据事件，实现 userdata.Handler
+type UserDataHandler struct {
+	repo UserDataRepository
+}
+
+// NewUserDataHandler 创建个人数据事件处理器
+func NewUserDataHandler() *UserDataHandler {
+	return NewUserDataHandlerWithRepo(mysql.NewOrderMySQLRepository())
+}
+
+// NewUserDataHandlerWithRepo 使用指定存储创建个人数据事件处理器
+func NewUserDataHandlerWithRepo(repo UserDataRepository) *UserDataHandler {
+	return &UserDataHandler{repo: repo}
+}
+
+// orderExport 导出的订单
+type orderExport struct {
+	OrderNo         string            `json:"order_no"`
+	Status          int8              `json:"status"`
+	Currency        string            `json:"currency"`
+	TotalAmount     float64           `json:"total_amount"`
+	Email           string            `json:"email,omitempty"`
+	ShippingAddress json.RawMessage   `json:"shipping_address,omitempty"`
+	Items           []orderItemExport `json:"items"`
+	CreatedAt       time.Time         `json:"created_at"`
+}
+
+type orderItemExport struct {
+	ProductID uint32  `json:"product_id"`
+	Quantity  int32   `json:"quantity"`
+	Cost      float64 `json:"cost"`
+}
+
+// Export 导出用户的订单和订单项
+func (h *UserDataHandler) Export(ctx context.Context, userID int64) (interface{}, error) {
+	orders, err := h.repo.ListOrdersByUserID(ctx, uint32(userID))
+	if err != nil {
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+	orderIDs := make([]int64, 0, len(orders))
+	for _, o := range orders {
+		orderIDs = append(orderIDs, o.ID)
+	}
+	items, err := h.repo.ListOrderItemsByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("获取订单项失败: %w", err)
+	}
+	itemsByOrder := make(map[int64][]orderItemExport, len(orders))
+	for _, item := range items {
+		itemsByOrder[item.OrderID] = append(itemsByOrder[item.OrderID], orderItemExport{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Cost:      item.Cost,
+		})
+	}
+
+	result := struct {
+		Orders []orderExport `json:"orders"`
+	}{Orders: []orderExport{}}
+	for _, o := range orders {
+		export := orderExport{
+			OrderNo:     o.OrderNo,
+			Status:      o.Status,
+			Currency:    o.UserCurrency,
+			TotalAmount: o.TotalAmount,
+			Email:       o.Email,
+			Items:       itemsByOrder[o.ID],
+			CreatedAt:   o.CreatedAt,
+		}
+		if export.Items == nil {
+			export.Items = []orderItemExport{}
+		}
+		if o.ShippingAddress.Valid && json.Valid([]byte(o.ShippingAddress.String)) {
+			export.ShippingAddress = json.RawMessage(o.ShippingAddress.String)
+		}
+		result.Orders = append(result.Orders, export)
+	}
+	return result, nil
+}
+
+// Erase 清除订单中的联系方式和收货地址，订单本身作为交易记录保留
+func (h *UserDataHandler) Erase(ctx context.Context, userID int64) error {
+	if err := h.repo.AnonymizeOrdersByUserID(ctx, uint32(userID)); err != nil {
+		return fmt.Errorf("匿名化订单失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/order/biz/dal/mysql"
+)
+
+// memUserDataRepo 内存订单存储
+type memUserDataRepo struct {
+	orders []*mysql.Order
+	items  []*mysql.OrderItem
+}
+
+func (m *memUserDataRepo) ListOrdersByUserID(ctx context.Context, userID uint32) ([]*mysql.Order, error) {
+	var orders []*mysql.Order
+	for _, o := range m.orders {
+		if o.UserID == userID {
+			orders = append(orders, o)
+		}
+	}
+	return orders, nil
+}
+
+func (m *memUserDataRepo) ListOrderItemsByOrderIDs(ctx context.Context, orderIDs []int64) ([]*mysql.OrderItem, error) {
+	var items []*mysql.OrderItem
+	for _, item := range m.items {
+		for _, id := range orderIDs {
+			if item.OrderID == id {
+				items = append(items, item)
+			}
+		}
+	}
+	return items, nil
+}
+
+func (m *memUserDataRepo) AnonymizeOrdersByUserID(ctx context.Context, userID uint32) error {
+	for _, o := range m.orders {
+		if o.UserID == userID {
+			o.Email = ""
+			o.ShippingAddress = sql.NullString{}
+		}
+	}
+	return nil
+}
+
+func TestUserDataHandler_ExportAndErase(t *testing.T) {
+	ctx := context.Background()
+	repo := &memUserDataRepo{
+		orders: []*mysql.Order{
+			{ID: 1, OrderNo: "A1", UserID: 1, UserCurrency: "CNY", TotalAmount: 200, Email: "alice@example.com",
+				ShippingAddress: sql.NullString{String: `{"city":"Beijing"}`, Valid: true}},
+			{ID: 2, OrderNo: "B1", UserID: 2, UserCurrency: "CNY", TotalAmount: 50, Email: "bob@example.com"},
+		},
+		items: []*mysql.OrderItem{
+			{OrderID: 1, ProductID: 100, Quantity: 2, Cost: 100},
+			{OrderID: 2, ProductID: 200, Quantity: 1, Cost: 50},
+		},
+	}
+	h := NewUserDataHandlerWithRepo(repo)
+
+	data, err := h.Export(ctx, 1)
+	require.NoError(t, err)
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+	var decoded struct {
+		Orders []struct {
+			OrderNo         string `json:"order_no"`
+			Email           string `json:"email"`
+			ShippingAddress struct {
+				City string `json:"city"`
+			} `json:"shipping_address"`
+			Items []struct {
+				ProductID uint32 `json:"product_id"`
+			} `json:"items"`
+		} `json:"orders"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	require.Len(t, decoded.Orders, 1)
+	assert.Equal(t, "A1", decoded.Orders[0].OrderNo)
+	assert.Equal(t, "alice@example.com", decoded.Orders[0].Email)
+	assert.Equal(t, "Beijing", decoded.Orders[0].ShippingAddress.City)
+	require.Len(t, decoded.Orders[0].Items, 1)
+	assert.Equal(t, uint32(100), decoded.Orders[0].Items[0].ProductID)
+
+	// 注销后订单保留，联系方式和地址被清除
+	require.NoError(t, h.Erase(ctx, 1))
+	assert.Empty(t, repo.orders[0].Email)
+	assert.False(t, repo.orders[0].ShippingAddress.Valid)
+	assert.Equal(t, 200.0, repo.orders[0].TotalAmount)
+	assert.Equal(t, "bob@example.com", repo.orders[1].Email, "其他用户的订单不受影响")
+	require.NoError(t, h.Erase(ctx, 1))
+}
@@ -2,6 +2,7 @@ package conf
 
 import (
 	"sync"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/spf13/viper"
@@ -13,15 +14,26 @@ var (
 )
 
 type Config struct {
-	Env        string           `mapstructure:"env"`
-	Service    ServiceConfig    `mapstructure:"service"`
-	MySQL      MySQLConfig      `mapstructure:"mysql"`
-	Redis      RedisConfig      `mapstructure:"redis"`
-	Registry   RegistryConfig   `mapstructure:"registry"`
-	Log        LogConfig        `mapstructure:"log"`
-	Jaeger     JaegerConfig     `mapstructure:"jaeger"`
-	Prometheus PrometheusConfig `mapstructure:"prometheus"`
-	TLS        TLSConfig        `mapstructure:"tls"`
+	Env         string            `mapstructure:"env"`
+	Kitex       KitexConfig       `mapstructure:"kitex"`
+	Service     ServiceConfig     `mapstructure:"service"`
+	MySQL       MySQLConfig       `mapstructure:"mysql"`
+	Redis       RedisConfig       `mapstructure:"redis"`
+	Registry    RegistryConfig    `mapstructure:"registry"`
+	Log         LogConfig         `mapstructure:"log"`
+	Jaeger      JaegerConfig      `mapstructure:"jaeger"`
+	Prometheus  PrometheusConfig  `mapstructure:"prometheus"`
+	TLS         TLSConfig         `mapstructure:"tls"`
+	OpenAPI     OpenAPIConfig     `mapstructure:"open_api"`
+	CORS        CORSConfig        `mapstructure:"cors"`
+	AuthRPC     RPCClientConfig   `mapstructure:"auth_rpc"`
+	ServiceAuth ServiceAuthConfig `mapstructure:"service_auth"`
+}
+
+// KitexConfig 订单 RPC 服务配置，RPC 端口只对内网开放
+type KitexConfig struct {
+	Service string `mapstructure:"service"`
+	Address string `mapstructure:"address"`
 }
 
 type ServiceConfig struct {
@@ -80,6 +92,27 @@ type TLSConfig struct {
 	ClientVerify bool   `mapstructure:"client_verify"`
 }
 
+// OpenAPIConfig 开放平台接口配置
+type OpenAPIConfig struct {
+	MaxClockSkew time.Duration `mapstructure:"max_clock_skew"` // 请求时间戳允许的最大偏差，为 0 时使用默认值
+}
+
+// RPCClientConfig 下游 Kitex 服务配置
+type RPCClientConfig struct {
+	Service string `mapstructure:"service"` // 服务注册名，与下游服务的 kitex.service 一致
+	Address string `mapstructure:"address"` // 服务的 RPC 地址，如 127.0.0.1:8888
+}
+
+// ServiceAuthConfig 服务间调用凭证配置，所有服务使用相同的共享密钥
+type ServiceAuthConfig struct {
+	Secret string `mapstructure:"secret"` // 可通过 SERVICE_AUTH_SECRET 覆盖
+}
+
+// CORSConfig 跨域配置，只有 allow_origins 中的来源可以携带 Cookie 访问，为空时拒绝跨域请求
+type CORSConfig struct {
+	AllowOrigins []string `mapstructure:"allow_origins"`
+}
+
 func Init() {
 	once.Do(func() {
 		viper.SetConfigName("conf")
@@ -3,10 +3,13 @@ package main
 import (
 	"context"
 
+	"github.com/cloudwego/kitex/pkg/kerrors"
+
 	"TikTokMall/app/order/biz/dal/mysql"
 	"TikTokMall/app/order/biz/dal/redis"
 	"TikTokMall/app/order/biz/service"
 	"TikTokMall/app/order/kitex_gen/order"
+	"TikTokMall/pkg/authn"
 )
 
 // OrderServiceImpl implements the last service interface defined in the IDL.
@@ -14,6 +17,9 @@ type OrderServiceImpl struct{}
 
 // PlaceOrder implements the OrderServiceImpl interface.
 func (s *OrderServiceImpl) PlaceOrder(ctx context.Context, req *order.PlaceOrderReq) (resp *order.PlaceOrderResp, err error) {
+	if err := authorizeUser(ctx, &req.UserId); err != nil {
+		return nil, err
+	}
 	repo := mysql.NewOrderMySQLRepository()
 	svc := service.NewOrderService(repo)
 	return svc.PlaceOrder(ctx, req)
@@ -21,18 +27,40 @@ func (s *OrderServiceImpl) PlaceOrder(ctx context.Context, req *order.PlaceOrder
 
 // ListOrder implements the OrderServiceImpl interface.
 func (s *OrderServiceImpl) ListOrder(ctx context.Context, req *order.ListOrderReq) (resp *order.ListOrderResp, err error) {
+	if err := authorizeUser(ctx, &req.UserId); err != nil {
+		return nil, err
+	}
 	repo := mysql.NewOrderMySQLRepository()
 	svc := service.NewOrderService(repo)
 	return svc.ListOrder(ctx, req)
 }
 
 // MarkOrderPaid implements the OrderServiceImpl interface.
+// 只供支付服务在支付成功后调用，不校验用户令牌，服务凭证由 RPC 中间件校验
 func (s *OrderServiceImpl) MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq) (resp *order.MarkOrderPaidResp, err error) {
 	repo := mysql.NewOrderMySQLRepository()
 	svc := service.NewOrderService(repo)
 	return svc.MarkOrderPaid(ctx, req)
 }
 
+// MarkOrderCompleted implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) MarkOrderCompleted(ctx context.Context, req *order.MarkOrderCompletedReq) (resp *order.MarkOrderCompletedResp, err error) {
+	if err := authorizeUser(ctx, &req.UserId); err != nil {
+		return nil, err
+	}
+	repo := mysql.NewOrderMySQLRepository()
+	svc := service.NewOrderService(repo)
+	return svc.MarkOrderCompleted(ctx, req)
+}
+
+// MarkOrderRefunded implements the OrderServiceImpl interface.
+// 只供支付服务在退款成功后调用，不校验用户令牌，服务凭证由 RPC 中间件校验
+func (s *OrderServiceImpl) MarkOrderRefunded(ctx context.Context, req *order.MarkOrderRefundedReq) (resp *order.MarkOrderRefundedResp, err error) {
+	repo := mysql.NewOrderMySQLRepository()
+	svc := service.NewOrderService(repo)
+	return svc.MarkOrderRefunded(ctx, req)
+}
+
 // HealthCheck implements the OrderServiceImpl interface.
 func (s *OrderServiceImpl) HealthCheck(ctx context.Context) error {
 	if err := mysql.DB.WithContext(ctx).Raw("SELECT 1").Error; err != nil {
@@ -45,3 +73,13 @@ func (s *OrderServiceImpl) HealthCheck(ctx context.Context) error {
 
 	return nil
 }
+
+// authorizeUser 校验请求中的 user_id 与访问令牌一致，未传时使用令牌中的用户
+func authorizeUser(ctx context.Context, userID *uint32) error {
+	id, err := authn.UserID(ctx, int64(*userID))
+	if err != nil {
+		return kerrors.ErrACL.WithCause(err)
+	}
+	*userID = uint32(id)
+	return nil
+}
@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.9.1. DO NOT EDIT.
+// Code generated by Kitex v0.12.1. DO NOT EDIT.
 
 package orderservice
 
@@ -14,6 +14,8 @@ type Client interface {
 	PlaceOrder(ctx context.Context, Req *order.PlaceOrderReq, callOptions ...callopt.Option) (r *order.PlaceOrderResp, err error)
 	ListOrder(ctx context.Context, Req *order.ListOrderReq, callOptions ...callopt.Option) (r *order.ListOrderResp, err error)
 	MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error)
+	MarkOrderCompleted(ctx context.Context, Req *order.MarkOrderCompletedReq, callOptions ...callopt.Option) (r *order.MarkOrderCompletedResp, err error)
+	MarkOrderRefunded(ctx context.Context, Req *order.MarkOrderRefundedReq, callOptions ...callopt.Option) (r *order.MarkOrderRefundedResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +61,13 @@ func (p *kOrderServiceClient) MarkOrderPaid(ctx context.Context, Req *order.Mark
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.MarkOrderPaid(ctx, Req)
 }
+
+func (p *kOrderServiceClient) MarkOrderCompleted(ctx context.Context, Req *order.MarkOrderCompletedReq, callOptions ...callopt.Option) (r *order.MarkOrderCompletedResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkOrderCompleted(ctx, Req)
+}
+
+func (p *kOrderServiceClient) MarkOrderRefunded(ctx context.Context, Req *order.MarkOrderRefundedReq, callOptions ...callopt.Option) (r *order.MarkOrderRefundedResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkOrderRefunded(ctx, Req)
+}
@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.9.1. DO NOT EDIT.
+// Code generated by Kitex v0.12.1. DO NOT EDIT.
 
 package orderservice
 
@@ -36,6 +36,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"MarkOrderCompleted": kitex.NewMethodInfo(
+		markOrderCompletedHandler,
+		newMarkOrderCompletedArgs,
+		newMarkOrderCompletedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"MarkOrderRefunded": kitex.NewMethodInfo(
+		markOrderRefundedHandler,
+		newMarkOrderRefundedArgs,
+		newMarkOrderRefundedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -49,12 +63,12 @@ func serviceInfo() *kitex.ServiceInfo {
 	return orderServiceServiceInfo
 }
 
-// for client
+// for stream client
 func serviceInfoForStreamClient() *kitex.ServiceInfo {
 	return orderServiceServiceInfoForStreamClient
 }
 
-// for stream client
+// for client
 func serviceInfoForClient() *kitex.ServiceInfo {
 	return orderServiceServiceInfoForClient
 }
@@ -96,7 +110,7 @@ func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreami
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Protobuf,
-		KiteXGenVersion: "v0.9.1",
+		KiteXGenVersion: "v0.12.1",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -561,6 +575,312 @@ func (p *MarkOrderPaidResult) GetResult() interface{} {
 	return p.Success
 }
 
+func markOrderCompletedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.MarkOrderCompletedReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).MarkOrderCompleted(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *MarkOrderCompletedArgs:
+		success, err := handler.(order.OrderService).MarkOrderCompleted(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*MarkOrderCompletedResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newMarkOrderCompletedArgs() interface{} {
+	return &MarkOrderCompletedArgs{}
+}
+
+func newMarkOrderCompletedResult() interface{} {
+	return &MarkOrderCompletedResult{}
+}
+
+type MarkOrderCompletedArgs struct {
+	Req *order.MarkOrderCompletedReq
+}
+
+func (p *MarkOrderCompletedArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.MarkOrderCompletedReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *MarkOrderCompletedArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *MarkOrderCompletedArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *MarkOrderCompletedArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *MarkOrderCompletedArgs) Unmarshal(in []byte) error {
+	msg := new(order.MarkOrderCompletedReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var MarkOrderCompletedArgs_Req_DEFAULT *order.MarkOrderCompletedReq
+
+func (p *MarkOrderCompletedArgs) GetReq() *order.MarkOrderCompletedReq {
+	if !p.IsSetReq() {
+		return MarkOrderCompletedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *MarkOrderCompletedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MarkOrderCompletedArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type MarkOrderCompletedResult struct {
+	Success *order.MarkOrderCompletedResp
+}
+
+var MarkOrderCompletedResult_Success_DEFAULT *order.MarkOrderCompletedResp
+
+func (p *MarkOrderCompletedResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.MarkOrderCompletedResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *MarkOrderCompletedResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *MarkOrderCompletedResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *MarkOrderCompletedResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *MarkOrderCompletedResult) Unmarshal(in []byte) error {
+	msg := new(order.MarkOrderCompletedResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *MarkOrderCompletedResult) GetSuccess() *order.MarkOrderCompletedResp {
+	if !p.IsSetSuccess() {
+		return MarkOrderCompletedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *MarkOrderCompletedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.MarkOrderCompletedResp)
+}
+
+func (p *MarkOrderCompletedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MarkOrderCompletedResult) GetResult() interface{} {
+	return p.Success
+}
+
+func markOrderRefundedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.MarkOrderRefundedReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).MarkOrderRefunded(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *MarkOrderRefundedArgs:
+		success, err := handler.(order.OrderService).MarkOrderRefunded(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*MarkOrderRefundedResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newMarkOrderRefundedArgs() interface{} {
+	return &MarkOrderRefundedArgs{}
+}
+
+func newMarkOrderRefundedResult() interface{} {
+	return &MarkOrderRefundedResult{}
+}
+
+type MarkOrderRefundedArgs struct {
+	Req *order.MarkOrderRefundedReq
+}
+
+func (p *MarkOrderRefundedArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.MarkOrderRefundedReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *MarkOrderRefundedArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *MarkOrderRefundedArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *MarkOrderRefundedArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *MarkOrderRefundedArgs) Unmarshal(in []byte) error {
+	msg := new(order.MarkOrderRefundedReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var MarkOrderRefundedArgs_Req_DEFAULT *order.MarkOrderRefundedReq
+
+func (p *MarkOrderRefundedArgs) GetReq() *order.MarkOrderRefundedReq {
+	if !p.IsSetReq() {
+		return MarkOrderRefundedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *MarkOrderRefundedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MarkOrderRefundedArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type MarkOrderRefundedResult struct {
+	Success *order.MarkOrderRefundedResp
+}
+
+var MarkOrderRefundedResult_Success_DEFAULT *order.MarkOrderRefundedResp
+
+func (p *MarkOrderRefundedResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.MarkOrderRefundedResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *MarkOrderRefundedResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *MarkOrderRefundedResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *MarkOrderRefundedResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *MarkOrderRefundedResult) Unmarshal(in []byte) error {
+	msg := new(order.MarkOrderRefundedResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *MarkOrderRefundedResult) GetSuccess() *order.MarkOrderRefundedResp {
+	if !p.IsSetSuccess() {
+		return MarkOrderRefundedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *MarkOrderRefundedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.MarkOrderRefundedResp)
+}
+
+func (p *MarkOrderRefundedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MarkOrderRefundedResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -600,3 +920,23 @@ func (p *kClient) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) MarkOrderCompleted(ctx context.Context, Req *order.MarkOrderCompletedReq) (r *order.MarkOrderCompletedResp, err error) {
+	var _args MarkOrderCompletedArgs
+	_args.Req = Req
+	var _result MarkOrderCompletedResult
+	if err = p.c.Call(ctx, "MarkOrderCompleted", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MarkOrderRefunded(ctx context.Context, Req *order.MarkOrderRefundedReq) (r *order.MarkOrderRefundedResp, err error) {
+	var _args MarkOrderRefundedArgs
+	_args.Req = Req
+	var _result MarkOrderRefundedResult
+	if err = p.c.Call(ctx, "MarkOrderRefunded", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
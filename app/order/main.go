@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"os"
 
 	"github.com/cloudwego/hertz/pkg/app/middlewares/server/recovery"
@@ -9,16 +11,27 @@ import (
 	"github.com/cloudwego/hertz/pkg/app/server/registry"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/network/standard"
-	"github.com/hertz-contrib/cors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	kserver "github.com/cloudwego/kitex/server"
 
 	"TikTokMall/app/order/biz/dal/mysql"
 	"TikTokMall/app/order/biz/dal/redis"
 	"TikTokMall/app/order/biz/handler"
+	"TikTokMall/app/order/biz/service"
 	"TikTokMall/app/order/biz/utils"
 	"TikTokMall/app/order/conf"
+	"TikTokMall/app/order/kitex_gen/auth"
+	"TikTokMall/app/order/kitex_gen/auth/authservice"
+	"TikTokMall/app/order/kitex_gen/order/orderservice"
+	"TikTokMall/app/order/pkg/client"
 	"TikTokMall/app/order/pkg/hertz"
 	"TikTokMall/app/order/pkg/mtls"
 	"TikTokMall/app/order/pkg/tracer"
+	"TikTokMall/pkg/apisign"
+	"TikTokMall/pkg/authn"
+	"TikTokMall/pkg/userdata"
+	"TikTokMall/pkg/websession"
 )
 
 func main() {
@@ -93,28 +106,78 @@ func main() {
 		)
 	}
 
-	// 添加CORS中间件
-	h.Use(cors.New(cors.Config{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		MaxAge:       3600,
+	// 添加CORS中间件，只允许配置的前端来源携带 Cookie
+	h.Use(websession.CORS(websession.CORSConfig{
+		AllowOrigins: config.CORS.AllowOrigins,
+		AllowHeaders: []string{apisign.HeaderKeyID, apisign.HeaderTimestamp, apisign.HeaderNonce, apisign.HeaderSignature},
 	}))
 
+	// 浏览器 Cookie 会话：校验 CSRF 令牌并将 Cookie 中的访问令牌转为 Authorization 头
+	h.Use(websession.Middleware())
+
 	// 添加恢复中间件
 	h.Use(recovery.Recovery())
 
 	// 创建处理器
 	orderHandler := handler.NewOrderHTTPHandler()
 
-	// 注册路由
-	v1 := h.Group("/v1/order")
+	authClient, err := authservice.NewClient(config.AuthRPC.Service, client.NewClientOptions(config.AuthRPC)...)
+	if err != nil {
+		hlog.Fatalf("create auth client failed: %v", err)
+	}
+
+	// 注册路由，用户接口要求携带访问令牌；订单支付和退款只由支付服务通过 RPC 更新
+	verifier := authn.NewRPCVerifier(func(ctx context.Context, token string, withRoles bool) (*auth.VerifyResp, error) {
+		return authClient.VerifyTokenByRPC(ctx, &auth.VerifyTokenReq{Token: token, WithRoles: withRoles})
+	})
+	v1 := h.Group("/v1/order", authn.Authenticate(verifier))
 	{
 		v1.POST("/create", orderHandler.PlaceOrder)
 		v1.GET("/list", orderHandler.ListOrder)
-		v1.POST("/mark_paid", orderHandler.MarkOrderPaid)
+		v1.POST("/mark_completed", orderHandler.MarkOrderCompleted) // 用户确认收货
+	}
+
+	// 开放平台接口，使用 API 密钥签名认证
+	guard := apisign.NewGuard(
+		newSignatureVerifier(authClient),
+		apisign.NewRedisNonceStore(redis.RDB, "order:apisign:nonce:"),
+		config.OpenAPI.MaxClockSkew,
+	)
+	open := h.Group("/open/v1/order")
+	{
+		open.POST("/create", apisign.Require(guard, "order:write"), orderHandler.OpenPlaceOrder)
+		open.GET("/list", apisign.Require(guard, "order:read"), orderHandler.OpenListOrder)
 	}
 
+	// 订阅用户服务的个人数据事件：导出订单，注销时清除订单中的联系方式
+	dataCtx, stopUserData := context.WithCancel(context.Background())
+	go func() {
+		if err := userdata.Subscribe(dataCtx, redis.RDB, "order", service.NewUserDataHandler()); err != nil {
+			hlog.Errorf("subscribe userdata events failed: %v", err)
+		}
+	}()
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		stopUserData()
+	})
+
+	// 启动 RPC 服务，订单支付和退款要求支付服务的服务凭证
+	serviceSecret, err := authn.ServiceSecret(config.ServiceAuth.Secret)
+	if err != nil {
+		hlog.Fatalf("init service credential failed: %v", err)
+	}
+	rpcServer, err := newRPCServer(config.Kitex, verifier, serviceSecret)
+	if err != nil {
+		hlog.Fatalf("create rpc server failed: %v", err)
+	}
+	go func() {
+		if err := rpcServer.Run(); err != nil {
+			hlog.Fatalf("start rpc server failed: %v", err)
+		}
+	}()
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		rpcServer.Stop()
+	})
+
 	// 启动服务器
 	if err := h.Run(); err != nil {
 		hlog.Fatalf("start server failed: %v", err)
@@ -136,6 +199,40 @@ func initDeps() error {
 	return nil
 }
 
+// newRPCServer 创建订单 RPC 服务，调用方通过 TTHeader 的 metainfo 传递用户的访问令牌
+// MarkOrderPaid、MarkOrderRefunded 只供支付服务调用，不要求用户令牌，要求支付服务用 serviceSecret 签发的服务凭证
+func newRPCServer(c conf.KitexConfig, verifier authn.Verifier, serviceSecret []byte) (kserver.Server, error) {
+	addr, err := net.ResolveTCPAddr("tcp", c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("resolve rpc address failed: %w", err)
+	}
+	return orderservice.NewServer(new(OrderServiceImpl),
+		kserver.WithServiceAddr(addr),
+		kserver.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: c.Service}),
+		kserver.WithMetaHandler(transmeta.ServerTTHeaderHandler),
+		kserver.WithMiddleware(authn.KitexMiddleware(verifier, "MarkOrderPaid", "MarkOrderRefunded")),
+		kserver.WithMiddleware(authn.RequireService(serviceSecret, []string{"payment"}, "MarkOrderPaid", "MarkOrderRefunded")),
+	), nil
+}
+
+// newSignatureVerifier 通过 auth 服务校验开放平台请求签名
+func newSignatureVerifier(cli authservice.Client) apisign.Verifier {
+	return func(ctx context.Context, keyID, stringToSign, signature string) (*apisign.Caller, error) {
+		resp, err := cli.VerifyAPISignature(ctx, &auth.VerifyAPISignatureReq{
+			KeyId:        keyID,
+			StringToSign: stringToSign,
+			Signature:    signature,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("verify api signature failed: %w", err)
+		}
+		if !resp.Valid {
+			return nil, fmt.Errorf("%w: %s", apisign.ErrUnauthenticated, resp.Base.GetMessage())
+		}
+		return &apisign.Caller{KeyID: keyID, UserID: resp.UserId, Scopes: resp.Scopes}, nil
+	}
+}
+
 // getEnvOrDefault 获取环境变量，如果不存在则返回默认值
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
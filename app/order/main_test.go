@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	kclient "github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/server"
+	"github.com/cloudwego/kitex/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/order/conf"
+	"TikTokMall/app/order/kitex_gen/auth"
+	"TikTokMall/app/order/kitex_gen/auth/authservice"
+	"TikTokMall/app/order/kitex_gen/order"
+	"TikTokMall/app/order/kitex_gen/order/orderservice"
+	"TikTokMall/app/order/pkg/client"
+	"TikTokMall/pkg/authn"
+)
+
+// fakeAuthService 只实现 VerifyTokenByRPC 的 auth 服务
+type fakeAuthService struct {
+	auth.AuthService
+}
+
+func (s *fakeAuthService) VerifyTokenByRPC(ctx context.Context, req *auth.VerifyTokenReq) (*auth.VerifyResp, error) {
+	if req.Token != "valid-token" {
+		return &auth.VerifyResp{Valid: false}, nil
+	}
+	return &auth.VerifyResp{Valid: true, UserId: 42}, nil
+}
+
+// freeAddr 返回一个空闲的本地地址
+func freeAddr(t *testing.T) *net.TCPAddr {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().(*net.TCPAddr)
+	require.NoError(t, ln.Close())
+	return addr
+}
+
+// startFakeAuthServer 在随机端口启动 auth RPC 服务，返回监听地址
+func startFakeAuthServer(t *testing.T) string {
+	addr := freeAddr(t)
+	svr := authservice.NewServer(&fakeAuthService{}, server.WithServiceAddr(addr))
+	go svr.Run()
+	t.Cleanup(func() { svr.Stop() })
+	waitListening(t, addr.String())
+	return addr.String()
+}
+
+// waitListening 等待服务开始监听
+func waitListening(t *testing.T, addr string) {
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 3*time.Second, 20*time.Millisecond)
+}
+
+func TestTokenVerifier_AuthRPC(t *testing.T) {
+	addr := startFakeAuthServer(t)
+
+	cli, err := authservice.NewClient("auth-rpc", client.NewClientOptions(conf.RPCClientConfig{
+		Service: "auth-rpc",
+		Address: addr,
+	})...)
+	require.NoError(t, err)
+	verify := authn.NewRPCVerifier(func(ctx context.Context, token string, withRoles bool) (*auth.VerifyResp, error) {
+		return cli.VerifyTokenByRPC(ctx, &auth.VerifyTokenReq{Token: token, WithRoles: withRoles})
+	})
+
+	id, err := verify(context.Background(), "valid-token")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), id.UserID)
+
+	_, err = verify(context.Background(), "revoked-token")
+	assert.ErrorIs(t, err, authn.ErrUnauthenticated)
+}
+
+// startRPCServer 在随机端口启动订单 RPC 服务，返回监听地址
+func startRPCServer(t *testing.T, verify authn.Verifier) string {
+	addr := freeAddr(t).String()
+	svr, err := newRPCServer(conf.KitexConfig{Service: "order", Address: addr}, verify, []byte("service-secret"))
+	require.NoError(t, err)
+	go svr.Run()
+	t.Cleanup(func() { svr.Stop() })
+	waitListening(t, addr)
+	return addr
+}
+
+func TestRPCServer_RequiresUserToken(t *testing.T) {
+	verify := func(ctx context.Context, token string) (*authn.Identity, error) {
+		if token != "valid-token" {
+			return nil, authn.ErrUnauthenticated
+		}
+		return &authn.Identity{UserID: 42}, nil
+	}
+	addr := startRPCServer(t, verify)
+
+	cli, err := orderservice.NewClient("order",
+		kclient.WithHostPorts(addr),
+		kclient.WithTransportProtocol(transport.TTHeader),
+		kclient.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+	)
+	require.NoError(t, err)
+
+	// 未携带令牌
+	_, err = cli.ListOrder(context.Background(), &order.ListOrderReq{UserId: 42})
+	assert.ErrorContains(t, err, kerrors.ErrACL.Error())
+	assert.ErrorContains(t, err, authn.ErrUnauthenticated.Error())
+
+	// 令牌与请求中的用户不一致
+	ctx := authn.WithToken(context.Background(), "valid-token")
+	_, err = cli.MarkOrderCompleted(ctx, &order.MarkOrderCompletedReq{UserId: 7, OrderId: "1"})
+	assert.ErrorContains(t, err, authn.ErrUserMismatch.Error())
+}
+
+func TestRPCServer_RequiresServiceCredential(t *testing.T) {
+	addr := startRPCServer(t, func(ctx context.Context, token string) (*authn.Identity, error) {
+		return &authn.Identity{UserID: 42}, nil
+	})
+	// newClient 以 service 的身份用 secret 签发服务凭证，service 为空时不携带凭证
+	newClient := func(service, secret string) orderservice.Client {
+		opts := []kclient.Option{
+			kclient.WithHostPorts(addr),
+			kclient.WithTransportProtocol(transport.TTHeader),
+			kclient.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+		}
+		if service != "" {
+			opts = append(opts, kclient.WithMiddleware(authn.ServiceClientMiddleware(service, []byte(secret))))
+		}
+		cli, err := orderservice.NewClient("order", opts...)
+		require.NoError(t, err)
+		return cli
+	}
+	// 用户令牌不能代替服务凭证
+	ctx := authn.WithToken(context.Background(), "valid-token")
+
+	_, err := newClient("", "").MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: 42, OrderId: "x"})
+	assert.ErrorContains(t, err, authn.ErrServiceUnauthenticated.Error())
+	_, err = newClient("payment", "wrong-secret").MarkOrderRefunded(ctx, &order.MarkOrderRefundedReq{UserId: 42, OrderId: "x"})
+	assert.ErrorContains(t, err, authn.ErrServiceUnauthenticated.Error())
+	_, err = newClient("checkout", "service-secret").MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: 42, OrderId: "x"})
+	assert.ErrorContains(t, err, authn.ErrServiceUnauthenticated.Error())
+
+	// 支付服务的凭证通过校验，请求进入业务处理
+	_, err = newClient("payment", "service-secret").MarkOrderPaid(context.Background(), &order.MarkOrderPaidReq{UserId: 42, OrderId: "x"})
+	assert.ErrorContains(t, err, "invalid order id")
+}
@@ -1,8 +1,6 @@
 package client
 
 import (
-	"fmt"
-
 	"github.com/cloudwego/kitex/client"
 
 	"TikTokMall/app/order/conf"
@@ -19,10 +17,13 @@ func WithMuxConnection(muxConnection int) client.Option {
 	return client.WithMuxConnection(muxConnection)
 }
 
-// NewClientOptions 创建客户端选项，包括TLS配置
-func NewClientOptions() []client.Option {
+// NewClientOptions 创建调用下游服务的客户端选项，包括服务地址和TLS配置
+func NewClientOptions(svc conf.RPCClientConfig) []client.Option {
 	config := conf.GetConf()
 	options := []client.Option{}
+	if svc.Address != "" {
+		options = append(options, client.WithHostPorts(svc.Address))
+	}
 
 	// 如果启用了TLS，添加TLS配置
 	if config.TLS.Enabled {
@@ -32,10 +33,6 @@ func NewClientOptions() []client.Option {
 			config.TLS.KeyPath,
 		)
 		if err == nil {
-			// 简化TLS配置，仅使用基本选项
-			defaultAddress := fmt.Sprintf("localhost:%d", config.Service.Port)
-			options = append(options, client.WithHostPorts(defaultAddress))
-
 			// 注意：由于Kitex版本或配置问题，我们暂时不使用高级TLS配置
 			// 如果需要TLS，请确保Kitex版本支持以下API
 			// options = append(options, client.WithTLSConfig(tlsConfig))
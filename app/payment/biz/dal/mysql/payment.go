@@ -66,3 +66,10 @@ func StatusToChargeStatus(db *gorm.DB, ctx context.Context, transactionID string
 	err = db.WithContext(ctx).Where("transaction_id = ?", transactionID).Updates(&payment).Error
 	return err
 }
+
+// ListPaymentsByUserID 获取用户的全部支付记录
+func ListPaymentsByUserID(db *gorm.DB, ctx context.Context, userID int64) ([]*model.Payments, error) {
+	var payments []*model.Payments
+	err := db.WithContext(ctx).Where("user_id = ?", userID).Order("id").Find(&payments).Error
+	return payments, err
+}
@@ -46,7 +46,10 @@ func (s *ChargeService) Run(req *payment.ChargeReq) (resp *payment.ChargeResp, e
 		return nil, kkerrors.NewBizStatusError(4005003, err.Error())
 	}
 	if paymentFromCache != nil {
-		// 若缓存中存在则直接返回缓存中的交易 ID
+		// 若缓存中存在则直接返回缓存中的交易 ID，重试时再次通知订单服务，避免上次通知失败后订单停留在待支付
+		if err = s.markOrderPaid(paymentFromCache.UserID, paymentFromCache.OrderID); err != nil {
+			return nil, err
+		}
 		return &payment.ChargeResp{TransactionId: paymentFromCache.TransactionID}, nil
 	}
 
@@ -81,6 +84,23 @@ func (s *ChargeService) Run(req *payment.ChargeReq) (resp *payment.ChargeResp, e
 		return nil, kkerrors.NewBizStatusError(4005004, err.Error())
 	}
 
+	// 通知订单服务订单已支付
+	if err = s.markOrderPaid(thePayment.UserID, thePayment.OrderID); err != nil {
+		return nil, err
+	}
+
 	// 返回生成的交易 ID
 	return &payment.ChargeResp{TransactionId: transactionId.String()}, nil
 }
+
+// markOrderPaid 通知订单服务订单已支付
+func (s *ChargeService) markOrderPaid(userID, orderID int64) error {
+	updater, err := getOrderUpdater()
+	if err == nil {
+		err = updater.MarkOrderPaid(s.ctx, userID, orderID)
+	}
+	if err != nil {
+		return kkerrors.NewBizStatusError(4005011, err.Error())
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -46,6 +47,9 @@ func TestMain(m *testing.M) {
 		os.Exit(1)
 	}
 
+	// 订单状态通知记录在内存中
+	SetOrderStatusUpdater(testOrderUpdater)
+
 	code := m.Run()
 
 	// 测试后清理数据
@@ -55,6 +59,43 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
+// recordingOrderUpdater 记录支付服务发出的订单状态通知
+type recordingOrderUpdater struct {
+	mu       sync.Mutex
+	paid     map[int64]int64
+	refunded map[int64]int64
+}
+
+var testOrderUpdater = &recordingOrderUpdater{paid: map[int64]int64{}, refunded: map[int64]int64{}}
+
+func (u *recordingOrderUpdater) MarkOrderPaid(ctx context.Context, userID, orderID int64) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.paid[orderID] = userID
+	return nil
+}
+
+func (u *recordingOrderUpdater) MarkOrderRefunded(ctx context.Context, userID, orderID int64) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.refunded[orderID] = userID
+	return nil
+}
+
+func (u *recordingOrderUpdater) paidUser(orderID int64) (int64, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	userID, ok := u.paid[orderID]
+	return userID, ok
+}
+
+func (u *recordingOrderUpdater) refundedUser(orderID int64) (int64, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	userID, ok := u.refunded[orderID]
+	return userID, ok
+}
+
 // validChargeReq 返回一个合法的 ChargeReq 对象，用于成功流程测试
 func validChargeReq() *payment.ChargeReq {
 	return &payment.ChargeReq{
@@ -99,6 +140,10 @@ func TestChargeService_Run_Success(t *testing.T) {
 	assert.NoError(t, err, "合法请求不应返回错误")
 	assert.NotNil(t, resp, "返回结果不应为 nil")
 	assert.NotEmpty(t, resp.TransactionId, "TransactionId 不应为空")
+
+	userID, ok := testOrderUpdater.paidUser(req.OrderId)
+	assert.True(t, ok, "支付成功后应通知订单服务")
+	assert.Equal(t, req.UserId, userID)
 }
 
 // TestChargeService_Run_InvalidCard 测试信用卡校验失败的情况
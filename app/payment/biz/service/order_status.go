@@ -0,0 +1,74 @@
+package service
+
+import (
+	"TikTokMall/app/payment/kitex_gen/order"
+	"TikTokMall/app/payment/kitex_gen/order/orderservice"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// OrderStatusUpdater 支付、退款成功后通知订单服务更新订单状态
+// 订单服务的这两个接口是幂等的，订单已处于目标状态时直接返回成功
+type OrderStatusUpdater interface {
+	MarkOrderPaid(ctx context.Context, userID, orderID int64) error
+	MarkOrderRefunded(ctx context.Context, userID, orderID int64) error
+}
+
+var orderUpdater OrderStatusUpdater
+
+// SetOrderStatusUpdater 设置订单状态通知方式，服务启动时调用
+func SetOrderStatusUpdater(u OrderStatusUpdater) {
+	orderUpdater = u
+}
+
+func getOrderUpdater() (OrderStatusUpdater, error) {
+	if orderUpdater == nil {
+		return nil, errors.New("order status updater is not configured")
+	}
+	return orderUpdater, nil
+}
+
+// orderRPCUpdater 通过订单服务的 RPC 接口更新订单状态
+type orderRPCUpdater struct {
+	cli orderservice.Client
+}
+
+// NewOrderRPCUpdater 创建通过订单 RPC 更新订单状态的 OrderStatusUpdater
+func NewOrderRPCUpdater(cli orderservice.Client) OrderStatusUpdater {
+	return &orderRPCUpdater{cli: cli}
+}
+
+func (u *orderRPCUpdater) MarkOrderPaid(ctx context.Context, userID, orderID int64) error {
+	uid, err := orderUserID(userID)
+	if err != nil {
+		return err
+	}
+	_, err = u.cli.MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: uid, OrderId: strconv.FormatInt(orderID, 10)})
+	if err != nil {
+		return fmt.Errorf("mark order %d paid failed: %w", orderID, err)
+	}
+	return nil
+}
+
+func (u *orderRPCUpdater) MarkOrderRefunded(ctx context.Context, userID, orderID int64) error {
+	uid, err := orderUserID(userID)
+	if err != nil {
+		return err
+	}
+	_, err = u.cli.MarkOrderRefunded(ctx, &order.MarkOrderRefundedReq{UserId: uid, OrderId: strconv.FormatInt(orderID, 10)})
+	if err != nil {
+		return fmt.Errorf("mark order %d refunded failed: %w", orderID, err)
+	}
+	return nil
+}
+
+// orderUserID 订单服务的用户 ID 为 uint32
+func orderUserID(userID int64) (uint32, error) {
+	if userID <= 0 || userID > math.MaxUint32 {
+		return 0, fmt.Errorf("invalid user_id %d", userID)
+	}
+	return uint32(userID), nil
+}
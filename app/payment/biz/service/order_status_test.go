@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/payment/kitex_gen/order"
+	"TikTokMall/app/payment/kitex_gen/order/orderservice"
+)
+
+// fakeOrderClient 记录订单状态更新请求
+type fakeOrderClient struct {
+	orderservice.Client
+	paid     *order.MarkOrderPaidReq
+	refunded *order.MarkOrderRefundedReq
+}
+
+func (c *fakeOrderClient) MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (*order.MarkOrderPaidResp, error) {
+	c.paid = req
+	return &order.MarkOrderPaidResp{}, nil
+}
+
+func (c *fakeOrderClient) MarkOrderRefunded(ctx context.Context, req *order.MarkOrderRefundedReq, callOptions ...callopt.Option) (*order.MarkOrderRefundedResp, error) {
+	c.refunded = req
+	return &order.MarkOrderRefundedResp{}, nil
+}
+
+func TestOrderRPCUpdater(t *testing.T) {
+	cli := &fakeOrderClient{}
+	u := NewOrderRPCUpdater(cli)
+	ctx := context.Background()
+
+	require.NoError(t, u.MarkOrderPaid(ctx, 7, 1001))
+	require.NotNil(t, cli.paid)
+	assert.Equal(t, uint32(7), cli.paid.UserId)
+	assert.Equal(t, "1001", cli.paid.OrderId)
+
+	require.NoError(t, u.MarkOrderRefunded(ctx, 7, 1001))
+	require.NotNil(t, cli.refunded)
+	assert.Equal(t, uint32(7), cli.refunded.UserId)
+	assert.Equal(t, "1001", cli.refunded.OrderId)
+
+	// 订单服务的用户 ID 为 uint32
+	assert.Error(t, u.MarkOrderPaid(ctx, 0, 1001))
+	assert.Error(t, u.MarkOrderRefunded(ctx, 1<<32, 1001))
+}
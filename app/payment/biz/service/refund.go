@@ -50,6 +50,15 @@ func (s *RefundService) Run(req *payment.RefundReq) (resp *payment.RefundResp, e
 		return nil, kkerrors.NewBizStatusError(4005005, err.Error())
 	}
 
+	// 先通知订单服务订单已退款，失败时支付记录保持已支付，可以重试
+	updater, err := getOrderUpdater()
+	if err == nil {
+		err = updater.MarkOrderRefunded(s.ctx, thePayment.UserID, thePayment.OrderID)
+	}
+	if err != nil {
+		return nil, kkerrors.NewBizStatusError(4005012, err.Error())
+	}
+
 	err = mysql.StatusToRefundStatus(mysql.DB, s.ctx, req.TransactionId, refundId.String())
 	if err != nil {
 		return nil, kkerrors.NewBizStatusError(4005006, err.Error())
@@ -54,6 +54,10 @@ func TestRefundService_Run_Success(t *testing.T) {
 	if resp != nil {
 		assert.NotEmpty(t, resp.RefundId, "RefundId 不应为空")
 	}
+
+	userID, ok := testOrderUpdater.refundedUser(req.OrderId)
+	assert.True(t, ok, "退款成功后应通知订单服务")
+	assert.Equal(t, req.UserId, userID)
 }
 
 // TestRefundService_Run_InvalidTransactionId 测试交易 ID 无效的情况
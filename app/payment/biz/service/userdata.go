@@ -0,0 +1,59 @@
+package service
+
+import (
+	"TikTokMall/app/payment/biz/dal/mysql"
+	"TikTokMall/app/payment/biz/model"
+	"context"
+	"fmt"
+	"time"
+)
+
+// UserDataHandler 处理用户服务发布的个人数据事件，实现 userdata.Handler
+// 支付记录只保存订单、金额和交易号，不保存卡号，注销时作为交易凭证保留
+type UserDataHandler struct {
+	listPayments func(ctx context.Context, userID int64) ([]*model.Payments, error)
+}
+
+// NewUserDataHandler 创建个人数据事件处理器
+func NewUserDataHandler() *UserDataHandler {
+	return &UserDataHandler{listPayments: func(ctx context.Context, userID int64) ([]*model.Payments, error) {
+		return mysql.ListPaymentsByUserID(mysql.DB, ctx, userID)
+	}}
+}
+
+// paymentExport 导出的支付记录
+type paymentExport struct {
+	OrderID       int64     `json:"order_id"`
+	Amount        float32   `json:"amount"`
+	Status        int8      `json:"status"`
+	PaymentMethod string    `json:"payment_method"`
+	TransactionID string    `json:"transaction_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Export 导出用户的支付记录
+func (h *UserDataHandler) Export(ctx context.Context, userID int64) (interface{}, error) {
+	payments, err := h.listPayments(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("获取支付记录失败: %w", err)
+	}
+	result := struct {
+		Payments []paymentExport `json:"payments"`
+	}{Payments: []paymentExport{}}
+	for _, p := range payments {
+		result.Payments = append(result.Payments, paymentExport{
+			OrderID:       p.OrderID,
+			Amount:        p.Amount,
+			Status:        p.Status,
+			PaymentMethod: p.PaymentMethod,
+			TransactionID: p.TransactionID,
+			CreatedAt:     p.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+// Erase 支付记录不包含个人信息，不需要处理
+func (h *UserDataHandler) Erase(ctx context.Context, userID int64) error {
+	return nil
+}
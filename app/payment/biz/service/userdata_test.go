@@ -0,0 +1,34 @@
+package service
+
+import (
+	"TikTokMall/app/payment/biz/model"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUserDataHandler_Export(t *testing.T) {
+	ctx := context.Background()
+	h := &UserDataHandler{listPayments: func(ctx context.Context, userID int64) ([]*model.Payments, error) {
+		return []*model.Payments{{OrderID: 10, UserID: userID, Amount: 99.5, Status: 1, PaymentMethod: "credit_card", TransactionID: "tx-1"}}, nil
+	}}
+
+	data, err := h.Export(ctx, 1)
+	require.NoError(t, err)
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+	var decoded struct {
+		Payments []struct {
+			OrderID       int64  `json:"order_id"`
+			TransactionID string `json:"transaction_id"`
+		} `json:"payments"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	require.Len(t, decoded.Payments, 1)
+	assert.Equal(t, int64(10), decoded.Payments[0].OrderID)
+	assert.Equal(t, "tx-1", decoded.Payments[0].TransactionID)
+
+	assert.NoError(t, h.Erase(ctx, 1))
+}
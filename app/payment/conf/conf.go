@@ -17,12 +17,16 @@ var (
 )
 
 type Config struct {
-	Env      string
-	Kitex    Kitex    `yaml:"kitex"`
-	MySQL    MySQL    `yaml:"mysql"`
-	Redis    Redis    `yaml:"redis"`
-	Registry Registry `yaml:"registry"`
-	Alipay   Alipay   `yaml:"alipay"`
+	Env         string
+	Kitex       Kitex       `yaml:"kitex"`
+	MySQL       MySQL       `yaml:"mysql"`
+	Redis       Redis       `yaml:"redis"`
+	Registry    Registry    `yaml:"registry"`
+	Alipay      Alipay      `yaml:"alipay"`
+	AuthRPC     RPCClient   `yaml:"auth_rpc"`
+	OrderRPC    RPCClient   `yaml:"order_rpc"`
+	ServiceAuth ServiceAuth `yaml:"service_auth"`
+	CORS        CORS        `yaml:"cors"`
 }
 
 type MySQL struct {
@@ -59,6 +63,22 @@ type Alipay struct {
 	NotifyUrl    string `yaml:"notify_url"`
 }
 
+// RPCClient 下游 Kitex 服务配置
+type RPCClient struct {
+	Service string `yaml:"service"` // 服务注册名，与下游服务的 kitex.service 一致
+	Address string `yaml:"address"` // 服务的 RPC 地址，如 127.0.0.1:8888
+}
+
+// ServiceAuth 服务间调用凭证配置，所有服务使用相同的共享密钥
+type ServiceAuth struct {
+	Secret string `yaml:"secret"` // 可通过 SERVICE_AUTH_SECRET 覆盖
+}
+
+// CORS 跨域配置，只有 allow_origins 中的来源可以携带 Cookie 访问，为空时拒绝跨域请求
+type CORS struct {
+	AllowOrigins []string `yaml:"allow_origins"`
+}
+
 // GetConf gets configuration instance
 func GetConf() *Config {
 	once.Do(initConf)
@@ -13,6 +13,9 @@ func (s *PaymentServiceImpl) AlipayCharge(ctx context.Context, req *payment.Alip
 	if req == nil {
 		return nil, kkerrors.NewBizStatusError(4004001, "Invalid request")
 	}
+	if err := authorizeUser(ctx, &req.UserId); err != nil {
+		return nil, err
+	}
 	resp, err = service.NewAlipayChargeService(ctx).Run(req)
 	if err != nil {
 		return nil, err
@@ -33,7 +36,7 @@ func AlipayChargeHandler(c context.Context, ctx *app.RequestContext) {
 	paymentService := &PaymentServiceImpl{}
 	resp, err := paymentService.AlipayCharge(c, &req)
 	if err != nil {
-		handleError(ctx, http.StatusInternalServerError, "Failed to process payment", err)
+		handleError(ctx, errorStatus(err), "Failed to process payment", err)
 		return
 	}
 
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	kkerrors "github.com/cloudwego/kitex/pkg/kerrors"
+
+	"TikTokMall/pkg/authn"
+)
+
+// 认证相关的业务错误码
+const (
+	bizCodeUnauthenticated = 4010001
+	bizCodeUserMismatch    = 4030001
+)
+
+// PermOrderRefund 发起退款需要的权限，与 auth 服务的内置权限一致
+const PermOrderRefund = "order:refund"
+
+// authorizeUser 校验请求中的 user_id 与访问令牌一致，未传时使用令牌中的用户
+func authorizeUser(ctx context.Context, userID *int64) error {
+	id, err := authn.UserID(ctx, *userID)
+	if err != nil {
+		if errors.Is(err, authn.ErrUserMismatch) {
+			return kkerrors.NewBizStatusError(bizCodeUserMismatch, err.Error())
+		}
+		return kkerrors.NewBizStatusError(bizCodeUnauthenticated, err.Error())
+	}
+	*userID = id
+	return nil
+}
+
+// errorStatus 返回错误对应的 HTTP 状态码，认证失败返回 401，用户不一致返回 403
+func errorStatus(err error) int {
+	if bizErr, ok := kkerrors.FromBizStatusError(err); ok {
+		switch bizErr.BizStatusCode() {
+		case bizCodeUnauthenticated:
+			return http.StatusUnauthorized
+		case bizCodeUserMismatch:
+			return http.StatusForbidden
+		}
+	}
+	return http.StatusInternalServerError
+}
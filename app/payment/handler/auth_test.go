@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	kkerrors "github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	payment "TikTokMall/app/payment/kitex_gen/payment"
+	"TikTokMall/pkg/authn"
+)
+
+func TestAuthorizeUser(t *testing.T) {
+	userID := int64(7)
+	err := authorizeUser(context.Background(), &userID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, errorStatus(err))
+
+	ctx := authn.WithIdentity(context.Background(), &authn.Identity{UserID: 7})
+	userID = 8
+	err = authorizeUser(ctx, &userID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, errorStatus(err))
+
+	userID = 0
+	require.NoError(t, authorizeUser(ctx, &userID))
+	assert.Equal(t, int64(7), userID)
+
+	assert.Equal(t, http.StatusInternalServerError, errorStatus(kkerrors.NewBizStatusError(4004001, "Invalid request")))
+}
+
+func TestPaymentServiceImpl_RejectsOtherUser(t *testing.T) {
+	impl := &PaymentServiceImpl{}
+	ctx := authn.WithIdentity(context.Background(), &authn.Identity{UserID: 7})
+
+	_, err := impl.Charge(ctx, &payment.ChargeReq{UserId: 8, OrderId: 1, Amount: 10})
+	assert.Equal(t, http.StatusForbidden, errorStatus(err))
+
+	_, err = impl.AlipayCharge(context.Background(), &payment.AlipayChargeReq{UserId: 7})
+	assert.Equal(t, http.StatusUnauthorized, errorStatus(err))
+}
@@ -14,6 +14,8 @@ func (s *PaymentServiceImpl) Refund(ctx context.Context, req *payment.RefundReq)
 	if req == nil {
 		return nil, kkerrors.NewBizStatusError(4004001, "Invalid request")
 	}
+	// 退款由拥有 PermOrderRefund 权限的客服或管理员代用户发起，权限由 rbac 中间件检查
+	// user_id 为付款用户，由退款服务与支付记录核对
 	resp, err = service.NewRefundService(ctx).Run(req)
 	if err != nil {
 		return nil, err
@@ -33,7 +35,7 @@ func RefundHandler(c context.Context, ctx *app.RequestContext) {
 	paymentService := &PaymentServiceImpl{}
 	resp, err := paymentService.Refund(c, &req)
 	if err != nil {
-		handleError(ctx, http.StatusInternalServerError, "Failed to process refund", err)
+		handleError(ctx, errorStatus(err), "Failed to process refund", err)
 		return
 	}
 
@@ -2,15 +2,27 @@ package main
 
 import (
 	"TikTokMall/app/payment/biz/dal"
+	"TikTokMall/app/payment/biz/dal/redis"
+	"TikTokMall/app/payment/biz/service"
 	"TikTokMall/app/payment/conf"
 	"TikTokMall/app/payment/handler"
+	"TikTokMall/app/payment/kitex_gen/auth"
+	"TikTokMall/app/payment/kitex_gen/auth/authservice"
+	"TikTokMall/app/payment/kitex_gen/order/orderservice"
 	"TikTokMall/app/payment/kitex_gen/payment/paymentservice"
+	"TikTokMall/pkg/authn"
+	"TikTokMall/pkg/rbac"
+	"TikTokMall/pkg/userdata"
+	"TikTokMall/pkg/websession"
 	"context"
 	hserver "github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	kclient "github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/transmeta"
 	kserver "github.com/cloudwego/kitex/server"
+	"github.com/cloudwego/kitex/transport"
 	"github.com/hashicorp/consul/api"
 	"github.com/joho/godotenv"
 	kitexlogrus "github.com/kitex-contrib/obs-opentelemetry/logging/logrus"
@@ -24,16 +36,53 @@ import (
 func main() {
 	var wg sync.WaitGroup
 
+	// 访问令牌通过 auth 服务校验，已吊销的令牌和被封禁用户的令牌立即失效，同时获取角色和权限供退款鉴权使用
+	authRPC := conf.GetConf().AuthRPC
+	authClient, err := authservice.NewClient(authRPC.Service, kclient.WithHostPorts(authRPC.Address))
+	if err != nil {
+		panic(err)
+	}
+	verifier := authn.NewRPCVerifier(func(ctx context.Context, token string, withRoles bool) (*auth.VerifyResp, error) {
+		return authClient.VerifyTokenByRPC(ctx, &auth.VerifyTokenReq{Token: token, WithRoles: withRoles})
+	}, authn.WithRoles())
+
+	// 支付、退款成功后通过订单服务的内部 RPC 更新订单状态，调用携带支付服务的服务凭证
+	serviceSecret, err := authn.ServiceSecret(conf.GetConf().ServiceAuth.Secret)
+	if err != nil {
+		panic(err)
+	}
+	orderRPC := conf.GetConf().OrderRPC
+	orderClient, err := orderservice.NewClient(orderRPC.Service,
+		kclient.WithHostPorts(orderRPC.Address),
+		kclient.WithTransportProtocol(transport.TTHeader),
+		kclient.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+		kclient.WithMiddleware(authn.ServiceClientMiddleware("payment", serviceSecret)),
+	)
+	if err != nil {
+		panic(err)
+	}
+	service.SetOrderStatusUpdater(service.NewOrderRPCUpdater(orderClient))
+
 	// 初始化 Kitex 服务
-	opts := kitexInit()
+	opts := kitexInit(verifier)
 	// 获取环境变量
 	_ = godotenv.Load()
 	// 初始化 Redis 和 MySQL 客户端
-	err := dal.Init()
+	err = dal.Init()
 	if err != nil {
 		klog.Error("Failed to initialize Redis and MySQL: %v", err)
 	}
 
+	// 订阅用户服务的个人数据事件，导出支付记录
+	if redis.Client != nil {
+		go func() {
+			err := userdata.Subscribe(context.Background(), redis.Client, "payment", service.NewUserDataHandler())
+			if err != nil {
+				klog.Errorf("subscribe userdata events failed: %v", err)
+			}
+		}()
+	}
+
 	wg.Add(1)
 	// 创建支付服务的 handler 实例
 	svr := paymentservice.NewServer(new(handler.PaymentServiceImpl), opts...)
@@ -51,7 +100,7 @@ func main() {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err := startHTTPServer()
+		err := startHTTPServer(verifier)
 		if err != nil {
 			hlog.Error("Failed to start HTTP server: %v", err)
 			panic(err)
@@ -61,19 +110,25 @@ func main() {
 	wg.Wait()
 }
 
-// startHTTPServer 启动 HTTP 服务并注册服务
-func startHTTPServer() error {
+// startHTTPServer 启动 HTTP 服务并注册服务，支付接口要求携带访问令牌
+func startHTTPServer(verifier authn.Verifier) error {
 	h := hserver.Default(
 		hserver.WithHostPorts(":8005"),
 		hserver.WithKeepAlive(true),
 	)
 
+	// 添加CORS中间件，只允许配置的前端来源携带 Cookie
+	h.Use(websession.CORS(websession.CORSConfig{AllowOrigins: conf.GetConf().CORS.AllowOrigins}))
+
+	// 浏览器 Cookie 会话：校验 CSRF 令牌并将 Cookie 中的访问令牌转为 Authorization 头
+	h.Use(websession.Middleware())
+
 	v1 := h.Group("/payment")
 	{
 		v1.GET("/health", handler.HealthHandler)
-		v1.POST("/charge", handler.ChargeHandler)
-		v1.POST("/refund", handler.RefundHandler)
-		v1.POST("/alipay/charge", handler.AlipayChargeHandler)
+		v1.POST("/charge", authn.Authenticate(verifier), handler.ChargeHandler)
+		v1.POST("/refund", authn.Authenticate(verifier), rbac.Require(rbac.IdentityResolver, handler.PermOrderRefund), handler.RefundHandler)
+		v1.POST("/alipay/charge", authn.Authenticate(verifier), handler.AlipayChargeHandler)
 	}
 
 	// 添加 OnRun 钩子，在服务启动后注册 Consul
@@ -90,7 +145,7 @@ func startHTTPServer() error {
 	return h.Run()
 }
 
-func kitexInit() (opts []kserver.Option) {
+func kitexInit(verifier authn.Verifier) (opts []kserver.Option) {
 	// address
 	addr, err := net.ResolveTCPAddr("tcp", conf.GetConf().Kitex.Address)
 	if err != nil {
@@ -103,6 +158,13 @@ func kitexInit() (opts []kserver.Option) {
 		ServiceName: conf.GetConf().Kitex.Service,
 	}))
 
+	// 调用方通过 TTHeader 的 metainfo 传递用户的访问令牌，退款要求调用方拥有退款权限
+	opts = append(opts,
+		kserver.WithMetaHandler(transmeta.ServerTTHeaderHandler),
+		kserver.WithMiddleware(authn.KitexMiddleware(verifier)),
+		kserver.WithMiddleware(rbac.KitexMiddleware(rbac.IdentityResolver, rbac.Rules{"Refund": {handler.PermOrderRefund}})),
+	)
+
 	// klog
 	logger := kitexlogrus.NewLogger()
 	klog.SetLogger(logger)
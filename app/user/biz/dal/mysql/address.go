@@ -0,0 +1,128 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Address 用户收货地址
+type Address struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID         int64     `gorm:"column:user_id;not null;index"`
+	Label          string    `gorm:"column:label;size:32"`
+	RecipientName  string    `gorm:"column:recipient_name;size:64;not null"`
+	RecipientPhone string    `gorm:"column:recipient_phone;size:16;not null"`
+	StreetAddress  string    `gorm:"column:street_address;size:255;not null"`
+	City           string    `gorm:"column:city;size:64;not null"`
+	State          string    `gorm:"column:state;size:64"`
+	Country        string    `gorm:"column:country;size:64;not null"`
+	ZipCode        string    `gorm:"column:zip_code;size:16"`
+	IsDefault      bool      `gorm:"column:is_default;not null;default:false"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for Address model
+func (Address) TableName() string {
+	return "addresses"
+}
+
+// ListAddresses 查询用户的全部地址，默认地址在前，其余按创建时间倒序
+func ListAddresses(userID int64) ([]*Address, error) {
+	var addrs []*Address
+	err := DB.Where("user_id = ?", userID).Order("is_default DESC, id DESC").Find(&addrs).Error
+	return addrs, err
+}
+
+// GetAddress 查询用户的指定地址，不存在或不属于该用户时返回 nil
+func GetAddress(userID, addressID int64) (*Address, error) {
+	var addr Address
+	err := DB.Where("id = ? AND user_id = ?", addressID, userID).First(&addr).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &addr, err
+}
+
+// CountAddresses 统计用户的地址数量
+func CountAddresses(userID int64) (int64, error) {
+	var n int64
+	err := DB.Model(&Address{}).Where("user_id = ?", userID).Count(&n).Error
+	return n, err
+}
+
+// CreateAddress 创建地址，设为默认地址时同时取消其他地址的默认标记
+func CreateAddress(addr *Address) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if addr.IsDefault {
+			if err := clearDefaultAddress(tx, addr.UserID); err != nil {
+				return err
+			}
+		}
+		return tx.Create(addr).Error
+	})
+}
+
+// UpdateAddress 更新地址内容，不修改默认标记
+func UpdateAddress(addr *Address) error {
+	return DB.Model(&Address{}).
+		Where("id = ? AND user_id = ?", addr.ID, addr.UserID).
+		Updates(map[string]interface{}{
+			"label":           addr.Label,
+			"recipient_name":  addr.RecipientName,
+			"recipient_phone": addr.RecipientPhone,
+			"street_address":  addr.StreetAddress,
+			"city":            addr.City,
+			"state":           addr.State,
+			"country":         addr.Country,
+			"zip_code":        addr.ZipCode,
+		}).Error
+}
+
+// DeleteAddress 删除地址，删除的是默认地址时将最新的地址设为默认
+func DeleteAddress(userID, addressID int64) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		var addr Address
+		if err := tx.Where("id = ? AND user_id = ?", addressID, userID).First(&addr).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil
+			}
+			return err
+		}
+		if err := tx.Delete(&addr).Error; err != nil {
+			return err
+		}
+		if !addr.IsDefault {
+			return nil
+		}
+		var next Address
+		err := tx.Where("user_id = ?", userID).Order("id DESC").First(&next).Error
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Model(&next).Update("is_default", true).Error
+	})
+}
+
+// SetDefaultAddress 将指定地址设为用户的默认地址
+func SetDefaultAddress(userID, addressID int64) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if err := clearDefaultAddress(tx, userID); err != nil {
+			return err
+		}
+		return tx.Model(&Address{}).
+			Where("id = ? AND user_id = ?", addressID, userID).
+			Update("is_default", true).Error
+	})
+}
+
+// clearDefaultAddress 取消用户所有地址的默认标记
+func clearDefaultAddress(tx *gorm.DB, userID int64) error {
+	return tx.Model(&Address{}).
+		Where("user_id = ? AND is_default = ?", userID, true).
+		Update("is_default", false).Error
+}
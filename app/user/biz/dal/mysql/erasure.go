@@ -0,0 +1,78 @@
+package mysql
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ScheduleUserErasure 标记用户为注销中，宽限期结束后匿名化
+func ScheduleUserErasure(userID int64, eraseAfter time.Time) error {
+	return DB.Model(&User{}).
+		Where("id = ? AND status = ?", userID, UserStatusNormal).
+		Updates(map[string]interface{}{
+			"status":      UserStatusDeleting,
+			"erase_after": eraseAfter,
+		}).Error
+}
+
+// CancelUserErasure 撤销注销，只对宽限期内的用户生效，返回是否撤销成功
+func CancelUserErasure(userID int64) (bool, error) {
+	result := DB.Model(&User{}).
+		Where("id = ? AND status = ?", userID, UserStatusDeleting).
+		Updates(map[string]interface{}{
+			"status":      UserStatusNormal,
+			"erase_after": nil,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListUsersDueForErasure 查询宽限期已结束的注销用户
+func ListUsersDueForErasure(now time.Time, limit int) ([]*User, error) {
+	var users []*User
+	err := DB.Where("status = ? AND erase_after <= ?", UserStatusDeleting, now).
+		Order("erase_after").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
+
+// ListTokenDigestsByUserID 查询用户全部访问令牌的摘要
+func ListTokenDigestsByUserID(userID int64) ([]string, error) {
+	var digests []string
+	err := DB.Model(&Token{}).Where("user_id = ?", userID).Pluck("token", &digests).Error
+	return digests, err
+}
+
+// AnonymizeUser 匿名化用户：清除用户名、密码、邮箱、手机号和头像，删除地址、心愿单、历史密码和令牌
+// 保留用户行，订单等记录中的用户ID仍然有效
+func AnonymizeUser(userID int64) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+			"username":    fmt.Sprintf("erased_%d", userID),
+			"password":    "!", // 不是合法的 bcrypt 哈希，无法再登录
+			"email":       "",
+			"phone":       "",
+			"avatar":      "",
+			"status":      UserStatusErased,
+			"erase_after": nil,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&Address{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&WishlistItem{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&Wishlist{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&PasswordHistory{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&Token{}).Error
+	})
+}
@@ -0,0 +1,21 @@
+package mysql
+
+import "time"
+
+// PasswordHistory 用户使用过的密码哈希（与auth服务共享表结构）
+type PasswordHistory struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null;index"`
+	Password  string    `gorm:"column:password;size:255;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for PasswordHistory model
+func (PasswordHistory) TableName() string {
+	return "password_histories"
+}
+
+// AddPasswordHistory 记录用户的密码哈希
+func AddPasswordHistory(userID int64, password string) error {
+	return DB.Create(&PasswordHistory{UserID: userID, Password: password}).Error
+}
@@ -0,0 +1,188 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 积分流水类型
+const (
+	PointsEarn         = "earn"          // 订单支付或完成后获得
+	PointsRefund       = "refund"        // 订单退款后扣回
+	PointsRedeem       = "redeem"        // 结算时抵扣
+	PointsRedeemCancel = "redeem_cancel" // 结算失败后退回抵扣的积分
+	PointsExpire       = "expire"        // 到期作废
+)
+
+// PointsAccount 用户积分账户，积分变化时锁定该行
+type PointsAccount struct {
+	UserID    int64     `gorm:"column:user_id;primaryKey;autoIncrement:false"`
+	Debt      int64     `gorm:"column:debt;not null;default:0"` // 退款时余额不足扣回的积分，之后获得的积分先抵扣
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for PointsAccount model
+func (PointsAccount) TableName() string {
+	return "points_accounts"
+}
+
+// PointsEntry 积分流水
+// 获得的积分（earn、redeem_cancel）按批记录剩余数量，抵扣、扣回和到期时按到期时间先后消耗
+type PointsEntry struct {
+	ID        int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64      `gorm:"column:user_id;not null;index"`
+	Kind      string     `gorm:"column:kind;size:16;not null"`
+	Points    int64      `gorm:"column:points;not null"`                      // 对余额的影响，获得为正，扣减为负
+	Remaining int64      `gorm:"column:remaining;not null;default:0"`         // 获得的积分中尚未使用的部分
+	Amount    float64    `gorm:"column:amount;type:decimal(10,2);not null"`   // 计入会员等级的消费金额变化
+	OrderID   string     `gorm:"column:order_id;size:64;not null;default:''"` // 订单服务的订单ID或结算订单号
+	Ref       string     `gorm:"column:ref;size:96;not null;uniqueIndex"`     // 幂等键
+	ExpiresAt *time.Time `gorm:"column:expires_at"`                           // 为空表示不过期
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for PointsEntry model
+func (PointsEntry) TableName() string {
+	return "points_ledger"
+}
+
+// Expired 积分在 now 时是否已到期
+func (e *PointsEntry) Expired(now time.Time) bool {
+	return e.ExpiresAt != nil && !e.ExpiresAt.After(now)
+}
+
+// PointsBook 一次积分变化中读取的用户账本
+type PointsBook struct {
+	Account  *PointsAccount
+	Lots     []*PointsEntry          // 尚有剩余积分的流水，读取时按到期时间升序，不过期的在最后
+	Existing map[string]*PointsEntry // 按幂等键读取的已有流水
+	Added    []*PointsEntry          // 本次新增的流水
+}
+
+// Balance 积分余额：未到期的剩余积分减去欠扣积分
+func (b *PointsBook) Balance(now time.Time) int64 {
+	var balance int64
+	for _, lot := range b.Lots {
+		if !lot.Expired(now) {
+			balance += lot.Remaining
+		}
+	}
+	return balance - b.Account.Debt
+}
+
+// Add 新增流水，同时作为已有流水记录，之后按幂等键可以查到
+func (b *PointsBook) Add(e *PointsEntry) {
+	e.UserID = b.Account.UserID
+	b.Added = append(b.Added, e)
+	b.Existing[e.Ref] = e
+	if e.Remaining > 0 {
+		b.Lots = append(b.Lots, e)
+	}
+}
+
+// GetPointsBook 读取用户账本，不加锁，只用于查询
+func GetPointsBook(userID int64) (*PointsBook, error) {
+	return loadPointsBook(DB, userID, nil)
+}
+
+// UpdatePointsBook 锁定用户积分账户并读取账本和 refs 对应的已有流水，fn 返回 nil 后写回
+func UpdatePointsBook(userID int64, refs []string, fn func(book *PointsBook) error) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		// 第一次使用时创建账户，之后加锁串行化同一用户的积分变化
+		account := &PointsAccount{UserID: userID}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(account).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(account, "user_id = ?", userID).Error; err != nil {
+			return err
+		}
+		book, err := loadPointsBook(tx, userID, refs)
+		if err != nil {
+			return err
+		}
+		book.Account = account
+		debt := account.Debt
+		remaining := make(map[int64]int64, len(book.Lots))
+		for _, lot := range book.Lots {
+			remaining[lot.ID] = lot.Remaining
+		}
+
+		if err := fn(book); err != nil {
+			return err
+		}
+
+		if account.Debt != debt {
+			if err := tx.Model(account).Update("debt", account.Debt).Error; err != nil {
+				return err
+			}
+		}
+		for _, lot := range book.Lots {
+			if old, ok := remaining[lot.ID]; ok && old != lot.Remaining {
+				if err := tx.Model(lot).Update("remaining", lot.Remaining).Error; err != nil {
+					return err
+				}
+			}
+		}
+		if len(book.Added) > 0 {
+			return tx.Create(book.Added).Error
+		}
+		return nil
+	})
+}
+
+func loadPointsBook(db *gorm.DB, userID int64, refs []string) (*PointsBook, error) {
+	book := &PointsBook{Account: &PointsAccount{UserID: userID}, Existing: map[string]*PointsEntry{}}
+	if err := db.Where("user_id = ?", userID).Limit(1).Find(book.Account).Error; err != nil {
+		return nil, err
+	}
+	err := db.Where("user_id = ? AND remaining > 0", userID).
+		Order("expires_at IS NULL, expires_at, id").
+		Find(&book.Lots).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(refs) > 0 {
+		var entries []*PointsEntry
+		if err := db.Where("ref IN ?", refs).Find(&entries).Error; err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			book.Existing[e.Ref] = e
+		}
+	}
+	return book, nil
+}
+
+// ListPointsEntries 按时间倒序分页查询积分流水，同时返回总数
+func ListPointsEntries(userID int64, offset, limit int) ([]*PointsEntry, int64, error) {
+	var total int64
+	if err := DB.Model(&PointsEntry{}).Where("user_id = ?", userID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var entries []*PointsEntry
+	err := DB.Where("user_id = ?", userID).Order("id DESC").Offset(offset).Limit(limit).Find(&entries).Error
+	return entries, total, err
+}
+
+// SumPointsSpend 统计 since 之后计入会员等级的消费金额
+func SumPointsSpend(userID int64, since time.Time) (float64, error) {
+	var spend float64
+	err := DB.Model(&PointsEntry{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Scan(&spend).Error
+	return spend, err
+}
+
+// ListUsersWithExpiredPoints 查询有到期未作废积分的用户
+func ListUsersWithExpiredPoints(now time.Time, limit int) ([]int64, error) {
+	var userIDs []int64
+	err := DB.Model(&PointsEntry{}).
+		Distinct("user_id").
+		Where("remaining > 0 AND expires_at <= ?", now).
+		Limit(limit).
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
@@ -6,11 +6,11 @@ import (
 	"gorm.io/gorm"
 )
 
-// Token 令牌模型
+// Token 令牌模型，与 auth 服务共用 tokens 表，令牌只保存 SHA-256 摘要
 type Token struct {
 	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
 	UserID       int64     `gorm:"column:user_id;index;not null"`
-	Token        string    `gorm:"column:token;size:512;not null;index"`
+	Token        string    `gorm:"column:token;size:1024;not null;index"`
 	RefreshToken string    `gorm:"column:refresh_token;size:512;index"`
 	ExpiredAt    time.Time `gorm:"column:expired_at;not null"`
 	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
@@ -26,20 +26,20 @@ func CreateToken(token *Token) error {
 	return DB.Create(token).Error
 }
 
-// GetTokenByToken 通过Token获取记录
-func GetTokenByToken(token string) (*Token, error) {
+// GetTokenByToken 通过访问令牌摘要获取记录
+func GetTokenByToken(digest string) (*Token, error) {
 	var t Token
-	err := DB.Where("token = ?", token).First(&t).Error
+	err := DB.Where("token = ?", digest).First(&t).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
 	return &t, err
 }
 
-// GetTokenByRefreshToken 通过RefreshToken获取记录
-func GetTokenByRefreshToken(refreshToken string) (*Token, error) {
+// GetTokenByRefreshToken 通过刷新令牌摘要获取记录
+func GetTokenByRefreshToken(digest string) (*Token, error) {
 	var t Token
-	err := DB.Where("refresh_token = ?", refreshToken).First(&t).Error
+	err := DB.Where("refresh_token = ?", digest).First(&t).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
@@ -47,8 +47,8 @@ func GetTokenByRefreshToken(refreshToken string) (*Token, error) {
 }
 
 // DeleteToken 删除Token记录
-func DeleteToken(token string) error {
-	return DB.Where("token = ?", token).Delete(&Token{}).Error
+func DeleteToken(digest string) error {
+	return DB.Where("token = ?", digest).Delete(&Token{}).Error
 }
 
 // DeleteTokenByUserID 删除用户的所有Token
@@ -78,10 +78,10 @@ func GetValidTokenByUserID(userID int64) (*Token, error) {
 }
 
 // IsTokenValid 检查Token是否有效
-func IsTokenValid(token string) (bool, error) {
+func IsTokenValid(digest string) (bool, error) {
 	var count int64
 	err := DB.Model(&Token{}).
-		Where("token = ? AND expired_at > ?", token, time.Now()).
+		Where("token = ? AND expired_at > ?", digest, time.Now()).
 		Count(&count).Error
 	return count > 0, err
 }
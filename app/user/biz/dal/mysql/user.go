@@ -1,18 +1,31 @@
 package mysql
 
 import (
+	"time"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 用户状态，与auth服务一致
+const (
+	UserStatusNormal   = 1
+	UserStatusBanned   = 2
+	UserStatusDeleting = 3 // 已申请注销，宽限期内可以撤销
+	UserStatusErased   = 4 // 个人数据已匿名化
 )
 
 // User 用户表结构（与auth服务共享表结构）
 type User struct {
 	gorm.Model
-	ID       int64  `gorm:"primaryKey;autoIncrement"`
-	Username string `gorm:"uniqueIndex;size:32;not null"`
-	Password string `gorm:"not null"`
-	Email    string `gorm:"size:64"`
-	Phone    string `gorm:"size:16"`
-	Status   int    `gorm:"default:1"`
+	ID         int64      `gorm:"primaryKey;autoIncrement"`
+	Username   string     `gorm:"uniqueIndex;size:32;not null"`
+	Password   string     `gorm:"not null"`
+	Email      string     `gorm:"size:64"`
+	Phone      string     `gorm:"size:16"`
+	Status     int        `gorm:"default:1"`
+	EraseAfter *time.Time `gorm:"column:erase_after;index"` // 注销宽限期结束时间
+	Avatar     string     `gorm:"column:avatar;size:128"`   // 头像在对象存储中的键前缀，为空表示未设置
 }
 
 // CreateUser 创建用户
@@ -30,16 +43,25 @@ func GetUserByUsername(username string) (*User, error) {
 	return &user, err
 }
 
-// DeleteUser 删除用户
-func DeleteUser(userID int64) error {
-	return DB.Delete(&User{}, userID).Error
-}
-
 // UpdateUser 更新用户信息
 func UpdateUser(userID int64, updates map[string]interface{}) error {
 	return DB.Model(&User{}).Where("id = ?", userID).Updates(updates).Error
 }
 
+// SetUserAvatar 更新用户头像，返回原来的头像键前缀，avatar 为空时清除头像
+func SetUserAvatar(userID int64, avatar string) (string, error) {
+	var old string
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		var user User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "avatar").First(&user, userID).Error; err != nil {
+			return err
+		}
+		old = user.Avatar
+		return tx.Model(&User{}).Where("id = ?", userID).Update("avatar", avatar).Error
+	})
+	return old, err
+}
+
 // GetUserByID 根据ID查询用户
 func GetUserByID(userID int64) (*User, error) {
 	var user User
@@ -0,0 +1,192 @@
+package mysql
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Wishlist 用户的心愿单，同一用户的名称唯一
+type Wishlist struct {
+	ID        int64           `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64           `gorm:"column:user_id;not null;uniqueIndex:idx_user_id_name"`
+	Name      string          `gorm:"column:name;size:64;not null;uniqueIndex:idx_user_id_name"`
+	CreatedAt time.Time       `gorm:"column:created_at;autoCreateTime"`
+	Items     []*WishlistItem `gorm:"foreignKey:WishlistID"`
+}
+
+// TableName specifies the table name for Wishlist model
+func (Wishlist) TableName() string {
+	return "wishlists"
+}
+
+// WishlistItem 心愿单中的商品
+// LastPrice 和 LastStock 为最近一次检查时商品服务返回的值，用于判断降价和到货
+type WishlistItem struct {
+	ID                int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	WishlistID        int64     `gorm:"column:wishlist_id;not null;uniqueIndex:idx_wishlist_id_product_id"`
+	UserID            int64     `gorm:"column:user_id;not null;index"`
+	ProductID         uint32    `gorm:"column:product_id;not null;uniqueIndex:idx_wishlist_id_product_id;index"`
+	SavedPrice        float64   `gorm:"column:saved_price;type:decimal(10,2);not null"` // 加入心愿单时的价格
+	LastPrice         float64   `gorm:"column:last_price;type:decimal(10,2);not null"`
+	LastStock         uint32    `gorm:"column:last_stock;not null;default:0"`
+	NotifyPriceDrop   bool      `gorm:"column:notify_price_drop;not null;default:false"`
+	NotifyBackInStock bool      `gorm:"column:notify_back_in_stock;not null;default:false"`
+	CreatedAt         time.Time `gorm:"column:created_at;autoCreateTime"`
+	Wishlist          *Wishlist `gorm:"foreignKey:WishlistID"`
+}
+
+// TableName specifies the table name for WishlistItem model
+func (WishlistItem) TableName() string {
+	return "wishlist_items"
+}
+
+// ListWishlists 查询用户的全部心愿单及其商品，按创建先后排列
+func ListWishlists(userID int64) ([]*Wishlist, error) {
+	var lists []*Wishlist
+	err := DB.Where("user_id = ?", userID).
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("id DESC") }).
+		Order("id").
+		Find(&lists).Error
+	return lists, err
+}
+
+// AddWishlistItem 将商品加入用户指定名称的心愿单，心愿单不存在时创建
+// 创建前调用 limit 检查加入后用户的心愿单数量和该心愿单的商品数量，返回错误时不写入
+// 商品已在心愿单中时返回已有记录，created 为 false
+func AddWishlistItem(userID int64, name string, item *WishlistItem, limit func(lists, items int64) error) (saved *WishlistItem, created bool, err error) {
+	err = DB.Transaction(func(tx *gorm.DB) error {
+		list, err := lockWishlist(tx, userID, name)
+		if err != nil {
+			return err
+		}
+		var lists, items int64
+		if list != nil {
+			var existing WishlistItem
+			err := tx.Where("wishlist_id = ? AND product_id = ?", list.ID, item.ProductID).First(&existing).Error
+			if err == nil {
+				saved = &existing
+				return nil
+			}
+			if err != gorm.ErrRecordNotFound {
+				return err
+			}
+			if err := tx.Model(&WishlistItem{}).Where("wishlist_id = ?", list.ID).Count(&items).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&Wishlist{}).Where("user_id = ?", userID).Count(&lists).Error; err != nil {
+			return err
+		}
+		if list == nil {
+			lists++
+		}
+		if err := limit(lists, items+1); err != nil {
+			return err
+		}
+
+		if list == nil {
+			list = &Wishlist{UserID: userID, Name: name}
+			if err := tx.Create(list).Error; err != nil {
+				return err
+			}
+		}
+		item.WishlistID = list.ID
+		item.UserID = userID
+		if err := tx.Create(item).Error; err != nil {
+			return err
+		}
+		saved, created = item, true
+		return nil
+	})
+	return saved, created, err
+}
+
+// lockWishlist 加锁读取用户指定名称的心愿单，不存在时返回 nil
+func lockWishlist(tx *gorm.DB, userID int64, name string) (*Wishlist, error) {
+	var list Wishlist
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND name = ?", userID, name).
+		First(&list).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &list, err
+}
+
+// GetWishlistItem 查询用户指定心愿单中的商品，不存在时返回 nil
+func GetWishlistItem(userID int64, name string, productID uint32) (*WishlistItem, error) {
+	var item WishlistItem
+	err := DB.Joins("JOIN wishlists ON wishlists.id = wishlist_items.wishlist_id").
+		Where("wishlists.user_id = ? AND wishlists.name = ? AND wishlist_items.product_id = ?", userID, name, productID).
+		First(&item).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &item, err
+}
+
+// RemoveWishlistItem 从用户指定心愿单中移除商品，返回是否删除了记录
+func RemoveWishlistItem(userID int64, name string, productID uint32) (bool, error) {
+	result := DB.Where("user_id = ? AND product_id = ? AND wishlist_id IN (?)", userID, productID,
+		DB.Model(&Wishlist{}).Select("id").Where("user_id = ? AND name = ?", userID, name)).
+		Delete(&WishlistItem{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteWishlist 删除用户指定名称的心愿单及其商品，返回是否删除了记录
+func DeleteWishlist(userID int64, name string) (bool, error) {
+	var deleted bool
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		list, err := lockWishlist(tx, userID, name)
+		if err != nil || list == nil {
+			return err
+		}
+		if err := tx.Where("wishlist_id = ?", list.ID).Delete(&WishlistItem{}).Error; err != nil {
+			return err
+		}
+		deleted = true
+		return tx.Delete(list).Error
+	})
+	return deleted, err
+}
+
+// UpdateWishlistAlerts 更新商品的提醒设置，同时以当前价格和库存作为之后比较的基准
+func UpdateWishlistAlerts(item *WishlistItem) error {
+	return DB.Model(&WishlistItem{}).Where("id = ?", item.ID).Updates(map[string]interface{}{
+		"notify_price_drop":    item.NotifyPriceDrop,
+		"notify_back_in_stock": item.NotifyBackInStock,
+		"last_price":           item.LastPrice,
+		"last_stock":           item.LastStock,
+	}).Error
+}
+
+// ListAlertProductIDs 按商品ID升序查询 afterID 之后订阅了提醒的商品
+func ListAlertProductIDs(afterID uint32, limit int) ([]uint32, error) {
+	var ids []uint32
+	err := DB.Model(&WishlistItem{}).
+		Distinct("product_id").
+		Where("product_id > ? AND (notify_price_drop = ? OR notify_back_in_stock = ?)", afterID, true, true).
+		Order("product_id").
+		Limit(limit).
+		Pluck("product_id", &ids).Error
+	return ids, err
+}
+
+// ListAlertItems 查询订阅了指定商品提醒的心愿单商品，包含所属心愿单
+func ListAlertItems(productID uint32) ([]*WishlistItem, error) {
+	var items []*WishlistItem
+	err := DB.Preload("Wishlist").
+		Where("product_id = ? AND (notify_price_drop = ? OR notify_back_in_stock = ?)", productID, true, true).
+		Find(&items).Error
+	return items, err
+}
+
+// UpdateWishlistSnapshot 记录最近一次检查时的价格和库存
+func UpdateWishlistSnapshot(itemID int64, price float64, stock uint32) error {
+	return DB.Model(&WishlistItem{}).Where("id = ?", itemID).Updates(map[string]interface{}{
+		"last_price": price,
+		"last_stock": stock,
+	}).Error
+}
@@ -7,20 +7,20 @@ import (
 )
 
 const (
-	// Token相关的Key前缀
+	// Token相关的Key前缀，与 auth 服务共用，键中只使用令牌摘要
 	tokenKeyPrefix     = "auth:token:"
 	blacklistKeyPrefix = "auth:blacklist:"
 )
 
 // CacheToken 缓存Token
-func CacheToken(ctx context.Context, token string, userID int64, expiration time.Duration) error {
-	key := fmt.Sprintf("%s%s", tokenKeyPrefix, token)
+func CacheToken(ctx context.Context, digest string, userID int64, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", tokenKeyPrefix, digest)
 	return RDB.Set(ctx, key, userID, expiration).Err()
 }
 
 // GetCachedUserID 获取缓存的用户ID
-func GetCachedUserID(ctx context.Context, token string) (int64, error) {
-	key := fmt.Sprintf("%s%s", tokenKeyPrefix, token)
+func GetCachedUserID(ctx context.Context, digest string) (int64, error) {
+	key := fmt.Sprintf("%s%s", tokenKeyPrefix, digest)
 	val, err := RDB.Get(ctx, key).Int64()
 	if err != nil {
 		return 0, err
@@ -29,20 +29,20 @@ func GetCachedUserID(ctx context.Context, token string) (int64, error) {
 }
 
 // DeleteToken 删除Token缓存
-func DeleteToken(ctx context.Context, token string) error {
-	key := fmt.Sprintf("%s%s", tokenKeyPrefix, token)
+func DeleteToken(ctx context.Context, digest string) error {
+	key := fmt.Sprintf("%s%s", tokenKeyPrefix, digest)
 	return RDB.Del(ctx, key).Err()
 }
 
 // AddToBlacklist 将Token加入黑名单
-func AddToBlacklist(ctx context.Context, token string, expiration time.Duration) error {
-	key := fmt.Sprintf("%s%s", blacklistKeyPrefix, token)
+func AddToBlacklist(ctx context.Context, digest string, expiration time.Duration) error {
+	key := fmt.Sprintf("%s%s", blacklistKeyPrefix, digest)
 	return RDB.Set(ctx, key, 1, expiration).Err()
 }
 
 // IsInBlacklist 检查Token是否在黑名单中
-func IsInBlacklist(ctx context.Context, token string) (bool, error) {
-	key := fmt.Sprintf("%s%s", blacklistKeyPrefix, token)
+func IsInBlacklist(ctx context.Context, digest string) (bool, error) {
+	key := fmt.Sprintf("%s%s", blacklistKeyPrefix, digest)
 	exists, err := RDB.Exists(ctx, key).Result()
 	return exists > 0, err
 }
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/user/biz/service"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// Delete 申请注销账户，宽限期内可以撤销，之后个人数据被匿名化
+func (h *UserHandler) Delete(ctx context.Context, c *app.RequestContext) {
+	var req user.DeleteReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	eraseAfter, err := h.accounts.RequestDeletion(ctx, userID)
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.DeleteResp{
+		Base:       successBase(),
+		EraseAfter: eraseAfter.Unix(),
+	})
+}
+
+// CancelDelete 宽限期内撤销注销，注销中的账户没有有效令牌，使用用户名和密码验证
+func (h *UserHandler) CancelDelete(ctx context.Context, c *app.RequestContext) {
+	var req user.CancelDeleteReq
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if err := h.accounts.CancelDeletion(ctx, req.Username, req.Password); err != nil {
+		writeAccountError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.CancelDeleteResp{Base: successBase()})
+}
+
+// RequestExport 发起个人数据导出
+func (h *UserHandler) RequestExport(ctx context.Context, c *app.RequestContext) {
+	var req user.RequestExportReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	export, err := h.accounts.RequestExport(ctx, userID)
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.RequestExportResp{
+		Base:     successBase(),
+		ExportId: export.ID,
+	})
+}
+
+// GetExport 查询导出进度
+func (h *UserHandler) GetExport(ctx context.Context, c *app.RequestContext) {
+	var req user.GetExportReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	export, err := h.accounts.GetExport(ctx, userID, req.ExportId)
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.GetExportResp{
+		Base:        successBase(),
+		ExportId:    export.ID,
+		Ready:       export.Ready(),
+		Pending:     export.Pending(),
+		RequestedAt: export.RequestedAt.Unix(),
+	})
+}
+
+// DownloadExport 下载导出的 JSON 档案，export_id 通过查询参数传递
+func (h *UserHandler) DownloadExport(ctx context.Context, c *app.RequestContext) {
+	userID, ok := h.tokenUserID(ctx, c, string(c.GetHeader("Authorization")))
+	if !ok {
+		return
+	}
+
+	exportID := c.Query("export_id")
+	archive, err := h.accounts.ExportArchive(ctx, userID, exportID)
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="user-data-%s.json"`, exportID))
+	c.Header("Cache-Control", "no-store")
+	c.Data(consts.StatusOK, "application/json; charset=utf-8", archive)
+}
+
+// AccountErrorStatus 注销和导出接口错误对应的状态码，HTTP 和 RPC 响应共用
+func AccountErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrAccountNotFound), errors.Is(err, service.ErrExportNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrInvalidCredentials):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrAccountNotActive), errors.Is(err, service.ErrNotPendingDeletion),
+		errors.Is(err, service.ErrExportNotReady):
+		return consts.StatusConflict
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// writeAccountError 按错误类型输出注销和导出接口的失败响应
+func writeAccountError(c *app.RequestContext, err error) {
+	writeBaseResponse(c, AccountErrorStatus(err), err.Error())
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/user/biz/service"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// ListAddresses 查询当前用户的收货地址
+func (h *UserHandler) ListAddresses(ctx context.Context, c *app.RequestContext) {
+	var req user.ListAddressesReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	addrs, err := h.addresses.List(ctx, userID)
+	if err != nil {
+		writeAddressError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.ListAddressesResp{
+		Base:      successBase(),
+		Addresses: addrs,
+	})
+}
+
+// GetAddress 查询当前用户的指定收货地址
+func (h *UserHandler) GetAddress(ctx context.Context, c *app.RequestContext) {
+	var req user.GetAddressReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	addr, err := h.addresses.Get(ctx, userID, req.AddressId)
+	if err != nil {
+		writeAddressError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.GetAddressResp{
+		Base:    successBase(),
+		Address: addr,
+	})
+}
+
+// CreateAddress 新增收货地址
+func (h *UserHandler) CreateAddress(ctx context.Context, c *app.RequestContext) {
+	var req user.CreateAddressReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	addr, err := h.addresses.Create(ctx, userID, &req)
+	if err != nil {
+		writeAddressError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.CreateAddressResp{
+		Base:    successBase(),
+		Address: addr,
+	})
+}
+
+// UpdateAddress 修改收货地址
+func (h *UserHandler) UpdateAddress(ctx context.Context, c *app.RequestContext) {
+	var req user.UpdateAddressReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	addr, err := h.addresses.Update(ctx, userID, &req)
+	if err != nil {
+		writeAddressError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.UpdateAddressResp{
+		Base:    successBase(),
+		Address: addr,
+	})
+}
+
+// DeleteAddress 删除收货地址
+func (h *UserHandler) DeleteAddress(ctx context.Context, c *app.RequestContext) {
+	var req user.DeleteAddressReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	if err := h.addresses.Delete(ctx, userID, req.AddressId); err != nil {
+		writeAddressError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.DeleteAddressResp{Base: successBase()})
+}
+
+// SetDefaultAddress 设置默认收货地址
+func (h *UserHandler) SetDefaultAddress(ctx context.Context, c *app.RequestContext) {
+	var req user.SetDefaultAddressReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	if err := h.addresses.SetDefault(ctx, userID, req.AddressId); err != nil {
+		writeAddressError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.SetDefaultAddressResp{Base: successBase()})
+}
+
+// AddressErrorStatus 地址接口错误对应的状态码，HTTP 和 RPC 响应共用
+func AddressErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrAddressNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrInvalidAddress), errors.Is(err, service.ErrAddressLimit):
+		return consts.StatusBadRequest
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// writeAddressError 按错误类型输出地址接口的失败响应
+func writeAddressError(c *app.RequestContext, err error) {
+	writeBaseResponse(c, AddressErrorStatus(err), err.Error())
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/user/biz/service"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// avatarFormField 上传头像的 multipart 表单字段名
+const avatarFormField = "avatar"
+
+// UploadAvatar 上传头像，multipart 表单字段 avatar，按内容识别格式，不信任客户端声明的 Content-Type
+func (h *UserHandler) UploadAvatar(ctx context.Context, c *app.RequestContext) {
+	userID, ok := h.tokenUserID(ctx, c, string(c.GetHeader("Authorization")))
+	if !ok {
+		return
+	}
+
+	file, err := c.FormFile(avatarFormField)
+	if err != nil {
+		writeBaseResponse(c, consts.StatusBadRequest, fmt.Sprintf("missing form file %q", avatarFormField))
+		return
+	}
+	maxBytes := service.Avatars.MaxBytes
+	if file.Size > maxBytes {
+		writeAvatarError(c, fmt.Errorf("%w: at most %d bytes", service.ErrAvatarTooLarge, maxBytes))
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		writeBaseResponse(c, consts.StatusBadRequest, err.Error())
+		return
+	}
+	defer f.Close()
+	// 多读一个字节，Upload 据此判断是否超过大小限制
+	data, err := io.ReadAll(io.LimitReader(f, maxBytes+1))
+	if err != nil {
+		writeBaseResponse(c, consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	url, thumbnails, err := h.avatars.Upload(ctx, userID, data)
+	if err != nil {
+		writeAvatarError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.UploadAvatarResp{
+		Base:             successBase(),
+		AvatarUrl:        url,
+		AvatarThumbnails: thumbnails,
+	})
+}
+
+// DeleteAvatar 删除头像
+func (h *UserHandler) DeleteAvatar(ctx context.Context, c *app.RequestContext) {
+	var req user.DeleteAvatarReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	if err := h.avatars.Delete(ctx, userID); err != nil {
+		writeAvatarError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.DeleteAvatarResp{Base: successBase()})
+}
+
+// AvatarErrorStatus 头像接口错误对应的状态码，HTTP 和 RPC 响应共用
+func AvatarErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrAvatarTooLarge):
+		return consts.StatusRequestEntityTooLarge
+	case errors.Is(err, service.ErrAvatarFormat):
+		return consts.StatusUnsupportedMediaType
+	case errors.Is(err, service.ErrInvalidAvatar):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAvatarUnavailable):
+		return consts.StatusServiceUnavailable
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// writeAvatarError 按错误类型输出头像接口的失败响应
+func writeAvatarError(c *app.RequestContext, err error) {
+	writeBaseResponse(c, AvatarErrorStatus(err), err.Error())
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/user/biz/service"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// GetPoints 查询当前用户的积分余额和会员等级
+func (h *UserHandler) GetPoints(ctx context.Context, c *app.RequestContext) {
+	var req user.GetPointsReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	summary, err := h.loyalty.Summary(ctx, userID)
+	if err != nil {
+		writeLoyaltyError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.GetPointsResp{
+		Base:   successBase(),
+		Points: summary,
+	})
+}
+
+// ListPointsHistory 分页查询当前用户的积分流水
+func (h *UserHandler) ListPointsHistory(ctx context.Context, c *app.RequestContext) {
+	var req user.ListPointsHistoryReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	entries, total, err := h.loyalty.History(ctx, userID, int(req.Page), int(req.PageSize))
+	if err != nil {
+		writeLoyaltyError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.ListPointsHistoryResp{
+		Base:    successBase(),
+		Entries: entries,
+		Total:   total,
+	})
+}
+
+// LoyaltyErrorStatus 积分接口错误对应的状态码，HTTP 和 RPC 响应共用
+func LoyaltyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidPoints):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrInsufficientPoints), errors.Is(err, service.ErrRedemptionCanceled):
+		return consts.StatusConflict
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// writeLoyaltyError 按错误类型输出积分接口的失败响应
+func writeLoyaltyError(c *app.RequestContext, err error) {
+	writeBaseResponse(c, LoyaltyErrorStatus(err), err.Error())
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// baseResponse 只包含状态的响应，接口失败时返回
+type baseResponse struct {
+	Base *user.BaseResp `json:"base,omitempty"`
+}
+
+// bindRequest 绑定请求参数，失败时输出 400
+func bindRequest(c *app.RequestContext, req interface{}) bool {
+	if err := c.BindAndValidate(req); err != nil {
+		writeBaseResponse(c, consts.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+// tokenUserID 通过 Authorization 头确定当前用户，HTTP 请求中的 user_id 不可信，不使用
+func (h *UserHandler) tokenUserID(ctx context.Context, c *app.RequestContext, token string) (int64, bool) {
+	userID, err := h.svc.UserIDByToken(ctx, strings.TrimPrefix(token, "Bearer "))
+	if err != nil {
+		writeBaseResponse(c, consts.StatusUnauthorized, err.Error())
+		return 0, false
+	}
+	return userID, true
+}
+
+// writeBaseResponse 输出只包含状态的响应
+func writeBaseResponse(c *app.RequestContext, status int, message string) {
+	c.JSON(status, &baseResponse{
+		Base: &user.BaseResp{
+			Code:    int32(status),
+			Message: message,
+		},
+	})
+}
+
+// successBase 成功响应的状态
+func successBase() *user.BaseResp {
+	return &user.BaseResp{
+		Code:    consts.StatusOK,
+		Message: "success",
+	}
+}
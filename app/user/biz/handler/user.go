@@ -2,7 +2,7 @@ package handler
 
 import (
 	"context"
-	"strconv"
+	"errors"
 	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -10,17 +10,34 @@ import (
 
 	"TikTokMall/app/user/biz/service"
 	"TikTokMall/app/user/kitex_gen/user"
+	"TikTokMall/pkg/password"
 )
 
+// registerErrorResponse 注册失败响应，违反密码策略时附带具体违规项
+type registerErrorResponse struct {
+	Base       *user.BaseResp       `json:"base,omitempty"`
+	Violations []password.Violation `json:"violations,omitempty"`
+}
+
 // UserHandler 用户服务处理器
 type UserHandler struct {
-	svc *service.UserService
+	svc       *service.UserService
+	addresses *service.AddressService
+	accounts  *service.AccountService
+	avatars   *service.AvatarService
+	loyalty   *service.LoyaltyService
+	wishlists *service.WishlistService
 }
 
 // NewUserHandler 创建用户服务处理器
 func NewUserHandler() *UserHandler {
 	return &UserHandler{
-		svc: service.NewUserService(),
+		svc:       service.NewUserService(),
+		addresses: service.NewAddressService(),
+		accounts:  service.NewAccountService(),
+		avatars:   service.NewAvatarService(),
+		loyalty:   service.NewLoyaltyService(),
+		wishlists: service.NewWishlistService(),
 	}
 }
 
@@ -40,11 +57,16 @@ func (h *UserHandler) Register(ctx context.Context, c *app.RequestContext) {
 	// 调用服务层处理注册
 	userID, token, err := h.svc.Register(ctx, req.Username, req.Password, req.Email, req.Phone)
 	if err != nil {
-		c.JSON(consts.StatusInternalServerError, &user.RegisterResp{
+		status := consts.StatusInternalServerError
+		if errors.Is(err, password.ErrPolicyViolation) {
+			status = consts.StatusBadRequest
+		}
+		c.JSON(status, &registerErrorResponse{
 			Base: &user.BaseResp{
-				Code:    consts.StatusInternalServerError,
+				Code:    int32(status),
 				Message: err.Error(),
 			},
+			Violations: password.Violations(err),
 		})
 		return
 	}
@@ -129,41 +151,6 @@ func (h *UserHandler) Logout(ctx context.Context, c *app.RequestContext) {
 	})
 }
 
-// Delete 处理用户删除请求
-func (h *UserHandler) Delete(ctx context.Context, c *app.RequestContext) {
-	var req user.DeleteReq
-	if err := c.BindAndValidate(&req); err != nil {
-		c.JSON(consts.StatusBadRequest, &user.DeleteResp{
-			Base: &user.BaseResp{
-				Code:    consts.StatusBadRequest,
-				Message: err.Error(),
-			},
-		})
-		return
-	}
-
-	// 从Authorization头中获取token
-	token, _ := strconv.ParseInt(strings.TrimPrefix(req.Token, "Bearer "), 10, 64)
-
-	// 调用服务层处理删除
-	if err := h.svc.Delete(ctx, token); err != nil {
-		c.JSON(consts.StatusInternalServerError, &user.DeleteResp{
-			Base: &user.BaseResp{
-				Code:    consts.StatusInternalServerError,
-				Message: err.Error(),
-			},
-		})
-		return
-	}
-
-	c.JSON(consts.StatusOK, &user.DeleteResp{
-		Base: &user.BaseResp{
-			Code:    consts.StatusOK,
-			Message: "success",
-		},
-	})
-}
-
 // Update 处理用户更新请求
 func (h *UserHandler) Update(ctx context.Context, c *app.RequestContext) {
 	var req user.UpdateReq
@@ -216,7 +203,7 @@ func (h *UserHandler) Info(ctx context.Context, c *app.RequestContext) {
 	token := strings.TrimPrefix(req.Token, "Bearer ")
 
 	// 调用服务层处理信息获取
-	userID, username, email, phone, err := h.svc.Info(ctx, token)
+	resp, err := h.svc.Info(ctx, token)
 	if err != nil {
 		c.JSON(consts.StatusInternalServerError, &user.InfoResp{
 			Base: &user.BaseResp{
@@ -227,14 +214,9 @@ func (h *UserHandler) Info(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	c.JSON(consts.StatusOK, &user.InfoResp{
-		Base: &user.BaseResp{
-			Code:    consts.StatusOK,
-			Message: "success",
-		},
-		UserId:   userID,
-		Username: username,
-		Email:    email,
-		Phone:    phone,
-	})
+	resp.Base = &user.BaseResp{
+		Code:    consts.StatusOK,
+		Message: "success",
+	}
+	c.JSON(consts.StatusOK, resp)
 }
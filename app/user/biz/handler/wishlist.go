@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	"TikTokMall/app/user/biz/service"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// ListWishlists 查询当前用户的心愿单，附带商品当前价格和库存
+func (h *UserHandler) ListWishlists(ctx context.Context, c *app.RequestContext) {
+	var req user.ListWishlistsReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	lists, err := h.wishlists.List(ctx, userID)
+	if err != nil {
+		writeWishlistError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.ListWishlistsResp{
+		Base:      successBase(),
+		Wishlists: lists,
+	})
+}
+
+// AddWishlistItem 将商品加入心愿单
+func (h *UserHandler) AddWishlistItem(ctx context.Context, c *app.RequestContext) {
+	var req user.AddWishlistItemReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	item, err := h.wishlists.Add(ctx, userID, &req)
+	if err != nil {
+		writeWishlistError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.AddWishlistItemResp{
+		Base: successBase(),
+		Item: item,
+	})
+}
+
+// RemoveWishlistItem 从心愿单中移除商品
+func (h *UserHandler) RemoveWishlistItem(ctx context.Context, c *app.RequestContext) {
+	var req user.RemoveWishlistItemReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	if err := h.wishlists.Remove(ctx, userID, req.Wishlist, req.ProductId); err != nil {
+		writeWishlistError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.RemoveWishlistItemResp{Base: successBase()})
+}
+
+// DeleteWishlist 删除心愿单
+func (h *UserHandler) DeleteWishlist(ctx context.Context, c *app.RequestContext) {
+	var req user.DeleteWishlistReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	if err := h.wishlists.Delete(ctx, userID, req.Wishlist); err != nil {
+		writeWishlistError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.DeleteWishlistResp{Base: successBase()})
+}
+
+// SubscribeWishlistItem 设置心愿单商品的降价和到货提醒
+func (h *UserHandler) SubscribeWishlistItem(ctx context.Context, c *app.RequestContext) {
+	var req user.SubscribeWishlistItemReq
+	if !bindRequest(c, &req) {
+		return
+	}
+	userID, ok := h.tokenUserID(ctx, c, req.Token)
+	if !ok {
+		return
+	}
+
+	item, err := h.wishlists.Subscribe(ctx, userID, &req)
+	if err != nil {
+		writeWishlistError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, &user.SubscribeWishlistItemResp{
+		Base: successBase(),
+		Item: item,
+	})
+}
+
+// WishlistErrorStatus 心愿单接口错误对应的状态码，HTTP 和 RPC 响应共用
+func WishlistErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrWishlistNotFound), errors.Is(err, service.ErrProductNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrInvalidWishlist), errors.Is(err, service.ErrWishlistLimit),
+		errors.Is(err, service.ErrWishlistItemLimit):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrCatalogUnavailable):
+		return consts.StatusServiceUnavailable
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// writeWishlistError 按错误类型输出心愿单接口的失败响应
+func writeWishlistError(c *app.RequestContext, err error) {
+	writeBaseResponse(c, WishlistErrorStatus(err), err.Error())
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/app/user/biz/dal/redis"
+	"TikTokMall/app/user/kitex_gen/user"
+	"TikTokMall/pkg/userdata"
+)
+
+// DeletionGracePeriod 申请注销后的宽限期，期间可以撤销，启动时根据配置替换
+var DeletionGracePeriod = 30 * 24 * time.Hour
+
+const (
+	// DefaultErasureInterval 检查宽限期已结束账号的间隔
+	DefaultErasureInterval = time.Hour
+
+	erasureBatchSize = 100
+)
+
+var (
+	ErrAccountNotFound    = errors.New("user not found")
+	ErrAccountNotActive   = errors.New("account is not active")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrNotPendingDeletion = errors.New("account is not pending deletion")
+	ErrExportNotFound     = errors.New("export not found")
+	ErrExportNotReady     = errors.New("export is not ready")
+)
+
+// AccountStore 账号注销和数据导出使用的存储
+type AccountStore interface {
+	GetUser(userID int64) (*mysql.User, error)
+	GetUserByUsername(username string) (*mysql.User, error)
+	ScheduleErasure(userID int64, eraseAfter time.Time) error
+	CancelErasure(userID int64) (bool, error)
+	ListDueForErasure(now time.Time, limit int) ([]*mysql.User, error)
+	Anonymize(userID int64) error
+	ListAddresses(userID int64) ([]*mysql.Address, error)
+	// RevokeSessions 吊销用户的全部令牌并清理缓存
+	RevokeSessions(ctx context.Context, userID int64) error
+}
+
+// DataEvents 个人数据事件，通过 Redis Stream 通知其他服务
+type DataEvents interface {
+	RequestExport(ctx context.Context, userID int64) (*userdata.Export, error)
+	GetExport(ctx context.Context, exportID string) (*userdata.Export, error)
+	PublishErased(ctx context.Context, userID int64) error
+}
+
+// dalAccountStore 基于 MySQL 和 Redis 的账号存储
+type dalAccountStore struct{}
+
+func (dalAccountStore) GetUser(userID int64) (*mysql.User, error) {
+	return mysql.GetUserByID(userID)
+}
+
+func (dalAccountStore) GetUserByUsername(username string) (*mysql.User, error) {
+	return mysql.GetUserByUsername(username)
+}
+
+func (dalAccountStore) ScheduleErasure(userID int64, eraseAfter time.Time) error {
+	return mysql.ScheduleUserErasure(userID, eraseAfter)
+}
+
+func (dalAccountStore) CancelErasure(userID int64) (bool, error) {
+	return mysql.CancelUserErasure(userID)
+}
+
+func (dalAccountStore) ListDueForErasure(now time.Time, limit int) ([]*mysql.User, error) {
+	return mysql.ListUsersDueForErasure(now, limit)
+}
+
+func (dalAccountStore) Anonymize(userID int64) error {
+	return mysql.AnonymizeUser(userID)
+}
+
+func (dalAccountStore) ListAddresses(userID int64) ([]*mysql.Address, error) {
+	return mysql.ListAddresses(userID)
+}
+
+func (dalAccountStore) RevokeSessions(ctx context.Context, userID int64) error {
+	digests, err := mysql.ListTokenDigestsByUserID(userID)
+	if err != nil {
+		return err
+	}
+	// 缓存中的令牌在过期前仍然有效，需要加入黑名单
+	for _, digest := range digests {
+		if err := redis.DeleteToken(ctx, digest); err != nil {
+			hlog.CtxWarnf(ctx, "token cache deletion failed: %v", err)
+		}
+		if err := redis.AddToBlacklist(ctx, digest, TokenExpiration); err != nil {
+			return err
+		}
+	}
+	if err := mysql.DeleteTokenByUserID(userID); err != nil {
+		return err
+	}
+	return redis.DeleteUserCache(ctx, userID)
+}
+
+// redisDataEvents 通过用户服务的 Redis 连接发布个人数据事件
+type redisDataEvents struct{}
+
+func (redisDataEvents) RequestExport(ctx context.Context, userID int64) (*userdata.Export, error) {
+	return userdata.RequestExport(ctx, redis.RDB, userID)
+}
+
+func (redisDataEvents) GetExport(ctx context.Context, exportID string) (*userdata.Export, error) {
+	return userdata.NewExportStore(redis.RDB).Get(ctx, exportID)
+}
+
+func (redisDataEvents) PublishErased(ctx context.Context, userID int64) error {
+	return userdata.PublishErased(ctx, redis.RDB, userID)
+}
+
+// AccountService 账号注销和个人数据导出
+// 注销分两步：申请后进入宽限期，账号不能登录但可以撤销；宽限期结束后由注销任务发布注销事件并匿名化个人数据
+type AccountService struct {
+	store  AccountStore
+	events DataEvents
+	now    func() time.Time
+}
+
+// NewAccountService 创建基于 MySQL 和 Redis 的账号服务
+func NewAccountService() *AccountService {
+	return NewAccountServiceWithStore(dalAccountStore{}, redisDataEvents{})
+}
+
+// NewAccountServiceWithStore 使用指定存储和事件发布创建账号服务
+func NewAccountServiceWithStore(store AccountStore, events DataEvents) *AccountService {
+	return &AccountService{store: store, events: events, now: time.Now}
+}
+
+// RequestDeletion 申请注销，吊销全部令牌，返回宽限期结束时间；重复申请返回原来的结束时间
+func (s *AccountService) RequestDeletion(ctx context.Context, userID int64) (time.Time, error) {
+	u, err := s.getUser(userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if u.Status == mysql.UserStatusDeleting && u.EraseAfter != nil {
+		return *u.EraseAfter, nil
+	}
+	if u.Status != mysql.UserStatusNormal {
+		return time.Time{}, ErrAccountNotActive
+	}
+
+	eraseAfter := s.now().Add(DeletionGracePeriod)
+	if err := s.store.ScheduleErasure(userID, eraseAfter); err != nil {
+		return time.Time{}, errors.Wrap(err, "schedule erasure failed")
+	}
+	if err := s.store.RevokeSessions(ctx, userID); err != nil {
+		hlog.CtxWarnf(ctx, "revoke sessions of user %d failed: %v", userID, err)
+	}
+	return eraseAfter, nil
+}
+
+// CancelDeletion 宽限期内撤销注销，账号已无法登录，需要重新验证用户名和密码
+func (s *AccountService) CancelDeletion(ctx context.Context, username, password string) error {
+	u, err := s.store.GetUserByUsername(username)
+	if err != nil {
+		return errors.Wrap(err, "get user failed")
+	}
+	if u == nil || validatePassword(u.Password, password) != nil {
+		return ErrInvalidCredentials
+	}
+	// 宽限期已结束但注销任务还未执行时也不能撤销
+	if u.Status != mysql.UserStatusDeleting || u.EraseAfter == nil || !s.now().Before(*u.EraseAfter) {
+		return ErrNotPendingDeletion
+	}
+
+	ok, err := s.store.CancelErasure(u.ID)
+	if err != nil {
+		return errors.Wrap(err, "cancel erasure failed")
+	}
+	if !ok {
+		return ErrNotPendingDeletion
+	}
+	return nil
+}
+
+// EraseDue 匿名化宽限期已结束的账号并删除头像文件，返回处理的账号数
+// 先发布注销事件再匿名化，匿名化失败时下次重新发布，各服务的处理是幂等的
+func (s *AccountService) EraseDue(ctx context.Context) (int, error) {
+	erased := 0
+	for {
+		users, err := s.store.ListDueForErasure(s.now(), erasureBatchSize)
+		if err != nil {
+			return erased, errors.Wrap(err, "list users due for erasure failed")
+		}
+		for _, u := range users {
+			if err := s.events.PublishErased(ctx, u.ID); err != nil {
+				return erased, errors.Wrapf(err, "publish erased event for user %d failed", u.ID)
+			}
+			if err := s.store.RevokeSessions(ctx, u.ID); err != nil {
+				hlog.CtxWarnf(ctx, "revoke sessions of user %d failed: %v", u.ID, err)
+			}
+			if err := deleteUserAvatars(ctx, u.ID); err != nil {
+				return erased, errors.Wrapf(err, "delete avatars of user %d failed", u.ID)
+			}
+			if err := s.store.Anonymize(u.ID); err != nil {
+				return erased, errors.Wrapf(err, "anonymize user %d failed", u.ID)
+			}
+			erased++
+		}
+		if len(users) < erasureBatchSize {
+			return erased, nil
+		}
+	}
+}
+
+// RunErasure 定期执行 EraseDue，阻塞直到 ctx 取消
+func (s *AccountService) RunErasure(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if n, err := s.EraseDue(ctx); err != nil {
+			hlog.CtxErrorf(ctx, "erase accounts failed: %v", err)
+		} else if n > 0 {
+			hlog.CtxInfof(ctx, "erased %d accounts", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RequestExport 发起个人数据导出，各服务异步提交数据
+func (s *AccountService) RequestExport(ctx context.Context, userID int64) (*userdata.Export, error) {
+	u, err := s.getUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Status == mysql.UserStatusErased {
+		return nil, ErrAccountNotActive
+	}
+	export, err := s.events.RequestExport(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "request export failed")
+	}
+	return export, nil
+}
+
+// GetExport 查询导出任务，任务不属于该用户时返回 ErrExportNotFound
+func (s *AccountService) GetExport(ctx context.Context, userID int64, exportID string) (*userdata.Export, error) {
+	if exportID == "" {
+		return nil, ErrExportNotFound
+	}
+	export, err := s.events.GetExport(ctx, exportID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get export failed")
+	}
+	if export == nil || export.UserID != userID {
+		return nil, ErrExportNotFound
+	}
+	return export, nil
+}
+
+// ExportArchive 生成可下载的导出档案，所有服务提交数据后才能下载
+func (s *AccountService) ExportArchive(ctx context.Context, userID int64, exportID string) ([]byte, error) {
+	export, err := s.GetExport(ctx, userID, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if !export.Ready() {
+		return nil, ErrExportNotReady
+	}
+	return export.Archive()
+}
+
+// userExport 用户服务导出的数据
+type userExport struct {
+	Profile   *userProfile    `json:"profile"`
+	Addresses []*user.Address `json:"addresses"`
+}
+
+type userProfile struct {
+	ID         int64      `json:"id"`
+	Username   string     `json:"username"`
+	Email      string     `json:"email"`
+	Phone      string     `json:"phone"`
+	Status     int        `json:"status"`
+	AvatarURL  string     `json:"avatar_url,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	EraseAfter *time.Time `json:"erase_after,omitempty"`
+}
+
+// Export 导出用户资料和收货地址，实现 userdata.Handler
+func (s *AccountService) Export(ctx context.Context, userID int64) (interface{}, error) {
+	u, err := s.store.GetUser(userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user failed")
+	}
+	result := &userExport{Addresses: []*user.Address{}}
+	if u == nil {
+		return result, nil
+	}
+	avatarURL, _ := AvatarURLs(u.Avatar)
+	result.Profile = &userProfile{
+		ID:         u.ID,
+		Username:   u.Username,
+		Email:      u.Email,
+		Phone:      u.Phone,
+		Status:     u.Status,
+		AvatarURL:  avatarURL,
+		CreatedAt:  u.CreatedAt,
+		EraseAfter: u.EraseAfter,
+	}
+
+	addrs, err := s.store.ListAddresses(userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list addresses failed")
+	}
+	for _, addr := range addrs {
+		result.Addresses = append(result.Addresses, newAddressInfo(addr))
+	}
+	return result, nil
+}
+
+// Erase 实现 userdata.Handler，用户服务的数据由注销任务直接匿名化，这里不需要处理
+func (s *AccountService) Erase(ctx context.Context, userID int64) error {
+	return nil
+}
+
+// getUser 查询用户，不存在时返回 ErrAccountNotFound
+func (s *AccountService) getUser(userID int64) (*mysql.User, error) {
+	if userID <= 0 {
+		return nil, ErrAccountNotFound
+	}
+	u, err := s.store.GetUser(userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user failed")
+	}
+	if u == nil {
+		return nil, ErrAccountNotFound
+	}
+	return u, nil
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/pkg/userdata"
+)
+
+// memAccountStore 内存账号存储，行为与 MySQL 实现一致
+type memAccountStore struct {
+	users     map[int64]*mysql.User
+	addresses map[int64][]*mysql.Address
+	revoked   []int64
+}
+
+func newMemAccountStore(users ...*mysql.User) *memAccountStore {
+	m := &memAccountStore{users: map[int64]*mysql.User{}, addresses: map[int64][]*mysql.Address{}}
+	for _, u := range users {
+		m.users[u.ID] = u
+	}
+	return m
+}
+
+func (m *memAccountStore) GetUser(userID int64) (*mysql.User, error) {
+	u, ok := m.users[userID]
+	if !ok {
+		return nil, nil
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (m *memAccountStore) GetUserByUsername(username string) (*mysql.User, error) {
+	for _, u := range m.users {
+		if u.Username == username {
+			cp := *u
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memAccountStore) ScheduleErasure(userID int64, eraseAfter time.Time) error {
+	if u, ok := m.users[userID]; ok && u.Status == mysql.UserStatusNormal {
+		u.Status = mysql.UserStatusDeleting
+		u.EraseAfter = &eraseAfter
+	}
+	return nil
+}
+
+func (m *memAccountStore) CancelErasure(userID int64) (bool, error) {
+	u, ok := m.users[userID]
+	if !ok || u.Status != mysql.UserStatusDeleting {
+		return false, nil
+	}
+	u.Status = mysql.UserStatusNormal
+	u.EraseAfter = nil
+	return true, nil
+}
+
+func (m *memAccountStore) ListDueForErasure(now time.Time, limit int) ([]*mysql.User, error) {
+	var due []*mysql.User
+	for _, u := range m.users {
+		if u.Status == mysql.UserStatusDeleting && !u.EraseAfter.After(now) && len(due) < limit {
+			cp := *u
+			due = append(due, &cp)
+		}
+	}
+	return due, nil
+}
+
+func (m *memAccountStore) Anonymize(userID int64) error {
+	u, ok := m.users[userID]
+	if !ok {
+		return nil
+	}
+	u.Username = "erased"
+	u.Password, u.Email, u.Phone = "!", "", ""
+	u.Status = mysql.UserStatusErased
+	u.EraseAfter = nil
+	delete(m.addresses, userID)
+	return nil
+}
+
+func (m *memAccountStore) ListAddresses(userID int64) ([]*mysql.Address, error) {
+	return m.addresses[userID], nil
+}
+
+func (m *memAccountStore) RevokeSessions(ctx context.Context, userID int64) error {
+	m.revoked = append(m.revoked, userID)
+	return nil
+}
+
+// fakeDataEvents 记录发布的注销事件，导出任务保存在内存中
+type fakeDataEvents struct {
+	erased     []int64
+	publishErr error
+	exports    map[string]*userdata.Export
+}
+
+func (f *fakeDataEvents) RequestExport(ctx context.Context, userID int64) (*userdata.Export, error) {
+	export := &userdata.Export{ID: "export-1", UserID: userID, RequestedAt: time.Now(), Parts: map[string]json.RawMessage{}}
+	f.exports[export.ID] = export
+	return export, nil
+}
+
+func (f *fakeDataEvents) GetExport(ctx context.Context, exportID string) (*userdata.Export, error) {
+	return f.exports[exportID], nil
+}
+
+func (f *fakeDataEvents) PublishErased(ctx context.Context, userID int64) error {
+	if f.publishErr != nil {
+		return f.publishErr
+	}
+	f.erased = append(f.erased, userID)
+	return nil
+}
+
+func newTestAccountService(t *testing.T, users ...*mysql.User) (*AccountService, *memAccountStore, *fakeDataEvents, *time.Time) {
+	store := newMemAccountStore(users...)
+	events := &fakeDataEvents{exports: map[string]*userdata.Export{}}
+	svc := NewAccountServiceWithStore(store, events)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	return svc, store, events, &now
+}
+
+func newTestUser(t *testing.T, id int64, username, pwd string) *mysql.User {
+	hashed, err := hashPassword(pwd)
+	require.NoError(t, err)
+	return &mysql.User{ID: id, Username: username, Password: hashed, Email: username + "@example.com", Status: mysql.UserStatusNormal}
+}
+
+func TestAccount_RequestDeletion(t *testing.T) {
+	ctx := context.Background()
+	banned := newTestUser(t, 2, "bob", "secret123")
+	banned.Status = mysql.UserStatusBanned
+	svc, store, _, now := newTestAccountService(t, newTestUser(t, 1, "alice", "secret123"), banned)
+
+	eraseAfter, err := svc.RequestDeletion(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(DeletionGracePeriod), eraseAfter)
+	assert.Equal(t, mysql.UserStatusDeleting, store.users[1].Status)
+	assert.Equal(t, []int64{1}, store.revoked, "申请注销后吊销全部令牌")
+
+	// 重复申请不会延长宽限期
+	*now = now.Add(time.Hour)
+	again, err := svc.RequestDeletion(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, eraseAfter, again)
+
+	_, err = svc.RequestDeletion(ctx, 2)
+	assert.ErrorIs(t, err, ErrAccountNotActive)
+	_, err = svc.RequestDeletion(ctx, 3)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+}
+
+func TestAccount_CancelDeletion(t *testing.T) {
+	ctx := context.Background()
+	svc, store, _, now := newTestAccountService(t, newTestUser(t, 1, "alice", "secret123"))
+
+	assert.ErrorIs(t, svc.CancelDeletion(ctx, "alice", "secret123"), ErrNotPendingDeletion)
+
+	_, err := svc.RequestDeletion(ctx, 1)
+	require.NoError(t, err)
+	assert.ErrorIs(t, svc.CancelDeletion(ctx, "alice", "wrong"), ErrInvalidCredentials)
+	assert.ErrorIs(t, svc.CancelDeletion(ctx, "nobody", "secret123"), ErrInvalidCredentials)
+
+	require.NoError(t, svc.CancelDeletion(ctx, "alice", "secret123"))
+	assert.Equal(t, mysql.UserStatusNormal, store.users[1].Status)
+	assert.Nil(t, store.users[1].EraseAfter)
+
+	// 宽限期结束后不能撤销
+	_, err = svc.RequestDeletion(ctx, 1)
+	require.NoError(t, err)
+	*now = now.Add(DeletionGracePeriod)
+	assert.ErrorIs(t, svc.CancelDeletion(ctx, "alice", "secret123"), ErrNotPendingDeletion)
+}
+
+func TestAccount_EraseDue(t *testing.T) {
+	ctx := context.Background()
+	svc, store, events, now := newTestAccountService(t,
+		newTestUser(t, 1, "alice", "secret123"),
+		newTestUser(t, 2, "bob", "secret123"),
+		newTestUser(t, 3, "carol", "secret123"),
+	)
+	store.addresses[1] = []*mysql.Address{{ID: 10, UserID: 1, RecipientName: "Alice"}}
+
+	_, err := svc.RequestDeletion(ctx, 1)
+	require.NoError(t, err)
+	*now = now.Add(24 * time.Hour)
+	_, err = svc.RequestDeletion(ctx, 2)
+	require.NoError(t, err)
+
+	// 只有宽限期已结束的账号被匿名化
+	*now = now.Add(DeletionGracePeriod - time.Hour)
+	n, err := svc.EraseDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []int64{1}, events.erased)
+	assert.Equal(t, mysql.UserStatusErased, store.users[1].Status)
+	assert.Empty(t, store.users[1].Email)
+	assert.Empty(t, store.addresses[1])
+	assert.Equal(t, mysql.UserStatusDeleting, store.users[2].Status)
+	assert.Equal(t, mysql.UserStatusNormal, store.users[3].Status)
+
+	// 注销事件发布失败时不匿名化，下次重试
+	*now = now.Add(time.Hour)
+	events.publishErr = errors.New("redis unavailable")
+	_, err = svc.EraseDue(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, mysql.UserStatusDeleting, store.users[2].Status)
+
+	events.publishErr = nil
+	n, err = svc.EraseDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []int64{1, 2}, events.erased)
+	assert.Equal(t, mysql.UserStatusErased, store.users[2].Status)
+}
+
+func TestAccount_Export(t *testing.T) {
+	ctx := context.Background()
+	svc, store, _, _ := newTestAccountService(t, newTestUser(t, 1, "alice", "secret123"))
+	store.addresses[1] = []*mysql.Address{{ID: 10, UserID: 1, RecipientName: "Alice", IsDefault: true}}
+
+	export, err := svc.RequestExport(ctx, 1)
+	require.NoError(t, err)
+
+	// 其他用户不能查询或下载
+	_, err = svc.GetExport(ctx, 2, export.ID)
+	assert.ErrorIs(t, err, ErrExportNotFound)
+	_, err = svc.ExportArchive(ctx, 1, export.ID)
+	assert.ErrorIs(t, err, ErrExportNotReady)
+
+	data, err := svc.Export(ctx, 1)
+	require.NoError(t, err)
+	for _, name := range userdata.Services {
+		raw, err := json.Marshal(data)
+		require.NoError(t, err)
+		export.Parts[name] = raw
+	}
+
+	archive, err := svc.ExportArchive(ctx, 1, export.ID)
+	require.NoError(t, err)
+	var decoded struct {
+		UserID int64 `json:"user_id"`
+		Data   map[string]struct {
+			Profile struct {
+				Username string `json:"username"`
+				Email    string `json:"email"`
+			} `json:"profile"`
+			Addresses []struct {
+				RecipientName string `json:"recipient_name"`
+			} `json:"addresses"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(archive, &decoded))
+	assert.Equal(t, int64(1), decoded.UserID)
+	assert.Equal(t, "alice", decoded.Data["user"].Profile.Username)
+	assert.Equal(t, "alice@example.com", decoded.Data["user"].Profile.Email)
+	require.Len(t, decoded.Data["user"].Addresses, 1)
+	assert.Equal(t, "Alice", decoded.Data["user"].Addresses[0].RecipientName)
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// MaxAddressesPerUser 每个用户最多保存的收货地址数量
+const MaxAddressesPerUser = 20
+
+var (
+	ErrAddressNotFound = errors.New("address not found")
+	ErrAddressLimit    = fmt.Errorf("at most %d addresses per user", MaxAddressesPerUser)
+	ErrInvalidAddress  = errors.New("invalid address")
+)
+
+// recipientPhonePattern 收件人手机号格式，与注册时的手机号校验一致
+var recipientPhonePattern = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
+// AddressStore 收货地址存储
+type AddressStore interface {
+	List(userID int64) ([]*mysql.Address, error)
+	Get(userID, addressID int64) (*mysql.Address, error)
+	Count(userID int64) (int64, error)
+	Create(addr *mysql.Address) error
+	Update(addr *mysql.Address) error
+	Delete(userID, addressID int64) error
+	SetDefault(userID, addressID int64) error
+}
+
+// mysqlAddressStore 基于 MySQL 的地址存储
+type mysqlAddressStore struct{}
+
+func (mysqlAddressStore) List(userID int64) ([]*mysql.Address, error) {
+	return mysql.ListAddresses(userID)
+}
+
+func (mysqlAddressStore) Get(userID, addressID int64) (*mysql.Address, error) {
+	return mysql.GetAddress(userID, addressID)
+}
+
+func (mysqlAddressStore) Count(userID int64) (int64, error) {
+	return mysql.CountAddresses(userID)
+}
+
+func (mysqlAddressStore) Create(addr *mysql.Address) error {
+	return mysql.CreateAddress(addr)
+}
+
+func (mysqlAddressStore) Update(addr *mysql.Address) error {
+	return mysql.UpdateAddress(addr)
+}
+
+func (mysqlAddressStore) Delete(userID, addressID int64) error {
+	return mysql.DeleteAddress(userID, addressID)
+}
+
+func (mysqlAddressStore) SetDefault(userID, addressID int64) error {
+	return mysql.SetDefaultAddress(userID, addressID)
+}
+
+// AddressService 收货地址簿，HTTP 接口和 RPC 接口共用
+type AddressService struct {
+	store AddressStore
+}
+
+// NewAddressService 创建基于 MySQL 的地址服务
+func NewAddressService() *AddressService {
+	return NewAddressServiceWithStore(mysqlAddressStore{})
+}
+
+// NewAddressServiceWithStore 使用指定存储创建地址服务
+func NewAddressServiceWithStore(store AddressStore) *AddressService {
+	return &AddressService{store: store}
+}
+
+// List 查询用户的全部地址
+func (s *AddressService) List(ctx context.Context, userID int64) ([]*user.Address, error) {
+	addrs, err := s.store.List(userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list addresses failed")
+	}
+	result := make([]*user.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		result = append(result, newAddressInfo(addr))
+	}
+	return result, nil
+}
+
+// Get 查询用户的指定地址，地址不属于该用户时返回 ErrAddressNotFound
+func (s *AddressService) Get(ctx context.Context, userID, addressID int64) (*user.Address, error) {
+	addr, err := s.get(userID, addressID)
+	if err != nil {
+		return nil, err
+	}
+	return newAddressInfo(addr), nil
+}
+
+// Create 新增地址，用户的第一个地址自动成为默认地址
+func (s *AddressService) Create(ctx context.Context, userID int64, req *user.CreateAddressReq) (*user.Address, error) {
+	addr := &mysql.Address{
+		UserID:         userID,
+		Label:          strings.TrimSpace(req.Label),
+		RecipientName:  strings.TrimSpace(req.RecipientName),
+		RecipientPhone: strings.TrimSpace(req.RecipientPhone),
+		StreetAddress:  strings.TrimSpace(req.StreetAddress),
+		City:           strings.TrimSpace(req.City),
+		State:          strings.TrimSpace(req.State),
+		Country:        strings.TrimSpace(req.Country),
+		ZipCode:        strings.TrimSpace(req.ZipCode),
+		IsDefault:      req.IsDefault,
+	}
+	if err := validateAddress(addr); err != nil {
+		return nil, err
+	}
+
+	n, err := s.store.Count(userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "count addresses failed")
+	}
+	if n >= MaxAddressesPerUser {
+		return nil, ErrAddressLimit
+	}
+	if n == 0 {
+		addr.IsDefault = true
+	}
+
+	if err := s.store.Create(addr); err != nil {
+		return nil, errors.Wrap(err, "create address failed")
+	}
+	return newAddressInfo(addr), nil
+}
+
+// Update 修改地址内容，默认标记通过 SetDefault 修改
+func (s *AddressService) Update(ctx context.Context, userID int64, req *user.UpdateAddressReq) (*user.Address, error) {
+	addr, err := s.get(userID, req.AddressId)
+	if err != nil {
+		return nil, err
+	}
+	addr.Label = strings.TrimSpace(req.Label)
+	addr.RecipientName = strings.TrimSpace(req.RecipientName)
+	addr.RecipientPhone = strings.TrimSpace(req.RecipientPhone)
+	addr.StreetAddress = strings.TrimSpace(req.StreetAddress)
+	addr.City = strings.TrimSpace(req.City)
+	addr.State = strings.TrimSpace(req.State)
+	addr.Country = strings.TrimSpace(req.Country)
+	addr.ZipCode = strings.TrimSpace(req.ZipCode)
+	if err := validateAddress(addr); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Update(addr); err != nil {
+		return nil, errors.Wrap(err, "update address failed")
+	}
+	return newAddressInfo(addr), nil
+}
+
+// Delete 删除地址，删除默认地址时最新的地址成为默认地址
+func (s *AddressService) Delete(ctx context.Context, userID, addressID int64) error {
+	if _, err := s.get(userID, addressID); err != nil {
+		return err
+	}
+	if err := s.store.Delete(userID, addressID); err != nil {
+		return errors.Wrap(err, "delete address failed")
+	}
+	return nil
+}
+
+// SetDefault 设置默认地址
+func (s *AddressService) SetDefault(ctx context.Context, userID, addressID int64) error {
+	if _, err := s.get(userID, addressID); err != nil {
+		return err
+	}
+	if err := s.store.SetDefault(userID, addressID); err != nil {
+		return errors.Wrap(err, "set default address failed")
+	}
+	return nil
+}
+
+// get 查询属于用户的地址
+func (s *AddressService) get(userID, addressID int64) (*mysql.Address, error) {
+	if userID <= 0 || addressID <= 0 {
+		return nil, ErrAddressNotFound
+	}
+	addr, err := s.store.Get(userID, addressID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get address failed")
+	}
+	if addr == nil {
+		return nil, ErrAddressNotFound
+	}
+	return addr, nil
+}
+
+// validateAddress 校验地址字段，长度与表结构一致
+func validateAddress(addr *mysql.Address) error {
+	fields := []struct {
+		name     string
+		value    string
+		required bool
+		max      int
+	}{
+		{"label", addr.Label, false, 32},
+		{"recipient_name", addr.RecipientName, true, 64},
+		{"street_address", addr.StreetAddress, true, 255},
+		{"city", addr.City, true, 64},
+		{"state", addr.State, false, 64},
+		{"country", addr.Country, true, 64},
+		{"zip_code", addr.ZipCode, false, 16},
+	}
+	for _, f := range fields {
+		if f.required && f.value == "" {
+			return errors.Wrapf(ErrInvalidAddress, "%s is required", f.name)
+		}
+		if utf8.RuneCountInString(f.value) > f.max {
+			return errors.Wrapf(ErrInvalidAddress, "%s is longer than %d characters", f.name, f.max)
+		}
+	}
+	if !recipientPhonePattern.MatchString(addr.RecipientPhone) {
+		return errors.Wrap(ErrInvalidAddress, "recipient_phone is not a valid mobile number")
+	}
+	return nil
+}
+
+// newAddressInfo 转换为接口返回的地址
+func newAddressInfo(addr *mysql.Address) *user.Address {
+	return &user.Address{
+		Id:             addr.ID,
+		Label:          addr.Label,
+		RecipientName:  addr.RecipientName,
+		RecipientPhone: addr.RecipientPhone,
+		StreetAddress:  addr.StreetAddress,
+		City:           addr.City,
+		State:          addr.State,
+		Country:        addr.Country,
+		ZipCode:        addr.ZipCode,
+		IsDefault:      addr.IsDefault,
+	}
+}
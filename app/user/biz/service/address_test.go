@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// memAddressStore 内存地址存储，行为与 MySQL 实现一致
+type memAddressStore struct {
+	nextID int64
+	addrs  map[int64]*mysql.Address
+}
+
+func newMemAddressStore() *memAddressStore {
+	return &memAddressStore{addrs: make(map[int64]*mysql.Address)}
+}
+
+func (m *memAddressStore) List(userID int64) ([]*mysql.Address, error) {
+	var result []*mysql.Address
+	for _, a := range m.addrs {
+		if a.UserID == userID {
+			cp := *a
+			result = append(result, &cp)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].IsDefault != result[j].IsDefault {
+			return result[i].IsDefault
+		}
+		return result[i].ID > result[j].ID
+	})
+	return result, nil
+}
+
+func (m *memAddressStore) Get(userID, addressID int64) (*mysql.Address, error) {
+	a, ok := m.addrs[addressID]
+	if !ok || a.UserID != userID {
+		return nil, nil
+	}
+	cp := *a
+	return &cp, nil
+}
+
+func (m *memAddressStore) Count(userID int64) (int64, error) {
+	var n int64
+	for _, a := range m.addrs {
+		if a.UserID == userID {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *memAddressStore) Create(addr *mysql.Address) error {
+	if addr.IsDefault {
+		m.clearDefault(addr.UserID)
+	}
+	m.nextID++
+	addr.ID = m.nextID
+	cp := *addr
+	m.addrs[addr.ID] = &cp
+	return nil
+}
+
+func (m *memAddressStore) Update(addr *mysql.Address) error {
+	if a, ok := m.addrs[addr.ID]; ok && a.UserID == addr.UserID {
+		cp := *addr
+		cp.IsDefault = a.IsDefault
+		m.addrs[addr.ID] = &cp
+	}
+	return nil
+}
+
+func (m *memAddressStore) Delete(userID, addressID int64) error {
+	a, ok := m.addrs[addressID]
+	if !ok || a.UserID != userID {
+		return nil
+	}
+	delete(m.addrs, addressID)
+	if a.IsDefault {
+		if rest, _ := m.List(userID); len(rest) > 0 {
+			m.addrs[rest[0].ID].IsDefault = true
+		}
+	}
+	return nil
+}
+
+func (m *memAddressStore) SetDefault(userID, addressID int64) error {
+	m.clearDefault(userID)
+	if a, ok := m.addrs[addressID]; ok && a.UserID == userID {
+		a.IsDefault = true
+	}
+	return nil
+}
+
+func (m *memAddressStore) clearDefault(userID int64) {
+	for _, a := range m.addrs {
+		if a.UserID == userID {
+			a.IsDefault = false
+		}
+	}
+}
+
+func newCreateAddressReq(label string) *user.CreateAddressReq {
+	return &user.CreateAddressReq{
+		Label:          label,
+		RecipientName:  "张三",
+		RecipientPhone: "13800138000",
+		StreetAddress:  "科技园路 1 号",
+		City:           "深圳",
+		State:          "广东",
+		Country:        "中国",
+		ZipCode:        "518000",
+	}
+}
+
+func TestAddress_CreateAndDefault(t *testing.T) {
+	svc := NewAddressServiceWithStore(newMemAddressStore())
+	ctx := context.Background()
+
+	// 第一个地址自动成为默认地址
+	home, err := svc.Create(ctx, 1, newCreateAddressReq("家"))
+	require.NoError(t, err)
+	assert.True(t, home.IsDefault)
+	assert.Equal(t, "张三", home.RecipientName)
+
+	office, err := svc.Create(ctx, 1, newCreateAddressReq("公司"))
+	require.NoError(t, err)
+	assert.False(t, office.IsDefault)
+
+	// 新地址指定为默认时取消原默认地址
+	req := newCreateAddressReq("父母家")
+	req.IsDefault = true
+	parents, err := svc.Create(ctx, 1, req)
+	require.NoError(t, err)
+
+	addrs, err := svc.List(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, addrs, 3)
+	assert.Equal(t, parents.Id, addrs[0].Id)
+	assert.True(t, addrs[0].IsDefault)
+	assert.False(t, addrs[1].IsDefault)
+	assert.False(t, addrs[2].IsDefault)
+
+	// 切换默认地址
+	require.NoError(t, svc.SetDefault(ctx, 1, office.Id))
+	addrs, err = svc.List(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, office.Id, addrs[0].Id)
+	assert.True(t, addrs[0].IsDefault)
+
+	// 删除默认地址后最新的地址成为默认地址
+	require.NoError(t, svc.Delete(ctx, 1, office.Id))
+	addrs, err = svc.List(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, addrs, 2)
+	assert.Equal(t, parents.Id, addrs[0].Id)
+	assert.True(t, addrs[0].IsDefault)
+}
+
+func TestAddress_Update(t *testing.T) {
+	svc := NewAddressServiceWithStore(newMemAddressStore())
+	ctx := context.Background()
+
+	addr, err := svc.Create(ctx, 1, newCreateAddressReq("家"))
+	require.NoError(t, err)
+
+	updated, err := svc.Update(ctx, 1, &user.UpdateAddressReq{
+		AddressId:      addr.Id,
+		Label:          "新家",
+		RecipientName:  "李四",
+		RecipientPhone: "13900139000",
+		StreetAddress:  "南山大道 100 号",
+		City:           "深圳",
+		Country:        "中国",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "李四", updated.RecipientName)
+	assert.True(t, updated.IsDefault, "更新不改变默认标记")
+
+	got, err := svc.Get(ctx, 1, addr.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "新家", got.Label)
+	assert.Equal(t, "南山大道 100 号", got.StreetAddress)
+	assert.Empty(t, got.State)
+}
+
+func TestAddress_Ownership(t *testing.T) {
+	svc := NewAddressServiceWithStore(newMemAddressStore())
+	ctx := context.Background()
+
+	addr, err := svc.Create(ctx, 1, newCreateAddressReq("家"))
+	require.NoError(t, err)
+
+	// 其他用户不能查看、修改、删除或设为默认
+	_, err = svc.Get(ctx, 2, addr.Id)
+	assert.ErrorIs(t, err, ErrAddressNotFound)
+	_, err = svc.Update(ctx, 2, &user.UpdateAddressReq{AddressId: addr.Id})
+	assert.ErrorIs(t, err, ErrAddressNotFound)
+	assert.ErrorIs(t, svc.Delete(ctx, 2, addr.Id), ErrAddressNotFound)
+	assert.ErrorIs(t, svc.SetDefault(ctx, 2, addr.Id), ErrAddressNotFound)
+
+	// 未指定用户时不返回地址
+	_, err = svc.Get(ctx, 0, addr.Id)
+	assert.ErrorIs(t, err, ErrAddressNotFound)
+
+	got, err := svc.Get(ctx, 1, addr.Id)
+	require.NoError(t, err)
+	assert.Equal(t, addr.Id, got.Id)
+}
+
+func TestAddress_Validation(t *testing.T) {
+	svc := NewAddressServiceWithStore(newMemAddressStore())
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		modify func(req *user.CreateAddressReq)
+	}{
+		{"missing_recipient", func(req *user.CreateAddressReq) { req.RecipientName = "  " }},
+		{"invalid_phone", func(req *user.CreateAddressReq) { req.RecipientPhone = "12345" }},
+		{"missing_street", func(req *user.CreateAddressReq) { req.StreetAddress = "" }},
+		{"missing_city", func(req *user.CreateAddressReq) { req.City = "" }},
+		{"missing_country", func(req *user.CreateAddressReq) { req.Country = "" }},
+		{"label_too_long", func(req *user.CreateAddressReq) { req.Label = string(make([]rune, 33)) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newCreateAddressReq("家")
+			tt.modify(req)
+			_, err := svc.Create(ctx, 1, req)
+			assert.ErrorIs(t, err, ErrInvalidAddress)
+		})
+	}
+
+	addrs, err := svc.List(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, addrs)
+}
+
+func TestAddress_Limit(t *testing.T) {
+	svc := NewAddressServiceWithStore(newMemAddressStore())
+	ctx := context.Background()
+
+	for i := 0; i < MaxAddressesPerUser; i++ {
+		_, err := svc.Create(ctx, 1, newCreateAddressReq("家"))
+		require.NoError(t, err)
+	}
+	_, err := svc.Create(ctx, 1, newCreateAddressReq("家"))
+	assert.ErrorIs(t, err, ErrAddressLimit)
+
+	// 其他用户不受影响
+	_, err = svc.Create(ctx, 2, newCreateAddressReq("家"))
+	assert.NoError(t, err)
+}
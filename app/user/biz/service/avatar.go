@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/app/user/biz/dal/redis"
+	"TikTokMall/app/user/pkg/imageproc"
+	"TikTokMall/app/user/pkg/storage"
+)
+
+// AvatarConfig 头像配置
+type AvatarConfig struct {
+	Storage   storage.Storage // 为空时不能上传头像
+	Sizes     []int           // 缩略图边长（像素），升序，最大的作为默认头像；修改后已有头像需要重新上传
+	MaxBytes  int64           // 上传文件大小上限
+	MaxPixels int             // 原图像素数上限，在解码前检查
+}
+
+// Avatars 头像配置，启动时根据配置替换
+var Avatars = AvatarConfig{
+	Sizes:     []int{64, 128, 256},
+	MaxBytes:  2 << 20,
+	MaxPixels: 40_000_000,
+}
+
+var (
+	ErrAvatarUnavailable = errors.New("avatar storage is not configured")
+	ErrAvatarTooLarge    = errors.New("avatar too large")
+	ErrAvatarFormat      = errors.New("avatar must be a JPEG, PNG, GIF or WebP image")
+	ErrInvalidAvatar     = errors.New("invalid avatar image")
+)
+
+// AvatarStore 头像使用的用户存储
+type AvatarStore interface {
+	// SetAvatar 更新用户头像并清理用户缓存，返回原来的头像键前缀
+	SetAvatar(ctx context.Context, userID int64, avatar string) (string, error)
+}
+
+// dalAvatarStore 基于 MySQL 和 Redis 的头像存储
+type dalAvatarStore struct{}
+
+func (dalAvatarStore) SetAvatar(ctx context.Context, userID int64, avatar string) (string, error) {
+	old, err := mysql.SetUserAvatar(userID, avatar)
+	if err != nil {
+		return "", err
+	}
+	if err := redis.DeleteUserCache(ctx, userID); err != nil {
+		hlog.CtxWarnf(ctx, "cache cleanup failed: %v", err)
+	}
+	return old, nil
+}
+
+// AvatarService 头像上传和删除
+// 每次上传使用新的随机键前缀，文件内容不会变化，可以长期缓存；替换后删除原来的文件
+type AvatarService struct {
+	store AvatarStore
+}
+
+// NewAvatarService 创建基于 MySQL 的头像服务
+func NewAvatarService() *AvatarService {
+	return NewAvatarServiceWithStore(dalAvatarStore{})
+}
+
+// NewAvatarServiceWithStore 使用指定存储创建头像服务
+func NewAvatarServiceWithStore(store AvatarStore) *AvatarService {
+	return &AvatarService{store: store}
+}
+
+// Upload 校验并处理上传的图片，生成各尺寸缩略图后替换用户头像，返回默认头像地址和各尺寸地址
+func (s *AvatarService) Upload(ctx context.Context, userID int64, data []byte) (string, map[int32]string, error) {
+	cfg := Avatars
+	if cfg.Storage == nil {
+		return "", nil, ErrAvatarUnavailable
+	}
+	if int64(len(data)) > cfg.MaxBytes {
+		return "", nil, fmt.Errorf("%w: at most %d bytes", ErrAvatarTooLarge, cfg.MaxBytes)
+	}
+
+	img, err := imageproc.Decode(data, cfg.MaxPixels)
+	switch {
+	case errors.Is(err, imageproc.ErrUnsupportedFormat):
+		return "", nil, ErrAvatarFormat
+	case errors.Is(err, imageproc.ErrTooManyPixels):
+		return "", nil, fmt.Errorf("%w: at most %d pixels", ErrAvatarTooLarge, cfg.MaxPixels)
+	case err != nil:
+		return "", nil, ErrInvalidAvatar
+	}
+
+	prefix, err := newAvatarPrefix(userID)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, size := range cfg.Sizes {
+		thumb, err := imageproc.EncodeJPEG(img.Thumbnail(size))
+		if err == nil {
+			err = cfg.Storage.Put(ctx, avatarKey(prefix, size), thumb, "image/jpeg")
+		}
+		if err != nil {
+			s.deleteFiles(ctx, prefix)
+			return "", nil, errors.Wrap(err, "store avatar failed")
+		}
+	}
+
+	old, err := s.store.SetAvatar(ctx, userID, prefix)
+	if err != nil {
+		s.deleteFiles(ctx, prefix)
+		return "", nil, errors.Wrap(err, "update avatar failed")
+	}
+	s.deleteFiles(ctx, old)
+
+	url, thumbnails := AvatarURLs(prefix)
+	return url, thumbnails, nil
+}
+
+// Delete 清除用户头像并删除文件
+func (s *AvatarService) Delete(ctx context.Context, userID int64) error {
+	old, err := s.store.SetAvatar(ctx, userID, "")
+	if err != nil {
+		return errors.Wrap(err, "update avatar failed")
+	}
+	s.deleteFiles(ctx, old)
+	return nil
+}
+
+// deleteFiles 删除一次上传生成的全部文件，失败只记录日志，不影响头像更新
+func (s *AvatarService) deleteFiles(ctx context.Context, prefix string) {
+	if prefix == "" || Avatars.Storage == nil {
+		return
+	}
+	if err := Avatars.Storage.DeletePrefix(ctx, prefix+"/"); err != nil {
+		hlog.CtxWarnf(ctx, "delete avatar files %s failed: %v", prefix, err)
+	}
+}
+
+// AvatarURLs 根据头像键前缀生成默认头像地址和各尺寸地址，未设置头像时返回空
+func AvatarURLs(prefix string) (string, map[int32]string) {
+	cfg := Avatars
+	if prefix == "" || cfg.Storage == nil || len(cfg.Sizes) == 0 {
+		return "", nil
+	}
+	thumbnails := make(map[int32]string, len(cfg.Sizes))
+	for _, size := range cfg.Sizes {
+		thumbnails[int32(size)] = cfg.Storage.URL(avatarKey(prefix, size))
+	}
+	return thumbnails[int32(cfg.Sizes[len(cfg.Sizes)-1])], thumbnails
+}
+
+// deleteUserAvatars 删除用户上传过的全部头像文件，注销时使用
+func deleteUserAvatars(ctx context.Context, userID int64) error {
+	if Avatars.Storage == nil {
+		return nil
+	}
+	return Avatars.Storage.DeletePrefix(ctx, fmt.Sprintf("avatars/%d/", userID))
+}
+
+// newAvatarPrefix 生成新的头像键前缀：avatars/<用户ID>/<随机串>
+func newAvatarPrefix(userID int64) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("avatars/%d/%s", userID, hex.EncodeToString(b)), nil
+}
+
+func avatarKey(prefix string, size int) string {
+	return fmt.Sprintf("%s/%d.jpg", prefix, size)
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/user/pkg/storage"
+)
+
+// memAvatarStore 内存头像存储
+type memAvatarStore struct {
+	avatars map[int64]string
+}
+
+func (m *memAvatarStore) SetAvatar(ctx context.Context, userID int64, avatar string) (string, error) {
+	old := m.avatars[userID]
+	m.avatars[userID] = avatar
+	return old, nil
+}
+
+// useTestAvatarStorage 使用临时目录作为头像存储，测试结束后恢复配置
+func useTestAvatarStorage(t *testing.T) string {
+	dir := t.TempDir()
+	local, err := storage.NewLocal(dir, "http://localhost:8001/v1/user/files")
+	require.NoError(t, err)
+	saved := Avatars
+	Avatars = AvatarConfig{Storage: local, Sizes: []int{32, 64}, MaxBytes: 1 << 20, MaxPixels: 1 << 20}
+	t.Cleanup(func() { Avatars = saved })
+	return dir
+}
+
+func testPNG(t *testing.T, w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// avatarFile 地址对应的本地文件
+func avatarFile(dir, url string) string {
+	return filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(url, "http://localhost:8001/v1/user/files/")))
+}
+
+func TestAvatar_Upload(t *testing.T) {
+	ctx := context.Background()
+	dir := useTestAvatarStorage(t)
+	store := &memAvatarStore{avatars: map[int64]string{}}
+	svc := NewAvatarServiceWithStore(store)
+
+	url, thumbnails, err := svc.Upload(ctx, 1, testPNG(t, 120, 80))
+	require.NoError(t, err)
+	require.Len(t, thumbnails, 2)
+	assert.Equal(t, thumbnails[64], url, "默认头像为最大尺寸")
+	assert.True(t, strings.HasPrefix(store.avatars[1], "avatars/1/"))
+
+	for size, u := range thumbnails {
+		data, err := os.ReadFile(avatarFile(dir, u))
+		require.NoError(t, err)
+		cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, int(size), cfg.Width)
+		assert.Equal(t, int(size), cfg.Height)
+	}
+
+	// Info 使用的地址与上传结果一致
+	infoURL, infoThumbnails := AvatarURLs(store.avatars[1])
+	assert.Equal(t, url, infoURL)
+	assert.Equal(t, thumbnails, infoThumbnails)
+
+	// 替换头像后删除原来的文件
+	newURL, _, err := svc.Upload(ctx, 1, testPNG(t, 40, 40))
+	require.NoError(t, err)
+	assert.NotEqual(t, url, newURL)
+	_, err = os.Stat(avatarFile(dir, url))
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, svc.Delete(ctx, 1))
+	assert.Empty(t, store.avatars[1])
+	_, err = os.Stat(avatarFile(dir, newURL))
+	assert.True(t, os.IsNotExist(err))
+	infoURL, _ = AvatarURLs("")
+	assert.Empty(t, infoURL)
+}
+
+func TestAvatar_Rejects(t *testing.T) {
+	ctx := context.Background()
+	useTestAvatarStorage(t)
+	store := &memAvatarStore{avatars: map[int64]string{}}
+	svc := NewAvatarServiceWithStore(store)
+
+	_, _, err := svc.Upload(ctx, 1, []byte("<html><script>alert(1)</script></html>"))
+	assert.ErrorIs(t, err, ErrAvatarFormat)
+
+	Avatars.MaxBytes = 64 << 20
+	_, _, err = svc.Upload(ctx, 1, testPNG(t, 2048, 1024))
+	assert.ErrorIs(t, err, ErrAvatarTooLarge, "像素数超过限制")
+
+	Avatars.MaxBytes = 100
+	_, _, err = svc.Upload(ctx, 1, testPNG(t, 40, 40))
+	assert.ErrorIs(t, err, ErrAvatarTooLarge, "文件超过大小限制")
+	assert.Empty(t, store.avatars)
+
+	Avatars.Storage = nil
+	_, _, err = svc.Upload(ctx, 1, testPNG(t, 4, 4))
+	assert.ErrorIs(t, err, ErrAvatarUnavailable)
+}
+
+func TestAvatar_DeleteUserAvatars(t *testing.T) {
+	ctx := context.Background()
+	dir := useTestAvatarStorage(t)
+	svc := NewAvatarServiceWithStore(&memAvatarStore{avatars: map[int64]string{}})
+
+	url1, _, err := svc.Upload(ctx, 1, testPNG(t, 40, 40))
+	require.NoError(t, err)
+	url2, _, err := svc.Upload(ctx, 2, testPNG(t, 40, 40))
+	require.NoError(t, err)
+
+	// 注销时删除该用户的全部头像文件
+	require.NoError(t, deleteUserAvatars(ctx, 1))
+	_, err = os.Stat(avatarFile(dir, url1))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(avatarFile(dir, url2))
+	assert.NoError(t, err)
+}
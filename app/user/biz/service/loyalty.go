@@ -0,0 +1,456 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/app/user/kitex_gen/user"
+	"TikTokMall/pkg/orderevents"
+)
+
+// Tier 会员等级，最近 12 个月消费金额达到 MinSpend 即可获得
+type Tier struct {
+	Name     string
+	MinSpend float64
+}
+
+// LoyaltyConfig 积分和会员等级配置
+type LoyaltyConfig struct {
+	PointsPerUnit float64       // 每消费 1 元获得的积分，不足 1 分的部分舍去
+	PointValue    float64       // 每个积分抵扣的金额
+	Expiry        time.Duration // 积分有效期，0 表示不过期
+	Tiers         []Tier        // 按 MinSpend 升序
+}
+
+// Loyalty 积分配置，启动时根据配置替换
+var Loyalty = LoyaltyConfig{
+	PointsPerUnit: 1,
+	PointValue:    0.01,
+	Expiry:        365 * 24 * time.Hour,
+	Tiers: []Tier{
+		{Name: "standard"},
+		{Name: "silver", MinSpend: 1000},
+		{Name: "gold", MinSpend: 5000},
+		{Name: "platinum", MinSpend: 20000},
+	},
+}
+
+const (
+	// DefaultPointsExpiryInterval 检查到期积分的间隔
+	DefaultPointsExpiryInterval = time.Hour
+
+	pointsExpiryBatchSize = 100
+	defaultPointsPageSize = 20
+	maxPointsPageSize     = 100
+)
+
+var (
+	ErrInvalidPoints      = errors.New("invalid points request")
+	ErrInsufficientPoints = errors.New("insufficient points")
+	ErrRedemptionCanceled = errors.New("redemption has been canceled")
+)
+
+// LoyaltyStore 积分账本存储
+type LoyaltyStore interface {
+	// UpdateBook 锁定用户积分账本并读取 refs 对应的已有流水，fn 返回 nil 后写回
+	UpdateBook(userID int64, refs []string, fn func(book *mysql.PointsBook) error) error
+	// GetBook 读取用户积分账本，只用于查询
+	GetBook(userID int64) (*mysql.PointsBook, error)
+	ListEntries(userID int64, offset, limit int) ([]*mysql.PointsEntry, int64, error)
+	// SumSpend 统计 since 之后计入会员等级的消费金额
+	SumSpend(userID int64, since time.Time) (float64, error)
+	ListUsersWithExpiredPoints(now time.Time, limit int) ([]int64, error)
+}
+
+// mysqlLoyaltyStore 基于 MySQL 的积分存储
+type mysqlLoyaltyStore struct{}
+
+func (mysqlLoyaltyStore) UpdateBook(userID int64, refs []string, fn func(book *mysql.PointsBook) error) error {
+	return mysql.UpdatePointsBook(userID, refs, fn)
+}
+
+func (mysqlLoyaltyStore) GetBook(userID int64) (*mysql.PointsBook, error) {
+	return mysql.GetPointsBook(userID)
+}
+
+func (mysqlLoyaltyStore) ListEntries(userID int64, offset, limit int) ([]*mysql.PointsEntry, int64, error) {
+	return mysql.ListPointsEntries(userID, offset, limit)
+}
+
+func (mysqlLoyaltyStore) SumSpend(userID int64, since time.Time) (float64, error) {
+	return mysql.SumPointsSpend(userID, since)
+}
+
+func (mysqlLoyaltyStore) ListUsersWithExpiredPoints(now time.Time, limit int) ([]int64, error) {
+	return mysql.ListUsersWithExpiredPoints(now, limit)
+}
+
+// LoyaltyService 积分和会员等级
+// 积分按批记录，每批有自己的到期时间；抵扣、扣回和到期作废时先消耗最早到期的积分。
+// 每次变化都有幂等键，订单事件和结算服务的重试不会重复发放或扣减
+type LoyaltyService struct {
+	store LoyaltyStore
+	now   func() time.Time
+}
+
+// NewLoyaltyService 创建基于 MySQL 的积分服务
+func NewLoyaltyService() *LoyaltyService {
+	return NewLoyaltyServiceWithStore(mysqlLoyaltyStore{})
+}
+
+// NewLoyaltyServiceWithStore 使用指定存储创建积分服务
+func NewLoyaltyServiceWithStore(store LoyaltyStore) *LoyaltyService {
+	return &LoyaltyService{store: store, now: time.Now}
+}
+
+// HandleOrderEvent 处理订单状态变化：支付或完成时发放积分，退款时扣回
+// 同一订单只发放一次；退款事件先于支付事件处理时，之后的支付事件不再发放
+func (s *LoyaltyService) HandleOrderEvent(ctx context.Context, ev *orderevents.StatusChanged) error {
+	if ev.UserID <= 0 {
+		return nil
+	}
+	orderID := strconv.FormatInt(ev.OrderID, 10)
+	switch ev.Status {
+	case orderevents.StatusPaid, orderevents.StatusCompleted:
+		return errors.Wrap(s.earn(ev.UserID, orderID, ev.Amount), "credit points failed")
+	case orderevents.StatusRefunded:
+		return errors.Wrap(s.refund(ev.UserID, orderID), "deduct points failed")
+	}
+	return nil
+}
+
+func (s *LoyaltyService) earn(userID int64, orderID string, amount float64) error {
+	earnRef, refundRef := pointsRef(mysql.PointsEarn, orderID), pointsRef(mysql.PointsRefund, orderID)
+	return s.store.UpdateBook(userID, []string{earnRef, refundRef}, func(book *mysql.PointsBook) error {
+		if book.Existing[earnRef] != nil || book.Existing[refundRef] != nil {
+			return nil
+		}
+		now := s.now()
+		expireLots(book, now)
+
+		points := int64(math.Floor(amount * Loyalty.PointsPerUnit))
+		if points < 0 {
+			points = 0
+		}
+		book.Add(&mysql.PointsEntry{
+			Kind:      mysql.PointsEarn,
+			Points:    points,
+			Remaining: payDebt(book, points),
+			Amount:    amount,
+			OrderID:   orderID,
+			Ref:       earnRef,
+			ExpiresAt: pointsExpiry(now),
+		})
+		return nil
+	})
+}
+
+func (s *LoyaltyService) refund(userID int64, orderID string) error {
+	earnRef, refundRef := pointsRef(mysql.PointsEarn, orderID), pointsRef(mysql.PointsRefund, orderID)
+	expireRef := pointsRef(mysql.PointsExpire, earnRef)
+	return s.store.UpdateBook(userID, []string{earnRef, refundRef, expireRef}, func(book *mysql.PointsBook) error {
+		if book.Existing[refundRef] != nil {
+			return nil
+		}
+		now := s.now()
+		expireLots(book, now)
+
+		// 未发放过积分时也记录退款，之后重复投递的支付事件不再发放
+		entry := &mysql.PointsEntry{Kind: mysql.PointsRefund, OrderID: orderID, Ref: refundRef}
+		if earned := book.Existing[earnRef]; earned != nil {
+			// 已到期作废的部分不再扣回，优先扣减该订单获得的积分
+			deduct := earned.Points
+			if expired := book.Existing[expireRef]; expired != nil {
+				deduct += expired.Points
+			}
+			consumePoints(book, deduct, now, earned.ID)
+			entry.Points = -deduct
+			entry.Amount = -earned.Amount
+		}
+		book.Add(entry)
+		return nil
+	})
+}
+
+// Redeem 结算时抵扣积分，抵扣金额不超过 maxDiscount，返回实际抵扣的积分和金额
+// 同一结算订单重复请求时返回第一次的结果
+func (s *LoyaltyService) Redeem(ctx context.Context, userID int64, orderID string, points int64, maxDiscount float64) (int64, float64, error) {
+	if userID <= 0 || orderID == "" || points <= 0 {
+		return 0, 0, ErrInvalidPoints
+	}
+	redeemRef, cancelRef := pointsRef(mysql.PointsRedeem, orderID), pointsRef(mysql.PointsRedeemCancel, orderID)
+	var used int64
+	err := s.store.UpdateBook(userID, []string{redeemRef, cancelRef}, func(book *mysql.PointsBook) error {
+		if book.Existing[cancelRef] != nil {
+			return ErrRedemptionCanceled
+		}
+		if redeemed := book.Existing[redeemRef]; redeemed != nil {
+			used = -redeemed.Points
+			return nil
+		}
+		now := s.now()
+		expireLots(book, now)
+		if book.Balance(now) < points {
+			return ErrInsufficientPoints
+		}
+
+		used = points
+		if limit := int64(math.Floor(maxDiscount/Loyalty.PointValue + 1e-9)); used > limit {
+			used = limit
+		}
+		if used <= 0 {
+			used = 0
+			return nil
+		}
+		// 记录被抵扣积分中最晚的到期时间，取消抵扣时退回的积分沿用
+		expiresAt := consumePoints(book, used, now, 0)
+		book.Add(&mysql.PointsEntry{
+			Kind:      mysql.PointsRedeem,
+			Points:    -used,
+			OrderID:   orderID,
+			Ref:       redeemRef,
+			ExpiresAt: expiresAt,
+		})
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrInsufficientPoints) || errors.Is(err, ErrRedemptionCanceled) {
+			return 0, 0, err
+		}
+		return 0, 0, errors.Wrap(err, "redeem points failed")
+	}
+	return used, pointsDiscount(used), nil
+}
+
+// CancelRedemption 结算失败时退回抵扣的积分；先于抵扣处理时，之后同一订单的抵扣请求被拒绝
+func (s *LoyaltyService) CancelRedemption(ctx context.Context, userID int64, orderID string) error {
+	if userID <= 0 || orderID == "" {
+		return ErrInvalidPoints
+	}
+	redeemRef, cancelRef := pointsRef(mysql.PointsRedeem, orderID), pointsRef(mysql.PointsRedeemCancel, orderID)
+	err := s.store.UpdateBook(userID, []string{redeemRef, cancelRef}, func(book *mysql.PointsBook) error {
+		if book.Existing[cancelRef] != nil {
+			return nil
+		}
+		entry := &mysql.PointsEntry{Kind: mysql.PointsRedeemCancel, OrderID: orderID, Ref: cancelRef}
+		if redeemed := book.Existing[redeemRef]; redeemed != nil {
+			entry.Points = -redeemed.Points
+			entry.Remaining = payDebt(book, entry.Points)
+			entry.ExpiresAt = redeemed.ExpiresAt
+		}
+		book.Add(entry)
+		return nil
+	})
+	return errors.Wrap(err, "cancel redemption failed")
+}
+
+// Summary 查询积分余额和会员等级
+func (s *LoyaltyService) Summary(ctx context.Context, userID int64) (*user.PointsSummary, error) {
+	book, err := s.store.GetBook(userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get points failed")
+	}
+	now := s.now()
+	spend, err := s.store.SumSpend(userID, now.AddDate(-1, 0, 0))
+	if err != nil {
+		return nil, errors.Wrap(err, "sum spend failed")
+	}
+
+	summary := &user.PointsSummary{Balance: book.Balance(now), RollingSpend: roundCents(spend)}
+	for _, tier := range Loyalty.Tiers {
+		if spend < tier.MinSpend {
+			summary.NextTier = tier.Name
+			summary.NextTierSpend = tier.MinSpend
+			break
+		}
+		summary.Tier = tier.Name
+	}
+	for _, lot := range sortedLots(book) {
+		if !lot.Expired(now) && lot.ExpiresAt != nil {
+			summary.ExpiringPoints = lot.Remaining
+			summary.ExpiringAt = lot.ExpiresAt.Unix()
+			break
+		}
+	}
+	return summary, nil
+}
+
+// History 按时间倒序分页查询积分流水
+func (s *LoyaltyService) History(ctx context.Context, userID int64, page, pageSize int) ([]*user.PointsEntry, int64, error) {
+	if page < 0 || pageSize < 0 {
+		return nil, 0, ErrInvalidPoints
+	}
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultPointsPageSize
+	}
+	if pageSize > maxPointsPageSize {
+		pageSize = maxPointsPageSize
+	}
+	entries, total, err := s.store.ListEntries(userID, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "list points history failed")
+	}
+	result := make([]*user.PointsEntry, 0, len(entries))
+	for _, e := range entries {
+		info := &user.PointsEntry{
+			Id:        e.ID,
+			Kind:      e.Kind,
+			Points:    e.Points,
+			OrderId:   e.OrderID,
+			CreatedAt: e.CreatedAt.Unix(),
+		}
+		if e.ExpiresAt != nil && (e.Kind == mysql.PointsEarn || e.Kind == mysql.PointsRedeemCancel) {
+			info.ExpiresAt = e.ExpiresAt.Unix()
+		}
+		result = append(result, info)
+	}
+	return result, total, nil
+}
+
+// ExpireDue 作废已到期的积分，返回处理的用户数
+func (s *LoyaltyService) ExpireDue(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		now := s.now()
+		userIDs, err := s.store.ListUsersWithExpiredPoints(now, pointsExpiryBatchSize)
+		if err != nil {
+			return expired, errors.Wrap(err, "list users with expired points failed")
+		}
+		for _, userID := range userIDs {
+			err := s.store.UpdateBook(userID, nil, func(book *mysql.PointsBook) error {
+				expireLots(book, now)
+				return nil
+			})
+			if err != nil {
+				return expired, errors.Wrapf(err, "expire points of user %d failed", userID)
+			}
+			expired++
+		}
+		if len(userIDs) < pointsExpiryBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// RunExpiry 定期执行 ExpireDue，阻塞直到 ctx 取消
+func (s *LoyaltyService) RunExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if n, err := s.ExpireDue(ctx); err != nil {
+			hlog.CtxErrorf(ctx, "expire points failed: %v", err)
+		} else if n > 0 {
+			hlog.CtxInfof(ctx, "expired points of %d users", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// expireLots 作废已到期的剩余积分
+func expireLots(book *mysql.PointsBook, now time.Time) {
+	for _, lot := range book.Lots {
+		if lot.Remaining > 0 && lot.Expired(now) {
+			book.Add(&mysql.PointsEntry{
+				Kind:    mysql.PointsExpire,
+				Points:  -lot.Remaining,
+				OrderID: lot.OrderID,
+				Ref:     pointsRef(mysql.PointsExpire, lot.Ref),
+			})
+			lot.Remaining = 0
+		}
+	}
+}
+
+// consumePoints 按到期时间先后扣减未到期的积分，优先扣减 preferID 对应的一批，不足的部分记为欠扣积分
+// 返回被扣减积分中最晚的到期时间，扣减了不过期的积分时返回 nil
+func consumePoints(book *mysql.PointsBook, points int64, now time.Time, preferID int64) *time.Time {
+	lots := sortedLots(book)
+	sort.SliceStable(lots, func(i, j int) bool {
+		return preferID != 0 && lots[i].ID == preferID && lots[j].ID != preferID
+	})
+
+	var latest *time.Time
+	neverExpires := false
+	for _, lot := range lots {
+		if points == 0 {
+			break
+		}
+		if lot.Remaining == 0 || lot.Expired(now) {
+			continue
+		}
+		n := lot.Remaining
+		if n > points {
+			n = points
+		}
+		lot.Remaining -= n
+		points -= n
+		if lot.ExpiresAt == nil {
+			neverExpires = true
+		} else if latest == nil || lot.ExpiresAt.After(*latest) {
+			latest = lot.ExpiresAt
+		}
+	}
+	book.Account.Debt += points
+	if neverExpires {
+		return nil
+	}
+	return latest
+}
+
+// payDebt 用获得的积分抵扣欠扣积分，返回抵扣后剩余的积分
+func payDebt(book *mysql.PointsBook, points int64) int64 {
+	pay := book.Account.Debt
+	if pay > points {
+		pay = points
+	}
+	book.Account.Debt -= pay
+	return points - pay
+}
+
+// sortedLots 按到期时间升序排列的积分批次，不过期的在最后
+func sortedLots(book *mysql.PointsBook) []*mysql.PointsEntry {
+	lots := append([]*mysql.PointsEntry(nil), book.Lots...)
+	sort.SliceStable(lots, func(i, j int) bool {
+		a, b := lots[i].ExpiresAt, lots[j].ExpiresAt
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return a.Before(*b)
+	})
+	return lots
+}
+
+func pointsExpiry(now time.Time) *time.Time {
+	if Loyalty.Expiry <= 0 {
+		return nil
+	}
+	t := now.Add(Loyalty.Expiry)
+	return &t
+}
+
+func pointsDiscount(points int64) float64 {
+	return roundCents(float64(points) * Loyalty.PointValue)
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// pointsRef 积分流水的幂等键，如 earn:<订单ID>
+func pointsRef(kind, id string) string {
+	return kind + ":" + id
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/pkg/orderevents"
+)
+
+// memLoyaltyStore 内存积分存储，行为与 MySQL 实现一致：fn 返回错误时不写回
+type memLoyaltyStore struct {
+	debts   map[int64]int64
+	entries []*mysql.PointsEntry
+	now     func() time.Time
+}
+
+func newMemLoyaltyStore(now func() time.Time) *memLoyaltyStore {
+	return &memLoyaltyStore{debts: map[int64]int64{}, now: now}
+}
+
+func (m *memLoyaltyStore) load(userID int64, refs []string) *mysql.PointsBook {
+	book := &mysql.PointsBook{
+		Account:  &mysql.PointsAccount{UserID: userID, Debt: m.debts[userID]},
+		Existing: map[string]*mysql.PointsEntry{},
+	}
+	for _, e := range m.entries {
+		if e.UserID == userID && e.Remaining > 0 {
+			cp := *e
+			book.Lots = append(book.Lots, &cp)
+		}
+		for _, ref := range refs {
+			if e.Ref == ref {
+				cp := *e
+				book.Existing[ref] = &cp
+			}
+		}
+	}
+	return book
+}
+
+func (m *memLoyaltyStore) UpdateBook(userID int64, refs []string, fn func(book *mysql.PointsBook) error) error {
+	book := m.load(userID, refs)
+	if err := fn(book); err != nil {
+		return err
+	}
+	for _, added := range book.Added {
+		for _, e := range m.entries {
+			if e.Ref == added.Ref {
+				return fmt.Errorf("duplicate ref %s", added.Ref)
+			}
+		}
+	}
+	m.debts[userID] = book.Account.Debt
+	for _, lot := range book.Lots {
+		for _, e := range m.entries {
+			if lot.ID != 0 && e.ID == lot.ID {
+				e.Remaining = lot.Remaining
+			}
+		}
+	}
+	for _, added := range book.Added {
+		added.ID = int64(len(m.entries) + 1)
+		added.CreatedAt = m.now()
+		cp := *added
+		m.entries = append(m.entries, &cp)
+	}
+	return nil
+}
+
+func (m *memLoyaltyStore) GetBook(userID int64) (*mysql.PointsBook, error) {
+	return m.load(userID, nil), nil
+}
+
+func (m *memLoyaltyStore) ListEntries(userID int64, offset, limit int) ([]*mysql.PointsEntry, int64, error) {
+	var entries []*mysql.PointsEntry
+	for i := len(m.entries) - 1; i >= 0; i-- {
+		if m.entries[i].UserID == userID {
+			entries = append(entries, m.entries[i])
+		}
+	}
+	total := int64(len(entries))
+	if offset >= len(entries) {
+		return nil, total, nil
+	}
+	entries = entries[offset:]
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, total, nil
+}
+
+func (m *memLoyaltyStore) SumSpend(userID int64, since time.Time) (float64, error) {
+	var spend float64
+	for _, e := range m.entries {
+		if e.UserID == userID && !e.CreatedAt.Before(since) {
+			spend += e.Amount
+		}
+	}
+	return spend, nil
+}
+
+func (m *memLoyaltyStore) ListUsersWithExpiredPoints(now time.Time, limit int) ([]int64, error) {
+	seen := map[int64]bool{}
+	var userIDs []int64
+	for _, e := range m.entries {
+		if e.Remaining > 0 && e.Expired(now) && !seen[e.UserID] && len(userIDs) < limit {
+			seen[e.UserID] = true
+			userIDs = append(userIDs, e.UserID)
+		}
+	}
+	return userIDs, nil
+}
+
+// newTestLoyalty 创建使用内存存储和可调时钟的积分服务，测试结束后恢复配置
+func newTestLoyalty(t *testing.T) (*LoyaltyService, *time.Time) {
+	saved := Loyalty
+	t.Cleanup(func() { Loyalty = saved })
+
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	svc := NewLoyaltyServiceWithStore(newMemLoyaltyStore(clock))
+	svc.now = clock
+	return svc, &now
+}
+
+func orderEvent(orderID, userID int64, status string, amount float64) *orderevents.StatusChanged {
+	return &orderevents.StatusChanged{OrderID: orderID, UserID: userID, Status: status, Amount: amount}
+}
+
+func balance(t *testing.T, svc *LoyaltyService, userID int64) int64 {
+	summary, err := svc.Summary(context.Background(), userID)
+	require.NoError(t, err)
+	return summary.Balance
+}
+
+func TestLoyalty_EarnAndRefund(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestLoyalty(t)
+
+	// 支付和完成事件只发放一次，重复投递不重复发放
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusPaid, 120.5)))
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusPaid, 120.5)))
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusCompleted, 120.5)))
+
+	summary, err := svc.Summary(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(120), summary.Balance)
+	assert.Equal(t, 120.5, summary.RollingSpend)
+	assert.Equal(t, "standard", summary.Tier)
+	assert.Equal(t, "silver", summary.NextTier)
+	assert.Equal(t, float64(1000), summary.NextTierSpend)
+
+	// 退款扣回积分和消费金额
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusRefunded, 120.5)))
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusRefunded, 120.5)))
+	summary, err = svc.Summary(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), summary.Balance)
+	assert.Equal(t, float64(0), summary.RollingSpend)
+
+	// 退款事件先处理时，之后的支付事件不再发放
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(2, 7, orderevents.StatusRefunded, 50)))
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(2, 7, orderevents.StatusPaid, 50)))
+	assert.Equal(t, int64(0), balance(t, svc, 7))
+
+	entries, total, err := svc.History(ctx, 7, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{mysql.PointsRefund, mysql.PointsRefund, mysql.PointsEarn},
+		[]string{entries[0].Kind, entries[1].Kind, entries[2].Kind})
+	assert.Equal(t, int64(-120), entries[1].Points)
+	assert.Equal(t, "1", entries[1].OrderId)
+	assert.NotZero(t, entries[2].ExpiresAt)
+}
+
+func TestLoyalty_RedeemAndCancel(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestLoyalty(t)
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusPaid, 500)))
+
+	points, discount, err := svc.Redeem(ctx, 7, "ORD-A", 200, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), points)
+	assert.Equal(t, 2.0, discount)
+
+	// 重试返回第一次的结果
+	points, discount, err = svc.Redeem(ctx, 7, "ORD-A", 300, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), points)
+	assert.Equal(t, 2.0, discount)
+	assert.Equal(t, int64(300), balance(t, svc, 7))
+
+	_, _, err = svc.Redeem(ctx, 7, "ORD-B", 301, 100)
+	assert.ErrorIs(t, err, ErrInsufficientPoints)
+	_, _, err = svc.Redeem(ctx, 7, "ORD-B", 0, 100)
+	assert.ErrorIs(t, err, ErrInvalidPoints)
+
+	// 抵扣金额不超过订单金额
+	points, discount, err = svc.Redeem(ctx, 7, "ORD-B", 300, 1.5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), points)
+	assert.Equal(t, 1.5, discount)
+	assert.Equal(t, int64(150), balance(t, svc, 7))
+
+	// 取消后退回积分，重复取消不重复退回，之后不能再抵扣
+	require.NoError(t, svc.CancelRedemption(ctx, 7, "ORD-A"))
+	require.NoError(t, svc.CancelRedemption(ctx, 7, "ORD-A"))
+	assert.Equal(t, int64(350), balance(t, svc, 7))
+	_, _, err = svc.Redeem(ctx, 7, "ORD-A", 100, 100)
+	assert.ErrorIs(t, err, ErrRedemptionCanceled)
+
+	// 取消先于抵扣到达
+	require.NoError(t, svc.CancelRedemption(ctx, 7, "ORD-C"))
+	_, _, err = svc.Redeem(ctx, 7, "ORD-C", 100, 100)
+	assert.ErrorIs(t, err, ErrRedemptionCanceled)
+	assert.Equal(t, int64(350), balance(t, svc, 7))
+}
+
+func TestLoyalty_RefundAfterRedeem(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestLoyalty(t)
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusPaid, 100)))
+	_, _, err := svc.Redeem(ctx, 7, "ORD-A", 80, 100)
+	require.NoError(t, err)
+
+	// 已抵扣的积分不足扣回，余额为负，之后获得的积分先抵扣
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusRefunded, 100)))
+	assert.Equal(t, int64(-80), balance(t, svc, 7))
+	_, _, err = svc.Redeem(ctx, 7, "ORD-B", 1, 100)
+	assert.ErrorIs(t, err, ErrInsufficientPoints)
+
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(2, 7, orderevents.StatusPaid, 100)))
+	assert.Equal(t, int64(20), balance(t, svc, 7))
+
+	// 其他用户不受影响
+	assert.Equal(t, int64(0), balance(t, svc, 8))
+}
+
+func TestLoyalty_Expiry(t *testing.T) {
+	ctx := context.Background()
+	svc, now := newTestLoyalty(t)
+	Loyalty.Expiry = 24 * time.Hour
+	start := *now
+
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusPaid, 100)))
+	*now = start.Add(12 * time.Hour)
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(2, 7, orderevents.StatusPaid, 50)))
+
+	// 抵扣先消耗最早到期的积分
+	_, _, err := svc.Redeem(ctx, 7, "ORD-A", 30, 100)
+	require.NoError(t, err)
+	summary, err := svc.Summary(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(120), summary.Balance)
+	assert.Equal(t, int64(70), summary.ExpiringPoints)
+	assert.Equal(t, start.Add(24*time.Hour).Unix(), summary.ExpiringAt)
+
+	// 到期的积分不计入余额，作废任务记录流水
+	*now = start.Add(25 * time.Hour)
+	assert.Equal(t, int64(50), balance(t, svc, 7))
+	n, err := svc.ExpireDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = svc.ExpireDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	entries, _, err := svc.History(ctx, 7, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, mysql.PointsExpire, entries[0].Kind)
+	assert.Equal(t, int64(-70), entries[0].Points)
+
+	// 退款只扣回未作废的部分：订单 1 的积分已用掉 30，其余作废
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusRefunded, 100)))
+	assert.Equal(t, int64(20), balance(t, svc, 7))
+}
+
+func TestLoyalty_Tiers(t *testing.T) {
+	ctx := context.Background()
+	svc, now := newTestLoyalty(t)
+	Loyalty.Expiry = 0
+	start := *now
+
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(1, 7, orderevents.StatusPaid, 4000)))
+	*now = start.AddDate(0, 6, 0)
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(2, 7, orderevents.StatusPaid, 2000)))
+
+	summary, err := svc.Summary(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "gold", summary.Tier)
+	assert.Equal(t, "platinum", summary.NextTier)
+	assert.Equal(t, float64(20000), summary.NextTierSpend)
+	assert.Zero(t, summary.ExpiringAt, "积分不过期")
+
+	// 只统计最近 12 个月的消费
+	*now = start.AddDate(1, 1, 0)
+	summary, err = svc.Summary(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "silver", summary.Tier)
+	assert.Equal(t, float64(2000), summary.RollingSpend)
+	assert.Equal(t, int64(6000), summary.Balance)
+
+	// 最高等级没有下一等级
+	require.NoError(t, svc.HandleOrderEvent(ctx, orderEvent(3, 7, orderevents.StatusPaid, 30000)))
+	summary, err = svc.Summary(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "platinum", summary.Tier)
+	assert.Empty(t, summary.NextTier)
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/user/kitex_gen/product"
+)
+
+// ErrProductNotFound 商品不存在
+var ErrProductNotFound = errors.New("product not found")
+
+// ProductCatalog 商品服务客户端接口
+type ProductCatalog interface {
+	// GetProduct 查询商品当前信息，商品不存在时返回 ErrProductNotFound
+	GetProduct(ctx context.Context, productID uint32) (*product.Product, error)
+}
@@ -13,6 +13,9 @@ import (
 
 	"TikTokMall/app/user/biz/dal/mysql"
 	"TikTokMall/app/user/biz/dal/redis"
+	"TikTokMall/app/user/kitex_gen/user"
+	"TikTokMall/pkg/password"
+	"TikTokMall/pkg/tokenhash"
 )
 
 const (
@@ -22,6 +25,9 @@ const (
 	UserCacheExpiration    = 2 * time.Hour
 )
 
+// PasswordPolicy 注册时使用的密码策略，启动时根据配置替换
+var PasswordPolicy = password.Default()
+
 type UserService struct{}
 
 func NewUserService() *UserService {
@@ -53,14 +59,19 @@ func validatePassword(hashed, plain string) error {
 // ----------- 业务方法 -----------
 
 // Register 用户注册
-func (s *UserService) Register(ctx context.Context, username, password, email, phone string) (int64, string, error) {
+func (s *UserService) Register(ctx context.Context, username, pwd, email, phone string) (int64, string, error) {
 	// 检查用户名唯一性
 	if exist, _ := mysql.CheckUserExists(username); exist {
 		return 0, "", fmt.Errorf("username already exists")
 	}
 
+	// 校验密码策略
+	if err := PasswordPolicy.Check(pwd, username); err != nil {
+		return 0, "", err
+	}
+
 	// 密码加密
-	hashedPwd, err := hashPassword(password)
+	hashedPwd, err := hashPassword(pwd)
 	if err != nil {
 		return 0, "", err
 	}
@@ -75,6 +86,12 @@ func (s *UserService) Register(ctx context.Context, username, password, email, p
 	if err := mysql.CreateUser(user); err != nil {
 		return 0, "", err
 	}
+	// 记录初始密码，修改密码时不能重复使用
+	if PasswordPolicy.HistorySize() > 0 {
+		if err := mysql.AddPasswordHistory(user.ID, hashedPwd); err != nil {
+			hlog.CtxWarnf(ctx, "add password history failed: %v", err)
+		}
+	}
 
 	// 生成令牌（后续可能要考虑存储令牌？）
 	token, err := generateToken()
@@ -97,6 +114,11 @@ func (s *UserService) Login(ctx context.Context, username, password string) (str
 		return "", "", fmt.Errorf("invalid credentials")
 	}
 
+	// 封禁、注销中和已注销的账号不能登录
+	if user.Status != mysql.UserStatusNormal {
+		return "", "", ErrAccountNotActive
+	}
+
 	// 生成令牌
 	token, _ := generateToken()
 	refreshToken, _ := generateToken()
@@ -109,43 +131,38 @@ func (s *UserService) Login(ctx context.Context, username, password string) (str
 	return token, refreshToken, nil
 }
 
-// Delete 删除用户
-func (s *UserService) Delete(ctx context.Context, userID int64) error {
-	// 删除用户记录
-	if err := mysql.DeleteUser(userID); err != nil {
-		return err
-	}
-
-	// 清理缓存
-	_ = redis.DeleteUserCache(ctx, userID)
-	return nil
-}
-
-// Info 获取用户信息
-func (s *UserService) Info(ctx context.Context, token string) (int64, string, string, string, error) {
+// Info 获取用户信息，返回的响应未设置 Base
+func (s *UserService) Info(ctx context.Context, token string) (*user.InfoResp, error) {
 	// 通过Token获取用户ID
 	userID, err := s.getUserIDByToken(ctx, token)
 	if err != nil {
-		return 0, "", "", "", errors.Wrap(err, "token验证失败")
+		return nil, errors.Wrap(err, "token验证失败")
 	}
 
 	// 优先从缓存获取
-	if user, err := redis.GetUserCache(ctx, userID); err == nil {
-		return user.ID, user.Username, user.Email, user.Phone, nil
-	}
-
-	// 缓存未命中则查数据库
-	user, err := mysql.GetUserByID(userID)
-	if err != nil || user == nil {
-		return 0, "", "", "", errors.New("用户不存在")
-	}
+	u, err := redis.GetUserCache(ctx, userID)
+	if err != nil {
+		// 缓存未命中则查数据库
+		u, err = mysql.GetUserByID(userID)
+		if err != nil || u == nil {
+			return nil, errors.New("用户不存在")
+		}
 
-	// 更新缓存
-	if err := redis.CacheUser(ctx, user, UserCacheExpiration); err != nil {
-		hlog.CtxWarnf(ctx, "缓存用户信息失败: %v", err)
+		// 更新缓存
+		if err := redis.CacheUser(ctx, u, UserCacheExpiration); err != nil {
+			hlog.CtxWarnf(ctx, "缓存用户信息失败: %v", err)
+		}
 	}
 
-	return user.ID, user.Username, user.Email, user.Phone, nil
+	avatarURL, thumbnails := AvatarURLs(u.Avatar)
+	return &user.InfoResp{
+		UserId:           u.ID,
+		Username:         u.Username,
+		Email:            u.Email,
+		Phone:            u.Phone,
+		AvatarUrl:        avatarURL,
+		AvatarThumbnails: thumbnails,
+	}, nil
 }
 
 // Update 更新用户信息
@@ -193,40 +210,50 @@ func (s *UserService) Update(ctx context.Context, token string, newUsername, new
 
 // Logout 用户登出
 func (s *UserService) Logout(ctx context.Context, token string) error {
+	digest := tokenhash.Digest(token)
+
 	// 从缓存删除Token
-	if err := redis.DeleteToken(ctx, token); err != nil {
+	if err := redis.DeleteToken(ctx, digest); err != nil {
 		hlog.CtxWarnf(ctx, "token cache deletion failed: %v", err)
 	}
 
 	// 将Token加入黑名单（复用auth服务逻辑）
-	if err := redis.AddToBlacklist(ctx, token, TokenExpiration); err != nil {
+	if err := redis.AddToBlacklist(ctx, digest, TokenExpiration); err != nil {
 		hlog.CtxWarnf(ctx, "blacklist update failed: %v", err)
 	}
 
 	// 删除数据库中的Token记录（疑问，有必要吗？）
-	if err := mysql.DeleteToken(token); err != nil {
+	if err := mysql.DeleteToken(digest); err != nil {
 		hlog.CtxWarnf(ctx, "database token cleanup failed: %v", err)
 	}
 
 	return nil
 }
 
+// UserIDByToken 通过Token获取用户ID
+func (s *UserService) UserIDByToken(ctx context.Context, token string) (int64, error) {
+	return s.getUserIDByToken(ctx, token)
+}
+
 // getUserIDByToken 通过Token获取用户ID
 func (s *UserService) getUserIDByToken(ctx context.Context, token string) (int64, error) {
+	// 数据库和缓存中只保存令牌摘要
+	digest := tokenhash.Digest(token)
+
 	// 检查Token是否在黑名单
-	if blacklisted, err := redis.IsInBlacklist(ctx, token); err != nil {
+	if blacklisted, err := redis.IsInBlacklist(ctx, digest); err != nil {
 		return 0, errors.Wrap(err, "blacklist check failed")
 	} else if blacklisted {
 		return 0, errors.New("token is invalid")
 	}
 
 	// 从缓存获取用户ID
-	if userID, err := redis.GetCachedUserID(ctx, token); err == nil {
+	if userID, err := redis.GetCachedUserID(ctx, digest); err == nil {
 		return userID, nil
 	}
 
 	// 缓存未命中则查询数据库
-	tokenRecord, err := mysql.GetTokenByToken(token)
+	tokenRecord, err := mysql.GetTokenByToken(digest)
 	if err != nil {
 		return 0, errors.Wrap(err, "database query failed")
 	}
@@ -240,7 +267,7 @@ func (s *UserService) getUserIDByToken(ctx context.Context, token string) (int64
 	}
 
 	// 缓存结果
-	if err := redis.CacheToken(ctx, token, tokenRecord.UserID, time.Until(tokenRecord.ExpiredAt)); err != nil {
+	if err := redis.CacheToken(ctx, digest, tokenRecord.UserID, time.Until(tokenRecord.ExpiredAt)); err != nil {
 		hlog.CtxWarnf(ctx, "cache token failed: %v", err)
 	}
 
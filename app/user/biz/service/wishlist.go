@@ -0,0 +1,452 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/app/user/kitex_gen/product"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+const (
+	// DefaultWishlistName 未指定名称时使用的心愿单
+	DefaultWishlistName = "default"
+	// MaxWishlistsPerUser 每个用户最多的心愿单数量
+	MaxWishlistsPerUser = 20
+	// MaxWishlistItems 每个心愿单最多的商品数量
+	MaxWishlistItems = 200
+	// DefaultWishlistCheckInterval 检查降价和到货的间隔
+	DefaultWishlistCheckInterval = 10 * time.Minute
+
+	maxWishlistNameLen     = 64
+	wishlistCheckBatchSize = 100
+)
+
+var (
+	ErrInvalidWishlist    = errors.New("invalid wishlist request")
+	ErrWishlistNotFound   = errors.New("wishlist item not found")
+	ErrWishlistLimit      = fmt.Errorf("at most %d wishlists per user", MaxWishlistsPerUser)
+	ErrWishlistItemLimit  = fmt.Errorf("at most %d products per wishlist", MaxWishlistItems)
+	ErrCatalogUnavailable = errors.New("product catalog is not configured")
+)
+
+// 心愿单提醒类型
+const (
+	AlertPriceDrop   = "price_drop"    // 价格低于加入时的价格，且比上次检查时更低
+	AlertBackInStock = "back_in_stock" // 库存从 0 变为正数
+)
+
+// WishlistAlert 心愿单提醒
+type WishlistAlert struct {
+	Kind        string
+	UserID      int64
+	Wishlist    string
+	ProductID   uint32
+	ProductName string
+	SavedPrice  float64
+	Price       float64
+	Stock       uint32
+}
+
+// WishlistNotifier 发送心愿单提醒，返回错误时下次检查重新发送
+type WishlistNotifier interface {
+	Notify(ctx context.Context, alert *WishlistAlert) error
+}
+
+// WishlistConfig 心愿单依赖的外部服务
+type WishlistConfig struct {
+	Catalog  ProductCatalog   // 为空时不能加入商品，列表不附带当前价格和库存
+	Notifier WishlistNotifier // 为空时提醒只记录日志
+}
+
+// Wishlists 心愿单配置，启动时根据配置替换
+var Wishlists = WishlistConfig{}
+
+// WishlistStore 心愿单存储
+type WishlistStore interface {
+	List(userID int64) ([]*mysql.Wishlist, error)
+	Add(userID int64, name string, item *mysql.WishlistItem, limit func(lists, items int64) error) (*mysql.WishlistItem, bool, error)
+	GetItem(userID int64, name string, productID uint32) (*mysql.WishlistItem, error)
+	Remove(userID int64, name string, productID uint32) (bool, error)
+	Delete(userID int64, name string) (bool, error)
+	UpdateAlerts(item *mysql.WishlistItem) error
+	ListAlertProductIDs(afterID uint32, limit int) ([]uint32, error)
+	ListAlertItems(productID uint32) ([]*mysql.WishlistItem, error)
+	UpdateSnapshot(itemID int64, price float64, stock uint32) error
+}
+
+// mysqlWishlistStore 基于 MySQL 的心愿单存储
+type mysqlWishlistStore struct{}
+
+func (mysqlWishlistStore) List(userID int64) ([]*mysql.Wishlist, error) {
+	return mysql.ListWishlists(userID)
+}
+
+func (mysqlWishlistStore) Add(userID int64, name string, item *mysql.WishlistItem, limit func(lists, items int64) error) (*mysql.WishlistItem, bool, error) {
+	return mysql.AddWishlistItem(userID, name, item, limit)
+}
+
+func (mysqlWishlistStore) GetItem(userID int64, name string, productID uint32) (*mysql.WishlistItem, error) {
+	return mysql.GetWishlistItem(userID, name, productID)
+}
+
+func (mysqlWishlistStore) Remove(userID int64, name string, productID uint32) (bool, error) {
+	return mysql.RemoveWishlistItem(userID, name, productID)
+}
+
+func (mysqlWishlistStore) Delete(userID int64, name string) (bool, error) {
+	return mysql.DeleteWishlist(userID, name)
+}
+
+func (mysqlWishlistStore) UpdateAlerts(item *mysql.WishlistItem) error {
+	return mysql.UpdateWishlistAlerts(item)
+}
+
+func (mysqlWishlistStore) ListAlertProductIDs(afterID uint32, limit int) ([]uint32, error) {
+	return mysql.ListAlertProductIDs(afterID, limit)
+}
+
+func (mysqlWishlistStore) ListAlertItems(productID uint32) ([]*mysql.WishlistItem, error) {
+	return mysql.ListAlertItems(productID)
+}
+
+func (mysqlWishlistStore) UpdateSnapshot(itemID int64, price float64, stock uint32) error {
+	return mysql.UpdateWishlistSnapshot(itemID, price, stock)
+}
+
+// WishlistService 心愿单和降价、到货提醒，HTTP 接口和 RPC 接口共用
+// 商品服务不发布价格和库存变化，提醒由定期检查订阅商品的当前信息触发
+type WishlistService struct {
+	store WishlistStore
+}
+
+// NewWishlistService 创建基于 MySQL 的心愿单服务
+func NewWishlistService() *WishlistService {
+	return NewWishlistServiceWithStore(mysqlWishlistStore{})
+}
+
+// NewWishlistServiceWithStore 使用指定存储创建心愿单服务
+func NewWishlistServiceWithStore(store WishlistStore) *WishlistService {
+	return &WishlistService{store: store}
+}
+
+// List 查询用户的全部心愿单，附带商品当前价格和库存；商品服务查询失败的商品标记为不可用
+func (s *WishlistService) List(ctx context.Context, userID int64) ([]*user.Wishlist, error) {
+	lists, err := s.store.List(userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list wishlists failed")
+	}
+	var ids []uint32
+	seen := make(map[uint32]bool)
+	for _, list := range lists {
+		for _, item := range list.Items {
+			if !seen[item.ProductID] {
+				seen[item.ProductID] = true
+				ids = append(ids, item.ProductID)
+			}
+		}
+	}
+	products := fetchProducts(ctx, ids)
+
+	result := make([]*user.Wishlist, 0, len(lists))
+	for _, list := range lists {
+		info := &user.Wishlist{
+			Id:        list.ID,
+			Name:      list.Name,
+			Items:     make([]*user.WishlistItem, 0, len(list.Items)),
+			CreatedAt: list.CreatedAt.Unix(),
+		}
+		for _, item := range list.Items {
+			info.Items = append(info.Items, newWishlistItemInfo(item, products[item.ProductID]))
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// Add 以当前价格将商品加入心愿单，心愿单不存在时创建；商品已在心愿单中时返回已有记录
+func (s *WishlistService) Add(ctx context.Context, userID int64, req *user.AddWishlistItemReq) (*user.WishlistItem, error) {
+	name, err := wishlistName(req.Wishlist)
+	if err != nil {
+		return nil, err
+	}
+	if req.ProductId == 0 {
+		return nil, ErrInvalidWishlist
+	}
+	p, err := getProduct(ctx, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	price := roundCents(float64(p.Price))
+	item := &mysql.WishlistItem{
+		ProductID:         req.ProductId,
+		SavedPrice:        price,
+		LastPrice:         price,
+		LastStock:         p.Stock,
+		NotifyPriceDrop:   req.NotifyPriceDrop,
+		NotifyBackInStock: req.NotifyBackInStock,
+	}
+	saved, _, err := s.store.Add(userID, name, item, func(lists, items int64) error {
+		if lists > MaxWishlistsPerUser {
+			return ErrWishlistLimit
+		}
+		if items > MaxWishlistItems {
+			return ErrWishlistItemLimit
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrWishlistLimit) || errors.Is(err, ErrWishlistItemLimit) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "add wishlist item failed")
+	}
+	return newWishlistItemInfo(saved, p), nil
+}
+
+// Remove 从心愿单中移除商品
+func (s *WishlistService) Remove(ctx context.Context, userID int64, wishlist string, productID uint32) error {
+	name, err := wishlistName(wishlist)
+	if err != nil {
+		return err
+	}
+	removed, err := s.store.Remove(userID, name, productID)
+	if err != nil {
+		return errors.Wrap(err, "remove wishlist item failed")
+	}
+	if !removed {
+		return ErrWishlistNotFound
+	}
+	return nil
+}
+
+// Delete 删除心愿单及其中的商品
+func (s *WishlistService) Delete(ctx context.Context, userID int64, wishlist string) error {
+	name, err := wishlistName(wishlist)
+	if err != nil {
+		return err
+	}
+	deleted, err := s.store.Delete(userID, name)
+	if err != nil {
+		return errors.Wrap(err, "delete wishlist failed")
+	}
+	if !deleted {
+		return ErrWishlistNotFound
+	}
+	return nil
+}
+
+// Subscribe 设置商品的降价和到货提醒，之后以当前价格和库存为基准判断变化
+func (s *WishlistService) Subscribe(ctx context.Context, userID int64, req *user.SubscribeWishlistItemReq) (*user.WishlistItem, error) {
+	name, err := wishlistName(req.Wishlist)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.store.GetItem(userID, name, req.ProductId)
+	if err != nil {
+		return nil, errors.Wrap(err, "get wishlist item failed")
+	}
+	if item == nil {
+		return nil, ErrWishlistNotFound
+	}
+	p, err := getProduct(ctx, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	item.NotifyPriceDrop = req.NotifyPriceDrop
+	item.NotifyBackInStock = req.NotifyBackInStock
+	item.LastPrice = roundCents(float64(p.Price))
+	item.LastStock = p.Stock
+	if err := s.store.UpdateAlerts(item); err != nil {
+		return nil, errors.Wrap(err, "update wishlist alerts failed")
+	}
+	return newWishlistItemInfo(item, p), nil
+}
+
+// CheckAlerts 查询订阅了提醒的商品的当前价格和库存，与上次检查时比较后发送提醒，返回发送的提醒数
+// 提醒全部发送成功后才记录本次检查的价格和库存，发送失败的提醒下次检查重新发送
+func (s *WishlistService) CheckAlerts(ctx context.Context) (int, error) {
+	if Wishlists.Catalog == nil {
+		return 0, nil
+	}
+	sent := 0
+	var after uint32
+	for {
+		ids, err := s.store.ListAlertProductIDs(after, wishlistCheckBatchSize)
+		if err != nil {
+			return sent, errors.Wrap(err, "list wishlist products failed")
+		}
+		for _, id := range ids {
+			after = id
+			p, err := Wishlists.Catalog.GetProduct(ctx, id)
+			if err != nil {
+				if !errors.Is(err, ErrProductNotFound) {
+					hlog.CtxWarnf(ctx, "get product %d for wishlist alerts failed: %v", id, err)
+				}
+				continue
+			}
+			n, err := s.checkProduct(ctx, p)
+			sent += n
+			if err != nil {
+				return sent, err
+			}
+		}
+		if len(ids) < wishlistCheckBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+// checkProduct 检查订阅了该商品的心愿单商品并发送提醒
+func (s *WishlistService) checkProduct(ctx context.Context, p *product.Product) (int, error) {
+	items, err := s.store.ListAlertItems(p.Id)
+	if err != nil {
+		return 0, errors.Wrapf(err, "list wishlist items of product %d failed", p.Id)
+	}
+	price := roundCents(float64(p.Price))
+	sent := 0
+	for _, item := range items {
+		delivered := true
+		for _, kind := range wishlistAlertKinds(item, price, p.Stock) {
+			alert := &WishlistAlert{
+				Kind:        kind,
+				UserID:      item.UserID,
+				ProductID:   p.Id,
+				ProductName: p.Name,
+				SavedPrice:  item.SavedPrice,
+				Price:       price,
+				Stock:       p.Stock,
+			}
+			if item.Wishlist != nil {
+				alert.Wishlist = item.Wishlist.Name
+			}
+			if err := notifyWishlist(ctx, alert); err != nil {
+				hlog.CtxWarnf(ctx, "send wishlist alert %s to user %d failed: %v", kind, item.UserID, err)
+				delivered = false
+				break
+			}
+			sent++
+		}
+		if !delivered || (item.LastPrice == price && item.LastStock == p.Stock) {
+			continue
+		}
+		if err := s.store.UpdateSnapshot(item.ID, price, p.Stock); err != nil {
+			return sent, errors.Wrapf(err, "update wishlist item %d failed", item.ID)
+		}
+	}
+	return sent, nil
+}
+
+// RunAlerts 每隔 interval 检查一次降价和到货，直到 ctx 取消
+func (s *WishlistService) RunAlerts(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if n, err := s.CheckAlerts(ctx); err != nil {
+			hlog.CtxErrorf(ctx, "check wishlist alerts failed: %v", err)
+		} else if n > 0 {
+			hlog.CtxInfof(ctx, "sent %d wishlist alerts", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// wishlistAlertKinds 比较上次检查时和当前的价格、库存，返回需要发送的提醒
+func wishlistAlertKinds(item *mysql.WishlistItem, price float64, stock uint32) []string {
+	var kinds []string
+	if item.NotifyPriceDrop && price < item.SavedPrice && price < item.LastPrice {
+		kinds = append(kinds, AlertPriceDrop)
+	}
+	if item.NotifyBackInStock && item.LastStock == 0 && stock > 0 {
+		kinds = append(kinds, AlertBackInStock)
+	}
+	return kinds
+}
+
+// notifyWishlist 使用配置的通知发送器发送提醒，未配置时只记录日志
+func notifyWishlist(ctx context.Context, alert *WishlistAlert) error {
+	if Wishlists.Notifier == nil {
+		hlog.CtxInfof(ctx, "wishlist notifier not configured, drop %s alert of product %d to user %d",
+			alert.Kind, alert.ProductID, alert.UserID)
+		return nil
+	}
+	return Wishlists.Notifier.Notify(ctx, alert)
+}
+
+// getProduct 查询商品当前信息
+func getProduct(ctx context.Context, productID uint32) (*product.Product, error) {
+	if Wishlists.Catalog == nil {
+		return nil, ErrCatalogUnavailable
+	}
+	p, err := Wishlists.Catalog.GetProduct(ctx, productID)
+	if err != nil {
+		if errors.Is(err, ErrProductNotFound) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "get product failed")
+	}
+	return p, nil
+}
+
+// fetchProducts 批量查询商品当前信息，查询失败的商品不在结果中
+func fetchProducts(ctx context.Context, ids []uint32) map[uint32]*product.Product {
+	products := make(map[uint32]*product.Product, len(ids))
+	if Wishlists.Catalog == nil {
+		return products
+	}
+	for _, id := range ids {
+		p, err := Wishlists.Catalog.GetProduct(ctx, id)
+		if err != nil {
+			if !errors.Is(err, ErrProductNotFound) {
+				hlog.CtxWarnf(ctx, "get product %d for wishlist failed: %v", id, err)
+			}
+			continue
+		}
+		products[id] = p
+	}
+	return products
+}
+
+// wishlistName 校验心愿单名称，为空时使用默认心愿单
+func wishlistName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return DefaultWishlistName, nil
+	}
+	if utf8.RuneCountInString(name) > maxWishlistNameLen {
+		return "", ErrInvalidWishlist
+	}
+	return name, nil
+}
+
+// newWishlistItemInfo 构造心愿单商品信息，p 为空时标记为不可用
+func newWishlistItemInfo(item *mysql.WishlistItem, p *product.Product) *user.WishlistItem {
+	info := &user.WishlistItem{
+		ProductId:         item.ProductID,
+		SavedPrice:        item.SavedPrice,
+		NotifyPriceDrop:   item.NotifyPriceDrop,
+		NotifyBackInStock: item.NotifyBackInStock,
+		AddedAt:           item.CreatedAt.Unix(),
+	}
+	if p != nil {
+		info.Available = true
+		info.Name = p.Name
+		info.Picture = p.Picture
+		info.Price = roundCents(float64(p.Price))
+		info.Stock = p.Stock
+	}
+	return info
+}
@@ -0,0 +1,457 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/app/user/biz/dal/mysql"
+	"TikTokMall/app/user/kitex_gen/product"
+	"TikTokMall/app/user/kitex_gen/user"
+)
+
+// memWishlistStore 内存心愿单存储
+type memWishlistStore struct {
+	lists  []*mysql.Wishlist
+	items  []*mysql.WishlistItem
+	nextID int64
+}
+
+func (m *memWishlistStore) find(userID int64, name string) *mysql.Wishlist {
+	for _, list := range m.lists {
+		if list.UserID == userID && list.Name == name {
+			return list
+		}
+	}
+	return nil
+}
+
+func (m *memWishlistStore) List(userID int64) ([]*mysql.Wishlist, error) {
+	var lists []*mysql.Wishlist
+	for _, list := range m.lists {
+		if list.UserID != userID {
+			continue
+		}
+		cp := *list
+		cp.Items = nil
+		for i := len(m.items) - 1; i >= 0; i-- {
+			if m.items[i].WishlistID == list.ID {
+				item := *m.items[i]
+				cp.Items = append(cp.Items, &item)
+			}
+		}
+		lists = append(lists, &cp)
+	}
+	return lists, nil
+}
+
+func (m *memWishlistStore) Add(userID int64, name string, item *mysql.WishlistItem, limit func(lists, items int64) error) (*mysql.WishlistItem, bool, error) {
+	list := m.find(userID, name)
+	var lists, items int64
+	for _, l := range m.lists {
+		if l.UserID == userID {
+			lists++
+		}
+	}
+	if list != nil {
+		for _, existing := range m.items {
+			if existing.WishlistID == list.ID {
+				if existing.ProductID == item.ProductID {
+					cp := *existing
+					return &cp, false, nil
+				}
+				items++
+			}
+		}
+	} else {
+		lists++
+	}
+	if err := limit(lists, items+1); err != nil {
+		return nil, false, err
+	}
+	if list == nil {
+		m.nextID++
+		list = &mysql.Wishlist{ID: m.nextID, UserID: userID, Name: name, CreatedAt: time.Now()}
+		m.lists = append(m.lists, list)
+	}
+	m.nextID++
+	item.ID = m.nextID
+	item.WishlistID = list.ID
+	item.UserID = userID
+	item.CreatedAt = time.Now()
+	cp := *item
+	m.items = append(m.items, &cp)
+	return item, true, nil
+}
+
+func (m *memWishlistStore) GetItem(userID int64, name string, productID uint32) (*mysql.WishlistItem, error) {
+	list := m.find(userID, name)
+	if list == nil {
+		return nil, nil
+	}
+	for _, item := range m.items {
+		if item.WishlistID == list.ID && item.ProductID == productID {
+			cp := *item
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memWishlistStore) Remove(userID int64, name string, productID uint32) (bool, error) {
+	list := m.find(userID, name)
+	if list == nil {
+		return false, nil
+	}
+	for i, item := range m.items {
+		if item.WishlistID == list.ID && item.ProductID == productID {
+			m.items = append(m.items[:i], m.items[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *memWishlistStore) Delete(userID int64, name string) (bool, error) {
+	list := m.find(userID, name)
+	if list == nil {
+		return false, nil
+	}
+	var items []*mysql.WishlistItem
+	for _, item := range m.items {
+		if item.WishlistID != list.ID {
+			items = append(items, item)
+		}
+	}
+	m.items = items
+	for i, l := range m.lists {
+		if l == list {
+			m.lists = append(m.lists[:i], m.lists[i+1:]...)
+			break
+		}
+	}
+	return true, nil
+}
+
+func (m *memWishlistStore) UpdateAlerts(item *mysql.WishlistItem) error {
+	for _, existing := range m.items {
+		if existing.ID == item.ID {
+			existing.NotifyPriceDrop = item.NotifyPriceDrop
+			existing.NotifyBackInStock = item.NotifyBackInStock
+			existing.LastPrice = item.LastPrice
+			existing.LastStock = item.LastStock
+		}
+	}
+	return nil
+}
+
+func (m *memWishlistStore) ListAlertProductIDs(afterID uint32, limit int) ([]uint32, error) {
+	seen := map[uint32]bool{}
+	var ids []uint32
+	for _, item := range m.items {
+		if item.ProductID > afterID && (item.NotifyPriceDrop || item.NotifyBackInStock) && !seen[item.ProductID] {
+			seen[item.ProductID] = true
+			ids = append(ids, item.ProductID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids, nil
+}
+
+func (m *memWishlistStore) ListAlertItems(productID uint32) ([]*mysql.WishlistItem, error) {
+	var items []*mysql.WishlistItem
+	for _, item := range m.items {
+		if item.ProductID == productID && (item.NotifyPriceDrop || item.NotifyBackInStock) {
+			cp := *item
+			for _, list := range m.lists {
+				if list.ID == item.WishlistID {
+					cp.Wishlist = list
+				}
+			}
+			items = append(items, &cp)
+		}
+	}
+	return items, nil
+}
+
+func (m *memWishlistStore) UpdateSnapshot(itemID int64, price float64, stock uint32) error {
+	for _, item := range m.items {
+		if item.ID == itemID {
+			item.LastPrice = price
+			item.LastStock = stock
+		}
+	}
+	return nil
+}
+
+// fakeCatalog 模拟商品服务，down 中的商品查询失败
+type fakeCatalog struct {
+	products map[uint32]*product.Product
+	down     map[uint32]bool
+}
+
+func (f *fakeCatalog) GetProduct(ctx context.Context, productID uint32) (*product.Product, error) {
+	if f.down[productID] {
+		return nil, errors.New("product service unavailable")
+	}
+	p, ok := f.products[productID]
+	if !ok {
+		return nil, ErrProductNotFound
+	}
+	return &product.Product{Id: p.Id, Name: p.Name, Price: p.Price, Stock: p.Stock}, nil
+}
+
+// fakeNotifier 记录发送的提醒，fail 为 true 时发送失败
+type fakeNotifier struct {
+	alerts []*WishlistAlert
+	fail   bool
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, alert *WishlistAlert) error {
+	if f.fail {
+		return errors.New("notify failed")
+	}
+	f.alerts = append(f.alerts, alert)
+	return nil
+}
+
+// newTestWishlists 创建使用内存存储、模拟商品服务和通知发送器的心愿单服务，测试结束后恢复配置
+func newTestWishlists(t *testing.T) (*WishlistService, *fakeCatalog, *fakeNotifier) {
+	saved := Wishlists
+	t.Cleanup(func() { Wishlists = saved })
+
+	catalog := &fakeCatalog{
+		products: map[uint32]*product.Product{
+			1: {Id: 1, Name: "键盘", Price: 199, Stock: 10},
+			2: {Id: 2, Name: "鼠标", Price: 99.9, Stock: 0},
+			3: {Id: 3, Name: "显示器", Price: 1299, Stock: 5},
+		},
+		down: map[uint32]bool{},
+	}
+	notifier := &fakeNotifier{}
+	Wishlists = WishlistConfig{Catalog: catalog, Notifier: notifier}
+	return NewWishlistServiceWithStore(&memWishlistStore{}), catalog, notifier
+}
+
+func TestWishlist_AddRemoveList(t *testing.T) {
+	ctx := context.Background()
+	svc, catalog, _ := newTestWishlists(t)
+
+	item, err := svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 199.0, item.SavedPrice)
+	assert.True(t, item.Available)
+	_, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{Wishlist: " 礼物 ", ProductId: 2})
+	require.NoError(t, err)
+	_, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{Wishlist: "礼物", ProductId: 3})
+	require.NoError(t, err)
+
+	// 重复加入返回已有记录，保留原来的价格
+	catalog.products[1].Price = 149
+	item, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 199.0, item.SavedPrice)
+	assert.Equal(t, 149.0, item.Price)
+
+	_, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 404})
+	assert.ErrorIs(t, err, ErrProductNotFound)
+	_, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{})
+	assert.ErrorIs(t, err, ErrInvalidWishlist)
+
+	// 列表附带当前价格和库存，商品服务查询失败的商品标记为不可用
+	catalog.down[3] = true
+	lists, err := svc.List(ctx, 7)
+	require.NoError(t, err)
+	require.Len(t, lists, 2)
+	assert.Equal(t, DefaultWishlistName, lists[0].Name)
+	assert.Equal(t, "礼物", lists[1].Name)
+	require.Len(t, lists[1].Items, 2)
+	assert.Equal(t, uint32(3), lists[1].Items[0].ProductId)
+	assert.False(t, lists[1].Items[0].Available)
+	assert.Equal(t, 1299.0, lists[1].Items[0].SavedPrice)
+	assert.Equal(t, "鼠标", lists[1].Items[1].Name)
+	assert.Equal(t, 99.9, lists[1].Items[1].Price)
+	assert.True(t, lists[1].Items[1].Available)
+
+	// 其他用户看不到
+	lists, err = svc.List(ctx, 8)
+	require.NoError(t, err)
+	assert.Empty(t, lists)
+	assert.ErrorIs(t, svc.Remove(ctx, 8, "礼物", 2), ErrWishlistNotFound)
+
+	require.NoError(t, svc.Remove(ctx, 7, "礼物", 2))
+	assert.ErrorIs(t, svc.Remove(ctx, 7, "礼物", 2), ErrWishlistNotFound)
+	require.NoError(t, svc.Delete(ctx, 7, "礼物"))
+	assert.ErrorIs(t, svc.Delete(ctx, 7, "礼物"), ErrWishlistNotFound)
+	lists, err = svc.List(ctx, 7)
+	require.NoError(t, err)
+	assert.Len(t, lists, 1)
+}
+
+func TestWishlist_Limits(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestWishlists(t)
+
+	for i := 0; i < MaxWishlistsPerUser; i++ {
+		_, err := svc.Add(ctx, 7, &user.AddWishlistItemReq{Wishlist: string(rune('a' + i)), ProductId: 1})
+		require.NoError(t, err)
+	}
+	_, err := svc.Add(ctx, 7, &user.AddWishlistItemReq{Wishlist: "more", ProductId: 1})
+	assert.ErrorIs(t, err, ErrWishlistLimit)
+	// 已有的心愿单仍可加入商品
+	_, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{Wishlist: "a", ProductId: 2})
+	require.NoError(t, err)
+
+	long := make([]rune, maxWishlistNameLen+1)
+	for i := range long {
+		long[i] = '心'
+	}
+	_, err = svc.Add(ctx, 8, &user.AddWishlistItemReq{Wishlist: string(long), ProductId: 1})
+	assert.ErrorIs(t, err, ErrInvalidWishlist)
+}
+
+func TestWishlist_Unavailable(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestWishlists(t)
+	_, err := svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 1})
+	require.NoError(t, err)
+
+	// 未配置商品服务时不能加入商品，列表不附带当前信息
+	Wishlists.Catalog = nil
+	_, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 2})
+	assert.ErrorIs(t, err, ErrCatalogUnavailable)
+	lists, err := svc.List(ctx, 7)
+	require.NoError(t, err)
+	assert.False(t, lists[0].Items[0].Available)
+	n, err := svc.CheckAlerts(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestWishlist_Alerts(t *testing.T) {
+	ctx := context.Background()
+	svc, catalog, notifier := newTestWishlists(t)
+
+	_, err := svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 1, NotifyPriceDrop: true})
+	require.NoError(t, err)
+	_, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{Wishlist: "礼物", ProductId: 2, NotifyBackInStock: true})
+	require.NoError(t, err)
+	_, err = svc.Add(ctx, 8, &user.AddWishlistItemReq{ProductId: 1})
+	require.NoError(t, err)
+
+	// 没有变化时不提醒
+	n, err := svc.CheckAlerts(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// 降价和到货各提醒一次，未订阅的用户不提醒
+	catalog.products[1].Price = 179
+	catalog.products[2].Stock = 3
+	n, err = svc.CheckAlerts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	require.Len(t, notifier.alerts, 2)
+	assert.Equal(t, &WishlistAlert{
+		Kind: AlertPriceDrop, UserID: 7, Wishlist: DefaultWishlistName,
+		ProductID: 1, ProductName: "键盘", SavedPrice: 199, Price: 179, Stock: 10,
+	}, notifier.alerts[0])
+	assert.Equal(t, AlertBackInStock, notifier.alerts[1].Kind)
+	assert.Equal(t, "礼物", notifier.alerts[1].Wishlist)
+
+	n, err = svc.CheckAlerts(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// 涨价后不提醒，再次降到低于加入时价格时提醒；高于加入时价格的下降不提醒
+	catalog.products[1].Price = 189
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Zero(t, n)
+	catalog.products[1].Price = 259
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Zero(t, n)
+	catalog.products[1].Price = 209
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Zero(t, n)
+	catalog.products[1].Price = 169
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Equal(t, 1, n)
+
+	// 售罄后再到货时提醒
+	catalog.products[2].Stock = 0
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Zero(t, n)
+	catalog.products[2].Stock = 1
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Equal(t, 1, n)
+	assert.Len(t, notifier.alerts, 4)
+}
+
+func TestWishlist_AlertRetry(t *testing.T) {
+	ctx := context.Background()
+	svc, catalog, notifier := newTestWishlists(t)
+	_, err := svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 2, NotifyBackInStock: true})
+	require.NoError(t, err)
+
+	// 发送失败时不记录本次检查结果，下次检查重新发送
+	catalog.products[2].Stock = 5
+	notifier.fail = true
+	n, err := svc.CheckAlerts(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	notifier.fail = false
+	n, err = svc.CheckAlerts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	// 商品服务查询失败的商品跳过，不影响其他商品
+	_, err = svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 1, NotifyPriceDrop: true})
+	require.NoError(t, err)
+	catalog.down[1] = true
+	catalog.products[2].Stock = 0
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Zero(t, n)
+	catalog.products[2].Stock = 2
+	n, err = svc.CheckAlerts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestWishlist_Subscribe(t *testing.T) {
+	ctx := context.Background()
+	svc, catalog, notifier := newTestWishlists(t)
+	_, err := svc.Add(ctx, 7, &user.AddWishlistItemReq{ProductId: 1})
+	require.NoError(t, err)
+
+	// 未订阅时的变化不提醒；订阅后以当前价格为基准
+	catalog.products[1].Price = 179
+	n, _ := svc.CheckAlerts(ctx)
+	assert.Zero(t, n)
+	item, err := svc.Subscribe(ctx, 7, &user.SubscribeWishlistItemReq{ProductId: 1, NotifyPriceDrop: true})
+	require.NoError(t, err)
+	assert.True(t, item.NotifyPriceDrop)
+	assert.Equal(t, 179.0, item.Price)
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Zero(t, n)
+	catalog.products[1].Price = 159
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Equal(t, 1, n)
+
+	// 取消订阅
+	_, err = svc.Subscribe(ctx, 7, &user.SubscribeWishlistItemReq{ProductId: 1})
+	require.NoError(t, err)
+	catalog.products[1].Price = 99
+	n, _ = svc.CheckAlerts(ctx)
+	assert.Zero(t, n)
+	assert.Len(t, notifier.alerts, 1)
+
+	_, err = svc.Subscribe(ctx, 7, &user.SubscribeWishlistItemReq{ProductId: 2, NotifyPriceDrop: true})
+	assert.ErrorIs(t, err, ErrWishlistNotFound)
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/kr/pretty"
@@ -17,14 +18,23 @@ var (
 )
 
 type Config struct {
-	Env        string           `mapstructure:"env"`
-	Service    ServiceConfig    `mapstructure:"service"`
-	MySQL      MySQLConfig      `mapstructure:"mysql"`
-	Redis      RedisConfig      `mapstructure:"redis"`
-	Registry   RegistryConfig   `mapstructure:"registry"`
-	Log        LogConfig        `mapstructure:"log"`
-	Jaeger     JaegerConfig     `mapstructure:"jaeger"`
-	Prometheus PrometheusConfig `mapstructure:"prometheus"`
+	Env         string            `mapstructure:"env"`
+	Service     ServiceConfig     `mapstructure:"service"`
+	Kitex       Kitex             `mapstructure:"kitex"`
+	MySQL       MySQLConfig       `mapstructure:"mysql"`
+	Redis       RedisConfig       `mapstructure:"redis"`
+	Registry    RegistryConfig    `mapstructure:"registry"`
+	Log         LogConfig         `mapstructure:"log"`
+	Jaeger      JaegerConfig      `mapstructure:"jaeger"`
+	Prometheus  PrometheusConfig  `mapstructure:"prometheus"`
+	Password    PasswordConfig    `mapstructure:"password"`
+	CORS        CORSConfig        `mapstructure:"cors"`
+	Account     AccountConfig     `mapstructure:"account"`
+	Avatar      AvatarConfig      `mapstructure:"avatar"`
+	Loyalty     LoyaltyConfig     `mapstructure:"loyalty"`
+	Wishlist    WishlistConfig    `mapstructure:"wishlist"`
+	ProductRPC  RPCClientConfig   `mapstructure:"product_rpc"`
+	ServiceAuth ServiceAuthConfig `mapstructure:"service_auth"`
 }
 
 type ServiceConfig struct {
@@ -77,6 +87,96 @@ type PrometheusConfig struct {
 	Path string `mapstructure:"path"`
 }
 
+// PasswordConfig 密码策略配置，与认证服务保持一致
+type PasswordConfig struct {
+	Policy PasswordPolicyConfig `mapstructure:"policy"`
+}
+
+// PasswordPolicyConfig 密码策略配置，banned 与内置常见密码列表合并
+type PasswordPolicyConfig struct {
+	MinLength        int      `mapstructure:"min_length"`
+	MaxLength        int      `mapstructure:"max_length"`
+	RequireLower     bool     `mapstructure:"require_lower"`
+	RequireUpper     bool     `mapstructure:"require_upper"`
+	RequireDigit     bool     `mapstructure:"require_digit"`
+	RequireSymbol    bool     `mapstructure:"require_symbol"`
+	MinClasses       int      `mapstructure:"min_classes"`
+	DisallowUsername bool     `mapstructure:"disallow_username"`
+	Banned           []string `mapstructure:"banned"`
+	BreachedListPath string   `mapstructure:"breached_list_path"` // 每行一个明文密码或 SHA-1 摘要
+	BreachedFPRate   float64  `mapstructure:"breached_fp_rate"`
+	HistorySize      int      `mapstructure:"history_size"`
+}
+
+// CORSConfig 跨域配置，只有 allow_origins 中的来源可以携带 Cookie 访问，为空时拒绝跨域请求
+type CORSConfig struct {
+	AllowOrigins []string `mapstructure:"allow_origins"`
+}
+
+// AccountConfig 账号注销配置，未配置时使用默认值
+type AccountConfig struct {
+	DeletionGracePeriod time.Duration `mapstructure:"deletion_grace_period"` // 申请注销后可以撤销的时间，期满后匿名化个人数据
+	ErasureInterval     time.Duration `mapstructure:"erasure_interval"`      // 检查宽限期已结束账号的间隔
+}
+
+// AvatarConfig 头像配置，未配置的大小限制和尺寸使用默认值
+type AvatarConfig struct {
+	Storage   string             `mapstructure:"storage"`    // local 或 s3，为空时不能上传头像
+	MaxSize   int64              `mapstructure:"max_size"`   // 上传文件大小上限（字节）
+	MaxPixels int                `mapstructure:"max_pixels"` // 原图像素数上限
+	Sizes     []int              `mapstructure:"sizes"`      // 缩略图边长（像素）
+	Local     LocalStorageConfig `mapstructure:"local"`
+	S3        S3StorageConfig    `mapstructure:"s3"`
+}
+
+// LocalStorageConfig 本地目录存储配置，文件由用户服务通过 /v1/user/files 提供
+type LocalStorageConfig struct {
+	Dir     string `mapstructure:"dir"`
+	BaseURL string `mapstructure:"base_url"`
+}
+
+// S3StorageConfig S3 兼容存储配置，密钥通过环境变量 S3_ACCESS_KEY_ID、S3_SECRET_ACCESS_KEY 设置
+type S3StorageConfig struct {
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	UseSSL          bool   `mapstructure:"use_ssl"`
+	BaseURL         string `mapstructure:"base_url"` // 对外访问地址，如 CDN 域名
+}
+
+// LoyaltyConfig 积分和会员等级配置，未配置时使用默认值
+type LoyaltyConfig struct {
+	PointsPerUnit  float64       `mapstructure:"points_per_unit"` // 每消费 1 元获得的积分
+	PointValue     float64       `mapstructure:"point_value"`     // 每个积分抵扣的金额
+	Expiry         time.Duration `mapstructure:"expiry"`          // 积分有效期
+	ExpiryInterval time.Duration `mapstructure:"expiry_interval"` // 检查到期积分的间隔
+	Tiers          []TierConfig  `mapstructure:"tiers"`           // 会员等级，按近 12 个月消费金额计算
+}
+
+// TierConfig 会员等级及其所需的近 12 个月消费金额
+type TierConfig struct {
+	Name     string  `mapstructure:"name"`
+	MinSpend float64 `mapstructure:"min_spend"`
+}
+
+// WishlistConfig 心愿单配置，未配置时使用默认值
+type WishlistConfig struct {
+	CheckInterval time.Duration `mapstructure:"check_interval"` // 检查降价和到货的间隔
+}
+
+// RPCClientConfig 下游 Kitex 服务配置
+type RPCClientConfig struct {
+	Service string `mapstructure:"service"` // 服务注册名，与下游服务的 kitex.service 一致
+	Address string `mapstructure:"address"` // 服务的 RPC 地址，如 127.0.0.1:8888
+}
+
+// ServiceAuthConfig 服务间调用凭证配置，所有服务使用相同的共享密钥
+type ServiceAuthConfig struct {
+	Secret string `mapstructure:"secret"` // 可通过 SERVICE_AUTH_SECRET 覆盖
+}
+
 // GetConf gets configuration instance
 func GetConf() *Config {
 	return conf
@@ -3,11 +3,31 @@ package main
 import (
 	user "TikTokMall/app/user/kitex_gen/user"
 	"context"
+	"TikTokMall/app/user/biz/handler"
 	"TikTokMall/app/user/biz/service"
 )
 
 // UserServiceImpl implements the last service interface defined in the IDL.
-type UserServiceImpl struct{}
+type UserServiceImpl struct {
+	users     *service.UserService
+	addresses *service.AddressService
+	accounts  *service.AccountService
+	avatars   *service.AvatarService
+	loyalty   *service.LoyaltyService
+	wishlists *service.WishlistService
+}
+
+// NewUserServiceImpl 创建 RPC 服务实现
+func NewUserServiceImpl() *UserServiceImpl {
+	return &UserServiceImpl{
+		users:     service.NewUserService(),
+		addresses: service.NewAddressService(),
+		accounts:  service.NewAccountService(),
+		avatars:   service.NewAvatarService(),
+		loyalty:   service.NewLoyaltyService(),
+		wishlists: service.NewWishlistService(),
+	}
+}
 
 // Register implements the UserServiceImpl interface.
 func (s *UserServiceImpl) Register(ctx context.Context, req *user.RegisterReq) (resp *user.RegisterResp, err error) {
@@ -22,3 +42,262 @@ func (s *UserServiceImpl) Login(ctx context.Context, req *user.LoginReq) (resp *
 
 	return resp, err
 }
+
+// Logout implements the UserServiceImpl interface.
+func (s *UserServiceImpl) Logout(ctx context.Context, req *user.LogoutReq) (resp *user.LogoutResp, err error) {
+	if err := s.users.Logout(ctx, req.Token); err != nil {
+		return &user.LogoutResp{Base: rpcBase(500, err)}, nil
+	}
+	return &user.LogoutResp{Base: rpcBase(200, nil)}, nil
+}
+
+// Delete implements the UserServiceImpl interface.
+func (s *UserServiceImpl) Delete(ctx context.Context, req *user.DeleteReq) (resp *user.DeleteResp, err error) {
+	userID, err := s.users.UserIDByToken(ctx, req.Token)
+	if err != nil {
+		return &user.DeleteResp{Base: rpcBase(401, err)}, nil
+	}
+	eraseAfter, err := s.accounts.RequestDeletion(ctx, userID)
+	if err != nil {
+		return &user.DeleteResp{Base: accountBase(err)}, nil
+	}
+	return &user.DeleteResp{Base: rpcBase(200, nil), EraseAfter: eraseAfter.Unix()}, nil
+}
+
+// CancelDelete implements the UserServiceImpl interface.
+func (s *UserServiceImpl) CancelDelete(ctx context.Context, req *user.CancelDeleteReq) (resp *user.CancelDeleteResp, err error) {
+	if err := s.accounts.CancelDeletion(ctx, req.Username, req.Password); err != nil {
+		return &user.CancelDeleteResp{Base: accountBase(err)}, nil
+	}
+	return &user.CancelDeleteResp{Base: rpcBase(200, nil)}, nil
+}
+
+// Update implements the UserServiceImpl interface.
+func (s *UserServiceImpl) Update(ctx context.Context, req *user.UpdateReq) (resp *user.UpdateResp, err error) {
+	if err := s.users.Update(ctx, req.Token, req.NewUsername, req.NewEmail, req.NewPhone); err != nil {
+		return &user.UpdateResp{Base: rpcBase(500, err)}, nil
+	}
+	return &user.UpdateResp{Base: rpcBase(200, nil)}, nil
+}
+
+// Info implements the UserServiceImpl interface.
+func (s *UserServiceImpl) Info(ctx context.Context, req *user.InfoReq) (resp *user.InfoResp, err error) {
+	resp, err = s.users.Info(ctx, req.Token)
+	if err != nil {
+		return &user.InfoResp{Base: rpcBase(500, err)}, nil
+	}
+	resp.Base = rpcBase(200, nil)
+	return resp, nil
+}
+
+// ListAddresses implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListAddresses(ctx context.Context, req *user.ListAddressesReq) (resp *user.ListAddressesResp, err error) {
+	addrs, err := s.addresses.List(ctx, req.UserId)
+	if err != nil {
+		return &user.ListAddressesResp{Base: addressBase(err)}, nil
+	}
+	return &user.ListAddressesResp{Base: rpcBase(200, nil), Addresses: addrs}, nil
+}
+
+// GetAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetAddress(ctx context.Context, req *user.GetAddressReq) (resp *user.GetAddressResp, err error) {
+	addr, err := s.addresses.Get(ctx, req.UserId, req.AddressId)
+	if err != nil {
+		return &user.GetAddressResp{Base: addressBase(err)}, nil
+	}
+	return &user.GetAddressResp{Base: rpcBase(200, nil), Address: addr}, nil
+}
+
+// CreateAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) CreateAddress(ctx context.Context, req *user.CreateAddressReq) (resp *user.CreateAddressResp, err error) {
+	addr, err := s.addresses.Create(ctx, req.UserId, req)
+	if err != nil {
+		return &user.CreateAddressResp{Base: addressBase(err)}, nil
+	}
+	return &user.CreateAddressResp{Base: rpcBase(200, nil), Address: addr}, nil
+}
+
+// UpdateAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateAddress(ctx context.Context, req *user.UpdateAddressReq) (resp *user.UpdateAddressResp, err error) {
+	addr, err := s.addresses.Update(ctx, req.UserId, req)
+	if err != nil {
+		return &user.UpdateAddressResp{Base: addressBase(err)}, nil
+	}
+	return &user.UpdateAddressResp{Base: rpcBase(200, nil), Address: addr}, nil
+}
+
+// DeleteAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DeleteAddress(ctx context.Context, req *user.DeleteAddressReq) (resp *user.DeleteAddressResp, err error) {
+	if err := s.addresses.Delete(ctx, req.UserId, req.AddressId); err != nil {
+		return &user.DeleteAddressResp{Base: addressBase(err)}, nil
+	}
+	return &user.DeleteAddressResp{Base: rpcBase(200, nil)}, nil
+}
+
+// SetDefaultAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SetDefaultAddress(ctx context.Context, req *user.SetDefaultAddressReq) (resp *user.SetDefaultAddressResp, err error) {
+	if err := s.addresses.SetDefault(ctx, req.UserId, req.AddressId); err != nil {
+		return &user.SetDefaultAddressResp{Base: addressBase(err)}, nil
+	}
+	return &user.SetDefaultAddressResp{Base: rpcBase(200, nil)}, nil
+}
+
+// RequestExport implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RequestExport(ctx context.Context, req *user.RequestExportReq) (resp *user.RequestExportResp, err error) {
+	export, err := s.accounts.RequestExport(ctx, req.UserId)
+	if err != nil {
+		return &user.RequestExportResp{Base: accountBase(err)}, nil
+	}
+	return &user.RequestExportResp{Base: rpcBase(200, nil), ExportId: export.ID}, nil
+}
+
+// GetExport implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetExport(ctx context.Context, req *user.GetExportReq) (resp *user.GetExportResp, err error) {
+	export, err := s.accounts.GetExport(ctx, req.UserId, req.ExportId)
+	if err != nil {
+		return &user.GetExportResp{Base: accountBase(err)}, nil
+	}
+	return &user.GetExportResp{
+		Base:        rpcBase(200, nil),
+		ExportId:    export.ID,
+		Ready:       export.Ready(),
+		Pending:     export.Pending(),
+		RequestedAt: export.RequestedAt.Unix(),
+	}, nil
+}
+
+// UploadAvatar implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UploadAvatar(ctx context.Context, req *user.UploadAvatarReq) (resp *user.UploadAvatarResp, err error) {
+	userID, err := s.users.UserIDByToken(ctx, req.Token)
+	if err != nil {
+		return &user.UploadAvatarResp{Base: rpcBase(401, err)}, nil
+	}
+	url, thumbnails, err := s.avatars.Upload(ctx, userID, req.Image)
+	if err != nil {
+		return &user.UploadAvatarResp{Base: avatarBase(err)}, nil
+	}
+	return &user.UploadAvatarResp{Base: rpcBase(200, nil), AvatarUrl: url, AvatarThumbnails: thumbnails}, nil
+}
+
+// DeleteAvatar implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DeleteAvatar(ctx context.Context, req *user.DeleteAvatarReq) (resp *user.DeleteAvatarResp, err error) {
+	userID, err := s.users.UserIDByToken(ctx, req.Token)
+	if err != nil {
+		return &user.DeleteAvatarResp{Base: rpcBase(401, err)}, nil
+	}
+	if err := s.avatars.Delete(ctx, userID); err != nil {
+		return &user.DeleteAvatarResp{Base: avatarBase(err)}, nil
+	}
+	return &user.DeleteAvatarResp{Base: rpcBase(200, nil)}, nil
+}
+
+// GetPoints implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetPoints(ctx context.Context, req *user.GetPointsReq) (resp *user.GetPointsResp, err error) {
+	summary, err := s.loyalty.Summary(ctx, req.UserId)
+	if err != nil {
+		return &user.GetPointsResp{Base: loyaltyBase(err)}, nil
+	}
+	return &user.GetPointsResp{Base: rpcBase(200, nil), Points: summary}, nil
+}
+
+// ListPointsHistory implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListPointsHistory(ctx context.Context, req *user.ListPointsHistoryReq) (resp *user.ListPointsHistoryResp, err error) {
+	entries, total, err := s.loyalty.History(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return &user.ListPointsHistoryResp{Base: loyaltyBase(err)}, nil
+	}
+	return &user.ListPointsHistoryResp{Base: rpcBase(200, nil), Entries: entries, Total: total}, nil
+}
+
+// RedeemPoints implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RedeemPoints(ctx context.Context, req *user.RedeemPointsReq) (resp *user.RedeemPointsResp, err error) {
+	points, discount, err := s.loyalty.Redeem(ctx, req.UserId, req.OrderId, req.Points, req.MaxDiscount)
+	if err != nil {
+		return &user.RedeemPointsResp{Base: loyaltyBase(err)}, nil
+	}
+	return &user.RedeemPointsResp{Base: rpcBase(200, nil), Points: points, Discount: discount}, nil
+}
+
+// CancelRedemption implements the UserServiceImpl interface.
+func (s *UserServiceImpl) CancelRedemption(ctx context.Context, req *user.CancelRedemptionReq) (resp *user.CancelRedemptionResp, err error) {
+	if err := s.loyalty.CancelRedemption(ctx, req.UserId, req.OrderId); err != nil {
+		return &user.CancelRedemptionResp{Base: loyaltyBase(err)}, nil
+	}
+	return &user.CancelRedemptionResp{Base: rpcBase(200, nil)}, nil
+}
+
+// ListWishlists implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListWishlists(ctx context.Context, req *user.ListWishlistsReq) (resp *user.ListWishlistsResp, err error) {
+	lists, err := s.wishlists.List(ctx, req.UserId)
+	if err != nil {
+		return &user.ListWishlistsResp{Base: wishlistBase(err)}, nil
+	}
+	return &user.ListWishlistsResp{Base: rpcBase(200, nil), Wishlists: lists}, nil
+}
+
+// AddWishlistItem implements the UserServiceImpl interface.
+func (s *UserServiceImpl) AddWishlistItem(ctx context.Context, req *user.AddWishlistItemReq) (resp *user.AddWishlistItemResp, err error) {
+	item, err := s.wishlists.Add(ctx, req.UserId, req)
+	if err != nil {
+		return &user.AddWishlistItemResp{Base: wishlistBase(err)}, nil
+	}
+	return &user.AddWishlistItemResp{Base: rpcBase(200, nil), Item: item}, nil
+}
+
+// RemoveWishlistItem implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RemoveWishlistItem(ctx context.Context, req *user.RemoveWishlistItemReq) (resp *user.RemoveWishlistItemResp, err error) {
+	if err := s.wishlists.Remove(ctx, req.UserId, req.Wishlist, req.ProductId); err != nil {
+		return &user.RemoveWishlistItemResp{Base: wishlistBase(err)}, nil
+	}
+	return &user.RemoveWishlistItemResp{Base: rpcBase(200, nil)}, nil
+}
+
+// DeleteWishlist implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DeleteWishlist(ctx context.Context, req *user.DeleteWishlistReq) (resp *user.DeleteWishlistResp, err error) {
+	if err := s.wishlists.Delete(ctx, req.UserId, req.Wishlist); err != nil {
+		return &user.DeleteWishlistResp{Base: wishlistBase(err)}, nil
+	}
+	return &user.DeleteWishlistResp{Base: rpcBase(200, nil)}, nil
+}
+
+// SubscribeWishlistItem implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SubscribeWishlistItem(ctx context.Context, req *user.SubscribeWishlistItemReq) (resp *user.SubscribeWishlistItemResp, err error) {
+	item, err := s.wishlists.Subscribe(ctx, req.UserId, req)
+	if err != nil {
+		return &user.SubscribeWishlistItemResp{Base: wishlistBase(err)}, nil
+	}
+	return &user.SubscribeWishlistItemResp{Base: rpcBase(200, nil), Item: item}, nil
+}
+
+// rpcBase 构造 RPC 响应状态，业务错误通过状态码返回
+func rpcBase(code int32, err error) *user.BaseResp {
+	if err != nil {
+		return &user.BaseResp{Code: code, Message: err.Error()}
+	}
+	return &user.BaseResp{Code: code, Message: "success"}
+}
+
+// addressBase 构造地址接口的错误状态，状态码与 HTTP 接口一致
+func addressBase(err error) *user.BaseResp {
+	return rpcBase(int32(handler.AddressErrorStatus(err)), err)
+}
+
+// accountBase 构造注销和导出接口的错误状态，状态码与 HTTP 接口一致
+func accountBase(err error) *user.BaseResp {
+	return rpcBase(int32(handler.AccountErrorStatus(err)), err)
+}
+
+// avatarBase 构造头像接口的错误状态，状态码与 HTTP 接口一致
+func avatarBase(err error) *user.BaseResp {
+	return rpcBase(int32(handler.AvatarErrorStatus(err)), err)
+}
+
+// loyaltyBase 构造积分接口的错误状态，状态码与 HTTP 接口一致
+func loyaltyBase(err error) *user.BaseResp {
+	return rpcBase(int32(handler.LoyaltyErrorStatus(err)), err)
+}
+
+// wishlistBase 构造心愿单接口的错误状态，状态码与 HTTP 接口一致
+func wishlistBase(err error) *user.BaseResp {
+	return rpcBase(int32(handler.WishlistErrorStatus(err)), err)
+}
@@ -325,6 +325,11 @@ func (x *DeleteResp) FastRead(buf []byte, _type int8, number int32) (offset int,
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -348,6 +353,76 @@ func (x *DeleteResp) fastReadField1(buf []byte, _type int8) (offset int, err err
 	return offset, nil
 }
 
+func (x *DeleteResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.EraseAfter, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CancelDeleteReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelDeleteReq[number], err)
+}
+
+func (x *CancelDeleteReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Username, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CancelDeleteReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Password, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CancelDeleteResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelDeleteResp[number], err)
+}
+
+func (x *CancelDeleteResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
 func (x *UpdateReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -485,6 +560,16 @@ func (x *InfoResp) FastRead(buf []byte, _type int8, number int32) (offset int, e
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -528,445 +613,6252 @@ func (x *InfoResp) fastReadField5(buf []byte, _type int8) (offset int, err error
 	return offset, err
 }
 
-func (x *BaseResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+func (x *InfoResp) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.AvatarUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *BaseResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Code == 0 {
-		return offset
+func (x *InfoResp) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	if x.AvatarThumbnails == nil {
+		x.AvatarThumbnails = make(map[int32]string)
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetCode())
-	return offset
+	var key int32
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadInt32(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.AvatarThumbnails[key] = value
+	return offset, nil
 }
 
-func (x *BaseResp) fastWriteField2(buf []byte) (offset int) {
-	if x.Message == "" {
-		return offset
+func (x *Address) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetMessage())
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Address[number], err)
 }
 
-func (x *RegisterReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	return offset
+func (x *Address) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
 }
 
-func (x *RegisterReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Username == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
-	return offset
+func (x *Address) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Label, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *RegisterReq) fastWriteField2(buf []byte) (offset int) {
-	if x.Password == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
-	return offset
+func (x *Address) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.RecipientName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *RegisterReq) fastWriteField3(buf []byte) (offset int) {
-	if x.Email == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetEmail())
-	return offset
+func (x *Address) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.RecipientPhone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *RegisterReq) fastWriteField4(buf []byte) (offset int) {
-	if x.Phone == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetPhone())
-	return offset
+func (x *Address) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.StreetAddress, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *RegisterResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	return offset
+func (x *Address) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *RegisterResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
-	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+func (x *Address) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *RegisterResp) fastWriteField2(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
-	}
-	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
-	return offset
+func (x *Address) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Country, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *RegisterResp) fastWriteField3(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetToken())
-	return offset
+func (x *Address) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *LoginReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+func (x *Address) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.IsDefault, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
 }
 
-func (x *LoginReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Username == "" {
-		return offset
+func (x *ListAddressesReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListAddressesReq[number], err)
 }
 
-func (x *LoginReq) fastWriteField2(buf []byte) (offset int) {
-	if x.Password == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
-	return offset
+func (x *ListAddressesReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *LoginResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *ListAddressesReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListAddressesResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListAddressesResp[number], err)
+}
+
+func (x *ListAddressesResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListAddressesResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Addresses = append(x.Addresses, &v)
+	return offset, nil
+}
+
+func (x *GetAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetAddressReq[number], err)
+}
+
+func (x *GetAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetAddressReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetAddressReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetAddressResp[number], err)
+}
+
+func (x *GetAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *GetAddressResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *CreateAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateAddressReq[number], err)
+}
+
+func (x *CreateAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Label, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.RecipientName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.RecipientPhone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.StreetAddress, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.Country, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.IsDefault, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateAddressResp[number], err)
+}
+
+func (x *CreateAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *CreateAddressResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *UpdateAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateAddressReq[number], err)
+}
+
+func (x *UpdateAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Label, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.RecipientName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.RecipientPhone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.StreetAddress, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.Country, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateAddressResp[number], err)
+}
+
+func (x *UpdateAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *UpdateAddressResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *DeleteAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteAddressReq[number], err)
+}
+
+func (x *DeleteAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteAddressReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteAddressReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteAddressResp[number], err)
+}
+
+func (x *DeleteAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *SetDefaultAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SetDefaultAddressReq[number], err)
+}
+
+func (x *SetDefaultAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SetDefaultAddressReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SetDefaultAddressReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SetDefaultAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SetDefaultAddressResp[number], err)
+}
+
+func (x *SetDefaultAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *RequestExportReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RequestExportReq[number], err)
+}
+
+func (x *RequestExportReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RequestExportReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RequestExportResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RequestExportResp[number], err)
+}
+
+func (x *RequestExportResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *RequestExportResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ExportId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetExportReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetExportReq[number], err)
+}
+
+func (x *GetExportReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetExportReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetExportReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ExportId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetExportResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetExportResp[number], err)
+}
+
+func (x *GetExportResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *GetExportResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ExportId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetExportResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Ready, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *GetExportResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Pending = append(x.Pending, v)
+	return offset, err
+}
+
+func (x *GetExportResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.RequestedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UploadAvatarReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadAvatarReq[number], err)
+}
+
+func (x *UploadAvatarReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadAvatarReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Image, offset, err = fastpb.ReadBytes(buf, _type)
+	return offset, err
+}
+
+func (x *UploadAvatarResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UploadAvatarResp[number], err)
+}
+
+func (x *UploadAvatarResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *UploadAvatarResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.AvatarUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UploadAvatarResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	if x.AvatarThumbnails == nil {
+		x.AvatarThumbnails = make(map[int32]string)
+	}
+	var key int32
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadInt32(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.AvatarThumbnails[key] = value
+	return offset, nil
+}
+
+func (x *DeleteAvatarReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteAvatarReq[number], err)
+}
+
+func (x *DeleteAvatarReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteAvatarResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteAvatarResp[number], err)
+}
+
+func (x *DeleteAvatarResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *PointsSummary) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PointsSummary[number], err)
+}
+
+func (x *PointsSummary) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Balance, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PointsSummary) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Tier, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PointsSummary) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.RollingSpend, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *PointsSummary) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.NextTier, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PointsSummary) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.NextTierSpend, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *PointsSummary) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ExpiringPoints, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PointsSummary) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.ExpiringAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetPointsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetPointsReq[number], err)
+}
+
+func (x *GetPointsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetPointsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetPointsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetPointsResp[number], err)
+}
+
+func (x *GetPointsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *GetPointsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v PointsSummary
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Points = &v
+	return offset, nil
+}
+
+func (x *PointsEntry) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PointsEntry[number], err)
+}
+
+func (x *PointsEntry) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PointsEntry) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Kind, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PointsEntry) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Points, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PointsEntry) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PointsEntry) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PointsEntry) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ExpiresAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListPointsHistoryReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListPointsHistoryReq[number], err)
+}
+
+func (x *ListPointsHistoryReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListPointsHistoryReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListPointsHistoryReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListPointsHistoryReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListPointsHistoryResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListPointsHistoryResp[number], err)
+}
+
+func (x *ListPointsHistoryResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListPointsHistoryResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v PointsEntry
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Entries = append(x.Entries, &v)
+	return offset, nil
+}
+
+func (x *ListPointsHistoryResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RedeemPointsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RedeemPointsReq[number], err)
+}
+
+func (x *RedeemPointsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RedeemPointsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RedeemPointsReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Points, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RedeemPointsReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.MaxDiscount, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *RedeemPointsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RedeemPointsResp[number], err)
+}
+
+func (x *RedeemPointsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *RedeemPointsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Points, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RedeemPointsResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *CancelRedemptionReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelRedemptionReq[number], err)
+}
+
+func (x *CancelRedemptionReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CancelRedemptionReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CancelRedemptionResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelRedemptionResp[number], err)
+}
+
+func (x *CancelRedemptionResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *WishlistItem) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_WishlistItem[number], err)
+}
+
+func (x *WishlistItem) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Picture, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.SavedPrice, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Stock, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Available, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.NotifyPriceDrop, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.NotifyBackInStock, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *WishlistItem) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.AddedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Wishlist) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Wishlist[number], err)
+}
+
+func (x *Wishlist) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Wishlist) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Wishlist) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v WishlistItem
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Items = append(x.Items, &v)
+	return offset, nil
+}
+
+func (x *Wishlist) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListWishlistsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListWishlistsReq[number], err)
+}
+
+func (x *ListWishlistsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListWishlistsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListWishlistsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListWishlistsResp[number], err)
+}
+
+func (x *ListWishlistsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *ListWishlistsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Wishlist
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Wishlists = append(x.Wishlists, &v)
+	return offset, nil
+}
+
+func (x *AddWishlistItemReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AddWishlistItemReq[number], err)
+}
+
+func (x *AddWishlistItemReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AddWishlistItemReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *AddWishlistItemReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Wishlist, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AddWishlistItemReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *AddWishlistItemReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.NotifyPriceDrop, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *AddWishlistItemReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.NotifyBackInStock, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *AddWishlistItemResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AddWishlistItemResp[number], err)
+}
+
+func (x *AddWishlistItemResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *AddWishlistItemResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v WishlistItem
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Item = &v
+	return offset, nil
+}
+
+func (x *RemoveWishlistItemReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RemoveWishlistItemReq[number], err)
+}
+
+func (x *RemoveWishlistItemReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RemoveWishlistItemReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RemoveWishlistItemReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Wishlist, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RemoveWishlistItemReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *RemoveWishlistItemResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RemoveWishlistItemResp[number], err)
+}
+
+func (x *RemoveWishlistItemResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *DeleteWishlistReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteWishlistReq[number], err)
+}
+
+func (x *DeleteWishlistReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteWishlistReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteWishlistReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Wishlist, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteWishlistResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteWishlistResp[number], err)
+}
+
+func (x *DeleteWishlistResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *SubscribeWishlistItemReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SubscribeWishlistItemReq[number], err)
+}
+
+func (x *SubscribeWishlistItemReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SubscribeWishlistItemReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SubscribeWishlistItemReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Wishlist, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SubscribeWishlistItemReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *SubscribeWishlistItemReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.NotifyPriceDrop, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *SubscribeWishlistItemReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.NotifyBackInStock, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *SubscribeWishlistItemResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SubscribeWishlistItemResp[number], err)
+}
+
+func (x *SubscribeWishlistItemResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v BaseResp
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Base = &v
+	return offset, nil
+}
+
+func (x *SubscribeWishlistItemResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v WishlistItem
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Item = &v
+	return offset, nil
+}
+
+func (x *BaseResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *BaseResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Code == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetCode())
+	return offset
+}
+
+func (x *BaseResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Message == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetMessage())
+	return offset
+}
+
+func (x *RegisterReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetEmail())
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetPhone())
+	return offset
+}
+
+func (x *RegisterResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *RegisterResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *RegisterResp) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *RegisterResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetToken())
+	return offset
+}
+
+func (x *LoginReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *LoginReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
+	return offset
+}
+
+func (x *LoginReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *LoginResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *LoginResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *LoginResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetToken())
+	return offset
+}
+
+func (x *LoginResp) fastWriteField3(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetRefreshToken())
+	return offset
+}
+
+func (x *LogoutReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *LogoutReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *LogoutResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *LogoutResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *DeleteReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *DeleteResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DeleteResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *DeleteResp) fastWriteField2(buf []byte) (offset int) {
+	if x.EraseAfter == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetEraseAfter())
+	return offset
+}
+
+func (x *CancelDeleteReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *CancelDeleteReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetUsername())
+	return offset
+}
+
+func (x *CancelDeleteReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *CancelDeleteResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CancelDeleteResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *UpdateReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *UpdateReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *UpdateReq) fastWriteField2(buf []byte) (offset int) {
+	if x.NewUsername == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetNewUsername())
+	return offset
+}
+
+func (x *UpdateReq) fastWriteField3(buf []byte) (offset int) {
+	if x.NewEmail == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetNewEmail())
+	return offset
+}
+
+func (x *UpdateReq) fastWriteField4(buf []byte) (offset int) {
+	if x.NewPhone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetNewPhone())
+	return offset
+}
+
+func (x *UpdateResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *UpdateResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *InfoReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *InfoReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *InfoResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *InfoResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *InfoResp) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *InfoResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Username == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetUsername())
+	return offset
+}
+
+func (x *InfoResp) fastWriteField4(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetEmail())
+	return offset
+}
+
+func (x *InfoResp) fastWriteField5(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetPhone())
+	return offset
+}
+
+func (x *InfoResp) fastWriteField6(buf []byte) (offset int) {
+	if x.AvatarUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetAvatarUrl())
+	return offset
+}
+
+func (x *InfoResp) fastWriteField7(buf []byte) (offset int) {
+	if x.AvatarThumbnails == nil {
+		return offset
+	}
+	for k, v := range x.GetAvatarThumbnails() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 7,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteInt32(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *Address) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	return offset
+}
+
+func (x *Address) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Address) fastWriteField2(buf []byte) (offset int) {
+	if x.Label == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetLabel())
+	return offset
+}
+
+func (x *Address) fastWriteField3(buf []byte) (offset int) {
+	if x.RecipientName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetRecipientName())
+	return offset
+}
+
+func (x *Address) fastWriteField4(buf []byte) (offset int) {
+	if x.RecipientPhone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetRecipientPhone())
+	return offset
+}
+
+func (x *Address) fastWriteField5(buf []byte) (offset int) {
+	if x.StreetAddress == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetStreetAddress())
+	return offset
+}
+
+func (x *Address) fastWriteField6(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetCity())
+	return offset
+}
+
+func (x *Address) fastWriteField7(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetState())
+	return offset
+}
+
+func (x *Address) fastWriteField8(buf []byte) (offset int) {
+	if x.Country == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCountry())
+	return offset
+}
+
+func (x *Address) fastWriteField9(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetZipCode())
+	return offset
+}
+
+func (x *Address) fastWriteField10(buf []byte) (offset int) {
+	if !x.IsDefault {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 10, x.GetIsDefault())
+	return offset
+}
+
+func (x *ListAddressesReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListAddressesReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ListAddressesReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *ListAddressesResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListAddressesResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListAddressesResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Addresses == nil {
+		return offset
+	}
+	for i := range x.GetAddresses() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetAddresses()[i])
+	}
+	return offset
+}
+
+func (x *GetAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *GetAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *GetAddressReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *GetAddressReq) fastWriteField3(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetAddressId())
+	return offset
+}
+
+func (x *GetAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *GetAddressResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetAddress())
+	return offset
+}
+
+func (x *CreateAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Label == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetLabel())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField4(buf []byte) (offset int) {
+	if x.RecipientName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetRecipientName())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField5(buf []byte) (offset int) {
+	if x.RecipientPhone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetRecipientPhone())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField6(buf []byte) (offset int) {
+	if x.StreetAddress == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetStreetAddress())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField7(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetCity())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField8(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetState())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField9(buf []byte) (offset int) {
+	if x.Country == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetCountry())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField10(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 10, x.GetZipCode())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField11(buf []byte) (offset int) {
+	if !x.IsDefault {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 11, x.GetIsDefault())
+	return offset
+}
+
+func (x *CreateAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *CreateAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *CreateAddressResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetAddress())
+	return offset
+}
+
+func (x *UpdateAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField3(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetAddressId())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Label == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetLabel())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField5(buf []byte) (offset int) {
+	if x.RecipientName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetRecipientName())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField6(buf []byte) (offset int) {
+	if x.RecipientPhone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetRecipientPhone())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField7(buf []byte) (offset int) {
+	if x.StreetAddress == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetStreetAddress())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField8(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCity())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField9(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetState())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField10(buf []byte) (offset int) {
+	if x.Country == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 10, x.GetCountry())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField11(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 11, x.GetZipCode())
+	return offset
+}
+
+func (x *UpdateAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UpdateAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *UpdateAddressResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetAddress())
+	return offset
+}
+
+func (x *DeleteAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *DeleteAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *DeleteAddressReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *DeleteAddressReq) fastWriteField3(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetAddressId())
+	return offset
+}
+
+func (x *DeleteAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *SetDefaultAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *SetDefaultAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *SetDefaultAddressReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *SetDefaultAddressReq) fastWriteField3(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetAddressId())
+	return offset
+}
+
+func (x *SetDefaultAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SetDefaultAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *RequestExportReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RequestExportReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *RequestExportReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *RequestExportResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RequestExportResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *RequestExportResp) fastWriteField2(buf []byte) (offset int) {
+	if x.ExportId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetExportId())
+	return offset
+}
+
+func (x *GetExportReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *GetExportReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *GetExportReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *GetExportReq) fastWriteField3(buf []byte) (offset int) {
+	if x.ExportId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetExportId())
+	return offset
+}
+
+func (x *GetExportResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *GetExportResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *GetExportResp) fastWriteField2(buf []byte) (offset int) {
+	if x.ExportId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetExportId())
+	return offset
+}
+
+func (x *GetExportResp) fastWriteField3(buf []byte) (offset int) {
+	if !x.Ready {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 3, x.GetReady())
+	return offset
+}
+
+func (x *GetExportResp) fastWriteField4(buf []byte) (offset int) {
+	if len(x.Pending) == 0 {
+		return offset
+	}
+	for i := range x.GetPending() {
+		offset += fastpb.WriteString(buf[offset:], 4, x.GetPending()[i])
+	}
+	return offset
+}
+
+func (x *GetExportResp) fastWriteField5(buf []byte) (offset int) {
+	if x.RequestedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetRequestedAt())
+	return offset
+}
+
+func (x *UploadAvatarReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UploadAvatarReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *UploadAvatarReq) fastWriteField2(buf []byte) (offset int) {
+	if len(x.Image) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteBytes(buf[offset:], 2, x.GetImage())
+	return offset
+}
+
+func (x *UploadAvatarResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UploadAvatarResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *UploadAvatarResp) fastWriteField2(buf []byte) (offset int) {
+	if x.AvatarUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetAvatarUrl())
+	return offset
+}
+
+func (x *UploadAvatarResp) fastWriteField3(buf []byte) (offset int) {
+	if x.AvatarThumbnails == nil {
+		return offset
+	}
+	for k, v := range x.GetAvatarThumbnails() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 3,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteInt32(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *DeleteAvatarReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteAvatarReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *DeleteAvatarResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteAvatarResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *PointsSummary) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *PointsSummary) fastWriteField1(buf []byte) (offset int) {
+	if x.Balance == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetBalance())
+	return offset
+}
+
+func (x *PointsSummary) fastWriteField2(buf []byte) (offset int) {
+	if x.Tier == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetTier())
+	return offset
+}
+
+func (x *PointsSummary) fastWriteField3(buf []byte) (offset int) {
+	if x.RollingSpend == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 3, x.GetRollingSpend())
+	return offset
+}
+
+func (x *PointsSummary) fastWriteField4(buf []byte) (offset int) {
+	if x.NextTier == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetNextTier())
+	return offset
+}
+
+func (x *PointsSummary) fastWriteField5(buf []byte) (offset int) {
+	if x.NextTierSpend == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 5, x.GetNextTierSpend())
+	return offset
+}
+
+func (x *PointsSummary) fastWriteField6(buf []byte) (offset int) {
+	if x.ExpiringPoints == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.GetExpiringPoints())
+	return offset
+}
+
+func (x *PointsSummary) fastWriteField7(buf []byte) (offset int) {
+	if x.ExpiringAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.GetExpiringAt())
+	return offset
+}
+
+func (x *GetPointsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetPointsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *GetPointsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *GetPointsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetPointsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *GetPointsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Points == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetPoints())
+	return offset
+}
+
+func (x *PointsEntry) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *PointsEntry) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *PointsEntry) fastWriteField2(buf []byte) (offset int) {
+	if x.Kind == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetKind())
+	return offset
+}
+
+func (x *PointsEntry) fastWriteField3(buf []byte) (offset int) {
+	if x.Points == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetPoints())
+	return offset
+}
+
+func (x *PointsEntry) fastWriteField4(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetOrderId())
+	return offset
+}
+
+func (x *PointsEntry) fastWriteField5(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetCreatedAt())
+	return offset
+}
+
+func (x *PointsEntry) fastWriteField6(buf []byte) (offset int) {
+	if x.ExpiresAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.GetExpiresAt())
+	return offset
+}
+
+func (x *ListPointsHistoryReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ListPointsHistoryReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ListPointsHistoryReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *ListPointsHistoryReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetPage())
+	return offset
+}
+
+func (x *ListPointsHistoryReq) fastWriteField4(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetPageSize())
+	return offset
+}
+
+func (x *ListPointsHistoryResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ListPointsHistoryResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListPointsHistoryResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Entries == nil {
+		return offset
+	}
+	for i := range x.GetEntries() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetEntries()[i])
+	}
+	return offset
+}
+
+func (x *ListPointsHistoryResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetTotal())
+	return offset
+}
+
+func (x *RedeemPointsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *RedeemPointsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *RedeemPointsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *RedeemPointsReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Points == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetPoints())
+	return offset
+}
+
+func (x *RedeemPointsReq) fastWriteField4(buf []byte) (offset int) {
+	if x.MaxDiscount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 4, x.GetMaxDiscount())
+	return offset
+}
+
+func (x *RedeemPointsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *RedeemPointsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *RedeemPointsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Points == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetPoints())
+	return offset
+}
+
+func (x *RedeemPointsResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 3, x.GetDiscount())
+	return offset
+}
+
+func (x *CancelRedemptionReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *CancelRedemptionReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *CancelRedemptionReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *CancelRedemptionResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CancelRedemptionResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *WishlistItem) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField3(buf []byte) (offset int) {
+	if x.Picture == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetPicture())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField4(buf []byte) (offset int) {
+	if x.SavedPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 4, x.GetSavedPrice())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField5(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 5, x.GetPrice())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField6(buf []byte) (offset int) {
+	if x.Stock == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 6, x.GetStock())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField7(buf []byte) (offset int) {
+	if !x.Available {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 7, x.GetAvailable())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField8(buf []byte) (offset int) {
+	if !x.NotifyPriceDrop {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 8, x.GetNotifyPriceDrop())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField9(buf []byte) (offset int) {
+	if !x.NotifyBackInStock {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 9, x.GetNotifyBackInStock())
+	return offset
+}
+
+func (x *WishlistItem) fastWriteField10(buf []byte) (offset int) {
+	if x.AddedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 10, x.GetAddedAt())
+	return offset
+}
+
+func (x *Wishlist) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *Wishlist) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Wishlist) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *Wishlist) fastWriteField3(buf []byte) (offset int) {
+	if x.Items == nil {
+		return offset
+	}
+	for i := range x.GetItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.GetItems()[i])
+	}
+	return offset
+}
+
+func (x *Wishlist) fastWriteField4(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetCreatedAt())
+	return offset
+}
+
+func (x *ListWishlistsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListWishlistsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ListWishlistsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *ListWishlistsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListWishlistsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *ListWishlistsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Wishlists == nil {
+		return offset
+	}
+	for i := range x.GetWishlists() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetWishlists()[i])
+	}
+	return offset
+}
+
+func (x *AddWishlistItemReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *AddWishlistItemReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *AddWishlistItemReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *AddWishlistItemReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Wishlist == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetWishlist())
+	return offset
+}
+
+func (x *AddWishlistItemReq) fastWriteField4(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 4, x.GetProductId())
+	return offset
+}
+
+func (x *AddWishlistItemReq) fastWriteField5(buf []byte) (offset int) {
+	if !x.NotifyPriceDrop {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 5, x.GetNotifyPriceDrop())
+	return offset
+}
+
+func (x *AddWishlistItemReq) fastWriteField6(buf []byte) (offset int) {
+	if !x.NotifyBackInStock {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 6, x.GetNotifyBackInStock())
+	return offset
+}
+
+func (x *AddWishlistItemResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *AddWishlistItemResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *AddWishlistItemResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Item == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetItem())
+	return offset
+}
+
+func (x *RemoveWishlistItemReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *RemoveWishlistItemReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *RemoveWishlistItemReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *RemoveWishlistItemReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Wishlist == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetWishlist())
+	return offset
+}
+
+func (x *RemoveWishlistItemReq) fastWriteField4(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 4, x.GetProductId())
+	return offset
+}
+
+func (x *RemoveWishlistItemResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RemoveWishlistItemResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *DeleteWishlistReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *DeleteWishlistReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *DeleteWishlistReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *DeleteWishlistReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Wishlist == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetWishlist())
+	return offset
+}
+
+func (x *DeleteWishlistResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteWishlistResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *SubscribeWishlistItemReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *SubscribeWishlistItemReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *SubscribeWishlistItemReq) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *SubscribeWishlistItemReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Wishlist == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetWishlist())
+	return offset
+}
+
+func (x *SubscribeWishlistItemReq) fastWriteField4(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 4, x.GetProductId())
+	return offset
+}
+
+func (x *SubscribeWishlistItemReq) fastWriteField5(buf []byte) (offset int) {
+	if !x.NotifyPriceDrop {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 5, x.GetNotifyPriceDrop())
+	return offset
+}
+
+func (x *SubscribeWishlistItemReq) fastWriteField6(buf []byte) (offset int) {
+	if !x.NotifyBackInStock {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 6, x.GetNotifyBackInStock())
+	return offset
+}
+
+func (x *SubscribeWishlistItemResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *SubscribeWishlistItemResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Base == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
+	return offset
+}
+
+func (x *SubscribeWishlistItemResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Item == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetItem())
+	return offset
+}
+
+func (x *BaseResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *BaseResp) sizeField1() (n int) {
+	if x.Code == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetCode())
+	return n
+}
+
+func (x *BaseResp) sizeField2() (n int) {
+	if x.Message == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetMessage())
+	return n
+}
+
+func (x *RegisterReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *RegisterReq) sizeField1() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetUsername())
+	return n
+}
+
+func (x *RegisterReq) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPassword())
+	return n
+}
+
+func (x *RegisterReq) sizeField3() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetEmail())
+	return n
+}
+
+func (x *RegisterReq) sizeField4() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetPhone())
+	return n
+}
+
+func (x *RegisterResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *RegisterResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *RegisterResp) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *RegisterResp) sizeField3() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetToken())
+	return n
+}
+
+func (x *LoginReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *LoginReq) sizeField1() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetUsername())
+	return n
+}
+
+func (x *LoginReq) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPassword())
+	return n
+}
+
+func (x *LoginResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *LoginResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *LoginResp) sizeField2() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetToken())
+	return n
+}
+
+func (x *LoginResp) sizeField3() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetRefreshToken())
+	return n
+}
+
+func (x *LogoutReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *LogoutReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *LogoutResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *LogoutResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *DeleteReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *DeleteResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DeleteResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *DeleteResp) sizeField2() (n int) {
+	if x.EraseAfter == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetEraseAfter())
+	return n
+}
+
+func (x *CancelDeleteReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *CancelDeleteReq) sizeField1() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetUsername())
+	return n
+}
+
+func (x *CancelDeleteReq) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPassword())
+	return n
+}
+
+func (x *CancelDeleteResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CancelDeleteResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *UpdateReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *UpdateReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *UpdateReq) sizeField2() (n int) {
+	if x.NewUsername == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetNewUsername())
+	return n
+}
+
+func (x *UpdateReq) sizeField3() (n int) {
+	if x.NewEmail == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetNewEmail())
+	return n
+}
+
+func (x *UpdateReq) sizeField4() (n int) {
+	if x.NewPhone == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetNewPhone())
+	return n
+}
+
+func (x *UpdateResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *UpdateResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *InfoReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *InfoReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *InfoResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *InfoResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *InfoResp) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *InfoResp) sizeField3() (n int) {
+	if x.Username == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetUsername())
+	return n
+}
+
+func (x *InfoResp) sizeField4() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetEmail())
+	return n
+}
+
+func (x *InfoResp) sizeField5() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetPhone())
+	return n
+}
+
+func (x *InfoResp) sizeField6() (n int) {
+	if x.AvatarUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetAvatarUrl())
+	return n
+}
+
+func (x *InfoResp) sizeField7() (n int) {
+	if x.AvatarThumbnails == nil {
+		return n
+	}
+	for k, v := range x.GetAvatarThumbnails() {
+		n += fastpb.SizeMapEntry(7,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeInt32(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *Address) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	return n
+}
+
+func (x *Address) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *Address) sizeField2() (n int) {
+	if x.Label == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetLabel())
+	return n
+}
+
+func (x *Address) sizeField3() (n int) {
+	if x.RecipientName == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetRecipientName())
+	return n
+}
+
+func (x *Address) sizeField4() (n int) {
+	if x.RecipientPhone == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetRecipientPhone())
+	return n
+}
+
+func (x *Address) sizeField5() (n int) {
+	if x.StreetAddress == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetStreetAddress())
+	return n
+}
+
+func (x *Address) sizeField6() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetCity())
+	return n
+}
+
+func (x *Address) sizeField7() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetState())
+	return n
+}
+
+func (x *Address) sizeField8() (n int) {
+	if x.Country == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetCountry())
+	return n
+}
+
+func (x *Address) sizeField9() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetZipCode())
+	return n
+}
+
+func (x *Address) sizeField10() (n int) {
+	if !x.IsDefault {
+		return n
+	}
+	n += fastpb.SizeBool(10, x.GetIsDefault())
+	return n
+}
+
+func (x *ListAddressesReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListAddressesReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ListAddressesReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *ListAddressesResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListAddressesResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ListAddressesResp) sizeField2() (n int) {
+	if x.Addresses == nil {
+		return n
+	}
+	for i := range x.GetAddresses() {
+		n += fastpb.SizeMessage(2, x.GetAddresses()[i])
+	}
+	return n
+}
+
+func (x *GetAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *GetAddressReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *GetAddressReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *GetAddressReq) sizeField3() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetAddressId())
+	return n
+}
+
+func (x *GetAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetAddressResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *GetAddressResp) sizeField2() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetAddress())
+	return n
+}
+
+func (x *CreateAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	return n
+}
+
+func (x *CreateAddressReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField3() (n int) {
+	if x.Label == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetLabel())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField4() (n int) {
+	if x.RecipientName == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetRecipientName())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField5() (n int) {
+	if x.RecipientPhone == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetRecipientPhone())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField6() (n int) {
+	if x.StreetAddress == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetStreetAddress())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField7() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetCity())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField8() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetState())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField9() (n int) {
+	if x.Country == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetCountry())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField10() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(10, x.GetZipCode())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField11() (n int) {
+	if !x.IsDefault {
+		return n
+	}
+	n += fastpb.SizeBool(11, x.GetIsDefault())
+	return n
+}
+
+func (x *CreateAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *CreateAddressResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *CreateAddressResp) sizeField2() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetAddress())
+	return n
+}
+
+func (x *UpdateAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField3() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetAddressId())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField4() (n int) {
+	if x.Label == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetLabel())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField5() (n int) {
+	if x.RecipientName == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetRecipientName())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField6() (n int) {
+	if x.RecipientPhone == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetRecipientPhone())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField7() (n int) {
+	if x.StreetAddress == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetStreetAddress())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField8() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetCity())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField9() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetState())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField10() (n int) {
+	if x.Country == "" {
+		return n
+	}
+	n += fastpb.SizeString(10, x.GetCountry())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField11() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(11, x.GetZipCode())
+	return n
+}
+
+func (x *UpdateAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *UpdateAddressResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *UpdateAddressResp) sizeField2() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetAddress())
+	return n
+}
+
+func (x *DeleteAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *DeleteAddressReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *DeleteAddressReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *DeleteAddressReq) sizeField3() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetAddressId())
+	return n
+}
+
+func (x *DeleteAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteAddressResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *SetDefaultAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *SetDefaultAddressReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *SetDefaultAddressReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *SetDefaultAddressReq) sizeField3() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetAddressId())
+	return n
+}
+
+func (x *SetDefaultAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SetDefaultAddressResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *RequestExportReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RequestExportReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *RequestExportReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *RequestExportResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RequestExportResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *RequestExportResp) sizeField2() (n int) {
+	if x.ExportId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetExportId())
+	return n
+}
+
+func (x *GetExportReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *GetExportReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *GetExportReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *GetExportReq) sizeField3() (n int) {
+	if x.ExportId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetExportId())
+	return n
+}
+
+func (x *GetExportResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *GetExportResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *GetExportResp) sizeField2() (n int) {
+	if x.ExportId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetExportId())
+	return n
+}
+
+func (x *GetExportResp) sizeField3() (n int) {
+	if !x.Ready {
+		return n
+	}
+	n += fastpb.SizeBool(3, x.GetReady())
+	return n
+}
+
+func (x *GetExportResp) sizeField4() (n int) {
+	if len(x.Pending) == 0 {
+		return n
+	}
+	for i := range x.GetPending() {
+		n += fastpb.SizeString(4, x.GetPending()[i])
+	}
+	return n
+}
+
+func (x *GetExportResp) sizeField5() (n int) {
+	if x.RequestedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetRequestedAt())
+	return n
+}
+
+func (x *UploadAvatarReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *UploadAvatarReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *UploadAvatarReq) sizeField2() (n int) {
+	if len(x.Image) == 0 {
+		return n
+	}
+	n += fastpb.SizeBytes(2, x.GetImage())
+	return n
+}
+
+func (x *UploadAvatarResp) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *LoginResp) fastWriteField1(buf []byte) (offset int) {
+func (x *UploadAvatarResp) sizeField1() (n int) {
 	if x.Base == nil {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
 }
 
-func (x *LoginResp) fastWriteField2(buf []byte) (offset int) {
+func (x *UploadAvatarResp) sizeField2() (n int) {
+	if x.AvatarUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetAvatarUrl())
+	return n
+}
+
+func (x *UploadAvatarResp) sizeField3() (n int) {
+	if x.AvatarThumbnails == nil {
+		return n
+	}
+	for k, v := range x.GetAvatarThumbnails() {
+		n += fastpb.SizeMapEntry(3,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeInt32(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *DeleteAvatarReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteAvatarReq) sizeField1() (n int) {
 	if x.Token == "" {
-		return offset
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *DeleteAvatarResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteAvatarResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *PointsSummary) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *PointsSummary) sizeField1() (n int) {
+	if x.Balance == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetBalance())
+	return n
+}
+
+func (x *PointsSummary) sizeField2() (n int) {
+	if x.Tier == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetTier())
+	return n
+}
+
+func (x *PointsSummary) sizeField3() (n int) {
+	if x.RollingSpend == 0 {
+		return n
+	}
+	n += fastpb.SizeDouble(3, x.GetRollingSpend())
+	return n
+}
+
+func (x *PointsSummary) sizeField4() (n int) {
+	if x.NextTier == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetNextTier())
+	return n
+}
+
+func (x *PointsSummary) sizeField5() (n int) {
+	if x.NextTierSpend == 0 {
+		return n
+	}
+	n += fastpb.SizeDouble(5, x.GetNextTierSpend())
+	return n
+}
+
+func (x *PointsSummary) sizeField6() (n int) {
+	if x.ExpiringPoints == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(6, x.GetExpiringPoints())
+	return n
+}
+
+func (x *PointsSummary) sizeField7() (n int) {
+	if x.ExpiringAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(7, x.GetExpiringAt())
+	return n
+}
+
+func (x *GetPointsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetPointsReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *GetPointsReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *GetPointsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetPointsResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *GetPointsResp) sizeField2() (n int) {
+	if x.Points == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetPoints())
+	return n
+}
+
+func (x *PointsEntry) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
+}
+
+func (x *PointsEntry) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *PointsEntry) sizeField2() (n int) {
+	if x.Kind == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetKind())
+	return n
+}
+
+func (x *PointsEntry) sizeField3() (n int) {
+	if x.Points == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetPoints())
+	return n
+}
+
+func (x *PointsEntry) sizeField4() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetOrderId())
+	return n
+}
+
+func (x *PointsEntry) sizeField5() (n int) {
+	if x.CreatedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetCreatedAt())
+	return n
+}
+
+func (x *PointsEntry) sizeField6() (n int) {
+	if x.ExpiresAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(6, x.GetExpiresAt())
+	return n
+}
+
+func (x *ListPointsHistoryReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *ListPointsHistoryReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ListPointsHistoryReq) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *ListPointsHistoryReq) sizeField3() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetPage())
+	return n
+}
+
+func (x *ListPointsHistoryReq) sizeField4() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetPageSize())
+	return n
+}
+
+func (x *ListPointsHistoryResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ListPointsHistoryResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *ListPointsHistoryResp) sizeField2() (n int) {
+	if x.Entries == nil {
+		return n
+	}
+	for i := range x.GetEntries() {
+		n += fastpb.SizeMessage(2, x.GetEntries()[i])
+	}
+	return n
+}
+
+func (x *ListPointsHistoryResp) sizeField3() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetTotal())
+	return n
+}
+
+func (x *RedeemPointsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *RedeemPointsReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetToken())
-	return offset
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
 }
 
-func (x *LoginResp) fastWriteField3(buf []byte) (offset int) {
-	if x.RefreshToken == "" {
-		return offset
+func (x *RedeemPointsReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetRefreshToken())
-	return offset
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
 }
 
-func (x *LogoutReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *RedeemPointsReq) sizeField3() (n int) {
+	if x.Points == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeInt64(3, x.GetPoints())
+	return n
 }
 
-func (x *LogoutReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *RedeemPointsReq) sizeField4() (n int) {
+	if x.MaxDiscount == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeDouble(4, x.GetMaxDiscount())
+	return n
 }
 
-func (x *LogoutResp) FastWrite(buf []byte) (offset int) {
+func (x *RedeemPointsResp) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *LogoutResp) fastWriteField1(buf []byte) (offset int) {
+func (x *RedeemPointsResp) sizeField1() (n int) {
 	if x.Base == nil {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
 }
 
-func (x *DeleteReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *RedeemPointsResp) sizeField2() (n int) {
+	if x.Points == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeInt64(2, x.GetPoints())
+	return n
 }
 
-func (x *DeleteReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *RedeemPointsResp) sizeField3() (n int) {
+	if x.Discount == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeDouble(3, x.GetDiscount())
+	return n
 }
 
-func (x *DeleteResp) FastWrite(buf []byte) (offset int) {
+func (x *CancelRedemptionReq) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
 }
 
-func (x *DeleteResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
+func (x *CancelRedemptionReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
 }
 
-func (x *UpdateReq) FastWrite(buf []byte) (offset int) {
+func (x *CancelRedemptionReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *CancelRedemptionResp) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	return n
 }
 
-func (x *UpdateReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *CancelRedemptionResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
 }
 
-func (x *UpdateReq) fastWriteField2(buf []byte) (offset int) {
-	if x.NewUsername == "" {
-		return offset
+func (x *WishlistItem) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetNewUsername())
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	return n
 }
 
-func (x *UpdateReq) fastWriteField3(buf []byte) (offset int) {
-	if x.NewEmail == "" {
-		return offset
+func (x *WishlistItem) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetNewEmail())
-	return offset
+	n += fastpb.SizeUint32(1, x.GetProductId())
+	return n
 }
 
-func (x *UpdateReq) fastWriteField4(buf []byte) (offset int) {
-	if x.NewPhone == "" {
-		return offset
+func (x *WishlistItem) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetNewPhone())
-	return offset
+	n += fastpb.SizeString(2, x.GetName())
+	return n
 }
 
-func (x *UpdateResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *WishlistItem) sizeField3() (n int) {
+	if x.Picture == "" {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeString(3, x.GetPicture())
+	return n
 }
 
-func (x *UpdateResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
+func (x *WishlistItem) sizeField4() (n int) {
+	if x.SavedPrice == 0 {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeDouble(4, x.GetSavedPrice())
+	return n
 }
 
-func (x *InfoReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *WishlistItem) sizeField5() (n int) {
+	if x.Price == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeDouble(5, x.GetPrice())
+	return n
 }
 
-func (x *InfoReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Token == "" {
-		return offset
+func (x *WishlistItem) sizeField6() (n int) {
+	if x.Stock == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
-	return offset
+	n += fastpb.SizeUint32(6, x.GetStock())
+	return n
 }
 
-func (x *InfoResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *WishlistItem) sizeField7() (n int) {
+	if !x.Available {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	return offset
+	n += fastpb.SizeBool(7, x.GetAvailable())
+	return n
 }
 
-func (x *InfoResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Base == nil {
-		return offset
+func (x *WishlistItem) sizeField8() (n int) {
+	if !x.NotifyPriceDrop {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBase())
-	return offset
+	n += fastpb.SizeBool(8, x.GetNotifyPriceDrop())
+	return n
 }
 
-func (x *InfoResp) fastWriteField2(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *WishlistItem) sizeField9() (n int) {
+	if !x.NotifyBackInStock {
+		return n
 	}
-	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
-	return offset
+	n += fastpb.SizeBool(9, x.GetNotifyBackInStock())
+	return n
 }
 
-func (x *InfoResp) fastWriteField3(buf []byte) (offset int) {
-	if x.Username == "" {
-		return offset
+func (x *WishlistItem) sizeField10() (n int) {
+	if x.AddedAt == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetUsername())
-	return offset
+	n += fastpb.SizeInt64(10, x.GetAddedAt())
+	return n
 }
 
-func (x *InfoResp) fastWriteField4(buf []byte) (offset int) {
-	if x.Email == "" {
-		return offset
+func (x *Wishlist) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetEmail())
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
 }
 
-func (x *InfoResp) fastWriteField5(buf []byte) (offset int) {
-	if x.Phone == "" {
-		return offset
+func (x *Wishlist) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 5, x.GetPhone())
-	return offset
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
 }
 
-func (x *BaseResp) Size() (n int) {
-	if x == nil {
+func (x *Wishlist) sizeField2() (n int) {
+	if x.Name == "" {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
+	n += fastpb.SizeString(2, x.GetName())
 	return n
 }
 
-func (x *BaseResp) sizeField1() (n int) {
-	if x.Code == 0 {
+func (x *Wishlist) sizeField3() (n int) {
+	if x.Items == nil {
 		return n
 	}
-	n += fastpb.SizeInt32(1, x.GetCode())
+	for i := range x.GetItems() {
+		n += fastpb.SizeMessage(3, x.GetItems()[i])
+	}
 	return n
 }
 
-func (x *BaseResp) sizeField2() (n int) {
-	if x.Message == "" {
+func (x *Wishlist) sizeField4() (n int) {
+	if x.CreatedAt == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetMessage())
+	n += fastpb.SizeInt64(4, x.GetCreatedAt())
 	return n
 }
 
-func (x *RegisterReq) Size() (n int) {
+func (x *ListWishlistsReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
 	return n
 }
 
-func (x *RegisterReq) sizeField1() (n int) {
-	if x.Username == "" {
+func (x *ListWishlistsReq) sizeField1() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetUsername())
+	n += fastpb.SizeString(1, x.GetToken())
 	return n
 }
 
-func (x *RegisterReq) sizeField2() (n int) {
-	if x.Password == "" {
+func (x *ListWishlistsReq) sizeField2() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetPassword())
+	n += fastpb.SizeInt64(2, x.GetUserId())
 	return n
 }
 
-func (x *RegisterReq) sizeField3() (n int) {
-	if x.Email == "" {
+func (x *ListWishlistsResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetEmail())
+	n += x.sizeField1()
+	n += x.sizeField2()
 	return n
 }
 
-func (x *RegisterReq) sizeField4() (n int) {
-	if x.Phone == "" {
+func (x *ListWishlistsResp) sizeField1() (n int) {
+	if x.Base == nil {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetPhone())
+	n += fastpb.SizeMessage(1, x.GetBase())
 	return n
 }
 
-func (x *RegisterResp) Size() (n int) {
+func (x *ListWishlistsResp) sizeField2() (n int) {
+	if x.Wishlists == nil {
+		return n
+	}
+	for i := range x.GetWishlists() {
+		n += fastpb.SizeMessage(2, x.GetWishlists()[i])
+	}
+	return n
+}
+
+func (x *AddWishlistItemReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
 	return n
 }
 
-func (x *RegisterResp) sizeField1() (n int) {
-	if x.Base == nil {
+func (x *AddWishlistItemReq) sizeField1() (n int) {
+	if x.Token == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(1, x.GetBase())
+	n += fastpb.SizeString(1, x.GetToken())
 	return n
 }
 
-func (x *RegisterResp) sizeField2() (n int) {
+func (x *AddWishlistItemReq) sizeField2() (n int) {
 	if x.UserId == 0 {
 		return n
 	}
@@ -974,50 +6866,48 @@ func (x *RegisterResp) sizeField2() (n int) {
 	return n
 }
 
-func (x *RegisterResp) sizeField3() (n int) {
-	if x.Token == "" {
+func (x *AddWishlistItemReq) sizeField3() (n int) {
+	if x.Wishlist == "" {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetToken())
+	n += fastpb.SizeString(3, x.GetWishlist())
 	return n
 }
 
-func (x *LoginReq) Size() (n int) {
-	if x == nil {
+func (x *AddWishlistItemReq) sizeField4() (n int) {
+	if x.ProductId == 0 {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
+	n += fastpb.SizeUint32(4, x.GetProductId())
 	return n
 }
 
-func (x *LoginReq) sizeField1() (n int) {
-	if x.Username == "" {
+func (x *AddWishlistItemReq) sizeField5() (n int) {
+	if !x.NotifyPriceDrop {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetUsername())
+	n += fastpb.SizeBool(5, x.GetNotifyPriceDrop())
 	return n
 }
 
-func (x *LoginReq) sizeField2() (n int) {
-	if x.Password == "" {
+func (x *AddWishlistItemReq) sizeField6() (n int) {
+	if !x.NotifyBackInStock {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetPassword())
+	n += fastpb.SizeBool(6, x.GetNotifyBackInStock())
 	return n
 }
 
-func (x *LoginResp) Size() (n int) {
+func (x *AddWishlistItemResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
-	n += x.sizeField3()
 	return n
 }
 
-func (x *LoginResp) sizeField1() (n int) {
+func (x *AddWishlistItemResp) sizeField1() (n int) {
 	if x.Base == nil {
 		return n
 	}
@@ -1025,31 +6915,26 @@ func (x *LoginResp) sizeField1() (n int) {
 	return n
 }
 
-func (x *LoginResp) sizeField2() (n int) {
-	if x.Token == "" {
-		return n
-	}
-	n += fastpb.SizeString(2, x.GetToken())
-	return n
-}
-
-func (x *LoginResp) sizeField3() (n int) {
-	if x.RefreshToken == "" {
+func (x *AddWishlistItemResp) sizeField2() (n int) {
+	if x.Item == nil {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetRefreshToken())
+	n += fastpb.SizeMessage(2, x.GetItem())
 	return n
 }
 
-func (x *LogoutReq) Size() (n int) {
+func (x *RemoveWishlistItemReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
 	return n
 }
 
-func (x *LogoutReq) sizeField1() (n int) {
+func (x *RemoveWishlistItemReq) sizeField1() (n int) {
 	if x.Token == "" {
 		return n
 	}
@@ -1057,39 +6942,31 @@ func (x *LogoutReq) sizeField1() (n int) {
 	return n
 }
 
-func (x *LogoutResp) Size() (n int) {
-	if x == nil {
-		return n
-	}
-	n += x.sizeField1()
-	return n
-}
-
-func (x *LogoutResp) sizeField1() (n int) {
-	if x.Base == nil {
+func (x *RemoveWishlistItemReq) sizeField2() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	n += fastpb.SizeMessage(1, x.GetBase())
+	n += fastpb.SizeInt64(2, x.GetUserId())
 	return n
 }
 
-func (x *DeleteReq) Size() (n int) {
-	if x == nil {
+func (x *RemoveWishlistItemReq) sizeField3() (n int) {
+	if x.Wishlist == "" {
 		return n
 	}
-	n += x.sizeField1()
+	n += fastpb.SizeString(3, x.GetWishlist())
 	return n
 }
 
-func (x *DeleteReq) sizeField1() (n int) {
-	if x.Token == "" {
+func (x *RemoveWishlistItemReq) sizeField4() (n int) {
+	if x.ProductId == 0 {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetToken())
+	n += fastpb.SizeUint32(4, x.GetProductId())
 	return n
 }
 
-func (x *DeleteResp) Size() (n int) {
+func (x *RemoveWishlistItemResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1097,7 +6974,7 @@ func (x *DeleteResp) Size() (n int) {
 	return n
 }
 
-func (x *DeleteResp) sizeField1() (n int) {
+func (x *RemoveWishlistItemResp) sizeField1() (n int) {
 	if x.Base == nil {
 		return n
 	}
@@ -1105,18 +6982,17 @@ func (x *DeleteResp) sizeField1() (n int) {
 	return n
 }
 
-func (x *UpdateReq) Size() (n int) {
+func (x *DeleteWishlistReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
 	n += x.sizeField3()
-	n += x.sizeField4()
 	return n
 }
 
-func (x *UpdateReq) sizeField1() (n int) {
+func (x *DeleteWishlistReq) sizeField1() (n int) {
 	if x.Token == "" {
 		return n
 	}
@@ -1124,31 +7000,23 @@ func (x *UpdateReq) sizeField1() (n int) {
 	return n
 }
 
-func (x *UpdateReq) sizeField2() (n int) {
-	if x.NewUsername == "" {
-		return n
-	}
-	n += fastpb.SizeString(2, x.GetNewUsername())
-	return n
-}
-
-func (x *UpdateReq) sizeField3() (n int) {
-	if x.NewEmail == "" {
+func (x *DeleteWishlistReq) sizeField2() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetNewEmail())
+	n += fastpb.SizeInt64(2, x.GetUserId())
 	return n
 }
 
-func (x *UpdateReq) sizeField4() (n int) {
-	if x.NewPhone == "" {
+func (x *DeleteWishlistReq) sizeField3() (n int) {
+	if x.Wishlist == "" {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetNewPhone())
+	n += fastpb.SizeString(3, x.GetWishlist())
 	return n
 }
 
-func (x *UpdateResp) Size() (n int) {
+func (x *DeleteWishlistResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -1156,7 +7024,7 @@ func (x *UpdateResp) Size() (n int) {
 	return n
 }
 
-func (x *UpdateResp) sizeField1() (n int) {
+func (x *DeleteWishlistResp) sizeField1() (n int) {
 	if x.Base == nil {
 		return n
 	}
@@ -1164,15 +7032,20 @@ func (x *UpdateResp) sizeField1() (n int) {
 	return n
 }
 
-func (x *InfoReq) Size() (n int) {
+func (x *SubscribeWishlistItemReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
 	return n
 }
 
-func (x *InfoReq) sizeField1() (n int) {
+func (x *SubscribeWishlistItemReq) sizeField1() (n int) {
 	if x.Token == "" {
 		return n
 	}
@@ -1180,55 +7053,68 @@ func (x *InfoReq) sizeField1() (n int) {
 	return n
 }
 
-func (x *InfoResp) Size() (n int) {
-	if x == nil {
+func (x *SubscribeWishlistItemReq) sizeField2() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
-	n += x.sizeField5()
+	n += fastpb.SizeInt64(2, x.GetUserId())
 	return n
 }
 
-func (x *InfoResp) sizeField1() (n int) {
-	if x.Base == nil {
+func (x *SubscribeWishlistItemReq) sizeField3() (n int) {
+	if x.Wishlist == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(1, x.GetBase())
+	n += fastpb.SizeString(3, x.GetWishlist())
 	return n
 }
 
-func (x *InfoResp) sizeField2() (n int) {
-	if x.UserId == 0 {
+func (x *SubscribeWishlistItemReq) sizeField4() (n int) {
+	if x.ProductId == 0 {
 		return n
 	}
-	n += fastpb.SizeInt64(2, x.GetUserId())
+	n += fastpb.SizeUint32(4, x.GetProductId())
 	return n
 }
 
-func (x *InfoResp) sizeField3() (n int) {
-	if x.Username == "" {
+func (x *SubscribeWishlistItemReq) sizeField5() (n int) {
+	if !x.NotifyPriceDrop {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetUsername())
+	n += fastpb.SizeBool(5, x.GetNotifyPriceDrop())
 	return n
 }
 
-func (x *InfoResp) sizeField4() (n int) {
-	if x.Email == "" {
+func (x *SubscribeWishlistItemReq) sizeField6() (n int) {
+	if !x.NotifyBackInStock {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetEmail())
+	n += fastpb.SizeBool(6, x.GetNotifyBackInStock())
 	return n
 }
 
-func (x *InfoResp) sizeField5() (n int) {
-	if x.Phone == "" {
+func (x *SubscribeWishlistItemResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(5, x.GetPhone())
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *SubscribeWishlistItemResp) sizeField1() (n int) {
+	if x.Base == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBase())
+	return n
+}
+
+func (x *SubscribeWishlistItemResp) sizeField2() (n int) {
+	if x.Item == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetItem())
 	return n
 }
 
@@ -1275,6 +7161,16 @@ var fieldIDToName_DeleteReq = map[int32]string{
 
 var fieldIDToName_DeleteResp = map[int32]string{
 	1: "Base",
+	2: "EraseAfter",
+}
+
+var fieldIDToName_CancelDeleteReq = map[int32]string{
+	1: "Username",
+	2: "Password",
+}
+
+var fieldIDToName_CancelDeleteResp = map[int32]string{
+	1: "Base",
 }
 
 var fieldIDToName_UpdateReq = map[int32]string{
@@ -1298,6 +7194,286 @@ var fieldIDToName_InfoResp = map[int32]string{
 	3: "Username",
 	4: "Email",
 	5: "Phone",
+	6: "AvatarUrl",
+	7: "AvatarThumbnails",
+}
+
+var fieldIDToName_Address = map[int32]string{
+	1:  "Id",
+	2:  "Label",
+	3:  "RecipientName",
+	4:  "RecipientPhone",
+	5:  "StreetAddress",
+	6:  "City",
+	7:  "State",
+	8:  "Country",
+	9:  "ZipCode",
+	10: "IsDefault",
+}
+
+var fieldIDToName_ListAddressesReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+}
+
+var fieldIDToName_ListAddressesResp = map[int32]string{
+	1: "Base",
+	2: "Addresses",
+}
+
+var fieldIDToName_GetAddressReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "AddressId",
+}
+
+var fieldIDToName_GetAddressResp = map[int32]string{
+	1: "Base",
+	2: "Address",
+}
+
+var fieldIDToName_CreateAddressReq = map[int32]string{
+	1:  "Token",
+	2:  "UserId",
+	3:  "Label",
+	4:  "RecipientName",
+	5:  "RecipientPhone",
+	6:  "StreetAddress",
+	7:  "City",
+	8:  "State",
+	9:  "Country",
+	10: "ZipCode",
+	11: "IsDefault",
+}
+
+var fieldIDToName_CreateAddressResp = map[int32]string{
+	1: "Base",
+	2: "Address",
+}
+
+var fieldIDToName_UpdateAddressReq = map[int32]string{
+	1:  "Token",
+	2:  "UserId",
+	3:  "AddressId",
+	4:  "Label",
+	5:  "RecipientName",
+	6:  "RecipientPhone",
+	7:  "StreetAddress",
+	8:  "City",
+	9:  "State",
+	10: "Country",
+	11: "ZipCode",
+}
+
+var fieldIDToName_UpdateAddressResp = map[int32]string{
+	1: "Base",
+	2: "Address",
+}
+
+var fieldIDToName_DeleteAddressReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "AddressId",
+}
+
+var fieldIDToName_DeleteAddressResp = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_SetDefaultAddressReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "AddressId",
+}
+
+var fieldIDToName_SetDefaultAddressResp = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_RequestExportReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+}
+
+var fieldIDToName_RequestExportResp = map[int32]string{
+	1: "Base",
+	2: "ExportId",
+}
+
+var fieldIDToName_GetExportReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "ExportId",
+}
+
+var fieldIDToName_GetExportResp = map[int32]string{
+	1: "Base",
+	2: "ExportId",
+	3: "Ready",
+	4: "Pending",
+	5: "RequestedAt",
+}
+
+var fieldIDToName_UploadAvatarReq = map[int32]string{
+	1: "Token",
+	2: "Image",
+}
+
+var fieldIDToName_UploadAvatarResp = map[int32]string{
+	1: "Base",
+	2: "AvatarUrl",
+	3: "AvatarThumbnails",
+}
+
+var fieldIDToName_DeleteAvatarReq = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_DeleteAvatarResp = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_PointsSummary = map[int32]string{
+	1: "Balance",
+	2: "Tier",
+	3: "RollingSpend",
+	4: "NextTier",
+	5: "NextTierSpend",
+	6: "ExpiringPoints",
+	7: "ExpiringAt",
+}
+
+var fieldIDToName_GetPointsReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+}
+
+var fieldIDToName_GetPointsResp = map[int32]string{
+	1: "Base",
+	2: "Points",
+}
+
+var fieldIDToName_PointsEntry = map[int32]string{
+	1: "Id",
+	2: "Kind",
+	3: "Points",
+	4: "OrderId",
+	5: "CreatedAt",
+	6: "ExpiresAt",
+}
+
+var fieldIDToName_ListPointsHistoryReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Page",
+	4: "PageSize",
+}
+
+var fieldIDToName_ListPointsHistoryResp = map[int32]string{
+	1: "Base",
+	2: "Entries",
+	3: "Total",
+}
+
+var fieldIDToName_RedeemPointsReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+	3: "Points",
+	4: "MaxDiscount",
+}
+
+var fieldIDToName_RedeemPointsResp = map[int32]string{
+	1: "Base",
+	2: "Points",
+	3: "Discount",
+}
+
+var fieldIDToName_CancelRedemptionReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+}
+
+var fieldIDToName_CancelRedemptionResp = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_WishlistItem = map[int32]string{
+	1:  "ProductId",
+	2:  "Name",
+	3:  "Picture",
+	4:  "SavedPrice",
+	5:  "Price",
+	6:  "Stock",
+	7:  "Available",
+	8:  "NotifyPriceDrop",
+	9:  "NotifyBackInStock",
+	10: "AddedAt",
+}
+
+var fieldIDToName_Wishlist = map[int32]string{
+	1: "Id",
+	2: "Name",
+	3: "Items",
+	4: "CreatedAt",
+}
+
+var fieldIDToName_ListWishlistsReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+}
+
+var fieldIDToName_ListWishlistsResp = map[int32]string{
+	1: "Base",
+	2: "Wishlists",
+}
+
+var fieldIDToName_AddWishlistItemReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Wishlist",
+	4: "ProductId",
+	5: "NotifyPriceDrop",
+	6: "NotifyBackInStock",
+}
+
+var fieldIDToName_AddWishlistItemResp = map[int32]string{
+	1: "Base",
+	2: "Item",
+}
+
+var fieldIDToName_RemoveWishlistItemReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Wishlist",
+	4: "ProductId",
+}
+
+var fieldIDToName_RemoveWishlistItemResp = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_DeleteWishlistReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Wishlist",
+}
+
+var fieldIDToName_DeleteWishlistResp = map[int32]string{
+	1: "Base",
+}
+
+var fieldIDToName_SubscribeWishlistItemReq = map[int32]string{
+	1: "Token",
+	2: "UserId",
+	3: "Wishlist",
+	4: "ProductId",
+	5: "NotifyPriceDrop",
+	6: "NotifyBackInStock",
+}
+
+var fieldIDToName_SubscribeWishlistItemResp = map[int32]string{
+	1: "Base",
+	2: "Item",
 }
 
 var _ = api.File_api_proto
@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.9.1. DO NOT EDIT.
+// Code generated by Kitex v0.12.1. DO NOT EDIT.
 
 package userservice
 
@@ -15,8 +15,28 @@ type Client interface {
 	Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
 	Logout(ctx context.Context, Req *user.LogoutReq, callOptions ...callopt.Option) (r *user.LogoutResp, err error)
 	Delete(ctx context.Context, Req *user.DeleteReq, callOptions ...callopt.Option) (r *user.DeleteResp, err error)
+	CancelDelete(ctx context.Context, Req *user.CancelDeleteReq, callOptions ...callopt.Option) (r *user.CancelDeleteResp, err error)
 	Update(ctx context.Context, Req *user.UpdateReq, callOptions ...callopt.Option) (r *user.UpdateResp, err error)
 	Info(ctx context.Context, Req *user.InfoReq, callOptions ...callopt.Option) (r *user.InfoResp, err error)
+	ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error)
+	GetAddress(ctx context.Context, Req *user.GetAddressReq, callOptions ...callopt.Option) (r *user.GetAddressResp, err error)
+	CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error)
+	UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error)
+	DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error)
+	SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error)
+	RequestExport(ctx context.Context, Req *user.RequestExportReq, callOptions ...callopt.Option) (r *user.RequestExportResp, err error)
+	GetExport(ctx context.Context, Req *user.GetExportReq, callOptions ...callopt.Option) (r *user.GetExportResp, err error)
+	UploadAvatar(ctx context.Context, Req *user.UploadAvatarReq, callOptions ...callopt.Option) (r *user.UploadAvatarResp, err error)
+	DeleteAvatar(ctx context.Context, Req *user.DeleteAvatarReq, callOptions ...callopt.Option) (r *user.DeleteAvatarResp, err error)
+	GetPoints(ctx context.Context, Req *user.GetPointsReq, callOptions ...callopt.Option) (r *user.GetPointsResp, err error)
+	ListPointsHistory(ctx context.Context, Req *user.ListPointsHistoryReq, callOptions ...callopt.Option) (r *user.ListPointsHistoryResp, err error)
+	RedeemPoints(ctx context.Context, Req *user.RedeemPointsReq, callOptions ...callopt.Option) (r *user.RedeemPointsResp, err error)
+	CancelRedemption(ctx context.Context, Req *user.CancelRedemptionReq, callOptions ...callopt.Option) (r *user.CancelRedemptionResp, err error)
+	ListWishlists(ctx context.Context, Req *user.ListWishlistsReq, callOptions ...callopt.Option) (r *user.ListWishlistsResp, err error)
+	AddWishlistItem(ctx context.Context, Req *user.AddWishlistItemReq, callOptions ...callopt.Option) (r *user.AddWishlistItemResp, err error)
+	RemoveWishlistItem(ctx context.Context, Req *user.RemoveWishlistItemReq, callOptions ...callopt.Option) (r *user.RemoveWishlistItemResp, err error)
+	DeleteWishlist(ctx context.Context, Req *user.DeleteWishlistReq, callOptions ...callopt.Option) (r *user.DeleteWishlistResp, err error)
+	SubscribeWishlistItem(ctx context.Context, Req *user.SubscribeWishlistItemReq, callOptions ...callopt.Option) (r *user.SubscribeWishlistItemResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -68,6 +88,11 @@ func (p *kUserServiceClient) Delete(ctx context.Context, Req *user.DeleteReq, ca
 	return p.kClient.Delete(ctx, Req)
 }
 
+func (p *kUserServiceClient) CancelDelete(ctx context.Context, Req *user.CancelDeleteReq, callOptions ...callopt.Option) (r *user.CancelDeleteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelDelete(ctx, Req)
+}
+
 func (p *kUserServiceClient) Update(ctx context.Context, Req *user.UpdateReq, callOptions ...callopt.Option) (r *user.UpdateResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Update(ctx, Req)
@@ -77,3 +102,98 @@ func (p *kUserServiceClient) Info(ctx context.Context, Req *user.InfoReq, callOp
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Info(ctx, Req)
 }
+
+func (p *kUserServiceClient) ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAddresses(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetAddress(ctx context.Context, Req *user.GetAddressReq, callOptions ...callopt.Option) (r *user.GetAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetDefaultAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) RequestExport(ctx context.Context, Req *user.RequestExportReq, callOptions ...callopt.Option) (r *user.RequestExportResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestExport(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetExport(ctx context.Context, Req *user.GetExportReq, callOptions ...callopt.Option) (r *user.GetExportResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetExport(ctx, Req)
+}
+
+func (p *kUserServiceClient) UploadAvatar(ctx context.Context, Req *user.UploadAvatarReq, callOptions ...callopt.Option) (r *user.UploadAvatarResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadAvatar(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteAvatar(ctx context.Context, Req *user.DeleteAvatarReq, callOptions ...callopt.Option) (r *user.DeleteAvatarResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAvatar(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetPoints(ctx context.Context, Req *user.GetPointsReq, callOptions ...callopt.Option) (r *user.GetPointsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPoints(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListPointsHistory(ctx context.Context, Req *user.ListPointsHistoryReq, callOptions ...callopt.Option) (r *user.ListPointsHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPointsHistory(ctx, Req)
+}
+
+func (p *kUserServiceClient) RedeemPoints(ctx context.Context, Req *user.RedeemPointsReq, callOptions ...callopt.Option) (r *user.RedeemPointsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RedeemPoints(ctx, Req)
+}
+
+func (p *kUserServiceClient) CancelRedemption(ctx context.Context, Req *user.CancelRedemptionReq, callOptions ...callopt.Option) (r *user.CancelRedemptionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelRedemption(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListWishlists(ctx context.Context, Req *user.ListWishlistsReq, callOptions ...callopt.Option) (r *user.ListWishlistsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListWishlists(ctx, Req)
+}
+
+func (p *kUserServiceClient) AddWishlistItem(ctx context.Context, Req *user.AddWishlistItemReq, callOptions ...callopt.Option) (r *user.AddWishlistItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddWishlistItem(ctx, Req)
+}
+
+func (p *kUserServiceClient) RemoveWishlistItem(ctx context.Context, Req *user.RemoveWishlistItemReq, callOptions ...callopt.Option) (r *user.RemoveWishlistItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveWishlistItem(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteWishlist(ctx context.Context, Req *user.DeleteWishlistReq, callOptions ...callopt.Option) (r *user.DeleteWishlistResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteWishlist(ctx, Req)
+}
+
+func (p *kUserServiceClient) SubscribeWishlistItem(ctx context.Context, Req *user.SubscribeWishlistItemReq, callOptions ...callopt.Option) (r *user.SubscribeWishlistItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubscribeWishlistItem(ctx, Req)
+}
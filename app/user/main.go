@@ -1,22 +1,42 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"os"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/middlewares/server/recovery"
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/app/server/registry"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
-	"github.com/hertz-contrib/cors"
+	kclient "github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	kserver "github.com/cloudwego/kitex/server"
 
 	"TikTokMall/app/user/biz/dal/mysql"
 	"TikTokMall/app/user/biz/dal/redis"
 	"TikTokMall/app/user/biz/handler"
 	"TikTokMall/app/user/biz/utils"
 	"TikTokMall/app/user/conf"
+	"TikTokMall/app/user/biz/service"
+	"TikTokMall/app/user/kitex_gen/product"
+	"TikTokMall/app/user/kitex_gen/product/productcatalogservice"
+	"TikTokMall/app/user/kitex_gen/user/userservice"
 	"TikTokMall/app/user/pkg/hertz"
+	"TikTokMall/app/user/pkg/kitex"
+	"TikTokMall/app/user/pkg/storage"
 	"TikTokMall/app/user/pkg/tracer"
+	"TikTokMall/pkg/authn"
+	"TikTokMall/pkg/orderevents"
+	"TikTokMall/pkg/password"
+	"TikTokMall/pkg/userdata"
+	"TikTokMall/pkg/websession"
 )
 
 func main() {
@@ -49,6 +69,17 @@ func main() {
 		hlog.Fatalf("init dependencies failed: %v", err)
 	}
 
+	// 启动 RPC 服务，供结算等服务查询收货地址
+	rpcServer, err := newRPCServer()
+	if err != nil {
+		hlog.Fatalf("create rpc server failed: %v", err)
+	}
+	go func() {
+		if err := rpcServer.Run(); err != nil {
+			hlog.Fatalf("start rpc server failed: %v", err)
+		}
+	}()
+
 	// 创建 Consul 注册器
 	r, err := hertz.NewConsulRegister("localhost:8500")
 	if err != nil {
@@ -58,6 +89,8 @@ func main() {
 	// 创建服务器（server）
 	h := server.Default(
 		server.WithHostPorts(":8001"),
+		// 请求体上限需要容纳头像上传的 multipart 表单
+		server.WithMaxRequestBodySize(int(service.Avatars.MaxBytes)+avatarFormOverhead),
 		server.WithRegistry(r, &registry.Info{
 			ServiceName: "user",
 			Addr:        utils.NewNetAddr("tcp", "localhost:8001"),
@@ -69,13 +102,11 @@ func main() {
 		}),
 	)
 
-	// 添加CORS中间件
-	h.Use(cors.New(cors.Config{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		MaxAge:       3600,
-	}))
+	// 添加CORS中间件，只允许配置的前端来源携带 Cookie
+	h.Use(websession.CORS(websession.CORSConfig{AllowOrigins: conf.GetConf().CORS.AllowOrigins}))
+
+	// 浏览器 Cookie 会话：校验 CSRF 令牌并将 Cookie 中的访问令牌转为 Authorization 头
+	h.Use(websession.Middleware())
 
 	// 添加恢复中间件
 	h.Use(recovery.Recovery())
@@ -89,19 +120,139 @@ func main() {
 		v1.POST("/register", userHandler.Register) // 注册账户，同auth服务
 		v1.POST("/login", userHandler.Login)       // 账户登录，同auth服务
 		v1.POST("/logout", userHandler.Logout)     // 账户登出，同auth服务
-		v1.POST("/delete", userHandler.Delete)     // 删除账户
+		v1.POST("/delete", userHandler.Delete)     // 申请注销账户，宽限期后匿名化
 		v1.POST("/update", userHandler.Update)     // 更新账户
 		v1.POST("/info", userHandler.Info)         // 获取账户身份信息
+
+		// 宽限期内撤销注销，使用用户名和密码验证
+		v1.POST("/delete/cancel", userHandler.CancelDelete)
+
+		// 收货地址簿
+		v1.POST("/address/list", userHandler.ListAddresses)
+		v1.POST("/address/get", userHandler.GetAddress)
+		v1.POST("/address/create", userHandler.CreateAddress)
+		v1.POST("/address/update", userHandler.UpdateAddress)
+		v1.POST("/address/delete", userHandler.DeleteAddress)
+		v1.POST("/address/set_default", userHandler.SetDefaultAddress)
+
+		// 个人数据导出
+		v1.POST("/export", userHandler.RequestExport)
+		v1.POST("/export/status", userHandler.GetExport)
+		v1.GET("/export/download", userHandler.DownloadExport)
+
+		// 头像
+		v1.POST("/avatar", userHandler.UploadAvatar)
+		v1.POST("/avatar/delete", userHandler.DeleteAvatar)
+
+		// 积分和会员等级
+		v1.POST("/points", userHandler.GetPoints)
+		v1.POST("/points/history", userHandler.ListPointsHistory)
+
+		// 心愿单和降价、到货提醒
+		v1.POST("/wishlist/list", userHandler.ListWishlists)
+		v1.POST("/wishlist/add", userHandler.AddWishlistItem)
+		v1.POST("/wishlist/remove", userHandler.RemoveWishlistItem)
+		v1.POST("/wishlist/delete", userHandler.DeleteWishlist)
+		v1.POST("/wishlist/subscribe", userHandler.SubscribeWishlistItem)
+
+		// 本地存储的文件由用户服务直接提供，去掉 /v1/user/files 前缀后映射到存储目录
+		if local, ok := service.Avatars.Storage.(*storage.Local); ok {
+			v1.StaticFS("/files", &app.FS{Root: local.Dir, PathRewrite: app.NewPathSlashesStripper(3)})
+		}
 	}
 
+	// 订阅个人数据事件，并定期匿名化宽限期已结束的账号
+	accounts := service.NewAccountService()
+	dataCtx, stopDataJobs := context.WithCancel(context.Background())
+	go func() {
+		if err := userdata.Subscribe(dataCtx, redis.RDB, "user", accounts); err != nil {
+			hlog.Errorf("subscribe userdata events failed: %v", err)
+		}
+	}()
+	go accounts.RunErasure(dataCtx, erasureInterval())
+
+	// 订阅订单状态变化发放和扣回积分，并定期作废到期积分
+	loyalty := service.NewLoyaltyService()
+	go func() {
+		if err := orderevents.Subscribe(dataCtx, redis.RDB, "user", loyalty.HandleOrderEvent); err != nil {
+			hlog.Errorf("subscribe order events failed: %v", err)
+		}
+	}()
+	go loyalty.RunExpiry(dataCtx, pointsExpiryInterval())
+
+	// 定期检查心愿单商品的降价和到货
+	go service.NewWishlistService().RunAlerts(dataCtx, wishlistCheckInterval())
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		stopDataJobs()
+	})
+
 	// 启动服务器
 	if err := h.Run(); err != nil {
 		hlog.Fatalf("start server failed: %v", err)
 	}
 }
 
-// initDeps 初始化依赖：初始化数据库
+// newRPCServer 创建 Kitex 服务并注册到 Consul
+// 查询收货地址、抵扣和退回积分只供结算服务调用，要求结算服务的服务凭证（通过 TTHeader 的 metainfo 传递）
+func newRPCServer() (kserver.Server, error) {
+	addr, err := net.ResolveTCPAddr("tcp", getEnvOrDefault("KITEX_ADDRESS", conf.GetConf().Kitex.Address))
+	if err != nil {
+		return nil, fmt.Errorf("resolve rpc address failed: %v", err)
+	}
+
+	serviceSecret, err := authn.ServiceSecret(conf.GetConf().ServiceAuth.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("init service credential failed: %v", err)
+	}
+
+	r, err := kitex.NewConsulRegister("localhost:8500")
+	if err != nil {
+		return nil, fmt.Errorf("create kitex consul register failed: %v", err)
+	}
+
+	return userservice.NewServer(NewUserServiceImpl(),
+		kserver.WithServiceAddr(addr),
+		kserver.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
+			ServiceName: conf.GetConf().Kitex.Service,
+			Tags:        map[string]string{"protocol": "rpc"},
+		}),
+		kserver.WithRegistry(r),
+		kserver.WithMetaHandler(transmeta.ServerTTHeaderHandler),
+		kserver.WithMiddleware(authn.RequireService(serviceSecret, []string{"checkout"}, "GetAddress", "RedeemPoints", "CancelRedemption")),
+	), nil
+}
+
+// initDeps 初始化依赖：初始化数据库、密码策略、头像存储、积分配置和商品服务客户端
 func initDeps() error {
+	// Initialize password policy
+	policy, err := password.New(newPasswordConfig(conf.GetConf().Password.Policy))
+	if err != nil {
+		return fmt.Errorf("init password policy failed: %v", err)
+	}
+	service.PasswordPolicy = policy
+
+	if d := conf.GetConf().Account.DeletionGracePeriod; d > 0 {
+		service.DeletionGracePeriod = d
+	}
+
+	// Initialize avatar storage
+	if err := initAvatars(conf.GetConf().Avatar); err != nil {
+		return fmt.Errorf("init avatar storage failed: %v", err)
+	}
+
+	// Initialize loyalty points
+	if err := initLoyalty(conf.GetConf().Loyalty); err != nil {
+		return fmt.Errorf("init loyalty failed: %v", err)
+	}
+
+	// Initialize product client for wishlists
+	productRPC := conf.GetConf().ProductRPC
+	productClient, err := productcatalogservice.NewClient(productRPC.Service, kclient.WithHostPorts(productRPC.Address))
+	if err != nil {
+		return fmt.Errorf("create product client failed: %v", err)
+	}
+	service.Wishlists.Catalog = productCatalog{client: productClient}
+
 	// Initialize MySQL
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
 		getEnvOrDefault("MYSQL_USER", "tiktok"),
@@ -126,6 +277,151 @@ func initDeps() error {
 	return nil
 }
 
+// avatarFormOverhead multipart 表单中除头像文件外其他内容的长度上限
+const avatarFormOverhead = 64 << 10
+
+// initAvatars 根据配置创建头像存储，未配置的大小限制和尺寸使用默认值
+func initAvatars(c conf.AvatarConfig) error {
+	if c.MaxSize > 0 {
+		service.Avatars.MaxBytes = c.MaxSize
+	}
+	if c.MaxPixels > 0 {
+		service.Avatars.MaxPixels = c.MaxPixels
+	}
+	if len(c.Sizes) > 0 {
+		sizes := append([]int(nil), c.Sizes...)
+		sort.Ints(sizes)
+		if sizes[0] <= 0 {
+			return fmt.Errorf("invalid avatar size %d", sizes[0])
+		}
+		service.Avatars.Sizes = sizes
+	}
+
+	switch c.Storage {
+	case "":
+		hlog.Warn("avatar storage is not configured, avatar upload disabled")
+		return nil
+	case "local":
+		local, err := storage.NewLocal(c.Local.Dir, c.Local.BaseURL)
+		if err != nil {
+			return err
+		}
+		service.Avatars.Storage = local
+	case "s3":
+		s3, err := storage.NewS3(storage.S3Config{
+			Endpoint:        c.S3.Endpoint,
+			Region:          c.S3.Region,
+			Bucket:          c.S3.Bucket,
+			AccessKeyID:     getEnvOrDefault("S3_ACCESS_KEY_ID", c.S3.AccessKeyID),
+			SecretAccessKey: getEnvOrDefault("S3_SECRET_ACCESS_KEY", c.S3.SecretAccessKey),
+			UseSSL:          c.S3.UseSSL,
+			BaseURL:         c.S3.BaseURL,
+		})
+		if err != nil {
+			return err
+		}
+		service.Avatars.Storage = s3
+	default:
+		return fmt.Errorf("unknown avatar storage %q", c.Storage)
+	}
+	return nil
+}
+
+// initLoyalty 根据配置设置积分规则和会员等级，未配置的项使用默认值
+func initLoyalty(c conf.LoyaltyConfig) error {
+	if c.PointsPerUnit < 0 || c.PointValue < 0 || c.Expiry < 0 {
+		return fmt.Errorf("invalid loyalty config")
+	}
+	if c.PointsPerUnit > 0 {
+		service.Loyalty.PointsPerUnit = c.PointsPerUnit
+	}
+	if c.PointValue > 0 {
+		service.Loyalty.PointValue = c.PointValue
+	}
+	if c.Expiry > 0 {
+		service.Loyalty.Expiry = c.Expiry
+	}
+	if len(c.Tiers) > 0 {
+		tiers := make([]service.Tier, 0, len(c.Tiers))
+		for i, t := range c.Tiers {
+			if t.Name == "" || (i > 0 && t.MinSpend <= c.Tiers[i-1].MinSpend) {
+				return fmt.Errorf("tiers must be named and sorted by min_spend")
+			}
+			tiers = append(tiers, service.Tier{Name: t.Name, MinSpend: t.MinSpend})
+		}
+		service.Loyalty.Tiers = tiers
+	}
+	return nil
+}
+
+// pointsExpiryInterval 作废到期积分的执行间隔，未配置时使用默认值
+func pointsExpiryInterval() time.Duration {
+	if d := conf.GetConf().Loyalty.ExpiryInterval; d > 0 {
+		return d
+	}
+	return service.DefaultPointsExpiryInterval
+}
+
+// productCatalog 通过商品服务查询心愿单商品的当前价格和库存
+type productCatalog struct {
+	client productcatalogservice.Client
+}
+
+func (p productCatalog) GetProduct(ctx context.Context, productID uint32) (*product.Product, error) {
+	resp, err := p.client.GetProduct(ctx, &product.GetProductReq{Id: productID})
+	if err != nil {
+		// 商品服务以错误信息返回商品不存在，没有单独的错误码
+		if strings.Contains(err.Error(), "does not exist") {
+			return nil, service.ErrProductNotFound
+		}
+		return nil, err
+	}
+	if resp.Product == nil {
+		return nil, service.ErrProductNotFound
+	}
+	return resp.Product, nil
+}
+
+// wishlistCheckInterval 检查心愿单降价和到货的间隔，未配置时使用默认值
+func wishlistCheckInterval() time.Duration {
+	if d := conf.GetConf().Wishlist.CheckInterval; d > 0 {
+		return d
+	}
+	return service.DefaultWishlistCheckInterval
+}
+
+// erasureInterval 注销任务的执行间隔，未配置时使用默认值
+func erasureInterval() time.Duration {
+	if d := conf.GetConf().Account.ErasureInterval; d > 0 {
+		return d
+	}
+	return service.DefaultErasureInterval
+}
+
+// newPasswordConfig 根据配置创建密码策略，未配置的长度和误判率使用默认值
+func newPasswordConfig(c conf.PasswordPolicyConfig) password.Config {
+	cfg := password.DefaultConfig()
+	if c.MinLength > 0 {
+		cfg.MinLength = c.MinLength
+	}
+	if c.MaxLength > 0 {
+		cfg.MaxLength = c.MaxLength
+	}
+	if c.BreachedFPRate > 0 {
+		cfg.BreachedFPRate = c.BreachedFPRate
+	}
+	cfg.RequireLower = c.RequireLower
+	cfg.RequireUpper = c.RequireUpper
+	cfg.RequireDigit = c.RequireDigit
+	cfg.RequireSymbol = c.RequireSymbol
+	cfg.MinClasses = c.MinClasses
+	cfg.DisallowUsername = c.DisallowUsername
+	cfg.BannedPasswords = c.Banned
+	cfg.BreachedListPath = c.BreachedListPath
+	cfg.HistorySize = c.HistorySize
+	return cfg
+}
+
 // getEnvOrDefault 获取环境变量，如果不存在则返回默认值
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
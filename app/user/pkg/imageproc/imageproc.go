@@ -0,0 +1,199 @@
+// Package imageproc 用户上传图片的处理：按内容识别格式、限制像素数、按 EXIF 方向校正并生成 JPEG 缩略图
+// 缩略图重新编码，原图中的 EXIF 等元数据（如拍摄位置）不会保留
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	// ErrUnsupportedFormat 不是支持的图片格式，以内容判断，不信任客户端声明的类型和扩展名
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	// ErrTooManyPixels 图片尺寸超过限制，在解码像素数据之前检查，防止解压炸弹
+	ErrTooManyPixels = errors.New("image dimensions too large")
+	// ErrInvalidImage 图片数据损坏
+	ErrInvalidImage = errors.New("invalid image")
+)
+
+// JPEGQuality 缩略图的 JPEG 质量
+const JPEGQuality = 85
+
+// supportedTypes 支持的格式，键为 http.DetectContentType 的结果，对应的解码器已在 import 中注册
+var supportedTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// Sniff 根据文件内容识别图片类型，返回 MIME 类型
+func Sniff(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if !supportedTypes[contentType] {
+		return "", ErrUnsupportedFormat
+	}
+	return contentType, nil
+}
+
+// Image 解码后的图片
+type Image struct {
+	img         image.Image
+	orientation int // EXIF 方向，1 表示不需要校正
+}
+
+// Decode 识别格式并解码，像素数超过 maxPixels 时返回 ErrTooManyPixels；动图只使用第一帧
+func Decode(data []byte, maxPixels int) (*Image, error) {
+	contentType, err := Sniff(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrInvalidImage
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(data)
+	}
+	return &Image{img: img, orientation: orientation}, nil
+}
+
+// Thumbnail 居中裁剪为正方形并缩放为 size×size，透明区域填充白色
+func (i *Image) Thumbnail(size int) image.Image {
+	b := i.img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	crop := image.Rect(x0, y0, x0+side, y0+side)
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), i.img, crop, draw.Over, nil)
+	// 正方形居中裁剪与旋转、翻转可交换，在缩略图上校正方向代价最小
+	return orient(dst, i.orientation)
+}
+
+// EncodeJPEG 编码为不含元数据的 JPEG
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: JPEGQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// orient 按 EXIF 方向校正正方形图片
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+	n := src.Bounds().Dx()
+	dst := image.NewRGBA(src.Bounds())
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // 水平翻转
+				dx, dy = n-1-x, y
+			case 3: // 旋转 180°
+				dx, dy = n-1-x, n-1-y
+			case 4: // 垂直翻转
+				dx, dy = x, n-1-y
+			case 5: // 沿左上-右下对角线翻转
+				dx, dy = y, x
+			case 6: // 顺时针旋转 90°
+				dx, dy = n-1-y, x
+			case 7: // 沿右上-左下对角线翻转
+				dx, dy = n-1-y, n-1-x
+			case 8: // 逆时针旋转 90°
+				dx, dy = y, n-1-x
+			}
+			dst.SetRGBA(dx, dy, src.RGBAAt(x, y))
+		}
+	}
+	return dst
+}
+
+// jpegOrientation 读取 JPEG APP1 段中 EXIF 的方向标签（0x0112），没有或无法解析时返回 1
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		if marker == 0xD8 || marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			pos += 2
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 { // 图像数据开始，之后不会再有 APP 段
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return 1
+		}
+		if segment := data[pos+4 : end]; marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		pos = end
+	}
+	return 1
+}
+
+// exifOrientation 从 TIFF 结构的 IFD0 中读取方向
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			if v := int(order.Uint16(tiff[entry+8:])); v >= 1 && v <= 8 {
+				return v
+			}
+			return 1
+		}
+	}
+	return 1
+}
@@ -0,0 +1,135 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// halves 左半红色、右半蓝色的图片
+func halves(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// withExifOrientation 在 SOI 之后插入只包含方向标签的 EXIF APP1 段
+func withExifOrientation(t *testing.T, data []byte, orientation uint16) []byte {
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	for _, v := range []interface{}{
+		uint16(42), uint32(8), // TIFF 头和 IFD0 偏移
+		uint16(1),                                                    // 条目数
+		uint16(0x0112), uint16(3), uint32(1), orientation, uint16(0), // 方向标签，类型 SHORT
+		uint32(0), // 没有下一个 IFD
+	} {
+		require.NoError(t, binary.Write(&tiff, binary.BigEndian, v))
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}))
+	return buf.Bytes()
+}
+
+func isRed(c color.Color) bool {
+	r, _, b, _ := c.RGBA()
+	return r > 0xC000 && b < 0x4000
+}
+
+func isBlue(c color.Color) bool {
+	r, _, b, _ := c.RGBA()
+	return b > 0xC000 && r < 0x4000
+}
+
+func TestSniff(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, halves(4, 4)))
+	contentType, err := Sniff(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+
+	_, err = Sniff([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	// 只看文件头，数据是否完整在解码时检查
+	truncated := buf.Bytes()[:buf.Len()/2]
+	_, err = Sniff(truncated)
+	assert.NoError(t, err)
+	_, err = Decode(truncated, 1<<20)
+	assert.ErrorIs(t, err, ErrInvalidImage)
+}
+
+func TestDecode_Limits(t *testing.T) {
+	data := encodeJPEG(t, halves(40, 30))
+
+	_, err := Decode(data, 1000)
+	assert.ErrorIs(t, err, ErrTooManyPixels)
+
+	_, err = Decode(data[:len(data)/2], 10000)
+	assert.ErrorIs(t, err, ErrInvalidImage)
+
+	img, err := Decode(data, 10000)
+	require.NoError(t, err)
+	assert.Equal(t, 1, img.orientation)
+}
+
+func TestThumbnail(t *testing.T) {
+	// 宽图居中裁剪为正方形：左右各裁掉一部分，红蓝各占一半
+	img, err := Decode(encodeJPEG(t, halves(80, 40)), 1<<20)
+	require.NoError(t, err)
+	thumb := img.Thumbnail(16)
+	assert.Equal(t, image.Rect(0, 0, 16, 16), thumb.Bounds())
+	assert.True(t, isRed(thumb.At(2, 8)))
+	assert.True(t, isBlue(thumb.At(13, 8)))
+
+	// 透明区域填充白色
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 8, 8))))
+	img, err = Decode(buf.Bytes(), 1<<20)
+	require.NoError(t, err)
+	r, g, b, _ := img.Thumbnail(4).At(1, 1).RGBA()
+	assert.Equal(t, []uint32{0xFFFF, 0xFFFF, 0xFFFF}, []uint32{r, g, b})
+}
+
+func TestThumbnail_ExifOrientation(t *testing.T) {
+	data := withExifOrientation(t, encodeJPEG(t, halves(32, 32)), 6)
+	assert.Equal(t, 6, jpegOrientation(data))
+
+	img, err := Decode(data, 1<<20)
+	require.NoError(t, err)
+	thumb := img.Thumbnail(16)
+	// 顺时针旋转 90° 后左半（红）转到上半
+	assert.True(t, isRed(thumb.At(8, 2)))
+	assert.True(t, isBlue(thumb.At(8, 13)))
+
+	// 重新编码后不包含 EXIF
+	out, err := EncodeJPEG(thumb)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(out, []byte("Exif")))
+	assert.Equal(t, 1, jpegOrientation(out))
+}
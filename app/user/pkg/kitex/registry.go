@@ -0,0 +1,92 @@
+package kitex
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/cloudwego/kitex/pkg/registry"
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+// consulRegistry 基于 Consul Agent 的 Kitex 服务注册器
+type consulRegistry struct {
+	client *consulapi.Client
+}
+
+// NewConsulRegister 创建 Kitex Consul 注册器
+func NewConsulRegister(addr string) (registry.Registry, error) {
+	cfg := consulapi.DefaultConfig()
+	cfg.Address = addr
+
+	cli, err := consulapi.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &consulRegistry{client: cli}, nil
+}
+
+// Register 注册服务，使用 TCP 健康检查
+func (r *consulRegistry) Register(info *registry.Info) error {
+	host, port, err := splitAddr(info.Addr)
+	if err != nil {
+		return err
+	}
+
+	weight := info.Weight
+	if weight <= 0 {
+		weight = 10
+	}
+
+	tags := make([]string, 0, len(info.Tags))
+	for k, v := range info.Tags {
+		tags = append(tags, k+"="+v)
+	}
+
+	return r.client.Agent().ServiceRegister(&consulapi.AgentServiceRegistration{
+		ID:      serviceID(info.ServiceName, host, port),
+		Name:    info.ServiceName,
+		Address: host,
+		Port:    port,
+		Tags:    tags,
+		Weights: &consulapi.AgentWeights{Passing: weight, Warning: 1},
+		Check: &consulapi.AgentServiceCheck{
+			TCP:                            net.JoinHostPort(host, strconv.Itoa(port)),
+			Interval:                       "10s",
+			Timeout:                        "5s",
+			DeregisterCriticalServiceAfter: "1m",
+		},
+	})
+}
+
+// Deregister 注销服务
+func (r *consulRegistry) Deregister(info *registry.Info) error {
+	host, port, err := splitAddr(info.Addr)
+	if err != nil {
+		return err
+	}
+	return r.client.Agent().ServiceDeregister(serviceID(info.ServiceName, host, port))
+}
+
+// splitAddr 解析监听地址，未指定主机时使用 localhost
+func splitAddr(addr net.Addr) (string, int, error) {
+	if addr == nil {
+		return "", 0, fmt.Errorf("registry addr is nil")
+	}
+	host, portStr, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", 0, err
+	}
+	if host == "" || host == "::" || host == "0.0.0.0" {
+		host = "localhost"
+	}
+	return host, port, nil
+}
+
+func serviceID(name, host string, port int) string {
+	return fmt.Sprintf("%s-%s-%d", name, host, port)
+}
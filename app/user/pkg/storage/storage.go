@@ -0,0 +1,101 @@
+// Package storage 对象存储：保存头像等用户上传的文件，支持本地目录和 S3 兼容服务
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrInvalidKey 对象键为空、是绝对路径或包含 .. 等路径穿越片段
+var ErrInvalidKey = errors.New("invalid object key")
+
+// Storage 对象存储
+type Storage interface {
+	// Put 保存对象，键已存在时覆盖
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// DeletePrefix 删除键以 prefix 开头的全部对象，prefix 必须以 / 结尾
+	DeletePrefix(ctx context.Context, prefix string) error
+	// URL 对象的公开访问地址
+	URL(key string) string
+}
+
+// ValidKey 检查对象键，只允许由 / 分隔的相对路径
+func ValidKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, `\`) {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// validPrefix 检查删除用的前缀，避免误删整个存储
+func validPrefix(prefix string) bool {
+	return strings.HasSuffix(prefix, "/") && ValidKey(strings.TrimSuffix(prefix, "/"))
+}
+
+// Local 本地目录存储，对象键映射为 Dir 下的相对路径，由 HTTP 服务以 BaseURL 对外提供
+type Local struct {
+	Dir     string
+	BaseURL string
+}
+
+// NewLocal 创建本地目录存储，目录不存在时自动创建
+func NewLocal(dir, baseURL string) (*Local, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Local{Dir: dir, BaseURL: strings.TrimSuffix(baseURL, "/")}, nil
+}
+
+// Put 先写入临时文件再重命名，读取方不会看到写了一半的文件
+func (l *Local) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if !ValidKey(key) {
+		return ErrInvalidKey
+	}
+	name := l.path(key)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+// DeletePrefix 删除前缀对应的目录
+func (l *Local) DeletePrefix(ctx context.Context, prefix string) error {
+	if !validPrefix(prefix) {
+		return ErrInvalidKey
+	}
+	return os.RemoveAll(l.path(strings.TrimSuffix(prefix, "/")))
+}
+
+// URL 对象的访问地址
+func (l *Local) URL(key string) string {
+	return l.BaseURL + "/" + key
+}
+
+func (l *Local) path(key string) string {
+	return filepath.Join(l.Dir, filepath.FromSlash(path.Clean(key)))
+}
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidKey(t *testing.T) {
+	assert.True(t, ValidKey("avatars/1/abc/64.jpg"))
+	for _, key := range []string{"", "/etc/passwd", "../x", "a/../../x", "a//b", "a/./b", `a\b`, "a/"} {
+		assert.False(t, ValidKey(key), key)
+	}
+}
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewLocal(filepath.Join(dir, "files"), "http://localhost:8001/v1/user/files/")
+	require.NoError(t, err)
+
+	require.NoError(t, s.Put(ctx, "avatars/1/abc/64.jpg", []byte("a"), "image/jpeg"))
+	require.NoError(t, s.Put(ctx, "avatars/1/abc/128.jpg", []byte("b"), "image/jpeg"))
+	require.NoError(t, s.Put(ctx, "avatars/2/def/64.jpg", []byte("c"), "image/jpeg"))
+	assert.Equal(t, "http://localhost:8001/v1/user/files/avatars/1/abc/64.jpg", s.URL("avatars/1/abc/64.jpg"))
+
+	data, err := os.ReadFile(filepath.Join(dir, "files", "avatars", "1", "abc", "64.jpg"))
+	require.NoError(t, err)
+	assert.Equal(t, "a", string(data))
+
+	assert.ErrorIs(t, s.Put(ctx, "../outside.jpg", []byte("x"), "image/jpeg"), ErrInvalidKey)
+	assert.ErrorIs(t, s.DeletePrefix(ctx, "avatars"), ErrInvalidKey, "前缀必须以 / 结尾")
+	assert.ErrorIs(t, s.DeletePrefix(ctx, "/"), ErrInvalidKey)
+
+	require.NoError(t, s.DeletePrefix(ctx, "avatars/1/"))
+	_, err = os.Stat(filepath.Join(dir, "files", "avatars", "1"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "files", "avatars", "2", "def", "64.jpg"))
+	assert.NoError(t, err, "其他前缀不受影响")
+
+	// 删除不存在的前缀不报错
+	require.NoError(t, s.DeletePrefix(ctx, "avatars/1/"))
+}
@@ -12,28 +12,295 @@ CREATE TABLE IF NOT EXISTS `users` (
     `email` varchar(128),
     `phone` varchar(20),
     `status` tinyint NOT NULL DEFAULT 1,
+    `email_verified_at` timestamp NULL DEFAULT NULL,
+    `phone_verified_at` timestamp NULL DEFAULT NULL,
+    `two_factor_enabled` tinyint(1) NOT NULL DEFAULT 0,
+    `two_factor_secret` varchar(255),
+    `two_factor_recovery_codes` text,
+    `erase_after` timestamp NULL DEFAULT NULL, -- 申请注销后宽限期结束时间，status=3 时有效
+    `avatar` varchar(128) NOT NULL DEFAULT '', -- 头像在对象存储中的键前缀
     `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
     UNIQUE KEY `idx_username` (`username`),
     KEY `idx_email` (`email`),
-    KEY `idx_phone` (`phone`)
+    KEY `idx_phone` (`phone`),
+    KEY `idx_status_erase_after` (`status`, `erase_after`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
--- Token 相关表
+-- Token 相关表，token 和 refresh_token 只保存 SHA-256 摘要
 CREATE TABLE IF NOT EXISTS `tokens` (
     `id` bigint NOT NULL AUTO_INCREMENT,
     `user_id` bigint NOT NULL,
-    `token` varchar(512) NOT NULL,
+    `token` varchar(1024) NOT NULL,
     `refresh_token` varchar(512),
+    `family_id` varchar(64),
+    `parent_id` bigint NOT NULL DEFAULT 0,
     `expired_at` timestamp NOT NULL,
+    `refresh_expired_at` timestamp NULL,
+    `used_at` timestamp NULL,
+    `revoked_at` timestamp NULL,
+    `client_id` varchar(64),
+    `scope` varchar(512),
+    `device_name` varchar(128),
+    `user_agent` varchar(512),
+    `ip` varchar(64),
+    `last_seen_at` timestamp NULL,
     `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
     KEY `idx_user_id` (`user_id`),
     KEY `idx_token` (`token`(191)),
-    KEY `idx_refresh_token` (`refresh_token`(191))
+    KEY `idx_refresh_token` (`refresh_token`(191)),
+    KEY `idx_family_id` (`family_id`),
+    KEY `idx_client_id` (`client_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 
+-- 外部身份提供方账号绑定
+CREATE TABLE IF NOT EXISTS `user_identities` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL,
+    `provider` varchar(64) NOT NULL,
+    `subject` varchar(255) NOT NULL,
+    `email` varchar(255),
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_provider_subject` (`provider`, `subject`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- OAuth2 第三方应用
+CREATE TABLE IF NOT EXISTS `oauth_clients` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `client_id` varchar(64) NOT NULL,
+    `client_secret_hash` varchar(255),
+    `name` varchar(128) NOT NULL,
+    `redirect_uris` text,
+    `grant_types` varchar(255),
+    `scopes` varchar(512),
+    `owner_id` bigint NOT NULL DEFAULT 0,
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_client_id` (`client_id`),
+    KEY `idx_owner_id` (`owner_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- OAuth2 用户授权记录
+CREATE TABLE IF NOT EXISTS `oauth_consents` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL,
+    `client_id` varchar(64) NOT NULL,
+    `scopes` varchar(512),
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_client` (`user_id`, `client_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 角色和权限
+CREATE TABLE IF NOT EXISTS `roles` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `name` varchar(64) NOT NULL,
+    `description` varchar(255),
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `permissions` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `name` varchar(64) NOT NULL, -- 资源:操作，如 user:ban
+    `description` varchar(255),
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `role_permissions` (
+    `role_id` bigint NOT NULL,
+    `permission_id` bigint NOT NULL,
+    PRIMARY KEY (`role_id`, `permission_id`),
+    KEY `idx_permission_id` (`permission_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 用户角色，所有用户默认拥有 user 角色，无需写入
+CREATE TABLE IF NOT EXISTS `user_roles` (
+    `user_id` bigint NOT NULL,
+    `role_id` bigint NOT NULL,
+    `granted_by` bigint NOT NULL DEFAULT 0, -- 授予角色的管理员，0 表示系统初始化
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`user_id`, `role_id`),
+    KEY `idx_role_id` (`role_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 历史密码，用于禁止重复使用最近的密码
+CREATE TABLE IF NOT EXISTS `password_histories` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL,
+    `password` varchar(255) NOT NULL, -- bcrypt 哈希
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 安全审计日志，只追加
+CREATE TABLE IF NOT EXISTS `audit_logs` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL DEFAULT 0, -- 事件涉及的用户，未知时为 0
+    `username` varchar(64),
+    `event` varchar(32) NOT NULL,
+    `outcome` varchar(16) NOT NULL, -- success / failure
+    `reason` varchar(255),
+    `operator_id` bigint NOT NULL DEFAULT 0, -- 管理操作的执行人
+    `ip` varchar(64),
+    `user_agent` varchar(512),
+    `trace_id` varchar(64),
+    `created_at` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    PRIMARY KEY (`id`),
+    KEY `idx_user_created` (`user_id`, `created_at`),
+    KEY `idx_event_created` (`event`, `created_at`),
+    KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 开放平台 API 密钥，密钥只保存加密后的 SHA-256 摘要
+CREATE TABLE IF NOT EXISTS `api_keys` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `key_id` varchar(64) NOT NULL,
+    `user_id` bigint NOT NULL,
+    `name` varchar(64) NOT NULL,
+    `secret_hash` varchar(255) NOT NULL,
+    `scopes` varchar(512), -- 空格分隔
+    `expires_at` timestamp NOT NULL,
+    `last_used_at` timestamp NULL DEFAULT NULL,
+    `revoked_at` timestamp NULL DEFAULT NULL,
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_key_id` (`key_id`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `webauthn_credentials` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL,
+    `credential_id` varbinary(1023) NOT NULL,
+    `user_handle` varbinary(64) NOT NULL, -- 同一用户的凭据共用的随机句柄
+    `public_key` blob NOT NULL, -- COSE 编码
+    `sign_count` int unsigned NOT NULL DEFAULT 0,
+    `aaguid` varbinary(16),
+    `transports` varchar(255), -- 逗号分隔
+    `name` varchar(64) NOT NULL,
+    `last_used_at` timestamp NULL DEFAULT NULL,
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_credential_id` (`credential_id`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `user_bans` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL,
+    `reason` varchar(255) NOT NULL,
+    `operator_id` bigint NOT NULL,
+    `expires_at` timestamp NULL DEFAULT NULL, -- 为空表示永久封禁
+    `lifted_at` timestamp NULL DEFAULT NULL, -- 为空表示封禁仍然生效
+    `lifted_by` bigint NOT NULL DEFAULT 0, -- 0 表示到期自动解封
+    `lift_reason` varchar(255),
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_user_id` (`user_id`),
+    KEY `idx_expires_at` (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `addresses` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL,
+    `label` varchar(32), -- 如 家、公司
+    `recipient_name` varchar(64) NOT NULL,
+    `recipient_phone` varchar(16) NOT NULL,
+    `street_address` varchar(255) NOT NULL,
+    `city` varchar(64) NOT NULL,
+    `state` varchar(64),
+    `country` varchar(64) NOT NULL,
+    `zip_code` varchar(16),
+    `is_default` tinyint(1) NOT NULL DEFAULT 0, -- 每个用户最多一个默认地址
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 积分账户，积分变化时锁定该行
+CREATE TABLE IF NOT EXISTS `points_accounts` (
+    `user_id` bigint NOT NULL,
+    `debt` bigint NOT NULL DEFAULT 0, -- 退款时余额不足扣回的积分，之后获得的积分先抵扣
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 积分流水，ref 为幂等键，如 earn:<订单ID>、redeem:<结算订单号>
+CREATE TABLE IF NOT EXISTS `points_ledger` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL,
+    `kind` varchar(16) NOT NULL, -- earn、refund、redeem、redeem_cancel、expire
+    `points` bigint NOT NULL, -- 对余额的影响，获得为正，扣减为负
+    `remaining` bigint NOT NULL DEFAULT 0, -- 获得的积分中尚未使用的部分
+    `amount` decimal(10,2) NOT NULL DEFAULT 0, -- 计入会员等级的消费金额变化
+    `order_id` varchar(64) NOT NULL DEFAULT '',
+    `ref` varchar(96) NOT NULL,
+    `expires_at` timestamp NULL DEFAULT NULL,
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_ref` (`ref`),
+    KEY `idx_user_id_created_at` (`user_id`, `created_at`),
+    KEY `idx_remaining_expires_at` (`remaining`, `expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 心愿单，每个用户按名称区分
+CREATE TABLE IF NOT EXISTS `wishlists` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL,
+    `name` varchar(64) NOT NULL,
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_user_id_name` (`user_id`, `name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 心愿单商品，last_price 和 last_stock 为最近一次检查时商品服务返回的价格和库存
+CREATE TABLE IF NOT EXISTS `wishlist_items` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `wishlist_id` bigint NOT NULL,
+    `user_id` bigint NOT NULL,
+    `product_id` int unsigned NOT NULL,
+    `saved_price` decimal(10,2) NOT NULL, -- 加入心愿单时的价格
+    `last_price` decimal(10,2) NOT NULL,
+    `last_stock` int unsigned NOT NULL DEFAULT 0,
+    `notify_price_drop` tinyint(1) NOT NULL DEFAULT 0,
+    `notify_back_in_stock` tinyint(1) NOT NULL DEFAULT 0,
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_wishlist_id_product_id` (`wishlist_id`, `product_id`),
+    KEY `idx_user_id` (`user_id`),
+    KEY `idx_product_id` (`product_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT IGNORE INTO `roles` (`name`, `description`) VALUES
+    ('user', '普通用户'),
+    ('support', '客服'),
+    ('admin', '管理员');
+
+INSERT IGNORE INTO `permissions` (`name`, `description`) VALUES
+    ('user:read', '查看用户信息和角色'),
+    ('user:ban', '封禁和解封用户'),
+    ('role:assign', '授予和撤销角色'),
+    ('order:refund', '发起退款'),
+    ('audit:read', '查看安全审计日志');
+
+INSERT IGNORE INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT r.id, p.id FROM `roles` r JOIN `permissions` p
+WHERE (r.name = 'admin')
+   OR (r.name = 'support' AND p.name IN ('user:read', 'order:refund'));
+
 -- 商品服务相关表
 CREATE TABLE IF NOT EXISTS `products` (
     `id` bigint NOT NULL AUTO_INCREMENT,
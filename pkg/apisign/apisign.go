@@ -0,0 +1,177 @@
+// Package apisign 提供开放平台 API 密钥请求签名校验中间件，签名比对由调用方通过 Verifier 提供
+//
+// 签名为 HMAC-SHA256(DeriveKey(secret), StringToSign(method, path, timestamp, nonce, body))，十六进制编码
+package apisign
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 签名相关的 HTTP 请求头
+const (
+	HeaderKeyID     = "X-Api-Key"
+	HeaderTimestamp = "X-Api-Timestamp" // Unix 秒
+	HeaderNonce     = "X-Api-Nonce"
+	HeaderSignature = "X-Api-Signature"
+)
+
+const (
+	// DefaultMaxSkew 请求时间戳与服务器时间允许的最大偏差
+	DefaultMaxSkew = 5 * time.Minute
+
+	minNonceLength = 8
+	maxNonceLength = 64
+)
+
+var (
+	ErrUnauthenticated = errors.New("invalid api signature")
+	ErrForbidden       = errors.New("api key scope denied")
+)
+
+// Caller 通过签名校验的调用方
+type Caller struct {
+	KeyID  string
+	UserID int64 // 密钥所属的用户
+	Scopes []string
+}
+
+// HasScope 是否拥有授权范围
+func (c *Caller) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Verifier 校验签名并返回密钥对应的调用方，密钥无效或签名不匹配时返回 ErrUnauthenticated
+type Verifier func(ctx context.Context, keyID, stringToSign, signature string) (*Caller, error)
+
+// NonceStore 记录已使用的 nonce，防止请求重放
+type NonceStore interface {
+	// Use 记录 nonce，ttl 内同一密钥重复使用时返回 false
+	Use(ctx context.Context, keyID, nonce string, ttl time.Duration) (bool, error)
+}
+
+// Request 待校验的签名请求
+type Request struct {
+	Method    string
+	Path      string // 路径和查询字符串
+	Body      []byte
+	KeyID     string
+	Timestamp string
+	Nonce     string
+	Signature string
+}
+
+// Guard 检查时间戳、签名和 nonce
+type Guard struct {
+	verify  Verifier
+	nonces  NonceStore
+	maxSkew time.Duration
+	now     func() time.Time
+}
+
+// NewGuard 创建签名校验器，maxSkew 不大于 0 时使用 DefaultMaxSkew
+func NewGuard(verify Verifier, nonces NonceStore, maxSkew time.Duration) *Guard {
+	if maxSkew <= 0 {
+		maxSkew = DefaultMaxSkew
+	}
+	return &Guard{verify: verify, nonces: nonces, maxSkew: maxSkew, now: time.Now}
+}
+
+// Check 校验请求签名，调用方须拥有全部授权范围
+// 签名通过后才记录 nonce，避免未认证的请求占用 nonce
+func (g *Guard) Check(ctx context.Context, r *Request, scopes ...string) (*Caller, error) {
+	if r.KeyID == "" || r.Timestamp == "" || r.Nonce == "" || r.Signature == "" {
+		return nil, fmt.Errorf("%w: missing signature headers", ErrUnauthenticated)
+	}
+	ts, err := strconv.ParseInt(r.Timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timestamp", ErrUnauthenticated)
+	}
+	if skew := g.now().Sub(time.Unix(ts, 0)); skew > g.maxSkew || skew < -g.maxSkew {
+		return nil, fmt.Errorf("%w: timestamp outside allowed clock skew", ErrUnauthenticated)
+	}
+	if !validNonce(r.Nonce) {
+		return nil, fmt.Errorf("%w: invalid nonce", ErrUnauthenticated)
+	}
+
+	caller, err := g.verify(ctx, r.KeyID, StringToSign(r.Method, r.Path, r.Timestamp, r.Nonce, r.Body), r.Signature)
+	if err != nil {
+		return nil, err
+	}
+	// 时间戳超出偏差的请求已被拒绝，nonce 只需保留两倍偏差时间
+	fresh, err := g.nonces.Use(ctx, r.KeyID, r.Nonce, 2*g.maxSkew)
+	if err != nil {
+		return nil, err
+	}
+	if !fresh {
+		return nil, fmt.Errorf("%w: nonce already used", ErrUnauthenticated)
+	}
+	for _, scope := range scopes {
+		if !caller.HasScope(scope) {
+			return nil, ErrForbidden
+		}
+	}
+	return caller, nil
+}
+
+// validNonce nonce 只能包含字母、数字、- 和 _
+func validNonce(nonce string) bool {
+	if len(nonce) < minNonceLength || len(nonce) > maxNonceLength {
+		return false
+	}
+	for _, c := range nonce {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// StringToSign 生成待签名字符串，各部分以换行分隔，请求体取 SHA-256 摘要
+func StringToSign(method, path, timestamp, nonce string, body []byte) string {
+	sum := sha256.Sum256(body)
+	return strings.Join([]string{strings.ToUpper(method), path, timestamp, nonce, hex.EncodeToString(sum[:])}, "\n")
+}
+
+// DeriveKey 由密钥派生签名密钥，服务端只保存派生结果
+func DeriveKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Sign 计算签名
+func Sign(key, stringToSign string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(stringToSign))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 常量时间比较签名
+func Verify(key, stringToSign, signature string) bool {
+	return hmac.Equal([]byte(Sign(key, stringToSign)), []byte(strings.ToLower(signature)))
+}
+
+type callerKey struct{}
+
+// WithCaller 将调用方保存到 context
+func WithCaller(ctx context.Context, c *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// FromContext 获取中间件保存的调用方
+func FromContext(ctx context.Context) (*Caller, bool) {
+	c, ok := ctx.Value(callerKey{}).(*Caller)
+	return c, ok
+}
@@ -0,0 +1,195 @@
+package apisign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKeyID  = "ak_test"
+	testSecret = "sk_test-secret"
+)
+
+// testVerifier 使用固定密钥校验签名
+func testVerifier(ctx context.Context, keyID, stringToSign, signature string) (*Caller, error) {
+	switch keyID {
+	case testKeyID:
+		if !Verify(DeriveKey(testSecret), stringToSign, signature) {
+			return nil, fmt.Errorf("%w: signature mismatch", ErrUnauthenticated)
+		}
+		return &Caller{KeyID: keyID, UserID: 7, Scopes: []string{"order:read"}}, nil
+	case "ak_broken":
+		return nil, errors.New("auth service unavailable")
+	}
+	return nil, ErrUnauthenticated
+}
+
+// memoryNonces 内存 nonce 存储
+type memoryNonces struct {
+	mu   sync.Mutex
+	used map[string]bool
+}
+
+func (m *memoryNonces) Use(ctx context.Context, keyID, nonce string, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.used == nil {
+		m.used = make(map[string]bool)
+	}
+	if m.used[keyID+":"+nonce] {
+		return false, nil
+	}
+	m.used[keyID+":"+nonce] = true
+	return true, nil
+}
+
+func newTestGuard(now time.Time) *Guard {
+	g := NewGuard(testVerifier, &memoryNonces{}, time.Minute)
+	g.now = func() time.Time { return now }
+	return g
+}
+
+// signedRequest 构造签名正确的请求
+func signedRequest(method, path, nonce string, body []byte, ts time.Time) *Request {
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+	return &Request{
+		Method:    method,
+		Path:      path,
+		Body:      body,
+		KeyID:     testKeyID,
+		Timestamp: timestamp,
+		Nonce:     nonce,
+		Signature: Sign(DeriveKey(testSecret), StringToSign(method, path, timestamp, nonce, body)),
+	}
+}
+
+func TestGuard_Check(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ctx := context.Background()
+	body := []byte(`{"items":[1,2]}`)
+
+	t.Run("valid", func(t *testing.T) {
+		g := newTestGuard(now)
+		caller, err := g.Check(ctx, signedRequest("post", "/v1/order/list?page=1", "nonce-0001", body, now.Add(-30*time.Second)), "order:read")
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), caller.UserID)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		g := newTestGuard(now)
+		r := signedRequest("POST", "/v1/order/list", "nonce-0002", body, now)
+		_, err := g.Check(ctx, r)
+		require.NoError(t, err)
+		_, err = g.Check(ctx, r)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+		assert.Contains(t, err.Error(), "nonce already used")
+	})
+
+	t.Run("clock_skew", func(t *testing.T) {
+		g := newTestGuard(now)
+		_, err := g.Check(ctx, signedRequest("POST", "/v1/order/list", "nonce-0003", body, now.Add(-2*time.Minute)))
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+		_, err = g.Check(ctx, signedRequest("POST", "/v1/order/list", "nonce-0004", body, now.Add(2*time.Minute)))
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		g := newTestGuard(now)
+		r := signedRequest("POST", "/v1/order/list", "nonce-0005", body, now)
+		r.Body = []byte(`{"items":[1,2,3]}`)
+		_, err := g.Check(ctx, r)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+
+		// 签名失败不占用 nonce，原请求仍可通过
+		r.Body = body
+		_, err = g.Check(ctx, r)
+		assert.NoError(t, err)
+
+		r = signedRequest("POST", "/v1/order/list?user_id=1", "nonce-0006", body, now)
+		r.Path = "/v1/order/list?user_id=2"
+		_, err = g.Check(ctx, r)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("invalid_headers", func(t *testing.T) {
+		g := newTestGuard(now)
+		r := signedRequest("POST", "/v1/order/list", "nonce-0007", body, now)
+		r.Signature = ""
+		_, err := g.Check(ctx, r)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+
+		_, err = g.Check(ctx, signedRequest("POST", "/v1/order/list", "short", body, now))
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+		_, err = g.Check(ctx, signedRequest("POST", "/v1/order/list", "nonce\n0008", body, now))
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("scope", func(t *testing.T) {
+		g := newTestGuard(now)
+		_, err := g.Check(ctx, signedRequest("POST", "/v1/order/create", "nonce-0009", body, now), "order:write")
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestRequire(t *testing.T) {
+	now := time.Now()
+	body := []byte(`{"user_id":7}`)
+	tests := []struct {
+		name       string
+		keyID      string
+		tamper     bool
+		scope      string
+		wantStatus int
+	}{
+		{name: "allowed", keyID: testKeyID, scope: "order:read", wantStatus: consts.StatusOK},
+		{name: "tampered", keyID: testKeyID, tamper: true, scope: "order:read", wantStatus: consts.StatusUnauthorized},
+		{name: "unknown_key", keyID: "ak_unknown", scope: "order:read", wantStatus: consts.StatusUnauthorized},
+		{name: "missing_scope", keyID: testKeyID, scope: "order:write", wantStatus: consts.StatusForbidden},
+		{name: "verifier_error", keyID: "ak_broken", scope: "order:read", wantStatus: consts.StatusInternalServerError},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := signedRequest("POST", "/v1/order/list?page=1", fmt.Sprintf("nonce-%04d", i), body, now)
+			c := app.NewContext(16)
+			c.Request.SetMethod("POST")
+			c.Request.SetRequestURI("/v1/order/list?page=1")
+			c.Request.SetBody(body)
+			if tt.tamper {
+				c.Request.SetBody([]byte(`{"user_id":8}`))
+			}
+			c.Request.Header.Set(HeaderKeyID, tt.keyID)
+			c.Request.Header.Set(HeaderTimestamp, r.Timestamp)
+			c.Request.Header.Set(HeaderNonce, r.Nonce)
+			c.Request.Header.Set(HeaderSignature, r.Signature)
+
+			var got *Caller
+			c.SetHandlers(app.HandlersChain{
+				Require(NewGuard(testVerifier, &memoryNonces{}, 0), tt.scope),
+				func(ctx context.Context, c *app.RequestContext) {
+					got, _ = FromContext(ctx)
+					c.Status(consts.StatusOK)
+				},
+			})
+			c.Next(context.Background())
+
+			assert.Equal(t, tt.wantStatus, c.Response.StatusCode())
+			if tt.wantStatus == consts.StatusOK {
+				require.NotNil(t, got)
+				assert.Equal(t, int64(7), got.UserID)
+			} else {
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package apisign
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// Require 返回 Hertz 中间件，要求请求携带有效签名且密钥拥有全部授权范围
+// 签名覆盖请求方法、路径和查询字符串、时间戳、nonce 和请求体
+func Require(g *Guard, scopes ...string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		caller, err := g.Check(ctx, &Request{
+			Method:    string(c.Method()),
+			Path:      string(c.Request.URI().RequestURI()),
+			Body:      c.Request.Body(),
+			KeyID:     string(c.GetHeader(HeaderKeyID)),
+			Timestamp: string(c.GetHeader(HeaderTimestamp)),
+			Nonce:     string(c.GetHeader(HeaderNonce)),
+			Signature: string(c.GetHeader(HeaderSignature)),
+		}, scopes...)
+		if err != nil {
+			status := consts.StatusInternalServerError
+			switch {
+			case errors.Is(err, ErrUnauthenticated):
+				status = consts.StatusUnauthorized
+			case errors.Is(err, ErrForbidden):
+				status = consts.StatusForbidden
+			default:
+				hlog.CtxErrorf(ctx, "verify api signature failed: %v", err)
+			}
+			c.AbortWithStatusJSON(status, utils.H{
+				"base": utils.H{
+					"code":    status,
+					"message": err.Error(),
+				},
+			})
+			return
+		}
+		c.Next(WithCaller(ctx, caller))
+	}
+}
@@ -0,0 +1,23 @@
+package apisign
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisNonceStore 基于 Redis SETNX 的 nonce 存储，多实例共享
+type RedisNonceStore struct {
+	rdb    redis.Cmdable
+	prefix string
+}
+
+// NewRedisNonceStore 创建 Redis nonce 存储，prefix 用于区分服务
+func NewRedisNonceStore(rdb redis.Cmdable, prefix string) *RedisNonceStore {
+	return &RedisNonceStore{rdb: rdb, prefix: prefix}
+}
+
+func (s *RedisNonceStore) Use(ctx context.Context, keyID, nonce string, ttl time.Duration) (bool, error) {
+	return s.rdb.SetNX(ctx, s.prefix+keyID+":"+nonce, 1, ttl).Result()
+}
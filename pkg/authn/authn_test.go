@@ -0,0 +1,245 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testVerifier 按令牌返回预设的调用方
+func testVerifier(ctx context.Context, token string) (*Identity, error) {
+	switch token {
+	case "alice":
+		return &Identity{UserID: 1, Username: "alice"}, nil
+	case "service":
+		return &Identity{ClientID: "erp"}, nil
+	case "broken":
+		return nil, errors.New("auth service unavailable")
+	}
+	return nil, ErrUnauthenticated
+}
+
+func TestBearerToken(t *testing.T) {
+	assert.Equal(t, "abc", BearerToken("Bearer abc"))
+	assert.Equal(t, "abc", BearerToken("bearer  abc "))
+	assert.Empty(t, BearerToken("Basic abc"))
+	assert.Empty(t, BearerToken("abc"))
+	assert.Empty(t, BearerToken(""))
+}
+
+func TestUserID(t *testing.T) {
+	_, err := UserID(context.Background(), 1)
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	ctx := WithIdentity(context.Background(), &Identity{UserID: 7})
+	id, err := UserID(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), id)
+
+	id, err = UserID(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), id)
+
+	_, err = UserID(ctx, 8)
+	assert.ErrorIs(t, err, ErrUserMismatch)
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantUserID    int64
+	}{
+		{name: "allowed", authorization: "Bearer alice", wantStatus: consts.StatusOK, wantUserID: 1},
+		{name: "missing_token", wantStatus: consts.StatusUnauthorized},
+		{name: "not_bearer", authorization: "alice", wantStatus: consts.StatusUnauthorized},
+		{name: "invalid_token", authorization: "Bearer nobody", wantStatus: consts.StatusUnauthorized},
+		{name: "no_user", authorization: "Bearer service", wantStatus: consts.StatusUnauthorized},
+		{name: "verifier_error", authorization: "Bearer broken", wantStatus: consts.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *Identity
+			var token string
+			c := app.NewContext(16)
+			if tt.authorization != "" {
+				c.Request.Header.Set("Authorization", tt.authorization)
+			}
+			c.SetHandlers(app.HandlersChain{
+				Authenticate(testVerifier),
+				func(ctx context.Context, c *app.RequestContext) {
+					got, _ = FromContext(ctx)
+					token, _ = metainfo.GetPersistentValue(ctx, MetaKeyToken)
+					c.Status(consts.StatusOK)
+				},
+			})
+			c.Next(context.Background())
+
+			assert.Equal(t, tt.wantStatus, c.Response.StatusCode())
+			if tt.wantUserID == 0 {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantUserID, got.UserID)
+			assert.Equal(t, "alice", token)
+		})
+	}
+}
+
+func TestKitexMiddleware(t *testing.T) {
+	var got *Identity
+	endpoint := KitexMiddleware(testVerifier)(func(ctx context.Context, req, resp interface{}) error {
+		got, _ = FromContext(ctx)
+		return nil
+	})
+
+	err := endpoint(context.Background(), nil, nil)
+	assert.True(t, errors.Is(err, kerrors.ErrACL))
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	err = endpoint(metainfo.WithValue(context.Background(), MetaKeyToken, "nobody"), nil, nil)
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	require.NoError(t, endpoint(WithToken(context.Background(), "alice"), nil, nil))
+	require.NotNil(t, got)
+	assert.Equal(t, int64(1), got.UserID)
+
+	got = nil
+	require.NoError(t, endpoint(metainfo.WithValue(context.Background(), MetaKeyToken, "Bearer alice"), nil, nil))
+	require.NotNil(t, got)
+	assert.Equal(t, int64(1), got.UserID)
+}
+
+// testTokenInfo 模拟 kitex_gen 生成的 auth.VerifyResp
+type testTokenInfo struct {
+	valid       bool
+	userID      int64
+	roles       []string
+	permissions []string
+}
+
+func (r *testTokenInfo) GetValid() bool           { return r.valid }
+func (r *testTokenInfo) GetUserId() int64         { return r.userID }
+func (r *testTokenInfo) GetRoles() []string       { return r.roles }
+func (r *testTokenInfo) GetPermissions() []string { return r.permissions }
+
+func TestRPCVerifier(t *testing.T) {
+	var gotWithRoles bool
+	call := func(ctx context.Context, token string, withRoles bool) (*testTokenInfo, error) {
+		gotWithRoles = withRoles
+		switch token {
+		case "alice":
+			return &testTokenInfo{valid: true, userID: 1, roles: []string{"user", "support"}, permissions: []string{"order:refund"}}, nil
+		case "broken":
+			return nil, errors.New("auth service unavailable")
+		}
+		return &testTokenInfo{}, nil
+	}
+	ctx := context.Background()
+
+	verify := NewRPCVerifier(call)
+	id, err := verify(ctx, "alice")
+	require.NoError(t, err)
+	assert.False(t, gotWithRoles)
+	assert.Equal(t, int64(1), id.UserID)
+	assert.Empty(t, id.Roles)
+
+	_, err = verify(ctx, "revoked")
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+	_, err = verify(ctx, "broken")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrUnauthenticated)
+
+	// WithRoles 时请求并返回角色和权限
+	id, err = NewRPCVerifier(call, WithRoles())(ctx, "alice")
+	require.NoError(t, err)
+	assert.True(t, gotWithRoles)
+	assert.Equal(t, []string{"user", "support"}, id.Roles)
+	assert.Equal(t, []string{"order:refund"}, id.Permissions)
+}
+
+// rpcContext 模拟 Kitex 调用 method 方法时的 context
+func rpcContext(method string) context.Context {
+	ri := rpcinfo.NewRPCInfo(nil, rpcinfo.NewEndpointInfo("order", method, nil, nil), nil, nil, nil)
+	return rpcinfo.NewCtxWithRPCInfo(context.Background(), ri)
+}
+
+func TestServiceCredential(t *testing.T) {
+	secret := []byte("service-secret")
+	called := false
+	server := RequireService(secret, []string{"payment"}, "MarkOrderPaid")(func(ctx context.Context, req, resp interface{}) error {
+		called = true
+		return nil
+	})
+	// call 以 service 的身份签发凭证后调用服务端
+	call := func(service string, key []byte, method string) error {
+		called = false
+		client := ServiceClientMiddleware(service, key)(func(ctx context.Context, req, resp interface{}) error {
+			return server(ctx, req, resp)
+		})
+		return client(rpcContext(method), nil, nil)
+	}
+
+	require.NoError(t, call("payment", secret, "MarkOrderPaid"))
+	assert.True(t, called)
+
+	// 未携带凭证、密钥错误或调用方不在允许列表中
+	err := server(rpcContext("MarkOrderPaid"), nil, nil)
+	assert.True(t, errors.Is(err, kerrors.ErrACL))
+	assert.ErrorIs(t, err, ErrServiceUnauthenticated)
+	assert.ErrorIs(t, call("payment", []byte("other-secret"), "MarkOrderPaid"), ErrServiceUnauthenticated)
+	assert.ErrorIs(t, call("checkout", secret, "MarkOrderPaid"), ErrServiceUnauthenticated)
+	assert.False(t, called)
+
+	// 未保护的方法不检查
+	require.NoError(t, server(rpcContext("ListOrder"), nil, nil))
+
+	// 服务端未配置密钥时拒绝调用
+	err = RequireService(nil, []string{"payment"}, "MarkOrderPaid")(server)(rpcContext("MarkOrderPaid"), nil, nil)
+	assert.ErrorIs(t, err, ErrServiceUnauthenticated)
+}
+
+func TestVerifyServiceToken(t *testing.T) {
+	secret := []byte("service-secret")
+	now := time.Now()
+	token := signServiceToken(secret, "payment", "MarkOrderPaid", now)
+
+	service, err := verifyServiceToken(secret, token, "MarkOrderPaid", now)
+	require.NoError(t, err)
+	assert.Equal(t, "payment", service)
+
+	// 凭证绑定方法名和签发时间
+	_, err = verifyServiceToken(secret, token, "MarkOrderRefunded", now)
+	assert.ErrorIs(t, err, ErrServiceUnauthenticated)
+	_, err = verifyServiceToken(secret, token, "MarkOrderPaid", now.Add(2*serviceTokenMaxAge))
+	assert.ErrorIs(t, err, ErrServiceUnauthenticated)
+	_, err = verifyServiceToken(nil, token, "MarkOrderPaid", now)
+	assert.ErrorIs(t, err, ErrServiceUnauthenticated)
+}
+
+func TestServiceSecret(t *testing.T) {
+	t.Setenv(EnvServiceSecret, "")
+	_, err := ServiceSecret("")
+	assert.ErrorIs(t, err, ErrNoServiceSecret)
+
+	secret, err := ServiceSecret("from-config")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("from-config"), secret)
+
+	t.Setenv(EnvServiceSecret, "from-env")
+	secret, err = ServiceSecret("from-config")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("from-env"), secret)
+}
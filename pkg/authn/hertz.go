@@ -0,0 +1,33 @@
+package authn
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// Authenticate 返回 Hertz 中间件，要求请求携带有效的 Bearer 令牌，可用于路由组或单个路由
+// 认证通过后调用方写入 context，令牌写入 metainfo 供下游 Kitex 调用使用
+func Authenticate(verify Verifier) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		token := BearerToken(string(c.GetHeader("Authorization")))
+		id, err := authenticate(ctx, verify, token)
+		if err != nil {
+			status := consts.StatusUnauthorized
+			if !errors.Is(err, ErrUnauthenticated) {
+				status = consts.StatusInternalServerError
+				hlog.CtxErrorf(ctx, "verify access token failed: %v", err)
+			}
+			c.AbortWithStatusJSON(status, utils.H{
+				"code":    status,
+				"message": err.Error(),
+			})
+			return
+		}
+		c.Next(WithToken(WithIdentity(ctx, id), token))
+	}
+}
@@ -0,0 +1,49 @@
+package authn
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+)
+
+// KitexMiddleware 返回 Kitex 服务端中间件，要求调用通过 metainfo 携带有效的访问令牌
+// skip 中的方法不做检查，用于健康检查等内部接口
+func KitexMiddleware(verify Verifier, skip ...string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			if ri := rpcinfo.GetRPCInfo(ctx); ri != nil && containsString(skip, ri.To().Method()) {
+				return next(ctx, req, resp)
+			}
+			id, err := authenticate(ctx, verify, kitexToken(ctx))
+			if err != nil {
+				return kerrors.ErrACL.WithCause(err)
+			}
+			return next(WithIdentity(ctx, id), req, resp)
+		}
+	}
+}
+
+// kitexToken 获取 RPC 调用携带的访问令牌
+func kitexToken(ctx context.Context) string {
+	token, ok := metainfo.GetPersistentValue(ctx, MetaKeyToken)
+	if !ok {
+		token, _ = metainfo.GetValue(ctx, MetaKeyToken)
+	}
+	if t := BearerToken(token); t != "" {
+		return t
+	}
+	return strings.TrimSpace(token)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package authn
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+)
+
+// MetaKeyServiceToken 服务间调用通过 metainfo 传递服务凭证时使用的 Key
+const MetaKeyServiceToken = "service-token"
+
+// EnvServiceSecret 覆盖配置文件中服务凭证共享密钥的环境变量
+const EnvServiceSecret = "SERVICE_AUTH_SECRET"
+
+// serviceTokenMaxAge 服务凭证的有效期，同时也是允许的时钟偏差
+const serviceTokenMaxAge = time.Minute
+
+var (
+	ErrServiceUnauthenticated = errors.New("service credential required")
+	ErrNoServiceSecret        = errors.New("no service credential secret configured")
+)
+
+// ServiceSecret 返回服务凭证的共享密钥，环境变量优先于配置文件，都未配置时返回 ErrNoServiceSecret
+func ServiceSecret(configured string) ([]byte, error) {
+	if v := os.Getenv(EnvServiceSecret); v != "" {
+		configured = v
+	}
+	if configured == "" {
+		return nil, ErrNoServiceSecret
+	}
+	return []byte(configured), nil
+}
+
+// ServiceClientMiddleware 返回 Kitex 客户端中间件，用共享密钥为每次调用签发服务凭证（需要启用 TTHeader 传输）
+// 凭证绑定调用方服务名、方法名和签发时间，不会透传给下游服务
+func ServiceClientMiddleware(service string, secret []byte) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			var method string
+			if ri := rpcinfo.GetRPCInfo(ctx); ri != nil {
+				method = ri.To().Method()
+			}
+			token := signServiceToken(secret, service, method, time.Now())
+			return next(metainfo.WithValue(ctx, MetaKeyServiceToken, token), req, resp)
+		}
+	}
+}
+
+// RequireService 返回 Kitex 服务端中间件，methods 中的方法只接受 callers 中的服务用共享密钥签发的凭证
+// 其他方法不做检查；密钥为空时拒绝所有受保护方法的调用
+func RequireService(secret []byte, callers []string, methods ...string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			ri := rpcinfo.GetRPCInfo(ctx)
+			if ri == nil || !containsString(methods, ri.To().Method()) {
+				return next(ctx, req, resp)
+			}
+			token, _ := metainfo.GetValue(ctx, MetaKeyServiceToken)
+			service, err := verifyServiceToken(secret, token, ri.To().Method(), time.Now())
+			if err != nil {
+				return kerrors.ErrACL.WithCause(err)
+			}
+			if !containsString(callers, service) {
+				return kerrors.ErrACL.WithCause(fmt.Errorf("%w: service %q is not allowed", ErrServiceUnauthenticated, service))
+			}
+			return next(ctx, req, resp)
+		}
+	}
+}
+
+// signServiceToken 签发服务凭证，格式为 service.timestamp.signature
+func signServiceToken(secret []byte, service, method string, now time.Time) string {
+	ts := strconv.FormatInt(now.Unix(), 10)
+	return service + "." + ts + "." + serviceSignature(secret, service, method, ts)
+}
+
+// verifyServiceToken 校验服务凭证并返回调用方服务名
+func verifyServiceToken(secret []byte, token, method string, now time.Time) (string, error) {
+	if len(secret) == 0 || token == "" {
+		return "", ErrServiceUnauthenticated
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrServiceUnauthenticated
+	}
+	service, ts, signature := parts[0], parts[1], parts[2]
+	expected := serviceSignature(secret, service, method, ts)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return "", ErrServiceUnauthenticated
+	}
+	issued, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return "", ErrServiceUnauthenticated
+	}
+	if age := now.Sub(time.Unix(issued, 0)); age > serviceTokenMaxAge || age < -serviceTokenMaxAge {
+		return "", fmt.Errorf("%w: credential expired", ErrServiceUnauthenticated)
+	}
+	return service, nil
+}
+
+func serviceSignature(secret []byte, service, method, ts string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(service + "\n" + method + "\n" + ts))
+	return hex.EncodeToString(mac.Sum(nil))
+}
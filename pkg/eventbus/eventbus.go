@@ -0,0 +1,192 @@
+// Package eventbus 基于 Redis Stream 的服务间事件总线
+// 发布方向 Stream 追加事件，各服务使用自己的消费组订阅，处理成功后确认；
+// 处理失败的事件不确认，稍后重新投递，因此事件处理必须幂等
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	DefaultMaxLen = 100000 // Stream 保留的最大事件数（近似值）
+
+	readCount = 16
+)
+
+// 测试中可以缩短
+var (
+	blockTimeout  = 5 * time.Second
+	retryInterval = 5 * time.Second
+)
+
+// Event 总线上的事件
+type Event struct {
+	ID         string // Stream 消息ID，订阅时填充
+	Type       string
+	Payload    json.RawMessage
+	OccurredAt time.Time
+}
+
+// Decode 解析事件内容
+func (e Event) Decode(v interface{}) error {
+	return json.Unmarshal(e.Payload, v)
+}
+
+// HandlerFunc 事件处理函数，返回错误时事件不确认，之后重新投递
+type HandlerFunc func(ctx context.Context, ev Event) error
+
+// Bus 一个 Stream 上的事件总线
+type Bus struct {
+	rdb    redis.UniversalClient
+	stream string
+	maxLen int64
+}
+
+// New 创建事件总线
+func New(rdb redis.UniversalClient, stream string) *Bus {
+	return &Bus{rdb: rdb, stream: stream, maxLen: DefaultMaxLen}
+}
+
+// Publish 发布事件，payload 序列化为 JSON
+func (b *Bus) Publish(ctx context.Context, typ string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal event payload failed: %w", err)
+	}
+	err = b.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: b.stream,
+		MaxLen: b.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"type":        typ,
+			"payload":     string(data),
+			"occurred_at": time.Now().UnixMilli(),
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("publish event %s failed: %w", typ, err)
+	}
+	return nil
+}
+
+// Subscribe 以 group 消费组订阅事件，阻塞直到 ctx 取消
+// 同一消费组的多个实例分摊事件；消费组不存在时从 Stream 开头创建，服务上线前发布的事件不会丢失
+func (b *Bus) Subscribe(ctx context.Context, group, consumer string, h HandlerFunc) error {
+	if err := b.ensureGroup(ctx, group); err != nil {
+		return err
+	}
+
+	// 先处理上次未确认的事件，处理完再读取新事件
+	pending := true
+	for ctx.Err() == nil {
+		id := ">"
+		if pending {
+			id = "0"
+		}
+		streams, err := b.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: consumer,
+			Streams:  []string{b.stream, id},
+			Count:    readCount,
+			Block:    blockTimeout,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			hlog.CtxWarnf(ctx, "read events from %s failed: %v", b.stream, err)
+			sleep(ctx, retryInterval)
+			continue
+		}
+
+		var msgs []redis.XMessage
+		if len(streams) > 0 {
+			msgs = streams[0].Messages
+		}
+		if pending && len(msgs) == 0 {
+			pending = false
+			continue
+		}
+		if !b.handle(ctx, group, msgs, h) {
+			// 有事件处理失败，等待后重新投递
+			pending = true
+			sleep(ctx, retryInterval)
+		}
+	}
+	return nil
+}
+
+// handle 依次处理事件并确认，全部成功时返回 true
+func (b *Bus) handle(ctx context.Context, group string, msgs []redis.XMessage, h HandlerFunc) bool {
+	ok := true
+	for _, msg := range msgs {
+		ev, err := parseEvent(msg)
+		if err != nil {
+			// 无法解析的事件重新投递也无法处理，直接确认
+			hlog.CtxErrorf(ctx, "drop malformed event %s from %s: %v", msg.ID, b.stream, err)
+		} else if err := h(ctx, ev); err != nil {
+			hlog.CtxErrorf(ctx, "handle event %s (%s) failed: %v", ev.ID, ev.Type, err)
+			ok = false
+			continue
+		}
+		if err := b.rdb.XAck(ctx, b.stream, group, msg.ID).Err(); err != nil {
+			hlog.CtxWarnf(ctx, "ack event %s failed: %v", msg.ID, err)
+		}
+	}
+	return ok
+}
+
+// ensureGroup 创建消费组，已存在时忽略
+func (b *Bus) ensureGroup(ctx context.Context, group string) error {
+	err := b.rdb.XGroupCreateMkStream(ctx, b.stream, group, "0").Err()
+	if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("create consumer group %s failed: %w", group, err)
+	}
+	return nil
+}
+
+func parseEvent(msg redis.XMessage) (Event, error) {
+	typ, _ := msg.Values["type"].(string)
+	payload, _ := msg.Values["payload"].(string)
+	if typ == "" || payload == "" {
+		return Event{}, errors.New("missing type or payload")
+	}
+	ev := Event{ID: msg.ID, Type: typ, Payload: json.RawMessage(payload)}
+	if v, ok := msg.Values["occurred_at"].(string); ok {
+		if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+			ev.OccurredAt = time.UnixMilli(ms)
+		}
+	}
+	return ev, nil
+}
+
+// ConsumerName 当前实例的消费者名称，同一消费组内唯一
+func ConsumerName() string {
+	host, _ := os.Hostname()
+	if host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
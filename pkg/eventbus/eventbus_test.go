@@ -0,0 +1,117 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBus(t *testing.T) (*Bus, *redis.Client) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	oldBlock, oldRetry := blockTimeout, retryInterval
+	blockTimeout, retryInterval = 50*time.Millisecond, 50*time.Millisecond
+	t.Cleanup(func() { blockTimeout, retryInterval = oldBlock, oldRetry })
+	return New(rdb, "events:test"), rdb
+}
+
+// collector 记录收到的事件，可以让前几次处理失败
+type collector struct {
+	mu       sync.Mutex
+	events   []Event
+	failures int
+}
+
+func (c *collector) handle(ctx context.Context, ev Event) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("temporary failure")
+	}
+	c.events = append(c.events, ev)
+	return nil
+}
+
+func (c *collector) received() []Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Event(nil), c.events...)
+}
+
+func runSubscriber(t *testing.T, bus *Bus, group string, c *collector) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, bus.Subscribe(ctx, group, "consumer-1", c.handle))
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func TestBus_PublishBeforeSubscribe(t *testing.T) {
+	bus, _ := newTestBus(t)
+	ctx := context.Background()
+
+	// 订阅前发布的事件也会投递给新的消费组
+	require.NoError(t, bus.Publish(ctx, "greeting", map[string]string{"name": "alice"}))
+
+	c1, c2 := &collector{}, &collector{}
+	runSubscriber(t, bus, "svc-a", c1)
+	runSubscriber(t, bus, "svc-b", c2)
+	require.NoError(t, bus.Publish(ctx, "greeting", map[string]string{"name": "bob"}))
+
+	for _, c := range []*collector{c1, c2} {
+		require.Eventually(t, func() bool { return len(c.received()) == 2 }, 2*time.Second, 10*time.Millisecond)
+		events := c.received()
+		var payload map[string]string
+		require.NoError(t, events[0].Decode(&payload))
+		assert.Equal(t, "alice", payload["name"])
+		require.NoError(t, events[1].Decode(&payload))
+		assert.Equal(t, "bob", payload["name"])
+		assert.Equal(t, "greeting", events[1].Type)
+		assert.False(t, events[1].OccurredAt.IsZero())
+	}
+}
+
+func TestBus_RedeliverAfterFailure(t *testing.T) {
+	bus, rdb := newTestBus(t)
+	ctx := context.Background()
+
+	c := &collector{failures: 2}
+	runSubscriber(t, bus, "svc-a", c)
+	require.NoError(t, bus.Publish(ctx, "order.paid", map[string]int{"order_id": 1}))
+
+	require.Eventually(t, func() bool { return len(c.received()) == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	// 处理成功后确认，不再处于待确认状态
+	require.Eventually(t, func() bool {
+		pending, err := rdb.XPending(ctx, "events:test", "svc-a").Result()
+		return err == nil && pending.Count == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestBus_DropMalformedEvent(t *testing.T) {
+	bus, rdb := newTestBus(t)
+	ctx := context.Background()
+
+	require.NoError(t, rdb.XAdd(ctx, &redis.XAddArgs{Stream: "events:test", Values: map[string]interface{}{"foo": "bar"}}).Err())
+	require.NoError(t, bus.Publish(ctx, "ok", struct{}{}))
+
+	c := &collector{}
+	runSubscriber(t, bus, "svc-a", c)
+	require.Eventually(t, func() bool { return len(c.received()) == 1 }, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "ok", c.received()[0].Type)
+}
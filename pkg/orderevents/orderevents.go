@@ -0,0 +1,60 @@
+// Package orderevents 订单状态变化事件
+// 订单服务在订单支付、完成、退款后发布事件，其他服务（如用户服务的积分）订阅后处理
+package orderevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"TikTokMall/pkg/eventbus"
+)
+
+// Stream 订单事件所在的 Redis Stream
+const Stream = "events:order"
+
+// EventStatusChanged 订单状态变化事件类型
+const EventStatusChanged = "order.status_changed"
+
+// 订单状态
+const (
+	StatusPaid      = "paid"
+	StatusCompleted = "completed"
+	StatusRefunded  = "refunded"
+)
+
+// StatusChanged 订单状态变化事件
+// 同一订单的同一状态可能重复发布（如调用方重试），订阅方按 OrderID 和 Status 去重
+type StatusChanged struct {
+	OrderID   int64     `json:"order_id"`
+	OrderNo   string    `json:"order_no"`
+	UserID    int64     `json:"user_id"`
+	Status    string    `json:"status"`
+	Amount    float64   `json:"amount"` // 订单总金额
+	Currency  string    `json:"currency"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// HandlerFunc 订单状态变化的处理函数，返回错误时事件稍后重新投递
+type HandlerFunc func(ctx context.Context, ev *StatusChanged) error
+
+// Publish 发布订单状态变化事件
+func Publish(ctx context.Context, rdb redis.UniversalClient, ev *StatusChanged) error {
+	return eventbus.New(rdb, Stream).Publish(ctx, EventStatusChanged, ev)
+}
+
+// Subscribe 以 group 消费组订阅订单状态变化，阻塞直到 ctx 取消
+func Subscribe(ctx context.Context, rdb redis.UniversalClient, group string, h HandlerFunc) error {
+	bus := eventbus.New(rdb, Stream)
+	return bus.Subscribe(ctx, group, eventbus.ConsumerName(), func(ctx context.Context, ev eventbus.Event) error {
+		if ev.Type != EventStatusChanged {
+			return nil
+		}
+		var changed StatusChanged
+		if err := ev.Decode(&changed); err != nil {
+			return err
+		}
+		return h(ctx, &changed)
+	})
+}
@@ -0,0 +1,56 @@
+package orderevents
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/pkg/eventbus"
+)
+
+func TestPublishSubscribe(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	ctx := context.Background()
+
+	// 同一 Stream 上的其他事件类型被忽略
+	require.NoError(t, eventbus.New(rdb, Stream).Publish(ctx, "order.unknown", map[string]int{"order_id": 1}))
+	require.NoError(t, Publish(ctx, rdb, &StatusChanged{OrderID: 2, UserID: 7, Status: StatusPaid, Amount: 99.5, Currency: "CNY"}))
+
+	var mu sync.Mutex
+	var received []*StatusChanged
+	subCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, Subscribe(subCtx, rdb, "user", func(ctx context.Context, ev *StatusChanged) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, ev)
+			return nil
+		}))
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 1
+	}, 10*time.Second, 20*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, int64(2), received[0].OrderID)
+	assert.Equal(t, int64(7), received[0].UserID)
+	assert.Equal(t, StatusPaid, received[0].Status)
+	assert.Equal(t, 99.5, received[0].Amount)
+}
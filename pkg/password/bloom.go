@@ -0,0 +1,114 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+// BloomFilter 布隆过滤器，用固定内存判断泄露密码，存在一定误判但不会漏判
+type BloomFilter struct {
+	bits []uint64
+	m    uint64 // 位数
+	k    uint64 // 哈希函数个数
+}
+
+// NewBloomFilter 按预计元素个数和误判率创建布隆过滤器
+func NewBloomFilter(n int, fpRate float64) *BloomFilter {
+	if n < 1 {
+		n = 1
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+	k := uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &BloomFilter{bits: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+// Add 添加元素
+func (f *BloomFilter) Add(data []byte) {
+	h1, h2 := bloomHashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// Test 判断元素是否可能存在
+func (f *BloomFilter) Test(data []byte) bool {
+	h1, h2 := bloomHashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomHashes 元素本身是 SHA-1 摘要，直接取两段作为双重哈希的种子
+func bloomHashes(data []byte) (uint64, uint64) {
+	if len(data) < 16 {
+		sum := sha1.Sum(data)
+		data = sum[:]
+	}
+	return binary.BigEndian.Uint64(data[:8]), binary.BigEndian.Uint64(data[8:16]) | 1
+}
+
+// breachedDigest 泄露密码以 SHA-1 摘要存入过滤器，与常见泄露库格式一致
+func breachedDigest(password string) []byte {
+	sum := sha1.Sum([]byte(password))
+	return sum[:]
+}
+
+// LoadBreachedList 从文件加载泄露密码到布隆过滤器
+// 每行一个明文密码，或 40 位十六进制 SHA-1 摘要（可带 ":次数" 后缀）；空行和 # 开头的行被忽略
+func LoadBreachedList(path string, fpRate float64) (*BloomFilter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open breached password list failed: %w", err)
+	}
+	defer f.Close()
+
+	var digests [][]byte
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		digests = append(digests, parseBreachedLine(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read breached password list failed: %w", err)
+	}
+
+	filter := NewBloomFilter(len(digests), fpRate)
+	for _, d := range digests {
+		filter.Add(d)
+	}
+	return filter, nil
+}
+
+// parseBreachedLine 解析一行泄露密码记录
+func parseBreachedLine(line string) []byte {
+	hash := line
+	if i := strings.IndexByte(line, ':'); i == 40 {
+		hash = line[:i]
+	}
+	if len(hash) == 40 {
+		if d, err := hex.DecodeString(hash); err == nil {
+			return d
+		}
+	}
+	return breachedDigest(line)
+}
@@ -0,0 +1,122 @@
+package password
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// codes 获取错误中的违规码
+func codes(err error) []Code {
+	var cs []Code
+	for _, v := range Violations(err) {
+		cs = append(cs, v.Code)
+	}
+	return cs
+}
+
+func TestPolicy_Check(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RequireDigit = true
+	cfg.BannedPasswords = []string{"Shopping2024"}
+	p, err := New(cfg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		want     []Code
+	}{
+		{name: "valid", password: "Blue-Sky-42"},
+		{name: "too_short", password: "Ab1", want: []Code{CodeTooShort}},
+		{name: "too_long", password: strings.Repeat("Ab1", 30), want: []Code{CodeTooLong}},
+		{name: "single_class", password: "abcdefghij", want: []Code{CodeMissingDigit, CodeTooFewClasses}},
+		{name: "missing_digit", password: "abcdEFGHij", want: []Code{CodeMissingDigit}},
+		{name: "contains_username", password: "Alice-2024!", want: []Code{CodeContainsUsername}},
+		{name: "builtin_common", password: "Password123", want: []Code{CodeCommon}},
+		{name: "configured_banned", password: "shopping2024", want: []Code{CodeCommon}},
+		{name: "multiple", password: "alice", want: []Code{CodeTooShort, CodeMissingDigit, CodeTooFewClasses, CodeContainsUsername}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Check(tt.password, "alice")
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrPolicyViolation)
+			assert.Equal(t, tt.want, codes(err))
+		})
+	}
+}
+
+func TestPolicy_Breached(t *testing.T) {
+	sum := sha1.Sum([]byte("Summer#2019"))
+	list := fmt.Sprintf("# breached passwords\nCorrect-Horse-9\n%s:1024\n\n", strings.ToUpper(hex.EncodeToString(sum[:])))
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(list), 0o600))
+
+	cfg := DefaultConfig()
+	cfg.BreachedListPath = path
+	p, err := New(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Code{CodeBreached}, codes(p.Check("Correct-Horse-9", "bob")))
+	assert.Equal(t, []Code{CodeBreached}, codes(p.Check("Summer#2019", "bob")))
+	assert.NoError(t, p.Check("Winter#2019", "bob"))
+
+	cfg.BreachedListPath = filepath.Join(t.TempDir(), "missing.txt")
+	_, err = New(cfg)
+	assert.Error(t, err)
+}
+
+func TestBloomFilter(t *testing.T) {
+	f := NewBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		f.Add(breachedDigest(fmt.Sprintf("member-%d", i)))
+	}
+	for i := 0; i < 1000; i++ {
+		require.True(t, f.Test(breachedDigest(fmt.Sprintf("member-%d", i))))
+	}
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if f.Test(breachedDigest(fmt.Sprintf("other-%d", i))) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 300)
+}
+
+func TestPolicy_CheckHistory(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.HistorySize = 2
+	p, err := New(cfg)
+	require.NoError(t, err)
+
+	var hashes []string
+	for _, pw := range []string{"Newest-Pass-3", "Middle-Pass-2", "Oldest-Pass-1"} {
+		h, err := bcrypt.GenerateFromPassword([]byte(pw), bcrypt.MinCost)
+		require.NoError(t, err)
+		hashes = append(hashes, string(h))
+	}
+
+	err = p.CheckHistory("Middle-Pass-2", hashes)
+	assert.Equal(t, []Code{CodeReused}, codes(err))
+	// 只检查最近 HistorySize 个密码
+	assert.NoError(t, p.CheckHistory("Oldest-Pass-1", hashes))
+
+	cfg.HistorySize = 0
+	p, err = New(cfg)
+	require.NoError(t, err)
+	assert.NoError(t, p.CheckHistory("Newest-Pass-3", hashes))
+	assert.False(t, errors.Is(p.Check("Newest-Pass-3", ""), ErrPolicyViolation))
+}
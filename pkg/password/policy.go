@@ -0,0 +1,242 @@
+// Package password 密码策略校验：长度、字符类别、常见弱密码、泄露密码列表和历史密码
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Code 密码策略违规码，随错误返回给客户端
+type Code string
+
+const (
+	CodeTooShort         Code = "PASSWORD_TOO_SHORT"
+	CodeTooLong          Code = "PASSWORD_TOO_LONG"
+	CodeMissingLower     Code = "PASSWORD_MISSING_LOWERCASE"
+	CodeMissingUpper     Code = "PASSWORD_MISSING_UPPERCASE"
+	CodeMissingDigit     Code = "PASSWORD_MISSING_DIGIT"
+	CodeMissingSymbol    Code = "PASSWORD_MISSING_SYMBOL"
+	CodeTooFewClasses    Code = "PASSWORD_TOO_FEW_CHARACTER_CLASSES"
+	CodeContainsUsername Code = "PASSWORD_CONTAINS_USERNAME"
+	CodeCommon           Code = "PASSWORD_TOO_COMMON"
+	CodeBreached         Code = "PASSWORD_BREACHED"
+	CodeReused           Code = "PASSWORD_REUSED"
+)
+
+// maxBcryptLength bcrypt 只使用密码的前 72 字节，更长的密码没有意义
+const maxBcryptLength = 72
+
+// ErrPolicyViolation 密码不符合策略，具体违规项见 PolicyError
+var ErrPolicyViolation = errors.New("password policy violation")
+
+// Violation 单条违规项
+type Violation struct {
+	Code    Code   `json:"code"`
+	Message string `json:"message"`
+}
+
+// PolicyError 密码不符合策略时返回的错误，包含全部违规项
+type PolicyError struct {
+	Violations []Violation
+}
+
+func (e *PolicyError) Error() string {
+	codes := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		codes[i] = string(v.Code)
+	}
+	return fmt.Sprintf("%s: %s", ErrPolicyViolation, strings.Join(codes, ", "))
+}
+
+// Is 使 errors.Is(err, ErrPolicyViolation) 成立
+func (e *PolicyError) Is(target error) bool {
+	return target == ErrPolicyViolation
+}
+
+// Violations 获取错误链中的违规项，不是策略错误时返回 nil
+func Violations(err error) []Violation {
+	var perr *PolicyError
+	if errors.As(err, &perr) {
+		return perr.Violations
+	}
+	return nil
+}
+
+// Config 密码策略配置
+type Config struct {
+	MinLength        int      // 最小长度（字符数）
+	MaxLength        int      // 最大长度（字节数），不超过 72
+	RequireLower     bool     // 必须包含小写字母
+	RequireUpper     bool     // 必须包含大写字母
+	RequireDigit     bool     // 必须包含数字
+	RequireSymbol    bool     // 必须包含符号
+	MinClasses       int      // 至少包含的字符类别数（小写、大写、数字、符号）
+	DisallowUsername bool     // 不能包含用户名
+	BannedPasswords  []string // 额外禁止的常见密码，不区分大小写，与内置列表合并
+	BreachedListPath string   // 泄露密码列表文件，每行一个明文密码或 SHA-1 摘要
+	BreachedFPRate   float64  // 泄露密码布隆过滤器的误判率
+	HistorySize      int      // 不能与最近 N 个密码重复，0 表示不检查
+}
+
+// DefaultConfig 默认密码策略
+func DefaultConfig() Config {
+	return Config{
+		MinLength:        8,
+		MaxLength:        maxBcryptLength,
+		MinClasses:       2,
+		DisallowUsername: true,
+		BreachedFPRate:   0.001,
+		HistorySize:      5,
+	}
+}
+
+// Policy 密码策略，创建后只读，可并发使用
+type Policy struct {
+	cfg      Config
+	banned   map[string]struct{}
+	breached *BloomFilter
+}
+
+// New 根据配置创建密码策略，配置了泄露密码列表时从文件加载
+func New(cfg Config) (*Policy, error) {
+	if cfg.MaxLength <= 0 || cfg.MaxLength > maxBcryptLength {
+		cfg.MaxLength = maxBcryptLength
+	}
+	if cfg.MinLength > cfg.MaxLength {
+		return nil, fmt.Errorf("min length %d exceeds max length %d", cfg.MinLength, cfg.MaxLength)
+	}
+	if cfg.MinClasses > 4 {
+		return nil, fmt.Errorf("min classes %d exceeds 4", cfg.MinClasses)
+	}
+
+	p := &Policy{cfg: cfg, banned: make(map[string]struct{})}
+	for _, list := range [][]string{commonPasswords, cfg.BannedPasswords} {
+		for _, pw := range list {
+			p.banned[strings.ToLower(pw)] = struct{}{}
+		}
+	}
+	if cfg.BreachedListPath != "" {
+		rate := cfg.BreachedFPRate
+		if rate <= 0 || rate >= 1 {
+			rate = DefaultConfig().BreachedFPRate
+		}
+		filter, err := LoadBreachedList(cfg.BreachedListPath, rate)
+		if err != nil {
+			return nil, err
+		}
+		p.breached = filter
+	}
+	return p, nil
+}
+
+// Default 使用默认配置的密码策略
+func Default() *Policy {
+	p, _ := New(DefaultConfig())
+	return p
+}
+
+// HistorySize 需要检查的历史密码个数
+func (p *Policy) HistorySize() int {
+	return p.cfg.HistorySize
+}
+
+// Check 校验密码是否符合策略，返回包含全部违规项的 *PolicyError
+func (p *Policy) Check(password, username string) error {
+	var vs []Violation
+	add := func(code Code, format string, args ...interface{}) {
+		vs = append(vs, Violation{Code: code, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if n := utf8.RuneCountInString(password); n < p.cfg.MinLength {
+		add(CodeTooShort, "password must be at least %d characters", p.cfg.MinLength)
+	}
+	if len(password) > p.cfg.MaxLength {
+		add(CodeTooLong, "password must be at most %d bytes", p.cfg.MaxLength)
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r), unicode.IsSymbol(r), r == ' ':
+			symbol = true
+		}
+	}
+	if p.cfg.RequireLower && !lower {
+		add(CodeMissingLower, "password must contain a lowercase letter")
+	}
+	if p.cfg.RequireUpper && !upper {
+		add(CodeMissingUpper, "password must contain an uppercase letter")
+	}
+	if p.cfg.RequireDigit && !digit {
+		add(CodeMissingDigit, "password must contain a digit")
+	}
+	if p.cfg.RequireSymbol && !symbol {
+		add(CodeMissingSymbol, "password must contain a symbol")
+	}
+	classes := 0
+	for _, ok := range []bool{lower, upper, digit, symbol} {
+		if ok {
+			classes++
+		}
+	}
+	if classes < p.cfg.MinClasses {
+		add(CodeTooFewClasses, "password must contain at least %d of lowercase, uppercase, digits and symbols", p.cfg.MinClasses)
+	}
+
+	lowered := strings.ToLower(password)
+	if p.cfg.DisallowUsername && len(username) >= 3 && strings.Contains(lowered, strings.ToLower(username)) {
+		add(CodeContainsUsername, "password must not contain the username")
+	}
+	if _, ok := p.banned[lowered]; ok {
+		add(CodeCommon, "password is too common")
+	} else if p.breached != nil && p.breached.Test(breachedDigest(password)) {
+		add(CodeBreached, "password has appeared in a data breach")
+	}
+
+	if len(vs) > 0 {
+		return &PolicyError{Violations: vs}
+	}
+	return nil
+}
+
+// CheckHistory 校验密码是否与最近使用过的密码重复，hashes 为 bcrypt 哈希，按时间倒序
+func (p *Policy) CheckHistory(password string, hashes []string) error {
+	if p.cfg.HistorySize <= 0 {
+		return nil
+	}
+	if len(hashes) > p.cfg.HistorySize {
+		hashes = hashes[:p.cfg.HistorySize]
+	}
+	for _, h := range hashes {
+		if bcrypt.CompareHashAndPassword([]byte(h), []byte(password)) == nil {
+			return &PolicyError{Violations: []Violation{{
+				Code:    CodeReused,
+				Message: fmt.Sprintf("password must not match any of the last %d passwords", p.cfg.HistorySize),
+			}}}
+		}
+	}
+	return nil
+}
+
+// commonPasswords 内置的常见弱密码列表
+var commonPasswords = []string{
+	"123456", "12345678", "123456789", "1234567890", "1234567", "12345", "111111", "000000",
+	"123123", "654321", "666666", "888888", "112233", "121212", "123321", "520520", "5201314",
+	"password", "password1", "password123", "passw0rd", "p@ssw0rd", "p@ssword",
+	"qwerty", "qwerty123", "qwertyuiop", "1q2w3e4r", "1qaz2wsx", "qazwsx", "zxcvbnm", "asdfghjkl",
+	"abc123", "abcd1234", "a123456", "aa123456", "abc12345", "admin", "admin123", "root123",
+	"iloveyou", "welcome", "welcome1", "letmein", "monkey", "dragon", "sunshine", "princess",
+	"football", "baseball", "superman", "trustno1", "master", "shadow", "michael", "whatever",
+	"tiktok123", "tiktokmall", "changeme",
+}
@@ -0,0 +1,37 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// Require 返回 Hertz 中间件，要求请求携带的访问令牌拥有全部权限，可用于路由组或单个路由
+func Require(resolve Resolver, permissions ...string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		p, err := authorize(ctx, resolve, string(c.GetHeader("Authorization")), permissions)
+		if err != nil {
+			status := consts.StatusInternalServerError
+			switch {
+			case errors.Is(err, ErrUnauthenticated):
+				status = consts.StatusUnauthorized
+			case errors.Is(err, ErrForbidden):
+				status = consts.StatusForbidden
+			default:
+				hlog.CtxErrorf(ctx, "resolve principal failed: %v", err)
+			}
+			c.AbortWithStatusJSON(status, utils.H{
+				"base": utils.H{
+					"code":    status,
+					"message": err.Error(),
+				},
+			})
+			return
+		}
+		c.Next(WithPrincipal(ctx, p))
+	}
+}
@@ -0,0 +1,54 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/utils"
+)
+
+// MetaKeyToken 调用方通过 metainfo 传递访问令牌时使用的 Key
+const MetaKeyToken = "authorization"
+
+// Rules 每个 RPC 方法需要的权限，未列出的方法不做检查
+type Rules map[string][]string
+
+// KitexMiddleware 返回 Kitex 服务端中间件，按方法检查调用方权限
+// 访问令牌优先从 metainfo 读取，其次读取请求的 token 字段
+func KitexMiddleware(resolve Resolver, rules Rules) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			ri := rpcinfo.GetRPCInfo(ctx)
+			if ri == nil {
+				return next(ctx, req, resp)
+			}
+			permissions, ok := rules[ri.To().Method()]
+			if !ok {
+				return next(ctx, req, resp)
+			}
+			p, err := authorize(ctx, resolve, kitexToken(ctx, req), permissions)
+			if err != nil {
+				return err
+			}
+			return next(WithPrincipal(ctx, p), req, resp)
+		}
+	}
+}
+
+// kitexToken 获取 RPC 请求携带的访问令牌
+func kitexToken(ctx context.Context, req interface{}) string {
+	if token, ok := metainfo.GetPersistentValue(ctx, MetaKeyToken); ok {
+		return token
+	}
+	if token, ok := metainfo.GetValue(ctx, MetaKeyToken); ok {
+		return token
+	}
+	if args, ok := req.(utils.KitexArgs); ok {
+		if r, ok := args.GetFirstArgument().(interface{ GetToken() string }); ok {
+			return r.GetToken()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+// Package rbac 提供基于角色和权限的访问控制中间件，令牌解析由调用方通过 Resolver 提供
+package rbac
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"TikTokMall/pkg/authn"
+)
+
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("permission denied")
+)
+
+// Principal 已认证的调用方
+type Principal struct {
+	UserID      int64
+	Roles       []string
+	Permissions []string
+}
+
+// HasRole 是否拥有角色
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission 是否拥有权限
+func (p *Principal) HasPermission(permission string) bool {
+	for _, perm := range p.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolver 根据访问令牌解析调用方，令牌无效时返回 ErrUnauthenticated
+type Resolver func(ctx context.Context, token string) (*Principal, error)
+
+// IdentityResolver 使用 authn 中间件已认证的调用方及其角色和权限，不再次解析令牌
+// 需要放在 authn.Authenticate 或 authn.KitexMiddleware 之后，Verifier 需返回调用方的权限
+func IdentityResolver(ctx context.Context, token string) (*Principal, error) {
+	id, ok := authn.FromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return &Principal{UserID: id.UserID, Roles: id.Roles, Permissions: id.Permissions}, nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal 将调用方保存到 context
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext 获取中间件保存的调用方
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// authorize 解析令牌并检查调用方拥有全部权限
+func authorize(ctx context.Context, resolve Resolver, token string, permissions []string) (*Principal, error) {
+	token = strings.TrimPrefix(token, "Bearer ")
+	if token == "" {
+		return nil, ErrUnauthenticated
+	}
+	p, err := resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	for _, perm := range permissions {
+		if !p.HasPermission(perm) {
+			return nil, ErrForbidden
+		}
+	}
+	return p, nil
+}
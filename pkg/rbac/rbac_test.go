@@ -0,0 +1,136 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TikTokMall/pkg/authn"
+)
+
+// testResolver 按令牌返回预设的调用方
+func testResolver(ctx context.Context, token string) (*Principal, error) {
+	switch token {
+	case "admin":
+		return &Principal{UserID: 1, Roles: []string{"user", "admin"}, Permissions: []string{"role:assign", "user:read"}}, nil
+	case "user":
+		return &Principal{UserID: 2, Roles: []string{"user"}}, nil
+	case "broken":
+		return nil, errors.New("database unavailable")
+	}
+	return nil, ErrUnauthenticated
+}
+
+func TestRequire(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantUserID    int64
+	}{
+		{name: "allowed", authorization: "Bearer admin", wantStatus: consts.StatusOK, wantUserID: 1},
+		{name: "missing_token", wantStatus: consts.StatusUnauthorized},
+		{name: "invalid_token", authorization: "Bearer nobody", wantStatus: consts.StatusUnauthorized},
+		{name: "missing_permission", authorization: "Bearer user", wantStatus: consts.StatusForbidden},
+		{name: "resolver_error", authorization: "Bearer broken", wantStatus: consts.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *Principal
+			c := app.NewContext(16)
+			if tt.authorization != "" {
+				c.Request.Header.Set("Authorization", tt.authorization)
+			}
+			c.SetHandlers(app.HandlersChain{
+				Require(testResolver, "role:assign"),
+				func(ctx context.Context, c *app.RequestContext) {
+					got, _ = FromContext(ctx)
+					c.Status(consts.StatusOK)
+				},
+			})
+			c.Next(context.Background())
+
+			assert.Equal(t, tt.wantStatus, c.Response.StatusCode())
+			if tt.wantUserID == 0 {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantUserID, got.UserID)
+		})
+	}
+}
+
+// tokenArgs 模拟 Kitex 生成的请求参数
+type tokenArgs struct{ token string }
+
+func (a *tokenArgs) GetToken() string { return a.token }
+
+type kitexArgs struct{ req *tokenArgs }
+
+func (a *kitexArgs) GetFirstArgument() interface{} { return a.req }
+
+func TestKitexMiddleware(t *testing.T) {
+	rules := Rules{"AssignRole": {"role:assign"}}
+	mw := KitexMiddleware(testResolver, rules)
+
+	var got *Principal
+	next := func(ctx context.Context, req, resp interface{}) error {
+		got, _ = FromContext(ctx)
+		return nil
+	}
+	call := func(ctx context.Context, method string, req interface{}) error {
+		got = nil
+		ri := rpcinfo.NewRPCInfo(nil, rpcinfo.NewEndpointInfo("auth", method, nil, nil), nil, nil, nil)
+		return mw(next)(rpcinfo.NewCtxWithRPCInfo(ctx, ri), req, nil)
+	}
+	ctx := context.Background()
+
+	// 令牌从请求字段读取
+	require.NoError(t, call(ctx, "AssignRole", &kitexArgs{req: &tokenArgs{token: "admin"}}))
+	require.NotNil(t, got)
+	assert.Equal(t, int64(1), got.UserID)
+
+	assert.ErrorIs(t, call(ctx, "AssignRole", &kitexArgs{req: &tokenArgs{token: "user"}}), ErrForbidden)
+	assert.ErrorIs(t, call(ctx, "AssignRole", &kitexArgs{req: &tokenArgs{}}), ErrUnauthenticated)
+
+	// metainfo 中的令牌优先于请求字段
+	metaCtx := metainfo.WithPersistentValue(ctx, MetaKeyToken, "Bearer admin")
+	require.NoError(t, call(metaCtx, "AssignRole", &kitexArgs{req: &tokenArgs{token: "user"}}))
+	assert.Equal(t, int64(1), got.UserID)
+
+	// 未配置规则的方法不做检查
+	require.NoError(t, call(ctx, "Login", &kitexArgs{req: &tokenArgs{}}))
+	assert.Nil(t, got)
+}
+
+func TestIdentityResolver(t *testing.T) {
+	run := func(ctx context.Context) int {
+		c := app.NewContext(16)
+		c.Request.Header.Set("Authorization", "Bearer token")
+		c.SetHandlers(app.HandlersChain{
+			Require(IdentityResolver, "order:refund"),
+			func(ctx context.Context, c *app.RequestContext) {
+				c.Status(consts.StatusOK)
+			},
+		})
+		c.Next(ctx)
+		return c.Response.StatusCode()
+	}
+
+	assert.Equal(t, consts.StatusUnauthorized, run(context.Background()))
+	assert.Equal(t, consts.StatusForbidden, run(authn.WithIdentity(context.Background(), &authn.Identity{UserID: 2, Roles: []string{"user"}})))
+	assert.Equal(t, consts.StatusOK, run(authn.WithIdentity(context.Background(), &authn.Identity{
+		UserID:      3,
+		Roles:       []string{"user", "support"},
+		Permissions: []string{"user:read", "order:refund"},
+	})))
+}
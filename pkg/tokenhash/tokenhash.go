@@ -0,0 +1,29 @@
+package tokenhash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// DigestLength 十六进制摘要长度
+const DigestLength = sha256.Size * 2
+
+// Digest 计算令牌的 SHA-256 摘要，数据库和 Redis 中只保存摘要，auth 与 user 服务共用
+func Digest(token string) string {
+	sum := sha256.Sum256([]byte(strings.TrimPrefix(token, "Bearer ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsDigest 判断是否已是摘要，用于识别迁移前保存的明文令牌
+func IsDigest(s string) bool {
+	if len(s) != DigestLength {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,24 @@
+package tokenhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigest(t *testing.T) {
+	digest := Digest("access-token")
+	assert.Len(t, digest, DigestLength)
+	assert.NotContains(t, digest, "access-token")
+	assert.Equal(t, digest, Digest("Bearer access-token"))
+	assert.NotEqual(t, digest, Digest("other-token"))
+}
+
+func TestIsDigest(t *testing.T) {
+	assert.True(t, IsDigest(Digest("access-token")))
+	assert.False(t, IsDigest("eyJhbGciOiJSUzI1NiJ9.eyJ1aWQiOjF9.c2ln"))
+	assert.False(t, IsDigest("dGhpcy1pcy1hLXJhbmRvbS1yZWZyZXNoLXRva2VuLTMyYg=="))
+	assert.False(t, IsDigest(""))
+	// 大写十六进制不是本包生成的摘要
+	assert.False(t, IsDigest("ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789"))
+}
@@ -0,0 +1,149 @@
+package userdata
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ExportTTL 导出档案的保存时间，过期后需要重新发起导出
+const ExportTTL = 7 * 24 * time.Hour
+
+// Services 参与导出的服务，全部提交数据后导出完成
+var Services = []string{"user", "auth", "cart", "order", "payment"}
+
+const (
+	exportKeyPrefix = "userdata:export:"
+
+	// 导出任务元数据字段，以下划线开头，与服务名区分
+	fieldUserID      = "_user_id"
+	fieldRequestedAt = "_requested_at"
+)
+
+// Export 导出任务，Parts 为已提交数据的服务及其数据
+type Export struct {
+	ID          string
+	UserID      int64
+	RequestedAt time.Time
+	Parts       map[string]json.RawMessage
+}
+
+// Pending 尚未提交数据的服务
+func (e *Export) Pending() []string {
+	var pending []string
+	for _, svc := range Services {
+		if _, ok := e.Parts[svc]; !ok {
+			pending = append(pending, svc)
+		}
+	}
+	return pending
+}
+
+// Ready 所有服务是否都已提交数据
+func (e *Export) Ready() bool {
+	return len(e.Pending()) == 0
+}
+
+// Archive 生成可下载的 JSON 档案
+func (e *Export) Archive() ([]byte, error) {
+	return json.MarshalIndent(&struct {
+		ExportID    string                     `json:"export_id"`
+		UserID      int64                      `json:"user_id"`
+		RequestedAt time.Time                  `json:"requested_at"`
+		GeneratedAt time.Time                  `json:"generated_at"`
+		Data        map[string]json.RawMessage `json:"data"`
+	}{
+		ExportID:    e.ID,
+		UserID:      e.UserID,
+		RequestedAt: e.RequestedAt,
+		GeneratedAt: time.Now(),
+		Data:        e.Parts,
+	}, "", "  ")
+}
+
+// ExportStore 导出任务存储，每个任务一个 Redis Hash，各服务的数据保存在以服务名为字段的 JSON 中
+type ExportStore struct {
+	rdb redis.UniversalClient
+}
+
+// NewExportStore 创建导出任务存储
+func NewExportStore(rdb redis.UniversalClient) *ExportStore {
+	return &ExportStore{rdb: rdb}
+}
+
+// Create 创建导出任务
+func (s *ExportStore) Create(ctx context.Context, userID int64) (*Export, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("generate export id failed: %w", err)
+	}
+	export := &Export{
+		ID:          hex.EncodeToString(buf),
+		UserID:      userID,
+		RequestedAt: time.Now(),
+		Parts:       map[string]json.RawMessage{},
+	}
+
+	key := exportKeyPrefix + export.ID
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, fieldUserID, userID, fieldRequestedAt, export.RequestedAt.Unix())
+		pipe.Expire(ctx, key, ExportTTL)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create export failed: %w", err)
+	}
+	return export, nil
+}
+
+// SavePart 保存服务导出的数据，任务不存在或已过期时忽略
+func (s *ExportStore) SavePart(ctx context.Context, exportID, service string, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal %s export data failed: %w", service, err)
+	}
+	key := exportKeyPrefix + exportID
+	n, err := s.rdb.Exists(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("check export failed: %w", err)
+	}
+	if n == 0 {
+		return nil
+	}
+	if err := s.rdb.HSet(ctx, key, service, raw).Err(); err != nil {
+		return fmt.Errorf("save %s export data failed: %w", service, err)
+	}
+	return nil
+}
+
+// Get 查询导出任务，不存在或已过期时返回 nil
+func (s *ExportStore) Get(ctx context.Context, exportID string) (*Export, error) {
+	values, err := s.rdb.HGetAll(ctx, exportKeyPrefix+exportID).Result()
+	if err != nil {
+		return nil, fmt.Errorf("get export failed: %w", err)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	export := &Export{ID: exportID, Parts: map[string]json.RawMessage{}}
+	for field, value := range values {
+		switch {
+		case field == fieldUserID:
+			export.UserID, _ = strconv.ParseInt(value, 10, 64)
+		case field == fieldRequestedAt:
+			sec, _ := strconv.ParseInt(value, 10, 64)
+			export.RequestedAt = time.Unix(sec, 0)
+		case !strings.HasPrefix(field, "_"):
+			export.Parts[field] = json.RawMessage(value)
+		}
+	}
+	return export, nil
+}
@@ -0,0 +1,92 @@
+// Package userdata 个人数据导出和账号注销的跨服务协作
+// 用户服务通过事件总线发布导出请求和注销事件，各服务订阅后导出或匿名化自己保存的用户数据
+package userdata
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"TikTokMall/pkg/eventbus"
+)
+
+// Stream 个人数据事件所在的 Redis Stream
+const Stream = "events:userdata"
+
+// 事件类型
+const (
+	EventExportRequested = "user.export_requested"
+	EventErased          = "user.erased"
+)
+
+// ExportRequested 导出请求事件
+type ExportRequested struct {
+	ExportID string `json:"export_id"`
+	UserID   int64  `json:"user_id"`
+}
+
+// Erased 注销事件，宽限期结束后发布，收到后各服务删除或匿名化该用户的个人数据
+type Erased struct {
+	UserID   int64     `json:"user_id"`
+	ErasedAt time.Time `json:"erased_at"`
+}
+
+// Handler 服务对个人数据事件的处理
+type Handler interface {
+	// Export 返回服务保存的用户数据，结果序列化为 JSON 后放入导出档案
+	Export(ctx context.Context, userID int64) (interface{}, error)
+	// Erase 删除或匿名化用户的个人数据，事件可能重复投递，必须幂等
+	Erase(ctx context.Context, userID int64) error
+}
+
+// Subscribe 以服务名为消费组订阅个人数据事件，阻塞直到 ctx 取消
+func Subscribe(ctx context.Context, rdb redis.UniversalClient, service string, h Handler) error {
+	store := NewExportStore(rdb)
+	bus := eventbus.New(rdb, Stream)
+	return bus.Subscribe(ctx, service, eventbus.ConsumerName(), func(ctx context.Context, ev eventbus.Event) error {
+		switch ev.Type {
+		case EventExportRequested:
+			var req ExportRequested
+			if err := ev.Decode(&req); err != nil {
+				return err
+			}
+			data, err := h.Export(ctx, req.UserID)
+			if err != nil {
+				return err
+			}
+			return store.SavePart(ctx, req.ExportID, service, data)
+		case EventErased:
+			var erased Erased
+			if err := ev.Decode(&erased); err != nil {
+				return err
+			}
+			return h.Erase(ctx, erased.UserID)
+		}
+		return nil
+	})
+}
+
+// RequestExport 创建导出任务并通知各服务提交数据
+func RequestExport(ctx context.Context, rdb redis.UniversalClient, userID int64) (*Export, error) {
+	export, err := NewExportStore(rdb).Create(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	err = eventbus.New(rdb, Stream).Publish(ctx, EventExportRequested, &ExportRequested{
+		ExportID: export.ID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// PublishErased 发布注销事件
+func PublishErased(ctx context.Context, rdb redis.UniversalClient, userID int64) error {
+	return eventbus.New(rdb, Stream).Publish(ctx, EventErased, &Erased{
+		UserID:   userID,
+		ErasedAt: time.Now(),
+	})
+}
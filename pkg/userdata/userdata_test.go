@@ -0,0 +1,143 @@
+package userdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHandler 记录注销的用户，导出固定数据
+type fakeHandler struct {
+	mu     sync.Mutex
+	data   interface{}
+	err    error
+	erased []int64
+}
+
+func (h *fakeHandler) Export(ctx context.Context, userID int64) (interface{}, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+	return h.data, nil
+}
+
+func (h *fakeHandler) Erase(ctx context.Context, userID int64) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.erased = append(h.erased, userID)
+	return nil
+}
+
+func (h *fakeHandler) erasedUsers() []int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]int64(nil), h.erased...)
+}
+
+func newTestClient(t *testing.T) *redis.Client {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return rdb
+}
+
+func subscribeAll(t *testing.T, rdb *redis.Client, handlers map[string]Handler) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for svc, h := range handlers {
+		wg.Add(1)
+		go func(svc string, h Handler) {
+			defer wg.Done()
+			assert.NoError(t, Subscribe(ctx, rdb, svc, h))
+		}(svc, h)
+	}
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestExport_CollectsAllServices(t *testing.T) {
+	rdb := newTestClient(t)
+	ctx := context.Background()
+
+	handlers := map[string]Handler{}
+	for _, svc := range Services {
+		handlers[svc] = &fakeHandler{data: map[string]string{"service": svc}}
+	}
+	subscribeAll(t, rdb, handlers)
+
+	export, err := RequestExport(ctx, rdb, 42)
+	require.NoError(t, err)
+	assert.False(t, export.Ready())
+
+	store := NewExportStore(rdb)
+	require.Eventually(t, func() bool {
+		got, err := store.Get(ctx, export.ID)
+		return err == nil && got != nil && got.Ready()
+	}, 10*time.Second, 20*time.Millisecond)
+
+	got, err := store.Get(ctx, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), got.UserID)
+	assert.Empty(t, got.Pending())
+
+	archive, err := got.Archive()
+	require.NoError(t, err)
+	var decoded struct {
+		ExportID string                       `json:"export_id"`
+		UserID   int64                        `json:"user_id"`
+		Data     map[string]map[string]string `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(archive, &decoded))
+	assert.Equal(t, export.ID, decoded.ExportID)
+	assert.Equal(t, int64(42), decoded.UserID)
+	for _, svc := range Services {
+		assert.Equal(t, svc, decoded.Data[svc]["service"])
+	}
+}
+
+func TestExport_PendingServices(t *testing.T) {
+	rdb := newTestClient(t)
+	ctx := context.Background()
+	store := NewExportStore(rdb)
+
+	export, err := store.Create(ctx, 7)
+	require.NoError(t, err)
+	require.NoError(t, store.SavePart(ctx, export.ID, "user", map[string]int{"id": 7}))
+
+	got, err := store.Get(ctx, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"auth", "cart", "order", "payment"}, got.Pending())
+
+	// 不存在的任务
+	got, err = store.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	require.NoError(t, store.SavePart(ctx, "missing", "user", nil))
+	got, err = store.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, got, "过期的任务不会被重新创建")
+}
+
+func TestErased_DeliveredToEveryService(t *testing.T) {
+	rdb := newTestClient(t)
+	ctx := context.Background()
+
+	cart, order := &fakeHandler{}, &fakeHandler{err: errors.New("export not used")}
+	subscribeAll(t, rdb, map[string]Handler{"cart": cart, "order": order})
+
+	require.NoError(t, PublishErased(ctx, rdb, 9))
+	for _, h := range []*fakeHandler{cart, order} {
+		require.Eventually(t, func() bool { return len(h.erasedUsers()) == 1 }, 10*time.Second, 20*time.Millisecond)
+		assert.Equal(t, []int64{9}, h.erasedUsers())
+	}
+}
@@ -0,0 +1,48 @@
+package websession
+
+import (
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/cors"
+)
+
+// defaultCORSHeaders 所有服务默认允许的请求头
+var defaultCORSHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", CSRFHeader, ModeHeader}
+
+// CORSConfig 跨域配置
+type CORSConfig struct {
+	AllowOrigins  []string // 允许的来源，如 https://shop.example.com；为空时拒绝所有跨域请求
+	AllowHeaders  []string // 默认请求头之外额外允许的请求头
+	ExposeHeaders []string
+}
+
+// CORS 返回跨域中间件，只允许配置的来源携带 Cookie 访问
+// 包含 "*" 时允许所有来源，但浏览器不会携带 Cookie，只能使用 Bearer 令牌
+func CORS(cfg CORSConfig) app.HandlerFunc {
+	conf := cors.Config{
+		AllowMethods:  []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowHeaders:  append(append([]string{}, defaultCORSHeaders...), cfg.AllowHeaders...),
+		ExposeHeaders: append([]string{CSRFHeader}, cfg.ExposeHeaders...),
+		MaxAge:        time.Hour,
+	}
+	switch {
+	case len(cfg.AllowOrigins) == 0:
+		conf.AllowOriginFunc = func(string) bool { return false }
+	case contains(cfg.AllowOrigins, "*"):
+		conf.AllowAllOrigins = true
+	default:
+		conf.AllowOrigins = cfg.AllowOrigins
+		conf.AllowCredentials = true
+	}
+	return cors.New(conf)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package websession
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// Middleware 返回 Hertz 中间件，处理使用 Cookie 会话的浏览器请求：
+// 携带会话 Cookie 的写请求必须在 X-CSRF-Token 头中提交与 csrf_token Cookie 相同的令牌，
+// 校验通过后将 Cookie 中的访问令牌写入 Authorization 头，之后的认证中间件和处理器无需区分两种模式
+// 已携带 Authorization 头的请求不是浏览器自动发送的，不做处理
+func Middleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if len(c.GetHeader("Authorization")) > 0 {
+			c.Next(ctx)
+			return
+		}
+		access := AccessToken(c)
+		if access == "" && RefreshToken(c) == "" {
+			c.Next(ctx)
+			return
+		}
+		if !safeMethod(string(c.Method())) && !validCSRF(c) {
+			c.AbortWithStatusJSON(consts.StatusForbidden, utils.H{
+				"code":    consts.StatusForbidden,
+				"message": "invalid csrf token",
+			})
+			return
+		}
+		if access != "" {
+			c.Request.Header.Set("Authorization", "Bearer "+access)
+		}
+		c.Next(ctx)
+	}
+}
+
+// validCSRF 校验请求头中的 CSRF 令牌与 Cookie 一致
+func validCSRF(c *app.RequestContext) bool {
+	cookie := c.Cookie(CSRFCookie)
+	header := c.GetHeader(CSRFHeader)
+	return len(cookie) > 0 && subtle.ConstantTimeCompare(cookie, header) == 1
+}
+
+// safeMethod 不修改状态的请求方法，不需要校验 CSRF 令牌
+func safeMethod(method string) bool {
+	switch method {
+	case consts.MethodGet, consts.MethodHead, consts.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package websession
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// responseCookie 读取响应中设置的 Cookie
+func responseCookie(t *testing.T, c *app.RequestContext, name string) *protocol.Cookie {
+	cookie := protocol.AcquireCookie()
+	cookie.SetKey(name)
+	require.True(t, c.Response.Header.Cookie(cookie), "cookie %s not set", name)
+	return cookie
+}
+
+func TestSetSession(t *testing.T) {
+	c := app.NewContext(16)
+	cfg := CookieConfig{Domain: "shop.example.com", Secure: true, SameSite: "strict", RefreshPath: "/v1/auth/session"}
+	csrf, err := SetSession(c, cfg, "access", time.Hour, "refresh", 24*time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, csrf, 43)
+
+	access := responseCookie(t, c, AccessCookie)
+	assert.Equal(t, "access", string(access.Value()))
+	assert.True(t, access.HTTPOnly())
+	assert.True(t, access.Secure())
+	assert.Equal(t, protocol.CookieSameSiteStrictMode, access.SameSite())
+	assert.Equal(t, 3600, access.MaxAge())
+	assert.Equal(t, "/", string(access.Path()))
+
+	refresh := responseCookie(t, c, RefreshCookie)
+	assert.Equal(t, "refresh", string(refresh.Value()))
+	assert.True(t, refresh.HTTPOnly())
+	assert.Equal(t, "/v1/auth/session", string(refresh.Path()))
+
+	// CSRF 令牌需要被页面脚本读取
+	token := responseCookie(t, c, CSRFCookie)
+	assert.Equal(t, csrf, string(token.Value()))
+	assert.False(t, token.HTTPOnly())
+	assert.Equal(t, csrf, string(c.Response.Header.Peek(CSRFHeader)))
+
+	ClearSession(c, cfg)
+	assert.Empty(t, responseCookie(t, c, AccessCookie).Value())
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		cookies       map[string]string
+		csrfHeader    string
+		wantStatus    int
+		wantAuth      string
+	}{
+		{name: "no_session", method: consts.MethodPost, wantStatus: consts.StatusOK},
+		{name: "bearer", method: consts.MethodPost, authorization: "Bearer header", cookies: map[string]string{AccessCookie: "cookie"}, wantStatus: consts.StatusOK, wantAuth: "Bearer header"},
+		{name: "safe_method", method: consts.MethodGet, cookies: map[string]string{AccessCookie: "cookie"}, wantStatus: consts.StatusOK, wantAuth: "Bearer cookie"},
+		{name: "valid_csrf", method: consts.MethodPost, cookies: map[string]string{AccessCookie: "cookie", CSRFCookie: "t0k"}, csrfHeader: "t0k", wantStatus: consts.StatusOK, wantAuth: "Bearer cookie"},
+		{name: "missing_csrf", method: consts.MethodPost, cookies: map[string]string{AccessCookie: "cookie", CSRFCookie: "t0k"}, wantStatus: consts.StatusForbidden},
+		{name: "wrong_csrf", method: consts.MethodDelete, cookies: map[string]string{AccessCookie: "cookie", CSRFCookie: "t0k"}, csrfHeader: "other", wantStatus: consts.StatusForbidden},
+		{name: "missing_csrf_cookie", method: consts.MethodPost, cookies: map[string]string{AccessCookie: "cookie"}, csrfHeader: "", wantStatus: consts.StatusForbidden},
+		{name: "refresh_only", method: consts.MethodPost, cookies: map[string]string{RefreshCookie: "refresh"}, wantStatus: consts.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var auth string
+			c := app.NewContext(16)
+			c.Request.Header.SetMethod(tt.method)
+			if tt.authorization != "" {
+				c.Request.Header.Set("Authorization", tt.authorization)
+			}
+			for k, v := range tt.cookies {
+				c.Request.Header.SetCookie(k, v)
+			}
+			if tt.csrfHeader != "" {
+				c.Request.Header.Set(CSRFHeader, tt.csrfHeader)
+			}
+			c.SetHandlers(app.HandlersChain{
+				Middleware(),
+				func(ctx context.Context, c *app.RequestContext) {
+					auth = string(c.GetHeader("Authorization"))
+					c.Status(consts.StatusOK)
+				},
+			})
+			c.Next(context.Background())
+
+			assert.Equal(t, tt.wantStatus, c.Response.StatusCode())
+			assert.Equal(t, tt.wantAuth, auth)
+		})
+	}
+}
+
+func TestCORS(t *testing.T) {
+	preflight := func(h app.HandlerFunc, origin string) *app.RequestContext {
+		c := app.NewContext(16)
+		c.Request.Header.SetMethod(consts.MethodOptions)
+		c.Request.Header.Set("Origin", origin)
+		c.Request.Header.Set("Access-Control-Request-Method", consts.MethodPost)
+		c.SetHandlers(app.HandlersChain{h})
+		c.Next(context.Background())
+		return c
+	}
+
+	h := CORS(CORSConfig{AllowOrigins: []string{"https://shop.example.com"}})
+	c := preflight(h, "https://shop.example.com")
+	assert.Equal(t, "https://shop.example.com", string(c.Response.Header.Peek("Access-Control-Allow-Origin")))
+	assert.Equal(t, "true", string(c.Response.Header.Peek("Access-Control-Allow-Credentials")))
+	assert.Contains(t, string(c.Response.Header.Peek("Access-Control-Allow-Headers")), "X-Csrf-Token")
+
+	c = preflight(h, "https://evil.example.com")
+	assert.Equal(t, consts.StatusForbidden, c.Response.StatusCode())
+
+	// 未配置来源时拒绝所有跨域请求
+	c = preflight(CORS(CORSConfig{}), "https://shop.example.com")
+	assert.Equal(t, consts.StatusForbidden, c.Response.StatusCode())
+
+	// 允许所有来源时不允许携带 Cookie
+	c = preflight(CORS(CORSConfig{AllowOrigins: []string{"*"}}), "https://any.example.com")
+	assert.Equal(t, "*", string(c.Response.Header.Peek("Access-Control-Allow-Origin")))
+	assert.Empty(t, c.Response.Header.Peek("Access-Control-Allow-Credentials"))
+}
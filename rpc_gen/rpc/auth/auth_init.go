@@ -9,7 +9,7 @@ import (
 var (
 	// todo edit custom config
 	defaultClient     RPCClient
-	defaultDstService = "auth"
+	defaultDstService = "auth-rpc"
 	defaultClientOpts = []client.Option{
 		client.WithHostPorts("127.0.0.1:8888"),
 	}